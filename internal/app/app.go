@@ -1,86 +1,145 @@
 package app
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
-	"strconv"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/VikaPaz/algalar/internal/config"
+	"github.com/VikaPaz/algalar/internal/metrics"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/VikaPaz/algalar/internal/notify"
 	"github.com/VikaPaz/algalar/internal/repository"
 	authRepository "github.com/VikaPaz/algalar/internal/repository/auth"
 	"github.com/VikaPaz/algalar/internal/server"
 	"github.com/VikaPaz/algalar/internal/server/rest"
 	"github.com/VikaPaz/algalar/internal/service"
 	authService "github.com/VikaPaz/algalar/internal/service/auth"
+	"github.com/VikaPaz/algalar/internal/tracing"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
-	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 )
 
 func Run() {
-	logger := NewLogger(logrus.DebugLevel, &logrus.TextFormatter{
+	logger := NewLogger(logrus.InfoLevel, &logrus.TextFormatter{
 		FullTimestamp: true,
 	})
 
-	if err := godotenv.Overload("env/.env"); err != nil {
-		logger.Errorf("Error loading .env file: %v", err)
+	conf, err := config.Load(os.Args[1:])
+	if err != nil {
+		logger.Errorf("Error loading configuration: %v", err)
 		return
 	}
 
-	confPostgres := repository.Config{
-		Host:     os.Getenv("DB_HOST"),
-		Port:     os.Getenv("POSTGRES_PORT"),
-		User:     os.Getenv("USER"),
-		Password: os.Getenv("PASSWORD"),
-		Dbname:   os.Getenv("DB_NAME"),
-	}
+	level, _ := logrus.ParseLevel(conf.Log.Level)
+	logger.SetLevel(level)
+	logger.SetFormatter(conf.Log.Formatter())
 
-	logger.Debugf("config: %v", confPostgres)
+	logger.AddHook(tracing.LogHook{})
 
-	port := os.Getenv("PORT")
+	// The access log is always JSON, one line per request, so that it can be
+	// shipped as is regardless of the format of the application log.
+	accessLogger := NewLogger(logrus.InfoLevel, &logrus.JSONFormatter{})
+	accessLogger.AddHook(tracing.LogHook{})
+
+	logger.Debugf("config:\n%s", conf)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:    conf.Tracing.Exporter,
+		Endpoint:    conf.Tracing.OTLPEndpoint,
+		Insecure:    conf.Tracing.OTLPInsecure,
+		ServiceName: conf.Tracing.ServiceName,
+		SampleRatio: conf.Tracing.SampleRatio,
+	})
+	if err != nil {
+		logger.Errorf("Error setting up tracing: %v", err)
+		return
+	}
+
+	confPostgres := repository.Config{
+		Host:            conf.Database.Host,
+		Port:            conf.Database.Port,
+		User:            conf.Database.User,
+		Password:        conf.Database.Password,
+		Dbname:          conf.Database.Name,
+		MaxOpenConns:    conf.Database.MaxOpenConns,
+		MaxIdleConns:    conf.Database.MaxIdleConns,
+		ConnMaxLifetime: conf.Database.ConnMaxLifetime.Duration,
+	}
 
 	dbConn, err := repository.Connection(confPostgres)
 	if err != nil {
-		logger.Errorf("Error connecting to database: %v, config: %v", err, confPostgres)
+		logger.Errorf("Error connecting to database %s at %s:%s: %v", confPostgres.Dbname, confPostgres.Host, confPostgres.Port, err)
 		return
 	}
 	logger.Infof("Connected to PostgreSQL")
+	defer func() {
+		if err := dbConn.Close(); err != nil {
+			logger.Errorf("Error closing database connection: %v", err)
+		}
+		logger.Infof("Database connection closed")
+	}()
 
-	repo := repository.NewRepository(dbConn, logger)
+	timeouts := repository.Timeouts{
+		Read:   conf.Database.ReadTimeout.Duration,
+		Write:  conf.Database.WriteTimeout.Duration,
+		Report: conf.Database.ReportTimeout.Duration,
+	}
 
-	authRepo := authRepository.NewRepository(dbConn, logger)
+	repo := repository.NewRepository(dbConn, logger, timeouts)
 
-	svc := service.NewService(repo, logger)
+	authRepo := authRepository.NewRepository(dbConn, logger, timeouts)
 
-	var accessSigningKey, refreshSigningKey string
-	var accsessTTL, refreshTTL int
-	accessSigningKey = os.Getenv("JWT_ACCESS_SIGNING_KEY")
-	accsessTTL, err = strconv.Atoi(os.Getenv("JWT_ACCESS_TTL_SEC"))
-	if err != nil {
-		logger.Errorf("Error loading JWT_ACCESS_TTL from .env file: %v", err)
-		return
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(dbConn, confPostgres.Dbname),
+	)
+	appMetrics := metrics.New(registry)
+
+	svc := service.NewService(repo, appMetrics, logger)
+
+	notifications := conf.Notifications
+	svc.SetSender(models.ChannelWebhook, notify.NewWebhook(notifications.SendTimeout.Duration))
+	if notifications.SMTP.Addr != "" {
+		svc.SetSender(models.ChannelEmail, notify.NewEmail(notifications.SMTP.Addr, notifications.SMTP.Username,
+			notifications.SMTP.Password, notifications.SMTP.From))
 	}
-	refreshSigningKey = os.Getenv("JWT_REFRESH_SIGNING_KEY")
-	refreshTTL, err = strconv.Atoi(os.Getenv("JWT_REFRESH_TTL_MIN"))
-	if err != nil {
-		logger.Errorf("Error loading JWT_REFRESH_TTL from .env file: %v", err)
-		return
+	if notifications.SMS.GatewayURL != "" {
+		svc.SetSender(models.ChannelSMS, notify.NewSMS(notifications.SMS.GatewayURL, notifications.SMS.Token,
+			notifications.SendTimeout.Duration))
+	}
+	if notifications.Telegram.BotToken != "" {
+		svc.SetSender(models.ChannelTelegram, notify.NewTelegram(notifications.Telegram.APIURL, notifications.Telegram.BotToken,
+			notifications.SendTimeout.Duration))
 	}
+	svc.SetWebhookClient(notify.NewSignedWebhook(conf.Webhooks.Timeout.Duration))
 
 	confAuth := authService.Config{
-		AccessSigningKey:  accessSigningKey,
-		RefreshSigningKey: refreshSigningKey,
-		AccessTTL:         time.Duration(accsessTTL) * time.Second,
-		RefreshTTL:        time.Duration(refreshTTL) * time.Minute,
+		AccessSigningKey:    conf.Auth.AccessSigningKey,
+		RefreshSigningKey:   conf.Auth.RefreshSigningKey,
+		ChallengeSigningKey: conf.Auth.ChallengeSigningKey,
+		AccessTTL:           conf.Auth.AccessTTL.Duration,
+		RefreshTTL:          conf.Auth.RefreshTTL.Duration,
+		ChallengeTTL:        conf.Auth.ChallengeTTL.Duration,
+		TOTPIssuer:          conf.Auth.TOTPIssuer,
 	}
 
 	auth := authService.NewService(confAuth, authRepo, logger)
 
 	confServer := server.Config{
-		SigningKey: accessSigningKey,
+		SigningKey:     conf.Auth.AccessSigningKey,
+		IdempotencyTTL: conf.Idempotency.TTL.Duration,
 	}
 
 	svr := server.NewServer(confServer, svc, auth, logger)
@@ -88,31 +147,176 @@ func Run() {
 	r := chi.NewRouter()
 
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"*"},
+		AllowedOrigins:   conf.Server.CORS.AllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
 		ExposedHeaders:   []string{"*"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
+	r.Use(server.RequestIDMiddleware)
+	r.Use(server.TracingMiddleware)
+	r.Use(server.RequestLogMiddleware(logger, accessLogger))
+	r.Use(server.RequestMetaMiddleware)
+	r.Use(server.MetricsMiddleware(appMetrics))
+
+	health := server.NewHealth(5*time.Second, logger,
+		server.ReadinessCheck{Name: "database", Check: repo.Ping},
+		server.ReadinessCheck{Name: "migrations", Check: repo.CheckMigrations},
+	)
+
+	r.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	r.Get("/healthz", health.Liveness)
+	r.Get("/readyz", health.Readiness)
 
 	options := rest.ChiServerOptions{
-		BaseRouter: r,
+		BaseRouter:       r,
+		Middlewares:      []rest.MiddlewareFunc{svr.IdempotencyMiddleware},
+		ErrorHandlerFunc: svr.HandleParamError,
 	}
 	router := rest.HandlerWithOptions(svr, options)
 
+	httpServer := &http.Server{
+		Addr:              ":" + conf.Server.Port,
+		Handler:           router,
+		ReadHeaderTimeout: conf.Server.ReadTimeout.Duration,
+		ReadTimeout:       conf.Server.ReadTimeout.Duration,
+		WriteTimeout:      conf.Server.WriteTimeout.Duration,
+		IdleTimeout:       conf.Server.IdleTimeout.Duration,
+	}
+
+	listener, err := net.Listen("tcp", httpServer.Addr)
+	if err != nil {
+		logger.Errorf("Cann't listen on port %s: %v", conf.Server.Port, err)
+		return
+	}
+
+	serverErr := make(chan error, 1)
 	go func() {
-		if err := http.ListenAndServeTLS(":"+port, "env/server.crt", "env/server.key", router); err != nil {
-			logger.Errorf("Cann't run server: %v", err)
+		if conf.Server.TLS.Enabled {
+			serverErr <- httpServer.ServeTLS(listener, conf.Server.TLS.CertFile, conf.Server.TLS.KeyFile)
 			return
 		}
-		logger.Infof("Run server on port: %s", port)
+		serverErr <- httpServer.Serve(listener)
+	}()
+	logger.Infof("Rest server is running on port: %s (TLS: %t)", conf.Server.Port, conf.Server.TLS.Enabled)
+
+	var workers sync.WaitGroup
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.MonitorSilentDevices(workersCtx,
+			conf.Monitoring.SilentDeviceThreshold.Duration,
+			conf.Monitoring.SilentDeviceCheckInterval.Duration)
+	}()
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.RateDrivers(workersCtx, service.RatingParams{
+			Interval:          conf.Rating.Interval.Duration,
+			Window:            conf.Rating.Window.Duration,
+			SpeedLimit:        conf.Rating.SpeedLimit,
+			HarshAcceleration: conf.Rating.HarshAcceleration,
+		})
+	}()
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.CheckWorkHours(workersCtx, service.WorkHoursParams{
+			Interval: conf.WorkHours.CheckInterval.Duration,
+			Rules: models.WorkRules{
+				MaxContinuousDriving: conf.WorkHours.MaxContinuousDriving.Duration,
+				MinBreak:             conf.WorkHours.MinBreak.Duration,
+				MaxDailyDriving:      conf.WorkHours.MaxDailyDriving.Duration,
+				MinDailyRest:         conf.WorkHours.MinDailyRest.Duration,
+				MaxWeeklyDriving:     conf.WorkHours.MaxWeeklyDriving.Duration,
+			},
+		})
+	}()
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.CheckDocumentExpiry(workersCtx, service.DocumentsParams{
+			Interval: conf.Documents.CheckInterval.Duration,
+			Notice:   conf.Documents.ExpiryNotice.Duration,
+		})
+	}()
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.CheckMaintenance(workersCtx, service.MaintenanceParams{
+			Interval:      conf.Maintenance.CheckInterval.Duration,
+			Notice:        conf.Maintenance.Notice.Duration,
+			MileageNotice: conf.Maintenance.MileageNotice,
+		})
+	}()
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.DeliverNotifications(workersCtx, service.DeliveryParams{
+			Interval:     conf.Notifications.DeliveryInterval.Duration,
+			BatchSize:    conf.Notifications.BatchSize,
+			MaxAttempts:  conf.Notifications.MaxAttempts,
+			RetryBackoff: conf.Notifications.RetryBackoff.Duration,
+		})
+	}()
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.DeliverWebhooks(workersCtx, service.WebhookParams{
+			Interval:     conf.Webhooks.DeliveryInterval.Duration,
+			BatchSize:    conf.Webhooks.BatchSize,
+			MaxAttempts:  conf.Webhooks.MaxAttempts,
+			RetryBackoff: conf.Webhooks.RetryBackoff.Duration,
+			MaxBackoff:   conf.Webhooks.MaxBackoff.Duration,
+			Lease:        conf.Webhooks.Lease.Duration,
+		})
+	}()
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		svc.PruneIdempotencyKeys(workersCtx, conf.Idempotency.PruneInterval.Duration)
 	}()
-	logger.Infof("Rest server is running on port: %s", port)
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	<-sigChan
+
+	select {
+	case sig := <-sigChan:
+		logger.Infof("Received %v, shutting down", sig)
+	case err := <-serverErr:
+		logger.Errorf("Server stopped unexpectedly: %v", err)
+	}
+
+	// Stop accepting traffic first, then let in-flight requests finish before
+	// the background workers and the database they depend on go away.
+	health.SetDraining()
+
+	// Keep serving while the orchestrator notices the failing readiness
+	// probe and takes this instance out of rotation, so that requests routed
+	// in the meantime are not refused.
+	if delay := conf.Server.DrainDelay.Duration; delay > 0 {
+		logger.Infof("Waiting %v for traffic to stop before draining", delay)
+		time.Sleep(delay)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), conf.Server.ShutdownTimeout.Duration)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Errorf("Error draining HTTP server: %v", err)
+	}
+	logger.Infof("HTTP server stopped")
+
+	stopWorkers()
+	workers.Wait()
+	logger.Infof("Background workers stopped")
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Errorf("Error flushing traces: %v", err)
+	}
 }
 
 func NewLogger(level logrus.Level, formatter logrus.Formatter) *logrus.Logger {
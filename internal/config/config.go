@@ -0,0 +1,506 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "[REDACTED]"
+
+// Config is the complete configuration of the server. It is assembled by Load
+// from, in increasing order of precedence: defaults, a YAML or TOML file,
+// environment variables (including those from the .env file) and command line
+// flags.
+type Config struct {
+	Server        ServerConfig        `yaml:"server" toml:"server"`
+	Log           LogConfig           `yaml:"log" toml:"log"`
+	Database      DatabaseConfig      `yaml:"database" toml:"database"`
+	Auth          AuthConfig          `yaml:"auth" toml:"auth"`
+	Monitoring    MonitoringConfig    `yaml:"monitoring" toml:"monitoring"`
+	Rating        RatingConfig        `yaml:"rating" toml:"rating"`
+	WorkHours     WorkHoursConfig     `yaml:"work_hours" toml:"work_hours"`
+	Documents     DocumentsConfig     `yaml:"documents" toml:"documents"`
+	Maintenance   MaintenanceConfig   `yaml:"maintenance" toml:"maintenance"`
+	Notifications NotificationsConfig `yaml:"notifications" toml:"notifications"`
+	Webhooks      WebhooksConfig      `yaml:"webhooks" toml:"webhooks"`
+	Idempotency   IdempotencyConfig   `yaml:"idempotency" toml:"idempotency"`
+	Tracing       TracingConfig       `yaml:"tracing" toml:"tracing"`
+}
+
+type ServerConfig struct {
+	Port            string     `yaml:"port" toml:"port"`
+	TLS             TLSConfig  `yaml:"tls" toml:"tls"`
+	CORS            CORSConfig `yaml:"cors" toml:"cors"`
+	ReadTimeout     Duration   `yaml:"read_timeout" toml:"read_timeout"`
+	WriteTimeout    Duration   `yaml:"write_timeout" toml:"write_timeout"`
+	IdleTimeout     Duration   `yaml:"idle_timeout" toml:"idle_timeout"`
+	DrainDelay      Duration   `yaml:"drain_delay" toml:"drain_delay"`
+	ShutdownTimeout Duration   `yaml:"shutdown_timeout" toml:"shutdown_timeout"`
+}
+
+type TLSConfig struct {
+	Enabled  bool   `yaml:"enabled" toml:"enabled"`
+	CertFile string `yaml:"cert_file" toml:"cert_file"`
+	KeyFile  string `yaml:"key_file" toml:"key_file"`
+}
+
+type CORSConfig struct {
+	AllowedOrigins []string `yaml:"allowed_origins" toml:"allowed_origins"`
+}
+
+type LogConfig struct {
+	Level  string `yaml:"level" toml:"level"`
+	Format string `yaml:"format" toml:"format"`
+}
+
+type DatabaseConfig struct {
+	Host            string   `yaml:"host" toml:"host"`
+	Port            string   `yaml:"port" toml:"port"`
+	User            string   `yaml:"user" toml:"user"`
+	Password        string   `yaml:"password" toml:"password"`
+	Name            string   `yaml:"name" toml:"name"`
+	MaxOpenConns    int      `yaml:"max_open_conns" toml:"max_open_conns"`
+	MaxIdleConns    int      `yaml:"max_idle_conns" toml:"max_idle_conns"`
+	ConnMaxLifetime Duration `yaml:"conn_max_lifetime" toml:"conn_max_lifetime"`
+	ReadTimeout     Duration `yaml:"read_timeout" toml:"read_timeout"`
+	WriteTimeout    Duration `yaml:"write_timeout" toml:"write_timeout"`
+	ReportTimeout   Duration `yaml:"report_timeout" toml:"report_timeout"`
+}
+
+type AuthConfig struct {
+	AccessSigningKey    string   `yaml:"access_signing_key" toml:"access_signing_key"`
+	RefreshSigningKey   string   `yaml:"refresh_signing_key" toml:"refresh_signing_key"`
+	ChallengeSigningKey string   `yaml:"challenge_signing_key" toml:"challenge_signing_key"`
+	AccessTTL           Duration `yaml:"access_ttl" toml:"access_ttl"`
+	RefreshTTL          Duration `yaml:"refresh_ttl" toml:"refresh_ttl"`
+	ChallengeTTL        Duration `yaml:"challenge_ttl" toml:"challenge_ttl"`
+	TOTPIssuer          string   `yaml:"totp_issuer" toml:"totp_issuer"`
+}
+
+type MonitoringConfig struct {
+	SilentDeviceThreshold     Duration `yaml:"silent_device_threshold" toml:"silent_device_threshold"`
+	SilentDeviceCheckInterval Duration `yaml:"silent_device_check_interval" toml:"silent_device_check_interval"`
+}
+
+// RatingConfig controls the periodic recalculation of driver ratings from
+// the behaviour of the last Window.
+type RatingConfig struct {
+	Interval          Duration `yaml:"interval" toml:"interval"`
+	Window            Duration `yaml:"window" toml:"window"`
+	SpeedLimit        float64  `yaml:"speed_limit" toml:"speed_limit"`
+	HarshAcceleration float64  `yaml:"harsh_acceleration" toml:"harsh_acceleration"`
+}
+
+// WorkHoursConfig holds the rest-time rules the work sessions of drivers are
+// checked against every CheckInterval.
+type WorkHoursConfig struct {
+	CheckInterval        Duration `yaml:"check_interval" toml:"check_interval"`
+	MaxContinuousDriving Duration `yaml:"max_continuous_driving" toml:"max_continuous_driving"`
+	MinBreak             Duration `yaml:"min_break" toml:"min_break"`
+	MaxDailyDriving      Duration `yaml:"max_daily_driving" toml:"max_daily_driving"`
+	MinDailyRest         Duration `yaml:"min_daily_rest" toml:"min_daily_rest"`
+	MaxWeeklyDriving     Duration `yaml:"max_weekly_driving" toml:"max_weekly_driving"`
+}
+
+// DocumentsConfig controls the check of driver licences and medical
+// certificates, which raises a notification ExpiryNotice before one expires.
+type DocumentsConfig struct {
+	CheckInterval Duration `yaml:"check_interval" toml:"check_interval"`
+	ExpiryNotice  Duration `yaml:"expiry_notice" toml:"expiry_notice"`
+}
+
+// MaintenanceConfig controls the check of maintenance plans, which records a
+// due task Notice before a plan by interval makes it due, or MileageNotice km
+// before a plan by mileage does.
+type MaintenanceConfig struct {
+	CheckInterval Duration `yaml:"check_interval" toml:"check_interval"`
+	Notice        Duration `yaml:"notice" toml:"notice"`
+	MileageNotice float64  `yaml:"mileage_notice" toml:"mileage_notice"`
+}
+
+// NotificationsConfig controls the delivery of notifications to the channels
+// of the routing rules. Every DeliveryInterval up to BatchSize notifications
+// are routed and as many deliveries attempted; a failed delivery is retried
+// after RetryBackoff, doubled on each attempt, up to MaxAttempts attempts.
+// Email, SMS and Telegram are only available once configured.
+type NotificationsConfig struct {
+	DeliveryInterval Duration       `yaml:"delivery_interval" toml:"delivery_interval"`
+	BatchSize        int            `yaml:"batch_size" toml:"batch_size"`
+	MaxAttempts      int            `yaml:"max_attempts" toml:"max_attempts"`
+	RetryBackoff     Duration       `yaml:"retry_backoff" toml:"retry_backoff"`
+	SendTimeout      Duration       `yaml:"send_timeout" toml:"send_timeout"`
+	SMTP             SMTPConfig     `yaml:"smtp" toml:"smtp"`
+	SMS              SMSConfig      `yaml:"sms" toml:"sms"`
+	Telegram         TelegramConfig `yaml:"telegram" toml:"telegram"`
+}
+
+type SMTPConfig struct {
+	Addr     string `yaml:"addr" toml:"addr"`
+	Username string `yaml:"username" toml:"username"`
+	Password string `yaml:"password" toml:"password"`
+	From     string `yaml:"from" toml:"from"`
+}
+
+// SMSConfig points to an HTTP SMS gateway.
+type SMSConfig struct {
+	GatewayURL string `yaml:"gateway_url" toml:"gateway_url"`
+	Token      string `yaml:"token" toml:"token"`
+}
+
+type TelegramConfig struct {
+	BotToken string `yaml:"bot_token" toml:"bot_token"`
+	APIURL   string `yaml:"api_url" toml:"api_url"`
+}
+
+// WebhooksConfig controls the delivery of fleet events to the webhook
+// endpoints of companies. Every DeliveryInterval up to BatchSize deliveries
+// are attempted, each with Timeout; a failed delivery is retried after
+// RetryBackoff, doubled on each attempt up to MaxBackoff, and is dead after
+// MaxAttempts attempts.
+type WebhooksConfig struct {
+	DeliveryInterval Duration `yaml:"delivery_interval" toml:"delivery_interval"`
+	BatchSize        int      `yaml:"batch_size" toml:"batch_size"`
+	MaxAttempts      int      `yaml:"max_attempts" toml:"max_attempts"`
+	RetryBackoff     Duration `yaml:"retry_backoff" toml:"retry_backoff"`
+	MaxBackoff       Duration `yaml:"max_backoff" toml:"max_backoff"`
+	Timeout          Duration `yaml:"timeout" toml:"timeout"`
+	Lease            Duration `yaml:"lease" toml:"lease"`
+}
+
+// IdempotencyConfig controls idempotency keys of ingestion requests. The
+// response to a request is kept for TTL, and expired keys are deleted every
+// PruneInterval.
+type IdempotencyConfig struct {
+	TTL           Duration `yaml:"ttl" toml:"ttl"`
+	PruneInterval Duration `yaml:"prune_interval" toml:"prune_interval"`
+}
+
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" toml:"exporter"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint" toml:"otlp_endpoint"`
+	OTLPInsecure bool    `yaml:"otlp_insecure" toml:"otlp_insecure"`
+	ServiceName  string  `yaml:"service_name" toml:"service_name"`
+	SampleRatio  float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+}
+
+// Duration is a time.Duration written as "30s" or "5m" in configuration files.
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+// Default returns the configuration used for every option that no source sets.
+// Secrets and database credentials have no defaults.
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Port: "8080",
+			TLS: TLSConfig{
+				Enabled:  true,
+				CertFile: "env/server.crt",
+				KeyFile:  "env/server.key",
+			},
+			CORS: CORSConfig{
+				AllowedOrigins: []string{"*"},
+			},
+			ReadTimeout:     Duration{15 * time.Second},
+			WriteTimeout:    Duration{60 * time.Second},
+			IdleTimeout:     Duration{120 * time.Second},
+			DrainDelay:      Duration{5 * time.Second},
+			ShutdownTimeout: Duration{30 * time.Second},
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "text",
+		},
+		Database: DatabaseConfig{
+			Host:            "localhost",
+			Port:            "5432",
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: Duration{5 * time.Minute},
+			ReadTimeout:     Duration{5 * time.Second},
+			WriteTimeout:    Duration{5 * time.Second},
+			ReportTimeout:   Duration{30 * time.Second},
+		},
+		Auth: AuthConfig{
+			AccessTTL:    Duration{8 * time.Hour},
+			RefreshTTL:   Duration{20 * 24 * time.Hour},
+			ChallengeTTL: Duration{5 * time.Minute},
+			TOTPIssuer:   "Algalar",
+		},
+		Monitoring: MonitoringConfig{
+			SilentDeviceThreshold:     Duration{30 * time.Minute},
+			SilentDeviceCheckInterval: Duration{time.Minute},
+		},
+		Rating: RatingConfig{
+			Interval:          Duration{time.Hour},
+			Window:            Duration{90 * 24 * time.Hour},
+			SpeedLimit:        90,
+			HarshAcceleration: 3.5,
+		},
+		WorkHours: WorkHoursConfig{
+			CheckInterval:        Duration{15 * time.Minute},
+			MaxContinuousDriving: Duration{4*time.Hour + 30*time.Minute},
+			MinBreak:             Duration{45 * time.Minute},
+			MaxDailyDriving:      Duration{9 * time.Hour},
+			MinDailyRest:         Duration{11 * time.Hour},
+			MaxWeeklyDriving:     Duration{56 * time.Hour},
+		},
+		Documents: DocumentsConfig{
+			CheckInterval: Duration{time.Hour},
+			ExpiryNotice:  Duration{30 * 24 * time.Hour},
+		},
+		Maintenance: MaintenanceConfig{
+			CheckInterval: Duration{time.Hour},
+			Notice:        Duration{7 * 24 * time.Hour},
+			MileageNotice: 500,
+		},
+		Notifications: NotificationsConfig{
+			DeliveryInterval: Duration{10 * time.Second},
+			BatchSize:        100,
+			MaxAttempts:      5,
+			RetryBackoff:     Duration{time.Minute},
+			SendTimeout:      Duration{10 * time.Second},
+			Telegram: TelegramConfig{
+				APIURL: "https://api.telegram.org",
+			},
+		},
+		Webhooks: WebhooksConfig{
+			DeliveryInterval: Duration{5 * time.Second},
+			BatchSize:        100,
+			MaxAttempts:      10,
+			RetryBackoff:     Duration{30 * time.Second},
+			MaxBackoff:       Duration{6 * time.Hour},
+			Timeout:          Duration{10 * time.Second},
+			Lease:            Duration{20 * time.Minute},
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:           Duration{24 * time.Hour},
+			PruneInterval: Duration{time.Hour},
+		},
+		Tracing: TracingConfig{
+			Exporter:     "none",
+			OTLPEndpoint: "localhost:4318",
+			ServiceName:  "algalar",
+			SampleRatio:  1,
+		},
+	}
+}
+
+// Validate reports every invalid option at once, naming the file key and the
+// environment variable that set it.
+func (c Config) Validate() error {
+	var errs []error
+	fail := func(key, env, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s (%s): %s", key, env, fmt.Sprintf(format, args...)))
+	}
+	required := func(key, env, value string) {
+		if value == "" {
+			fail(key, env, "is required")
+		}
+	}
+	port := func(key, env, value string) {
+		if p, err := strconv.Atoi(value); err != nil || p < 1 || p > 65535 {
+			fail(key, env, "must be a port number between 1 and 65535, got %q", value)
+		}
+	}
+	positive := func(key, env string, d Duration) {
+		if d.Duration <= 0 {
+			fail(key, env, "must be positive, got %s", d)
+		}
+	}
+	notNegative := func(key, env string, d Duration) {
+		if d.Duration < 0 {
+			fail(key, env, "must not be negative, got %s", d)
+		}
+	}
+
+	port("server.port", "PORT", c.Server.Port)
+	if c.Server.TLS.Enabled {
+		for _, f := range []struct{ key, env, path string }{
+			{"server.tls.cert_file", "TLS_CERT_FILE", c.Server.TLS.CertFile},
+			{"server.tls.key_file", "TLS_KEY_FILE", c.Server.TLS.KeyFile},
+		} {
+			if f.path == "" {
+				fail(f.key, f.env, "is required when TLS is enabled")
+				continue
+			}
+			if _, err := os.Stat(f.path); err != nil {
+				fail(f.key, f.env, "cannot be read: %v; provide the file or disable TLS with TLS_ENABLED=false", err)
+			}
+		}
+	}
+	if len(c.Server.CORS.AllowedOrigins) == 0 {
+		fail("server.cors.allowed_origins", "CORS_ALLOWED_ORIGINS", "must list at least one origin, use \"*\" to allow any")
+	}
+	positive("server.read_timeout", "HTTP_READ_TIMEOUT_SEC", c.Server.ReadTimeout)
+	positive("server.write_timeout", "HTTP_WRITE_TIMEOUT_SEC", c.Server.WriteTimeout)
+	positive("server.idle_timeout", "HTTP_IDLE_TIMEOUT_SEC", c.Server.IdleTimeout)
+	notNegative("server.drain_delay", "DRAIN_DELAY_SEC", c.Server.DrainDelay)
+	positive("server.shutdown_timeout", "SHUTDOWN_TIMEOUT_SEC", c.Server.ShutdownTimeout)
+
+	if _, err := logrus.ParseLevel(c.Log.Level); err != nil {
+		fail("log.level", "LOG_LEVEL", "must be one of panic, fatal, error, warn, info, debug, trace, got %q", c.Log.Level)
+	}
+	if c.Log.Format != "text" && c.Log.Format != "json" {
+		fail("log.format", "LOG_FORMAT", "must be text or json, got %q", c.Log.Format)
+	}
+
+	required("database.host", "DB_HOST", c.Database.Host)
+	port("database.port", "POSTGRES_PORT", c.Database.Port)
+	required("database.user", "DB_USER", c.Database.User)
+	required("database.name", "DB_NAME", c.Database.Name)
+	if c.Database.MaxOpenConns < 0 {
+		fail("database.max_open_conns", "DB_MAX_OPEN_CONNS", "must not be negative, use 0 for no limit")
+	}
+	if c.Database.MaxIdleConns < 0 {
+		fail("database.max_idle_conns", "DB_MAX_IDLE_CONNS", "must not be negative")
+	}
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		fail("database.max_idle_conns", "DB_MAX_IDLE_CONNS", "must not exceed max_open_conns (%d)", c.Database.MaxOpenConns)
+	}
+	notNegative("database.conn_max_lifetime", "DB_CONN_MAX_LIFETIME_SEC", c.Database.ConnMaxLifetime)
+	notNegative("database.read_timeout", "DB_READ_TIMEOUT_MS", c.Database.ReadTimeout)
+	notNegative("database.write_timeout", "DB_WRITE_TIMEOUT_MS", c.Database.WriteTimeout)
+	notNegative("database.report_timeout", "DB_REPORT_TIMEOUT_MS", c.Database.ReportTimeout)
+
+	required("auth.access_signing_key", "JWT_ACCESS_SIGNING_KEY", c.Auth.AccessSigningKey)
+	required("auth.refresh_signing_key", "JWT_REFRESH_SIGNING_KEY", c.Auth.RefreshSigningKey)
+	required("auth.challenge_signing_key", "JWT_CHALLENGE_SIGNING_KEY", c.Auth.ChallengeSigningKey)
+	positive("auth.access_ttl", "JWT_ACCESS_TTL_SEC", c.Auth.AccessTTL)
+	positive("auth.refresh_ttl", "JWT_REFRESH_TTL_MIN", c.Auth.RefreshTTL)
+	positive("auth.challenge_ttl", "JWT_CHALLENGE_TTL_SEC", c.Auth.ChallengeTTL)
+	required("auth.totp_issuer", "TOTP_ISSUER", c.Auth.TOTPIssuer)
+
+	positive("monitoring.silent_device_threshold", "SILENT_DEVICE_THRESHOLD_MIN", c.Monitoring.SilentDeviceThreshold)
+	positive("monitoring.silent_device_check_interval", "SILENT_DEVICE_CHECK_INTERVAL_SEC", c.Monitoring.SilentDeviceCheckInterval)
+
+	positive("rating.interval", "RATING_INTERVAL_MIN", c.Rating.Interval)
+	positive("rating.window", "RATING_WINDOW_DAYS", c.Rating.Window)
+	if c.Rating.SpeedLimit <= 0 {
+		fail("rating.speed_limit", "RATING_SPEED_LIMIT_KMH", "must be positive, got %g", c.Rating.SpeedLimit)
+	}
+	if c.Rating.HarshAcceleration <= 0 {
+		fail("rating.harsh_acceleration", "RATING_HARSH_ACCELERATION_MS2", "must be positive, got %g", c.Rating.HarshAcceleration)
+	}
+
+	positive("work_hours.check_interval", "WORK_HOURS_CHECK_INTERVAL_MIN", c.WorkHours.CheckInterval)
+	positive("work_hours.max_continuous_driving", "WORK_MAX_CONTINUOUS_DRIVING_MIN", c.WorkHours.MaxContinuousDriving)
+	positive("work_hours.min_break", "WORK_MIN_BREAK_MIN", c.WorkHours.MinBreak)
+	positive("work_hours.max_daily_driving", "WORK_MAX_DAILY_DRIVING_MIN", c.WorkHours.MaxDailyDriving)
+	positive("work_hours.min_daily_rest", "WORK_MIN_DAILY_REST_MIN", c.WorkHours.MinDailyRest)
+	positive("work_hours.max_weekly_driving", "WORK_MAX_WEEKLY_DRIVING_HOURS", c.WorkHours.MaxWeeklyDriving)
+	if c.WorkHours.MinDailyRest.Duration >= 24*time.Hour {
+		fail("work_hours.min_daily_rest", "WORK_MIN_DAILY_REST_MIN", "must be shorter than a day, got %s", c.WorkHours.MinDailyRest.Duration)
+	}
+
+	positive("documents.check_interval", "DOCUMENTS_CHECK_INTERVAL_MIN", c.Documents.CheckInterval)
+	positive("documents.expiry_notice", "DOCUMENTS_EXPIRY_NOTICE_DAYS", c.Documents.ExpiryNotice)
+
+	positive("maintenance.check_interval", "MAINTENANCE_CHECK_INTERVAL_MIN", c.Maintenance.CheckInterval)
+	notNegative("maintenance.notice", "MAINTENANCE_NOTICE_DAYS", c.Maintenance.Notice)
+	if c.Maintenance.MileageNotice < 0 {
+		fail("maintenance.mileage_notice", "MAINTENANCE_MILEAGE_NOTICE_KM", "must not be negative, got %g", c.Maintenance.MileageNotice)
+	}
+
+	positive("notifications.delivery_interval", "NOTIFY_DELIVERY_INTERVAL_SEC", c.Notifications.DeliveryInterval)
+	if c.Notifications.BatchSize < 1 {
+		fail("notifications.batch_size", "NOTIFY_BATCH_SIZE", "must be positive, got %d", c.Notifications.BatchSize)
+	}
+	if c.Notifications.MaxAttempts < 1 {
+		fail("notifications.max_attempts", "NOTIFY_MAX_ATTEMPTS", "must be positive, got %d", c.Notifications.MaxAttempts)
+	}
+	positive("notifications.retry_backoff", "NOTIFY_RETRY_BACKOFF_SEC", c.Notifications.RetryBackoff)
+	positive("notifications.send_timeout", "NOTIFY_SEND_TIMEOUT_SEC", c.Notifications.SendTimeout)
+	if c.Notifications.SMTP.Addr != "" {
+		required("notifications.smtp.from", "SMTP_FROM", c.Notifications.SMTP.From)
+	}
+	if c.Notifications.Telegram.BotToken != "" {
+		required("notifications.telegram.api_url", "TELEGRAM_API_URL", c.Notifications.Telegram.APIURL)
+	}
+
+	positive("webhooks.delivery_interval", "WEBHOOK_DELIVERY_INTERVAL_SEC", c.Webhooks.DeliveryInterval)
+	if c.Webhooks.BatchSize < 1 {
+		fail("webhooks.batch_size", "WEBHOOK_BATCH_SIZE", "must be positive, got %d", c.Webhooks.BatchSize)
+	}
+	if c.Webhooks.MaxAttempts < 1 {
+		fail("webhooks.max_attempts", "WEBHOOK_MAX_ATTEMPTS", "must be positive, got %d", c.Webhooks.MaxAttempts)
+	}
+	positive("webhooks.retry_backoff", "WEBHOOK_RETRY_BACKOFF_SEC", c.Webhooks.RetryBackoff)
+	positive("webhooks.max_backoff", "WEBHOOK_MAX_BACKOFF_MIN", c.Webhooks.MaxBackoff)
+	if c.Webhooks.MaxBackoff.Duration < c.Webhooks.RetryBackoff.Duration {
+		fail("webhooks.max_backoff", "WEBHOOK_MAX_BACKOFF_MIN", "must not be shorter than the retry backoff, got %s", c.Webhooks.MaxBackoff.Duration)
+	}
+	positive("webhooks.timeout", "WEBHOOK_TIMEOUT_SEC", c.Webhooks.Timeout)
+	positive("webhooks.lease", "WEBHOOK_LEASE_MIN", c.Webhooks.Lease)
+	positive("idempotency.ttl", "IDEMPOTENCY_TTL_HOURS", c.Idempotency.TTL)
+	positive("idempotency.prune_interval", "IDEMPOTENCY_PRUNE_INTERVAL_MIN", c.Idempotency.PruneInterval)
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		fail("tracing.exporter", "TRACING_EXPORTER", "must be none, stdout or otlp, got %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.Exporter == "otlp" {
+		required("tracing.otlp_endpoint", "TRACING_OTLP_ENDPOINT", c.Tracing.OTLPEndpoint)
+	}
+	required("tracing.service_name", "TRACING_SERVICE_NAME", c.Tracing.ServiceName)
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		fail("tracing.sample_ratio", "TRACING_SAMPLE_RATIO", "must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Redacted returns a copy of the configuration that is safe to log.
+func (c Config) Redacted() Config {
+	redact := func(s *string) {
+		if *s != "" {
+			*s = redacted
+		}
+	}
+	redact(&c.Database.Password)
+	redact(&c.Auth.AccessSigningKey)
+	redact(&c.Auth.RefreshSigningKey)
+	redact(&c.Auth.ChallengeSigningKey)
+	redact(&c.Notifications.SMTP.Password)
+	redact(&c.Notifications.SMS.Token)
+	redact(&c.Notifications.Telegram.BotToken)
+	c.Server.CORS.AllowedOrigins = append([]string(nil), c.Server.CORS.AllowedOrigins...)
+	return c
+}
+
+// String renders the redacted configuration as YAML.
+func (c Config) String() string {
+	out, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return fmt.Sprintf("config: %v", err)
+	}
+	return string(out)
+}
+
+func (c LogConfig) Formatter() logrus.Formatter {
+	if c.Format == "json" {
+		return &logrus.JSONFormatter{}
+	}
+	return &logrus.TextFormatter{FullTimestamp: true}
+}
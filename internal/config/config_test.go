@@ -0,0 +1,109 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setRequiredEnv(t *testing.T) {
+	t.Setenv("DB_USER", "user")
+	t.Setenv("DB_PASSWORD", "secret-password")
+	t.Setenv("DB_NAME", "algalar")
+	t.Setenv("JWT_ACCESS_SIGNING_KEY", "access-key")
+	t.Setenv("JWT_REFRESH_SIGNING_KEY", "refresh-key")
+	t.Setenv("JWT_CHALLENGE_SIGNING_KEY", "challenge-key")
+	t.Setenv("TLS_ENABLED", "false")
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("PORT", "9100")
+	t.Setenv("DRAIN_DELAY_SEC", "10")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(`
+server:
+  port: "9000"
+  shutdown_timeout: 45s
+log:
+  level: warn
+database:
+  max_open_conns: 50
+`), 0o600)
+	assert.NoError(t, err)
+
+	conf, err := Load([]string{"-config", path, "-log-level", "error"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "9100", conf.Server.Port)
+	assert.Equal(t, "error", conf.Log.Level)
+	assert.Equal(t, 10*time.Second, conf.Server.DrainDelay.Duration)
+	assert.Equal(t, 45*time.Second, conf.Server.ShutdownTimeout.Duration)
+	assert.Equal(t, 50, conf.Database.MaxOpenConns)
+	assert.Equal(t, 5*time.Second, conf.Database.ReadTimeout.Duration)
+}
+
+func TestLoadTOML(t *testing.T) {
+	setRequiredEnv(t)
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	err := os.WriteFile(path, []byte(`
+[log]
+format = "json"
+
+[monitoring]
+silent_device_threshold = "10m"
+`), 0o600)
+	assert.NoError(t, err)
+
+	conf, err := Load([]string{"-config", path})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "json", conf.Log.Format)
+	assert.Equal(t, 10*time.Minute, conf.Monitoring.SilentDeviceThreshold.Duration)
+}
+
+func TestLoadRejectsUnknownFileKeys(t *testing.T) {
+	setRequiredEnv(t)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("server:\n  prot: \"9000\"\n"), 0o600))
+
+	_, err := Load([]string{"-config", path})
+	assert.ErrorContains(t, err, "prot")
+}
+
+func TestLoadMalformedEnv(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("DB_READ_TIMEOUT_MS", "5s")
+
+	_, err := Load(nil)
+	assert.ErrorContains(t, err, "DB_READ_TIMEOUT_MS: must be a whole number of milliseconds")
+}
+
+func TestValidateReportsEveryError(t *testing.T) {
+	conf := Default()
+	conf.Server.TLS.Enabled = false
+	conf.Log.Format = "xml"
+
+	err := conf.Validate()
+	assert.ErrorContains(t, err, "log.format (LOG_FORMAT)")
+	assert.ErrorContains(t, err, "database.user (DB_USER): is required")
+	assert.ErrorContains(t, err, "auth.access_signing_key (JWT_ACCESS_SIGNING_KEY): is required")
+}
+
+func TestStringRedactsSecrets(t *testing.T) {
+	conf := Default()
+	conf.Database.Password = "secret-password"
+	conf.Auth.AccessSigningKey = "access-key"
+
+	out := conf.String()
+	assert.NotContains(t, out, "secret-password")
+	assert.NotContains(t, out, "access-key")
+	assert.Contains(t, out, redacted)
+	assert.Equal(t, "secret-password", conf.Database.Password)
+}
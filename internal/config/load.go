@@ -0,0 +1,307 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultEnvFile = "env/.env"
+
+// Load builds the configuration from defaults, the file named by -config or
+// CONFIG_FILE, the environment and the command line flags in args, in that
+// order of precedence, and validates the result.
+//
+// Variables from the .env file never override ones already set in the
+// process environment.
+func Load(args []string) (Config, error) {
+	flags := flag.NewFlagSet("algalar", flag.ContinueOnError)
+	configFile := flags.String("config", "", "path to a YAML or TOML configuration file (env CONFIG_FILE)")
+	envFile := flags.String("env-file", defaultEnvFile, "path to a .env file")
+	port := flags.String("port", "", "HTTP port (env PORT)")
+	logLevel := flags.String("log-level", "", "log level (env LOG_LEVEL)")
+	logFormat := flags.String("log-format", "", "log format: text or json (env LOG_FORMAT)")
+	tls := flags.Bool("tls", true, "serve HTTPS (env TLS_ENABLED)")
+	tracingExporter := flags.String("tracing-exporter", "", "trace exporter: none, stdout or otlp (env TRACING_EXPORTER)")
+	corsOrigins := flags.String("cors-origins", "", "comma separated allowed CORS origins (env CORS_ALLOWED_ORIGINS)")
+	if err := flags.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	if err := godotenv.Load(*envFile); err != nil {
+		if *envFile != defaultEnvFile || !errors.Is(err, fs.ErrNotExist) {
+			return Config{}, fmt.Errorf("loading env file %s: %w", *envFile, err)
+		}
+	}
+
+	cfg := Default()
+
+	if *configFile == "" {
+		*configFile = os.Getenv("CONFIG_FILE")
+	}
+	if *configFile != "" {
+		if err := loadFile(&cfg, *configFile); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if err := loadEnv(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "port":
+			cfg.Server.Port = *port
+		case "log-level":
+			cfg.Log.Level = *logLevel
+		case "log-format":
+			cfg.Log.Format = *logFormat
+		case "tls":
+			cfg.Server.TLS.Enabled = *tls
+		case "tracing-exporter":
+			cfg.Tracing.Exporter = *tracingExporter
+		case "cors-origins":
+			cfg.Server.CORS.AllowedOrigins = splitList(*corsOrigins)
+		}
+	})
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// loadFile overlays the options present in a YAML or TOML file on cfg; the
+// format is chosen by extension.
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("parsing config file %s: %w", path, err)
+		}
+	case ".toml":
+		dec := toml.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(cfg); err != nil {
+			return fmt.Errorf("parsing config file %s: %w", path, err)
+		}
+	default:
+		return fmt.Errorf("config file %s: unsupported extension %q, use .yaml, .yml or .toml", path, ext)
+	}
+	return nil
+}
+
+// envBinding sets one option from the environment variable key.
+type envBinding struct {
+	key string
+	set func(cfg *Config, value string) error
+}
+
+// envBindings keeps the variable names and units the .env files have always
+// used, so existing deployments keep working.
+var envBindings = []envBinding{
+	{"PORT", setString(func(c *Config) *string { return &c.Server.Port })},
+	{"TLS_ENABLED", setBool(func(c *Config) *bool { return &c.Server.TLS.Enabled })},
+	{"TLS_CERT_FILE", setString(func(c *Config) *string { return &c.Server.TLS.CertFile })},
+	{"TLS_KEY_FILE", setString(func(c *Config) *string { return &c.Server.TLS.KeyFile })},
+	{"CORS_ALLOWED_ORIGINS", setList(func(c *Config) *[]string { return &c.Server.CORS.AllowedOrigins })},
+	{"HTTP_READ_TIMEOUT_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Server.ReadTimeout })},
+	{"HTTP_WRITE_TIMEOUT_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Server.WriteTimeout })},
+	{"HTTP_IDLE_TIMEOUT_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Server.IdleTimeout })},
+	{"DRAIN_DELAY_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Server.DrainDelay })},
+	{"SHUTDOWN_TIMEOUT_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Server.ShutdownTimeout })},
+
+	{"LOG_LEVEL", setString(func(c *Config) *string { return &c.Log.Level })},
+	{"LOG_FORMAT", setString(func(c *Config) *string { return &c.Log.Format })},
+
+	{"DB_HOST", setString(func(c *Config) *string { return &c.Database.Host })},
+	{"POSTGRES_PORT", setString(func(c *Config) *string { return &c.Database.Port })},
+	{"DB_USER", setString(func(c *Config) *string { return &c.Database.User })},
+	{"DB_PASSWORD", setString(func(c *Config) *string { return &c.Database.Password })},
+	{"DB_NAME", setString(func(c *Config) *string { return &c.Database.Name })},
+	{"DB_MAX_OPEN_CONNS", setInt(func(c *Config) *int { return &c.Database.MaxOpenConns })},
+	{"DB_MAX_IDLE_CONNS", setInt(func(c *Config) *int { return &c.Database.MaxIdleConns })},
+	{"DB_CONN_MAX_LIFETIME_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Database.ConnMaxLifetime })},
+	{"DB_READ_TIMEOUT_MS", setDuration(time.Millisecond, func(c *Config) *Duration { return &c.Database.ReadTimeout })},
+	{"DB_WRITE_TIMEOUT_MS", setDuration(time.Millisecond, func(c *Config) *Duration { return &c.Database.WriteTimeout })},
+	{"DB_REPORT_TIMEOUT_MS", setDuration(time.Millisecond, func(c *Config) *Duration { return &c.Database.ReportTimeout })},
+
+	{"JWT_ACCESS_SIGNING_KEY", setString(func(c *Config) *string { return &c.Auth.AccessSigningKey })},
+	{"JWT_REFRESH_SIGNING_KEY", setString(func(c *Config) *string { return &c.Auth.RefreshSigningKey })},
+	{"JWT_CHALLENGE_SIGNING_KEY", setString(func(c *Config) *string { return &c.Auth.ChallengeSigningKey })},
+	{"JWT_ACCESS_TTL_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Auth.AccessTTL })},
+	{"JWT_REFRESH_TTL_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Auth.RefreshTTL })},
+	{"JWT_CHALLENGE_TTL_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Auth.ChallengeTTL })},
+	{"TOTP_ISSUER", setString(func(c *Config) *string { return &c.Auth.TOTPIssuer })},
+
+	{"SILENT_DEVICE_THRESHOLD_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Monitoring.SilentDeviceThreshold })},
+	{"SILENT_DEVICE_CHECK_INTERVAL_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Monitoring.SilentDeviceCheckInterval })},
+
+	{"RATING_INTERVAL_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Rating.Interval })},
+	{"RATING_WINDOW_DAYS", setDuration(24*time.Hour, func(c *Config) *Duration { return &c.Rating.Window })},
+	{"RATING_SPEED_LIMIT_KMH", setFloat(func(c *Config) *float64 { return &c.Rating.SpeedLimit })},
+	{"RATING_HARSH_ACCELERATION_MS2", setFloat(func(c *Config) *float64 { return &c.Rating.HarshAcceleration })},
+
+	{"WORK_HOURS_CHECK_INTERVAL_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.WorkHours.CheckInterval })},
+	{"WORK_MAX_CONTINUOUS_DRIVING_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.WorkHours.MaxContinuousDriving })},
+	{"WORK_MIN_BREAK_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.WorkHours.MinBreak })},
+	{"WORK_MAX_DAILY_DRIVING_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.WorkHours.MaxDailyDriving })},
+	{"WORK_MIN_DAILY_REST_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.WorkHours.MinDailyRest })},
+	{"WORK_MAX_WEEKLY_DRIVING_HOURS", setDuration(time.Hour, func(c *Config) *Duration { return &c.WorkHours.MaxWeeklyDriving })},
+
+	{"DOCUMENTS_CHECK_INTERVAL_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Documents.CheckInterval })},
+	{"DOCUMENTS_EXPIRY_NOTICE_DAYS", setDuration(24*time.Hour, func(c *Config) *Duration { return &c.Documents.ExpiryNotice })},
+
+	{"MAINTENANCE_CHECK_INTERVAL_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Maintenance.CheckInterval })},
+	{"MAINTENANCE_NOTICE_DAYS", setDuration(24*time.Hour, func(c *Config) *Duration { return &c.Maintenance.Notice })},
+	{"MAINTENANCE_MILEAGE_NOTICE_KM", setFloat(func(c *Config) *float64 { return &c.Maintenance.MileageNotice })},
+
+	{"NOTIFY_DELIVERY_INTERVAL_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Notifications.DeliveryInterval })},
+	{"NOTIFY_BATCH_SIZE", setInt(func(c *Config) *int { return &c.Notifications.BatchSize })},
+	{"NOTIFY_MAX_ATTEMPTS", setInt(func(c *Config) *int { return &c.Notifications.MaxAttempts })},
+	{"NOTIFY_RETRY_BACKOFF_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Notifications.RetryBackoff })},
+	{"NOTIFY_SEND_TIMEOUT_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Notifications.SendTimeout })},
+	{"SMTP_ADDR", setString(func(c *Config) *string { return &c.Notifications.SMTP.Addr })},
+	{"SMTP_USERNAME", setString(func(c *Config) *string { return &c.Notifications.SMTP.Username })},
+	{"SMTP_PASSWORD", setString(func(c *Config) *string { return &c.Notifications.SMTP.Password })},
+	{"SMTP_FROM", setString(func(c *Config) *string { return &c.Notifications.SMTP.From })},
+	{"SMS_GATEWAY_URL", setString(func(c *Config) *string { return &c.Notifications.SMS.GatewayURL })},
+	{"SMS_GATEWAY_TOKEN", setString(func(c *Config) *string { return &c.Notifications.SMS.Token })},
+	{"TELEGRAM_BOT_TOKEN", setString(func(c *Config) *string { return &c.Notifications.Telegram.BotToken })},
+	{"TELEGRAM_API_URL", setString(func(c *Config) *string { return &c.Notifications.Telegram.APIURL })},
+	{"WEBHOOK_DELIVERY_INTERVAL_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Webhooks.DeliveryInterval })},
+	{"WEBHOOK_BATCH_SIZE", setInt(func(c *Config) *int { return &c.Webhooks.BatchSize })},
+	{"WEBHOOK_MAX_ATTEMPTS", setInt(func(c *Config) *int { return &c.Webhooks.MaxAttempts })},
+	{"WEBHOOK_RETRY_BACKOFF_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Webhooks.RetryBackoff })},
+	{"WEBHOOK_MAX_BACKOFF_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Webhooks.MaxBackoff })},
+	{"WEBHOOK_TIMEOUT_SEC", setDuration(time.Second, func(c *Config) *Duration { return &c.Webhooks.Timeout })},
+	{"WEBHOOK_LEASE_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Webhooks.Lease })},
+	{"IDEMPOTENCY_TTL_HOURS", setDuration(time.Hour, func(c *Config) *Duration { return &c.Idempotency.TTL })},
+	{"IDEMPOTENCY_PRUNE_INTERVAL_MIN", setDuration(time.Minute, func(c *Config) *Duration { return &c.Idempotency.PruneInterval })},
+
+	{"TRACING_EXPORTER", setString(func(c *Config) *string { return &c.Tracing.Exporter })},
+	{"TRACING_OTLP_ENDPOINT", setString(func(c *Config) *string { return &c.Tracing.OTLPEndpoint })},
+	{"TRACING_OTLP_INSECURE", setBool(func(c *Config) *bool { return &c.Tracing.OTLPInsecure })},
+	{"TRACING_SERVICE_NAME", setString(func(c *Config) *string { return &c.Tracing.ServiceName })},
+	{"TRACING_SAMPLE_RATIO", setFloat(func(c *Config) *float64 { return &c.Tracing.SampleRatio })},
+}
+
+// loadEnv overlays the options set in the environment on cfg and reports every
+// malformed variable at once.
+func loadEnv(cfg *Config) error {
+	var errs []error
+	for _, b := range envBindings {
+		value, ok := os.LookupEnv(b.key)
+		if !ok {
+			continue
+		}
+		if err := b.set(cfg, strings.TrimSpace(value)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", b.key, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid environment:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func setString(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+func setInt(field func(*Config) *int) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("must be an integer, got %q", v)
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func setFloat(field func(*Config) *float64) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("must be a number, got %q", v)
+		}
+		*field(c) = f
+		return nil
+	}
+}
+
+func setBool(field func(*Config) *bool) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("must be true or false, got %q", v)
+		}
+		*field(c) = b
+		return nil
+	}
+}
+
+// setDuration reads a whole number of unit, matching the _SEC/_MIN/_MS suffix
+// of the variable.
+func setDuration(unit time.Duration, field func(*Config) *Duration) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("must be a whole number of %s, got %q", unitName(unit), v)
+		}
+		*field(c) = Duration{time.Duration(n) * unit}
+		return nil
+	}
+}
+
+func setList(field func(*Config) *[]string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		*field(c) = splitList(v)
+		return nil
+	}
+}
+
+func splitList(v string) []string {
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func unitName(unit time.Duration) string {
+	switch unit {
+	case time.Millisecond:
+		return "milliseconds"
+	case time.Minute:
+		return "minutes"
+	default:
+		return "seconds"
+	}
+}
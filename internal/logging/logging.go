@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+type ctxKey struct{}
+
+// requestLogger is the logger of a single request. The company is filled in
+// once the request is authenticated, after the logger was created.
+type requestLogger struct {
+	entry     *logrus.Entry
+	companyID atomic.Value
+}
+
+// NewContext returns a copy of ctx that carries entry as the request-scoped logger.
+func NewContext(ctx context.Context, entry *logrus.Entry) context.Context {
+	return context.WithValue(ctx, ctxKey{}, &requestLogger{entry: entry})
+}
+
+// SetCompanyID records the authenticated company of the request in ctx, so that
+// it is added to every later log line of the request.
+func SetCompanyID(ctx context.Context, companyID string) {
+	if rl, ok := ctx.Value(ctxKey{}).(*requestLogger); ok {
+		rl.companyID.Store(companyID)
+	}
+}
+
+// CompanyID returns the company recorded with SetCompanyID, or "".
+func CompanyID(ctx context.Context) string {
+	rl, ok := ctx.Value(ctxKey{}).(*requestLogger)
+	if !ok {
+		return ""
+	}
+	id, _ := rl.companyID.Load().(string)
+	return id
+}
+
+// FromContext returns the request-scoped logger of ctx, or fallback when ctx
+// does not belong to a request, e.g. in background workers.
+func FromContext(ctx context.Context, fallback *logrus.Logger) *logrus.Entry {
+	rl, ok := ctx.Value(ctxKey{}).(*requestLogger)
+	if !ok {
+		return fallback.WithContext(ctx)
+	}
+
+	entry := rl.entry
+	if id, _ := rl.companyID.Load().(string); id != "" {
+		entry = entry.WithField("company_id", id)
+	}
+	return entry.WithContext(ctx)
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	driver := models.Driver{
+		ID:       "1",
+		Name:     "John",
+		Surname:  "Doe",
+		Phone:    "123456789",
+		Birthday: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := Redact(driver).(models.Driver)
+	assert.Equal(t, "1", got.ID)
+	assert.Equal(t, redacted, got.Name)
+	assert.Equal(t, redacted, got.Phone)
+	assert.Equal(t, "", got.Middle)
+	assert.True(t, got.Birthday.IsZero())
+	assert.Equal(t, "John", driver.Name)
+
+	users := Redact([]*models.User{{ID: "2", INN: "1234567890"}}).([]*models.User)
+	assert.Equal(t, "2", users[0].ID)
+	assert.Equal(t, redacted, users[0].INN)
+
+	assert.Equal(t, "plain", Redact("plain"))
+	assert.Nil(t, Redact(nil))
+}
+
+func TestFromContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	FromContext(context.Background(), logger).Info("outside a request")
+	assert.NotContains(t, buf.String(), "request_id")
+
+	buf.Reset()
+	ctx := NewContext(context.Background(), logger.WithField("request_id", "abc"))
+	SetCompanyID(ctx, "company")
+	FromContext(ctx, logger).Info("inside a request")
+	assert.Contains(t, buf.String(), `"request_id":"abc"`)
+	assert.Contains(t, buf.String(), `"company_id":"company"`)
+	assert.Equal(t, "company", CompanyID(ctx))
+}
@@ -0,0 +1,73 @@
+package logging
+
+import "reflect"
+
+const redacted = "[REDACTED]"
+
+// Values of the log tag of model fields. Fields tagged redact carry personal
+// data and fields tagged secret carry credentials: secrets, tokens and their
+// hashes. Both are masked in logs; secrets are kept out of the audit log too.
+const (
+	TagRedact = "redact"
+	TagSecret = "secret"
+)
+
+// Redact returns a copy of v with every field tagged `log:"redact"` or
+// `log:"secret"` masked, so that models carrying personal data can be logged. Structs are handled at any
+// depth through pointers and slices; other values are returned unchanged.
+func Redact(v any) any {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return v
+	}
+	return redactValue(rv).Interface()
+}
+
+func redactValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Elem().Type())
+		out.Elem().Set(redactValue(v.Elem()))
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			out.Index(i).Set(redactValue(v.Index(i)))
+		}
+		return out
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if tag := field.Tag.Get("log"); tag == TagRedact || tag == TagSecret {
+				mask(out.Field(i))
+				continue
+			}
+			out.Field(i).Set(redactValue(out.Field(i)))
+		}
+		return out
+	}
+	return v
+}
+
+// mask replaces a non-empty string with a marker, so that it is still visible
+// whether the field was set, and zeroes any other value.
+func mask(f reflect.Value) {
+	if f.Kind() == reflect.String {
+		if f.Len() > 0 {
+			f.SetString(redacted)
+		}
+		return
+	}
+	f.SetZero()
+}
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "algalar"
+
+// Metrics is the Prometheus implementation of the metrics the server and
+// service layers report.
+type Metrics struct {
+	httpDuration   *prometheus.HistogramVec
+	sensorReadings *prometheus.CounterVec
+	positions      *prometheus.CounterVec
+	breakages      *prometheus.CounterVec
+	mileageUpdates *prometheus.CounterVec
+	silentDevices  *prometheus.GaugeVec
+}
+
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP requests by route and response status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		sensorReadings: newIngestCounter("sensor_readings_total", "Sensor readings ingested."),
+		positions:      newIngestCounter("positions_total", "Car positions ingested."),
+		breakages:      newIngestCounter("breakages_total", "Breakages registered."),
+		mileageUpdates: newIngestCounter("mileage_updates_total", "Wheel mileage updates applied."),
+		silentDevices: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "devices",
+			Name:      "silent",
+			Help:      "Devices that have not reported sensor data or positions within the silence threshold.",
+		}, []string{"company"}),
+	}
+
+	reg.MustRegister(
+		m.httpDuration,
+		m.sensorReadings,
+		m.positions,
+		m.breakages,
+		m.mileageUpdates,
+		m.silentDevices,
+	)
+	return m
+}
+
+func newIngestCounter(name, help string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ingest",
+		Name:      name,
+		Help:      help,
+	}, []string{"company"})
+}
+
+func (m *Metrics) ObserveRequest(method, route string, status int, duration time.Duration) {
+	m.httpDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func (m *Metrics) SensorReadingIngested(companyID string) {
+	m.sensorReadings.WithLabelValues(companyID).Inc()
+}
+
+func (m *Metrics) PositionIngested(companyID string) {
+	m.positions.WithLabelValues(companyID).Inc()
+}
+
+func (m *Metrics) BreakageIngested(companyID string) {
+	m.breakages.WithLabelValues(companyID).Inc()
+}
+
+func (m *Metrics) MileageUpdated(companyID string) {
+	m.mileageUpdates.WithLabelValues(companyID).Inc()
+}
+
+// SetSilentDevices replaces the silent device counts, so companies whose
+// devices came back online drop out of the gauge.
+func (m *Metrics) SetSilentDevices(counts map[string]int) {
+	m.silentDevices.Reset()
+	for companyID, count := range counts {
+		m.silentDevices.WithLabelValues(companyID).Set(float64(count))
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+var (
+	AuditActionAssign             = "assign"
+	AuditActionUnassign           = "unassign"
+	AuditResourceDriverAssignment = "driver_assignment"
+)
+
+// DriverAssignment is a shift of a driver on a car. EndedAt is nil while the
+// driver is still assigned.
+type DriverAssignment struct {
+	ID          string
+	IDCompany   string
+	IDDriver    string
+	IDCar       string
+	StateNumber string
+	StartedAt   time.Time
+	EndedAt     *time.Time
+}
+
+// AssignmentFilter selects the assignments of a company, optionally of one
+// driver or car and overlapping the period from From to To.
+type AssignmentFilter struct {
+	IDCompany string
+	IDDriver  *string
+	IDCar     *string
+	From      *time.Time
+	To        *time.Time
+}
+
+// DriverTrip is the route driven during an assignment, limited to the
+// requested period. Positions are in the order they were recorded.
+type DriverTrip struct {
+	Assignment DriverAssignment
+	Positions  []Position
+}
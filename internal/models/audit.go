@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+var (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+)
+
+var (
+	AuditResourceUser         = "user"
+	AuditResourceCar          = "car"
+	AuditResourceWheel        = "wheel"
+	AuditResourceDriver       = "driver"
+	AuditResourceBreakage     = "breakage"
+	AuditResourceNotification = "notification"
+)
+
+type AuditEntry struct {
+	ID           string
+	IDCompany    string
+	ActorID      string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Changes      map[string]AuditChange
+	Metadata     RequestMeta
+	CreatedAt    time.Time
+}
+
+type AuditChange struct {
+	Before any `json:"before,omitempty"`
+	After  any `json:"after,omitempty"`
+}
+
+type AuditFilter struct {
+	IDCompany    string
+	ActorID      *string
+	Action       *string
+	ResourceType *string
+	ResourceID   *string
+	From         *time.Time
+	To           *time.Time
+}
+
+// AuditWriter receives the entries of an audit log export one at a time.
+type AuditWriter interface {
+	WriteAuditEntry(entry AuditEntry) error
+}
+
+type RequestMeta struct {
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	Method    string `json:"method,omitempty"`
+	Path      string `json:"path,omitempty"`
+}
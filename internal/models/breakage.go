@@ -0,0 +1,89 @@
+package models
+
+import "time"
+
+var (
+	AuditActionTransition = "transition"
+)
+
+// Statuses of a breakage.
+const (
+	BreakageStatusReported     = "reported"
+	BreakageStatusAcknowledged = "acknowledged"
+	BreakageStatusInRepair     = "in_repair"
+	BreakageStatusResolved     = "resolved"
+	BreakageStatusClosed       = "closed"
+)
+
+// BreakageStatuses are the statuses of a breakage, in the order it goes
+// through them.
+var BreakageStatuses = []string{
+	BreakageStatusReported,
+	BreakageStatusAcknowledged,
+	BreakageStatusInRepair,
+	BreakageStatusResolved,
+	BreakageStatusClosed,
+}
+
+// BreakageTransitions are the statuses a breakage may move to from each
+// status. A resolved breakage is reopened by moving it back to repair, a
+// closed one is final.
+var BreakageTransitions = map[string][]string{
+	BreakageStatusReported:     {BreakageStatusAcknowledged, BreakageStatusInRepair, BreakageStatusClosed},
+	BreakageStatusAcknowledged: {BreakageStatusInRepair, BreakageStatusResolved, BreakageStatusClosed},
+	BreakageStatusInRepair:     {BreakageStatusResolved},
+	BreakageStatusResolved:     {BreakageStatusInRepair, BreakageStatusClosed},
+	BreakageStatusClosed:       {},
+}
+
+// BreakageRepair are the details of the repair of a breakage. Nil fields are
+// not known yet.
+type BreakageRepair struct {
+	Assignee    *string
+	RepairNotes *string
+	Cost        *float64
+}
+
+// BreakageDetails is a breakage with the state of its repair. ResolvedAt is
+// set once it is resolved or closed, and the downtime of the car runs from
+// CreatedAt until then.
+type BreakageDetails struct {
+	ID          string
+	IDCar       string
+	StateNumber string
+	DriverName  string `log:"redact"`
+	Location    Point
+	Type        string
+	IDType      *string
+	TypeName    *string
+	Severity    *string
+	MustStop    bool
+	Description string
+	Status      string
+	BreakageRepair
+	CreatedAt       time.Time
+	ResolvedAt      *time.Time
+	UpdatedAt       *time.Time
+	DowntimeMinutes int
+}
+
+// BreakageStatusChange moves a breakage to Status.
+type BreakageStatusChange struct {
+	IDCompany  string
+	IDBreakage string
+	Status     string
+	Note       *string
+	ChangedBy  string
+	ChangedAt  time.Time
+}
+
+// BreakageHistoryEntry is a status change of a breakage.
+type BreakageHistoryEntry struct {
+	ID         string
+	IDBreakage string
+	FromStatus string
+	ToStatus   string
+	Note       *string
+	ChangedBy  string
+	CreatedAt  time.Time
+}
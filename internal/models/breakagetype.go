@@ -0,0 +1,91 @@
+package models
+
+import "time"
+
+var (
+	AuditResourceBreakageType = "breakage_type"
+)
+
+// Severities of breakage types, from the least to the most severe.
+const (
+	SeverityLow      = "low"
+	SeverityMedium   = "medium"
+	SeverityHigh     = "high"
+	SeverityCritical = "critical"
+)
+
+// Severities are the severities of breakage types.
+var Severities = []string{SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+
+// Components of a car a breakage type may be linked to.
+const (
+	ComponentEngine       = "engine"
+	ComponentTransmission = "transmission"
+	ComponentBrakes       = "brakes"
+	ComponentSuspension   = "suspension"
+	ComponentElectrical   = "electrical"
+	ComponentBody         = "body"
+	ComponentWheel        = "wheel"
+	ComponentOther        = "other"
+)
+
+// Components are the components of a car a breakage type may be linked to.
+var Components = []string{
+	ComponentEngine,
+	ComponentTransmission,
+	ComponentBrakes,
+	ComponentSuspension,
+	ComponentElectrical,
+	ComponentBody,
+	ComponentWheel,
+	ComponentOther,
+}
+
+// BreakageType is an entry of the breakage catalogue of a company. Breakages
+// whose device-reported type is Code or one of DeviceCodes are classified as
+// it when they are registered. WheelPosition is only set for wheel
+// breakages of a specific wheel.
+type BreakageType struct {
+	ID            string
+	IDCompany     string
+	Code          string
+	Name          string
+	Severity      string
+	MustStop      bool
+	Component     *string
+	WheelPosition *int
+	DeviceCodes   []string
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+// Groupings of breakage statistics.
+const (
+	BreakageStatsByType     = "type"
+	BreakageStatsByCarModel = "car_model"
+	BreakageStatsByDriver   = "driver"
+)
+
+// BreakageStatsGroupings are the groupings of breakage statistics.
+var BreakageStatsGroupings = []string{BreakageStatsByType, BreakageStatsByCarModel, BreakageStatsByDriver}
+
+// BreakageStatsQuery selects the breakages of a company registered from From
+// to To, grouped by GroupBy.
+type BreakageStatsQuery struct {
+	IDCompany string
+	GroupBy   string
+	From      *time.Time
+	To        *time.Time
+}
+
+// BreakageStats is the number of breakages in a group. Breakages not in the
+// catalogue are grouped by their device-reported type, and Severity is nil
+// for them and for groupings other than by type.
+type BreakageStats struct {
+	Key           string
+	Name          string `log:"redact"`
+	Severity      *string
+	Count         int
+	MustStopCount int
+	LastAt        time.Time
+}
@@ -0,0 +1,10 @@
+package models
+
+type contextKey string
+
+// Keys of the values the HTTP layer stores in a request context.
+const (
+	UserIDKey      contextKey = "user_id"
+	RequestIDKey   contextKey = "request_id"
+	RequestMetaKey contextKey = "request_meta"
+)
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+var (
+	AuditActionDeactivate       = "deactivate"
+	AuditActionDelete           = "delete"
+	AuditResourceDriverDocument = "driver_document"
+)
+
+// Types of driver documents.
+const (
+	DocumentTypeLicence            = "licence"
+	DocumentTypeMedicalCertificate = "medical_certificate"
+	DocumentTypeOther              = "other"
+)
+
+// DocumentTypes are the types of driver documents.
+var DocumentTypes = []string{
+	DocumentTypeLicence,
+	DocumentTypeMedicalCertificate,
+	DocumentTypeOther,
+}
+
+// LicenceCategories are the categories a driving licence may list.
+var LicenceCategories = []string{
+	"A", "A1", "B", "B1", "BE", "C", "C1", "CE", "C1E", "D", "D1", "DE", "D1E", "M", "Tm", "Tb",
+}
+
+// DriverDocument is a file attached to a driver. Content is only loaded when
+// the file itself is requested.
+type DriverDocument struct {
+	ID          string
+	IDCompany   string
+	IDDriver    string
+	Type        string
+	FileName    string
+	ContentType string
+	Size        int
+	Content     []byte `log:"redact"`
+	CreatedAt   time.Time
+}
+
+// Types of expiry alerts.
+const (
+	ExpiryLicence            = "licence_expiry"
+	ExpiryMedicalCertificate = "medical_certificate_expiry"
+)
+
+// ExpiryAlert is raised once for a licence or medical certificate of a driver
+// that expires, or has expired, on ExpiresAt.
+type ExpiryAlert struct {
+	ID        string
+	IDCompany string
+	IDDriver  string
+	Type      string
+	ExpiresAt time.Time
+	// Note is the text of the notification raised for the alert.
+	Note      string
+	CreatedAt time.Time
+}
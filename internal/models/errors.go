@@ -5,6 +5,7 @@ import "errors"
 var (
 	ErrLoadEnvFailed                 = errors.New("failed to load environment")
 	ErrConnectionDBFailed            = errors.New("failed to connect to database")
+	ErrMigrationsNotApplied          = errors.New("database migrations are not applied")
 	ErrServerFailed                  = errors.New("failed to connect to server")
 	ErrClientFailed                  = errors.New("failed to create client")
 	ErrNoContent                     = errors.New("failed to provide content: not exists")
@@ -42,4 +43,38 @@ var (
 	ErrFailedToUpdateCurrentPosition = errors.New("failed to update current car position")
 	ErrFailedToRetrieveNotifications = errors.New("failed to retrieve notifications")
 	ErrFailedToUpdateMileage         = errors.New("failed to update mileage data")
+	ErrInvalidChallengeToken         = errors.New("invalid challenge token")
+	ErrInvalidTOTPCode               = errors.New("invalid two-factor authentication code")
+	ErrTwoFactorRequired             = errors.New("two-factor authentication is required by company policy")
+	ErrTwoFactorNotEnabled           = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorAlreadyEnabled       = errors.New("two-factor authentication is already enabled")
+	ErrTooManyTOTPAttempts           = errors.New("too many failed two-factor authentication attempts, try again later")
+	ErrInvalidCredentials            = errors.New("invalid login or password")
+	ErrInvalidParameter              = errors.New("invalid request parameter")
+	ErrInvalidCursor                 = errors.New("invalid pagination cursor")
+	ErrInvalidSort                   = errors.New("invalid sort field")
+	ErrImportRejected                = errors.New("import rejected: some rows are invalid")
+	ErrDriverNotAssigned             = errors.New("driver is not assigned to a car")
+	ErrAssignmentConflict            = errors.New("assignment overlaps a later one")
+	ErrWorkSessionConflict           = errors.New("work session overlaps another one")
+	ErrWorkSessionNotOpen            = errors.New("driver has no open work session")
+	ErrDriverDeactivated             = errors.New("driver is deactivated")
+	ErrDocumentNotFound              = errors.New("document not found")
+	ErrBreakageNotFound              = errors.New("breakage not found")
+	ErrInvalidStatusTransition       = errors.New("status transition is not allowed")
+	ErrBreakageTypeNotFound          = errors.New("breakage type not found")
+	ErrBreakageCodeTaken             = errors.New("breakage code is already in the catalogue")
+	ErrMaintenancePlanNotFound       = errors.New("maintenance plan not found")
+	ErrMaintenanceTaskNotFound       = errors.New("maintenance task not found")
+	ErrMaintenanceTaskNotDue         = errors.New("maintenance task is not due")
+	ErrWorkOrderNotFound             = errors.New("work order not found")
+	ErrWorkOrderClosed               = errors.New("work order is not open")
+	ErrNotificationRuleNotFound      = errors.New("notification rule not found")
+	ErrNotificationNotFound          = errors.New("notification not found")
+	ErrGeofenceNotFound              = errors.New("geofence not found")
+	ErrChannelNotConfigured          = errors.New("delivery channel is not configured")
+	ErrWebhookNotFound               = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound       = errors.New("webhook delivery not found")
+	ErrIdempotencyKeyInProgress      = errors.New("a request with this idempotency key is in progress")
+	ErrIdempotencyKeyReused          = errors.New("idempotency key was used for a different request")
 )
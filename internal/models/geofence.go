@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+var (
+	AuditResourceGeofence = "geofence"
+)
+
+// Geofence is a circular area of the company, Radius meters around Center.
+// Every car of the company entering or leaving an active geofence raises a
+// geofence notification.
+type Geofence struct {
+	ID        string
+	IDCompany string
+	Name      string
+	Center    Point
+	Radius    float64
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
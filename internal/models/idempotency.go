@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// IdempotencyKey records a request a company sent with an Idempotency-Key
+// header, so that a retry of it is answered with the response it got instead
+// of being applied again. StatusCode is nil while the request is in progress.
+type IdempotencyKey struct {
+	IDCompany   string
+	Key         string
+	Route       string
+	RequestHash string
+	StatusCode  *int
+	ContentType string
+	Response    []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
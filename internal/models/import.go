@@ -0,0 +1,57 @@
+package models
+
+var (
+	ImportKindCars    = "cars"
+	ImportKindWheels  = "wheels"
+	ImportKindDrivers = "drivers"
+)
+
+var AuditActionImport = "import"
+
+// ImportRowError is a problem with a cell of an import file. Row is the row
+// number as shown by spreadsheet programs, the header being row 1.
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column"`
+	Message string `json:"message"`
+}
+
+// ImportBatch holds the rows of an import file of one kind that are valid on
+// their own, together with the errors found in the others. Only one of Cars,
+// Wheels and Drivers is filled, as chosen by Kind.
+type ImportBatch struct {
+	Kind      string
+	IDCompany string
+	Rows      int
+	Cars      []ImportCar
+	Wheels    []ImportWheel
+	Drivers   []ImportDriver
+	Errors    []ImportRowError
+}
+
+type ImportCar struct {
+	Row int
+	Car Car
+}
+
+// ImportWheel is a wheel mounted on the company's car with StateNumber.
+type ImportWheel struct {
+	Row         int
+	StateNumber string
+	Wheel       Wheel
+}
+
+// ImportDriver is a driver of the company's car with StateNumber.
+type ImportDriver struct {
+	Row         int
+	StateNumber string
+	Driver      Driver
+}
+
+type ImportResult struct {
+	Kind    string
+	DryRun  bool
+	Rows    int
+	Created int
+	Errors  []ImportRowError
+}
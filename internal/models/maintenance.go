@@ -0,0 +1,171 @@
+package models
+
+import "time"
+
+var (
+	AuditResourceMaintenancePlan = "maintenance_plan"
+	AuditResourceWorkOrder       = "work_order"
+)
+
+// Kinds of maintenance.
+const (
+	MaintenanceTireRotation    = "tire_rotation"
+	MaintenancePressureCheck   = "pressure_check"
+	MaintenanceTireReplacement = "tire_replacement"
+	MaintenanceInspection      = "inspection"
+)
+
+// MaintenanceKinds are the kinds of maintenance a plan may schedule.
+var MaintenanceKinds = []string{
+	MaintenanceTireRotation,
+	MaintenancePressureCheck,
+	MaintenanceTireReplacement,
+	MaintenanceInspection,
+}
+
+// Triggers of maintenance plans.
+const (
+	// MaintenanceByMileage is due for a wheel every MileageInterval km.
+	MaintenanceByMileage = "mileage"
+	// MaintenanceByInterval is due for a car every IntervalDays days.
+	MaintenanceByInterval = "interval"
+	// MaintenanceByCondition is due for a wheel whose last sensor reading
+	// meets Condition.
+	MaintenanceByCondition = "condition"
+)
+
+// MaintenanceTriggers are the triggers of maintenance plans.
+var MaintenanceTriggers = []string{MaintenanceByMileage, MaintenanceByInterval, MaintenanceByCondition}
+
+// Sensor conditions of maintenance plans, relative to the limits of the
+// wheel.
+const (
+	ConditionPressureLow     = "pressure_low"
+	ConditionPressureHigh    = "pressure_high"
+	ConditionTemperatureHigh = "temperature_high"
+)
+
+// MaintenanceConditions are the sensor conditions of maintenance plans.
+var MaintenanceConditions = []string{ConditionPressureLow, ConditionPressureHigh, ConditionTemperatureHigh}
+
+// MaintenancePlan schedules maintenance of a car of the company, or of all
+// its cars when IDCar is nil. Only the field of its Trigger is set among
+// MileageInterval, IntervalDays and Condition. Inactive plans raise no tasks.
+type MaintenancePlan struct {
+	ID              string
+	IDCompany       string
+	IDCar           *string
+	Name            string
+	Kind            string
+	Trigger         string
+	MileageInterval *float64
+	IntervalDays    *int
+	Condition       *string
+	Active          bool
+	CreatedAt       time.Time
+	UpdatedAt       *time.Time
+}
+
+// Statuses of a maintenance task.
+const (
+	MaintenanceTaskDue       = "due"
+	MaintenanceTaskScheduled = "scheduled"
+	MaintenanceTaskDone      = "done"
+)
+
+// MaintenanceTask is maintenance of a car, or of one of its wheels, that a
+// plan made due. It is scheduled while a work order for it is open. DueAt is
+// set for plans by interval or condition and DueMileage for plans by mileage.
+type MaintenanceTask struct {
+	ID          string
+	IDCompany   string
+	IDPlan      string
+	IDCar       string
+	IDWheel     *string
+	Reason      string
+	DueAt       *time.Time
+	DueMileage  *float64
+	Status      string
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+	// CompletedMileage is the mileage of the wheel once the task was done,
+	// which the next task of a plan by mileage is counted from.
+	CompletedMileage *float64
+}
+
+// MaintenanceDue is maintenance that is due or will be due soon, with what a
+// dashboard shows about it. ID is empty until the task is recorded.
+type MaintenanceDue struct {
+	MaintenanceTask
+	PlanName      string
+	Kind          string
+	StateNumber   string
+	WheelPosition *int
+	// Mileage is the current mileage of the wheel.
+	Mileage     *float64
+	Overdue     bool
+	IDWorkOrder *string
+}
+
+// MaintenanceFilter selects the open maintenance tasks of a company.
+type MaintenanceFilter struct {
+	IDCompany string
+	IDCar     *string
+}
+
+// Statuses of a work order.
+const (
+	WorkOrderOpen      = "open"
+	WorkOrderCompleted = "completed"
+	WorkOrderCancelled = "cancelled"
+)
+
+// WorkOrderStatuses are the statuses of a work order.
+var WorkOrderStatuses = []string{WorkOrderOpen, WorkOrderCompleted, WorkOrderCancelled}
+
+// WorkOrder is repair or maintenance work on a car, or on one of its wheels,
+// opened from a breakage or from a maintenance task.
+type WorkOrder struct {
+	ID          string
+	IDCompany   string
+	IDCar       string
+	IDWheel     *string
+	IDBreakage  *string
+	IDTask      *string
+	Title       string
+	Status      string
+	Assignee    *string
+	Notes       *string
+	Cost        *float64
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// WorkOrderFilter selects the work orders of a company.
+type WorkOrderFilter struct {
+	IDCompany string
+	IDCar     *string
+	Status    *string
+}
+
+// TireReplacement is the tire fitted on a wheel by a work order.
+type TireReplacement struct {
+	Brand string
+	Model string
+	Size  float32
+	Cost  float32
+}
+
+// WorkOrderCompletion completes an open work order. A new Tire resets the
+// mileage of the wheel, as does ResetMileage.
+type WorkOrderCompletion struct {
+	IDCompany    string
+	IDWorkOrder  string
+	Notes        *string
+	Cost         *float64
+	Tire         *TireReplacement
+	ResetMileage bool
+	CompletedAt  time.Time
+}
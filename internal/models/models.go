@@ -8,14 +8,14 @@ import (
 
 type User struct {
 	ID       string
-	INN      string
-	Name     string
-	Surname  string
+	INN      string `log:"redact"`
+	Name     string `log:"redact"`
+	Surname  string `log:"redact"`
 	Gender   string
-	Login    string
-	Password string
+	Login    string `log:"redact"`
+	Password string `log:"secret"`
 	Timezone int
-	Phone    string
+	Phone    string `log:"redact"`
 }
 
 type Car struct {
@@ -86,42 +86,67 @@ type PressureDataByWheelIDFilter struct {
 	To      time.Time
 }
 
+// Driver is a driver of the company. IDCar is the car the driver is assigned
+// to, if any; see DriverAssignment.
 type Driver struct {
 	ID         string
 	IDCompany  string
 	IDCar      string
-	Name       string
-	Surname    string
-	Middle     string
-	Phone      string
-	Birthday   time.Time
+	Name       string    `log:"redact"`
+	Surname    string    `log:"redact"`
+	Middle     string    `log:"redact"`
+	Phone      string    `log:"redact"`
+	Birthday   time.Time `log:"redact"`
 	Rating     float32
 	WorkedTime int
-	CreatedAt  time.Time
+	Licence
+	// DeactivatedAt is when the driver was deactivated, nil while active.
+	DeactivatedAt *time.Time
+	CreatedAt     time.Time
+}
+
+// Licence is the driving licence and medical certificate of a driver. Fields
+// that are not known are nil.
+type Licence struct {
+	LicenceNumber     *string `log:"redact"`
+	LicenceCategories []string
+	LicenceExpiresAt  *time.Time
+	MedicalExpiresAt  *time.Time
 }
 
 type DriverStatisticsResponse struct {
-	FullName       string
+	FullName       string `log:"redact"`
 	WorkedTime     int
 	Experience     float32
 	Rating         float32
 	BreakagesCount int
 	DriverID       string
+	// Rank is the place of the driver among the company's drivers by rating.
+	Rank   int
+	Active bool
 }
 
 type DriverInfoResponse struct {
-	Name       string
-	Surname    string
-	MiddleName string
-	Phone      string
-	Birthday   time.Time
+	Name       string    `log:"redact"`
+	Surname    string    `log:"redact"`
+	MiddleName string    `log:"redact"`
+	Phone      string    `log:"redact"`
+	Birthday   time.Time `log:"redact"`
+	Licence
+	DeactivatedAt *time.Time
+	// Rating is the breakdown of the driver's latest calculated rating, nil
+	// until the driver has been rated.
+	Rating *DriverRating
 }
 
+// Position is a point of a car's route. IDDriver is the driver assigned to
+// the car when it was recorded, nil if there was none.
 type Position struct {
 	ID           string
 	DeviceNumber string
 	Location     Point
 	CreatedAt    time.Time
+	IDDriver     *string
 }
 
 type Point struct {
@@ -151,12 +176,15 @@ type CurrentPositionResponse struct {
 	IDUnicum string
 }
 
+// Breakage is a breakage as registered. IDType is the entry of the breakage
+// catalogue its type maps onto, if any.
 type Breakage struct {
 	ID          string
 	CarID       string
 	DriverID    string
 	Location    Point
 	Type        string
+	IDType      *string
 	Description string
 	CreatedAt   time.Time
 }
@@ -170,16 +198,25 @@ type BreakageFromMqtt struct {
 }
 
 type BreakageInfo struct {
-	ID          string
-	DriverName  string
-	StateNumber string
-	Type        string
-	Description string
-	CreatedAt   time.Time
+	ID              string
+	DriverName      string `log:"redact"`
+	StateNumber     string
+	Type            string
+	TypeName        *string
+	Severity        *string
+	MustStop        bool
+	Description     string
+	Status          string
+	Assignee        *string
+	Cost            *float64
+	CreatedAt       time.Time
+	ResolvedAt      *time.Time
+	DowntimeMinutes int
 }
 
 type Notification struct {
 	ID         string
+	IDCompany  string
 	IDCar      string
 	IDBreakage string
 	Note       string
@@ -187,19 +224,44 @@ type Notification struct {
 	CreatedAt  time.Time
 }
 
+// NotificationInfo describes a notification. Exactly one of the details is
+// set, the one of its type. Description, DriverName, Location and
+// BreakageStatus predate the details and are kept for existing clients:
+// BreakageStatus is the current status of the breakage it is about, nil for
+// other notifications.
 type NotificationInfo struct {
-	Description string    `json:"description"`
-	DriverName  string    `json:"driver_name"`
-	Location    Point     `json:"location"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Severity       string    `json:"severity"`
+	Status         string    `json:"status"`
+	IDCar          *string   `json:"car_id,omitempty"`
+	Description    string    `json:"description"`
+	DriverName     string    `json:"driver_name" log:"redact"`
+	Location       Point     `json:"location"`
+	BreakageStatus *string   `json:"breakage_status,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+
+	Breakage        *BreakageNotice        `json:"breakage,omitempty"`
+	WorkViolation   *WorkViolationNotice   `json:"work_violation,omitempty"`
+	DocumentExpiry  *DocumentExpiryNotice  `json:"document_expiry,omitempty"`
+	MaintenanceDue  *MaintenanceDueNotice  `json:"maintenance_due,omitempty"`
+	SensorThreshold *SensorThresholdNotice `json:"sensor_threshold,omitempty"`
+	SensorOffline   *SensorOfflineNotice   `json:"sensor_offline,omitempty"`
+	Geofence        *GeofenceNotice        `json:"geofence,omitempty"`
 }
 
 type NotificationListItem struct {
-	ID           string    `json:"id"`
-	StateNumber  string    `json:"state_number"`
-	Brand        string    `json:"brand"`
-	BreakageType string    `json:"breakage_type"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Severity       string    `json:"severity"`
+	Status         string    `json:"status"`
+	IDCar          *string   `json:"car_id,omitempty"`
+	Note           string    `json:"note"`
+	StateNumber    string    `json:"state_number"`
+	Brand          string    `json:"brand"`
+	BreakageType   string    `json:"breakage_type"`
+	BreakageStatus *string   `json:"breakage_status,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
 }
 
 type GetReportParams struct {
@@ -239,3 +301,23 @@ type UpdateMileage struct {
 	DeviceNum string
 	Mileage   float32
 }
+
+type TOTP struct {
+	UserID       string
+	Secret       string `log:"secret"`
+	Enabled      bool
+	LastUsedStep int64
+	LockedUntil  *time.Time
+}
+
+type TwoFactorState struct {
+	Enabled           bool
+	Required          bool
+	RecoveryCodesLeft int
+}
+
+type TOTPEnrollment struct {
+	Secret string `log:"secret"`
+	URL    string `log:"secret"`
+	QRCode []byte `log:"secret"`
+}
@@ -2,4 +2,19 @@ package models
 
 var (
 	NoteBreakage = "Произошла поломка"
+	// NoteSensorThreshold is the note of a sensor threshold notification,
+	// formatted with the position and axle of the wheel, the metric, the
+	// reading and its bounds.
+	NoteSensorThreshold = "Колесо %d оси %d: %s %.2f вне допустимых пределов %s"
+	// NoteGeofenceEnter and NoteGeofenceExit are the notes of a geofence
+	// notification, formatted with the state number of the car and the name
+	// of the geofence.
+	NoteGeofenceEnter = "Автомобиль %s въехал в геозону «%s»"
+	NoteGeofenceExit  = "Автомобиль %s выехал из геозоны «%s»"
 )
+
+// MetricNames are the names of the metrics of sensor readings in notes.
+var MetricNames = map[string]string{
+	MetricPressure:    "давление",
+	MetricTemperature: "температура",
+}
@@ -0,0 +1,142 @@
+package models
+
+import "time"
+
+// NotificationSeverity is the severity of notifications of each type. A
+// notification of a breakage of a type of the catalogue has the severity of
+// its type instead.
+var NotificationSeverity = map[string]string{
+	EventBreakage:        SeverityMedium,
+	EventWorkViolation:   SeverityMedium,
+	EventDocumentExpiry:  SeverityLow,
+	EventMaintenanceDue:  SeverityLow,
+	EventSensorThreshold: SeverityHigh,
+	EventSensorOffline:   SeverityMedium,
+	EventGeofence:        SeverityMedium,
+}
+
+// Metrics of a sensor reading checked against the bounds of its wheel.
+const (
+	MetricPressure    = "pressure"
+	MetricTemperature = "temperature"
+)
+
+// Transitions of a car across the boundary of a geofence.
+const (
+	GeofenceEnter = "enter"
+	GeofenceExit  = "exit"
+)
+
+// BreakageNotice is the detail of a breakage notification.
+type BreakageNotice struct {
+	ID          string  `json:"id"`
+	Type        string  `json:"type"`
+	TypeID      *string `json:"type_id,omitempty"`
+	Status      string  `json:"status"`
+	Description string  `json:"description"`
+	DriverID    string  `json:"driver_id"`
+	DriverName  string  `json:"driver_name" log:"redact"`
+	Latitude    float32 `json:"latitude"`
+	Longitude   float32 `json:"longitude"`
+}
+
+// WorkViolationNotice is the detail of a work violation notification.
+type WorkViolationNotice struct {
+	ID            string    `json:"id"`
+	DriverID      string    `json:"driver_id"`
+	DriverName    string    `json:"driver_name" log:"redact"`
+	Type          string    `json:"type"`
+	PeriodStart   time.Time `json:"period_start"`
+	PeriodEnd     time.Time `json:"period_end"`
+	LimitMinutes  int       `json:"limit_minutes"`
+	ActualMinutes int       `json:"actual_minutes"`
+}
+
+// DocumentExpiryNotice is the detail of a notification of a driver document
+// about to expire.
+type DocumentExpiryNotice struct {
+	ID         string    `json:"id"`
+	DriverID   string    `json:"driver_id"`
+	DriverName string    `json:"driver_name" log:"redact"`
+	Document   string    `json:"document"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// MaintenanceDueNotice is the detail of a notification of a maintenance task
+// falling due.
+type MaintenanceDueNotice struct {
+	TaskID     string     `json:"task_id"`
+	PlanID     string     `json:"plan_id"`
+	PlanName   string     `json:"plan_name"`
+	WheelID    *string    `json:"wheel_id,omitempty"`
+	Reason     string     `json:"reason"`
+	DueAt      *time.Time `json:"due_at,omitempty"`
+	DueMileage *float64   `json:"due_mileage,omitempty"`
+	Status     string     `json:"status"`
+}
+
+// SensorThresholdNotice is the detail of a notification of a sensor reading
+// out of the bounds of its wheel. A nil bound is not set. It is stored as
+// the payload of the notification.
+type SensorThresholdNotice struct {
+	WheelID      string    `json:"wheel_id"`
+	DeviceNumber string    `json:"device_number"`
+	SensorNumber string    `json:"sensor_number"`
+	Metric       string    `json:"metric"`
+	Value        float32   `json:"value"`
+	Min          *float32  `json:"min,omitempty"`
+	Max          *float32  `json:"max,omitempty"`
+	MeasuredAt   time.Time `json:"measured_at"`
+}
+
+// SensorOfflineNotice is the detail of a notification of a device that
+// stopped reporting. It is stored as the payload of the notification.
+type SensorOfflineNotice struct {
+	DeviceNumber string    `json:"device_number"`
+	LastSeenAt   time.Time `json:"last_seen_at"`
+}
+
+// GeofenceNotice is the detail of a notification of a car entering or
+// leaving a geofence. It is stored as the payload of the notification.
+type GeofenceNotice struct {
+	GeofenceID string    `json:"geofence_id"`
+	Geofence   string    `json:"geofence"`
+	Transition string    `json:"transition"`
+	Latitude   float32   `json:"latitude"`
+	Longitude  float32   `json:"longitude"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// SensorWheel is the wheel a sensor is mounted on, with the bounds of its
+// readings. A nil bound is not set and not checked.
+type SensorWheel struct {
+	ID             string
+	IDCompany      string
+	IDCar          string
+	AxisNumber     int
+	Position       int
+	MinPressure    *float32
+	MaxPressure    *float32
+	MinTemperature *float32
+	MaxTemperature *float32
+}
+
+// SensorThresholdNotification is a notification of a sensor reading out of
+// the bounds of a wheel of the car of the company.
+type SensorThresholdNotification struct {
+	IDCompany string
+	IDCar     string
+	Note      string
+	Notice    SensorThresholdNotice
+	CreatedAt time.Time
+}
+
+// GeofenceNotification is a notification of a car of the company crossing
+// the boundary of one of its geofences.
+type GeofenceNotification struct {
+	IDCompany string
+	IDCar     string
+	Note      string
+	Notice    GeofenceNotice
+	CreatedAt time.Time
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// PageRequest selects a page of a list. A Cursor returned with a previous page
+// continues the list after that page; Offset is only used without a cursor.
+type PageRequest struct {
+	Limit  int
+	Offset int
+	Cursor string
+	// Sort is a sort field of the list, prefixed with "-" for descending order.
+	// Empty selects the list's default order.
+	Sort string
+}
+
+// Page is one page of a list. NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T
+	Total      int
+	NextCursor string
+}
+
+type CarFilter struct {
+	IDCompany string
+	Brand     *string
+	Type      *string
+}
+
+type DriverFilter struct {
+	IDCompany       string
+	IncludeInactive bool
+}
+
+// NotificationFilter selects notifications of the user. MinSeverity selects
+// the notifications at least that severe.
+type NotificationFilter struct {
+	IDUser         string
+	Status         *string
+	Type           *string
+	MinSeverity    *string
+	BreakageType   *string
+	BreakageStatus *string
+	From           *time.Time
+	To             *time.Time
+}
+
+// BreakageFilter selects the breakages of a car. Statuses, when not empty,
+// are the statuses the breakages may be in.
+type BreakageFilter struct {
+	IDCompany string
+	IDCar     string
+	Type      *string
+	Severity  *string
+	Statuses  []string
+	From      *time.Time
+	To        *time.Time
+}
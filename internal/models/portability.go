@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+// PortableEntities are the kinds of records in a data export archive, in the
+// order they have to be restored in so that references resolve. Wheel
+// mileage is part of the wheels.
+var PortableEntities = []string{
+	"cars",
+	"wheels",
+	"drivers",
+	"driver_assignments",
+	"car_positions",
+	"sensor_data",
+	"positions",
+	"breakage_types",
+	"breakages",
+	"notifications",
+}
+
+var (
+	ExportFormatJSONL = "jsonl"
+	ExportFormatCSV   = "csv"
+)
+
+var (
+	AuditActionExport    = "export"
+	AuditResourceCompany = "company"
+)
+
+// ExportFilter selects the records of a company to export. From and To bound
+// the time series (sensor data, positions, breakages and notifications); the
+// other records are exported whole.
+type ExportFilter struct {
+	IDCompany string
+	From      *time.Time
+	To        *time.Time
+}
+
+// RecordWriter receives the records of one entity of an export, the column
+// names first. Values are those returned by the database driver.
+type RecordWriter interface {
+	WriteHeader(columns []string) error
+	WriteRecord(values []any) error
+}
+
+// RecordSource yields the records of an archive being restored, entity by
+// entity in the order of PortableEntities. Next returns io.EOF after the
+// last record.
+type RecordSource interface {
+	Next() (entity string, record map[string]any, err error)
+}
+
+// RestoreResult counts the records of one entity of a restored archive.
+// Records whose ID already exists, or which refer to records of another
+// company, are skipped.
+type RestoreResult struct {
+	Entity  string
+	Read    int
+	Created int
+	Skipped int
+}
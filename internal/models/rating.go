@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// BehaviourQuery selects the behaviour measured for driver ratings: what
+// happened since Since while the drivers were assigned to a car.
+type BehaviourQuery struct {
+	Since time.Time
+	// SpeedLimit is the speed in km/h above which a driver is speeding.
+	SpeedLimit float64
+	// HarshAcceleration is the change of speed in m/s², either way, that
+	// counts as harsh braking or accelerating.
+	HarshAcceleration float64
+	// MaxGap is the longest time between two reports of a device that still
+	// counts as continuous driving.
+	MaxGap time.Duration
+}
+
+// DriverBehaviour is what a driver did during the window of a rating.
+type DriverBehaviour struct {
+	IDDriver  string
+	IDCompany string
+	// WorkedTime is the driver's total worked time in minutes.
+	WorkedTime  int
+	Breakages   int
+	HarshEvents int
+	// DrivenSeconds is the time spent moving.
+	DrivenSeconds   float64
+	SpeedingSeconds float64
+	DistanceKm      float64
+	// UnderInflatedSeconds is the time wheels were driven on below their
+	// minimum pressure after an alert had been raised for the car.
+	UnderInflatedSeconds float64
+}
+
+// DriverRating is a driver's rating out of 10 with its breakdown. The
+// components add up to Rating.
+type DriverRating struct {
+	DriverBehaviour
+	Rating          float32
+	Safety          float32
+	Smoothness      float32
+	SpeedDiscipline float32
+	TireCare        float32
+	Experience      float32
+	WindowStart     time.Time
+	CalculatedAt    time.Time
+}
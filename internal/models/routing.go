@@ -0,0 +1,120 @@
+package models
+
+import "time"
+
+var (
+	AuditResourceNotificationRule = "notification_rule"
+)
+
+// Events notifications are raised for, which are the types of notifications.
+const (
+	EventBreakage        = "breakage"
+	EventWorkViolation   = "work_violation"
+	EventDocumentExpiry  = "document_expiry"
+	EventMaintenanceDue  = "maintenance_due"
+	EventSensorThreshold = "sensor_threshold"
+	EventSensorOffline   = "sensor_offline"
+	EventGeofence        = "geofence"
+)
+
+// NotificationEvents are the events notifications are raised for.
+var NotificationEvents = []string{EventBreakage, EventWorkViolation, EventDocumentExpiry, EventMaintenanceDue,
+	EventSensorThreshold, EventSensorOffline, EventGeofence}
+
+// Channels notifications are delivered through.
+const (
+	ChannelEmail    = "email"
+	ChannelWebhook  = "webhook"
+	ChannelSMS      = "sms"
+	ChannelTelegram = "telegram"
+)
+
+// NotificationChannels are the channels notifications are delivered through.
+var NotificationChannels = []string{ChannelEmail, ChannelWebhook, ChannelSMS, ChannelTelegram}
+
+// NotificationRule routes the notifications of the company to Target through
+// Channel: an email address, a URL, a phone number or a Telegram chat ID.
+// Empty EventTypes match every event and empty CarIDs every car; a rule with
+// CarIDs only matches notifications about a car. A rule with MinSeverity only
+// matches notifications at least that severe. Deliveries that fall in the
+// quiet hours, from QuietFrom to QuietTo minutes after local midnight, wait
+// until they end.
+type NotificationRule struct {
+	ID          string
+	IDCompany   string
+	Name        string
+	EventTypes  []string
+	MinSeverity *string
+	CarIDs      []string
+	Channel     string
+	Target      string
+	QuietFrom   *int
+	QuietTo     *int
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// RoutedNotification is a notification to be routed. IDCar is only set for
+// notifications about a car.
+type RoutedNotification struct {
+	ID        string
+	IDCompany string
+	Event     string
+	Severity  *string
+	IDCar     *string
+	Note      string
+	CreatedAt time.Time
+}
+
+// Statuses of a notification delivery.
+const (
+	DeliveryPending = "pending"
+	DeliverySent    = "sent"
+	DeliveryFailed  = "failed"
+)
+
+// NotificationDelivery is the delivery of a notification through a channel.
+// A pending delivery is attempted at NextAttemptAt; it fails once it runs out
+// of attempts.
+type NotificationDelivery struct {
+	ID             string
+	IDNotification string
+	IDRule         *string
+	Channel        string
+	Target         string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      *string
+	SentAt         *time.Time
+	CreatedAt      time.Time
+}
+
+// Routing is the outcome of routing notifications: the deliveries to make,
+// the webhook events announcing the notifications and the notifications
+// routed, including those no rule matched.
+type Routing struct {
+	Deliveries      []NotificationDelivery
+	Events          []WebhookEvent
+	NotificationIDs []string
+}
+
+// PendingDelivery is a delivery due for an attempt with the notification it
+// delivers.
+type PendingDelivery struct {
+	NotificationDelivery
+	Notification RoutedNotification
+}
+
+// Message is what a channel sends to a target.
+type Message struct {
+	Channel        string
+	Target         string
+	Subject        string
+	Text           string
+	IDNotification string
+	Event          string
+	Severity       *string
+	CreatedAt      time.Time
+}
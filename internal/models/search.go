@@ -0,0 +1,31 @@
+package models
+
+var (
+	SearchTypeCar      = "car"
+	SearchTypeDriver   = "driver"
+	SearchTypeWheel    = "wheel"
+	SearchTypeBreakage = "breakage"
+)
+
+// SearchTypes are the kinds of records a search looks through.
+var SearchTypes = []string{SearchTypeCar, SearchTypeDriver, SearchTypeWheel, SearchTypeBreakage}
+
+type SearchQuery struct {
+	IDCompany string
+	Text      string
+	// Types restricts the search to some of SearchTypes; empty means all.
+	Types []string
+	Limit int
+}
+
+type SearchResult struct {
+	Type     string
+	ID       string
+	Title    string
+	Subtitle string
+	// IDCar is the car the record belongs to, empty for drivers without a car.
+	IDCar string
+	// MatchedField is the searched field that matched the query best.
+	MatchedField string
+	Score        float64
+}
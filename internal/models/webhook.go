@@ -0,0 +1,178 @@
+package models
+
+import "time"
+
+var (
+	AuditResourceWebhook = "webhook"
+)
+
+// Fleet events webhook endpoints subscribe to.
+const (
+	WebhookBreakageCreated     = "breakage.created"
+	WebhookNotificationCreated = "notification.created"
+	WebhookCarRegistered       = "car.registered"
+	WebhookWheelChanged        = "wheel.changed"
+	WebhookGeofenceCrossed     = "geofence.crossed"
+)
+
+// WebhookEventTypes are the fleet events webhook endpoints subscribe to.
+var WebhookEventTypes = []string{WebhookBreakageCreated, WebhookNotificationCreated, WebhookCarRegistered, WebhookWheelChanged, WebhookGeofenceCrossed}
+
+// WebhookEndpoint receives the events of the company it subscribes to, every
+// event if EventTypes is empty. Payloads are signed with Secret.
+type WebhookEndpoint struct {
+	ID          string
+	IDCompany   string
+	URL         string
+	Description *string
+	EventTypes  []string
+	Secret      string `log:"secret"`
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// WebhookEvent is a fleet event of a company, queued for every active
+// endpoint of the company subscribed to its type. Payload is the JSON body
+// endpoints receive.
+type WebhookEvent struct {
+	ID        string
+	IDCompany string
+	Type      string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// Statuses of a webhook delivery.
+const (
+	WebhookPending   = "pending"
+	WebhookDelivered = "delivered"
+	// WebhookDead is a delivery that ran out of attempts, kept until it is
+	// replayed.
+	WebhookDead = "dead"
+)
+
+// WebhookStatuses are the statuses of a webhook delivery.
+var WebhookStatuses = []string{WebhookPending, WebhookDelivered, WebhookDead}
+
+// WebhookDelivery is an event in the outbox of an endpoint. A pending
+// delivery is attempted at NextAttemptAt.
+type WebhookDelivery struct {
+	ID             string
+	IDEndpoint     string
+	IDCompany      string
+	IDEvent        string
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      *string
+	LastStatusCode *int
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+// PendingWebhook is a delivery due for an attempt with where it goes.
+type PendingWebhook struct {
+	WebhookDelivery
+	URL    string
+	Secret string `log:"secret"`
+}
+
+// WebhookAttempt is an attempt of a webhook delivery. StatusCode is nil if
+// the endpoint did not respond.
+type WebhookAttempt struct {
+	ID          string
+	IDDelivery  string
+	AttemptedAt time.Time
+	StatusCode  *int
+	Error       *string
+	Duration    time.Duration
+}
+
+// WebhookDeliveryFilter selects the webhook deliveries of a company.
+type WebhookDeliveryFilter struct {
+	IDCompany  string
+	IDEndpoint *string
+	Status     *string
+	EventType  *string
+}
+
+// WebhookRequest is a signed delivery of a payload to a URL.
+type WebhookRequest struct {
+	URL        string
+	Secret     string `log:"secret"`
+	IDDelivery string
+	EventType  string
+	Payload    []byte
+	Timestamp  time.Time
+}
+
+// WebhookEnvelope is the JSON body of a webhook delivery. Data is one of the
+// webhook data types below, depending on Type.
+type WebhookEnvelope struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CompanyID string    `json:"company_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// BreakageWebhookData is the data of a breakage.created event.
+type BreakageWebhookData struct {
+	ID          string    `json:"id"`
+	CarID       string    `json:"car_id"`
+	DriverID    string    `json:"driver_id,omitempty"`
+	Type        string    `json:"type"`
+	TypeID      *string   `json:"type_id,omitempty"`
+	Description string    `json:"description"`
+	Latitude    float32   `json:"latitude"`
+	Longitude   float32   `json:"longitude"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NotificationWebhookData is the data of a notification.created event.
+type NotificationWebhookData struct {
+	ID        string    `json:"id"`
+	Event     string    `json:"event"`
+	Severity  *string   `json:"severity,omitempty"`
+	CarID     *string   `json:"car_id,omitempty"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CarWebhookData is the data of a car.registered event.
+type CarWebhookData struct {
+	ID           string `json:"id"`
+	StateNumber  string `json:"state_number"`
+	Brand        string `json:"brand"`
+	DeviceNumber string `json:"device_number"`
+	UniqueID     string `json:"unique_id"`
+	AxleCount    int    `json:"axle_count"`
+	Type         string `json:"type"`
+}
+
+// WheelWebhookData is the data of a wheel.changed event.
+type WheelWebhookData struct {
+	ID           string  `json:"id"`
+	CarID        string  `json:"car_id"`
+	Position     int     `json:"position"`
+	AxisNumber   int     `json:"axis_number"`
+	SensorNumber string  `json:"sensor_number"`
+	Brand        string  `json:"brand"`
+	Model        string  `json:"model"`
+	Size         float32 `json:"size"`
+	Mileage      float32 `json:"mileage"`
+}
+
+// GeofenceWebhookData is the data of a geofence.crossed event.
+type GeofenceWebhookData struct {
+	GeofenceID string    `json:"geofence_id"`
+	Geofence   string    `json:"geofence"`
+	CarID      string    `json:"car_id"`
+	Transition string    `json:"transition"`
+	Latitude   float32   `json:"latitude"`
+	Longitude  float32   `json:"longitude"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,128 @@
+package models
+
+import "time"
+
+var (
+	AuditActionStart         = "start"
+	AuditActionEnd           = "end"
+	AuditResourceWorkSession = "work_session"
+)
+
+// Sources of work sessions.
+const (
+	WorkSourceDevice = "device"
+	WorkSourceManual = "manual"
+)
+
+// Types of work violations, one per rest-time rule.
+const (
+	WorkViolationContinuousDriving = "continuous_driving"
+	WorkViolationDailyDriving      = "daily_driving"
+	WorkViolationDailyRest         = "daily_rest"
+	WorkViolationWeeklyDriving     = "weekly_driving"
+)
+
+// WorkViolationTypes are the types of work violations.
+var WorkViolationTypes = []string{
+	WorkViolationContinuousDriving,
+	WorkViolationDailyDriving,
+	WorkViolationDailyRest,
+	WorkViolationWeeklyDriving,
+}
+
+// WorkSession is a period a driver worked, on IDCar if it is known. EndedAt
+// is nil while the session is open.
+type WorkSession struct {
+	ID          string
+	IDCompany   string
+	IDDriver    string
+	IDCar       *string
+	StateNumber string
+	Source      string
+	StartedAt   time.Time
+	EndedAt     *time.Time
+}
+
+// WorkSessionFilter selects the work sessions of a company, optionally of one
+// driver and overlapping the period from From to To.
+type WorkSessionFilter struct {
+	IDCompany string
+	IDDriver  *string
+	From      *time.Time
+	To        *time.Time
+}
+
+// WorkRules are the rest-time rules work sessions are checked against.
+// Sessions less than MinBreak apart count as continuous driving.
+type WorkRules struct {
+	MaxContinuousDriving time.Duration
+	MinBreak             time.Duration
+	MaxDailyDriving      time.Duration
+	MinDailyRest         time.Duration
+	MaxWeeklyDriving     time.Duration
+}
+
+// WorkViolation is a breach of a rest-time rule by a driver during the period
+// from PeriodStart to PeriodEnd: the continuous stretch, day or week checked.
+type WorkViolation struct {
+	ID            string
+	IDCompany     string
+	IDDriver      string
+	Type          string
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+	LimitMinutes  int
+	ActualMinutes int
+	// Note is the text of the notification raised for the violation.
+	Note      string
+	CreatedAt time.Time
+}
+
+// WorkViolationFilter selects the work violations of a company, optionally of
+// one driver or type and with periods overlapping From to To.
+type WorkViolationFilter struct {
+	IDCompany string
+	IDDriver  *string
+	Type      *string
+	From      *time.Time
+	To        *time.Time
+}
+
+// WorkDay is the work of a driver during a day of the company's time zone.
+// FirstStart and LastEnd are nil on days off.
+type WorkDay struct {
+	Date       time.Time
+	FirstStart *time.Time
+	LastEnd    *time.Time
+	// Periods is the number of separate periods of work, overlapping sessions
+	// being one period.
+	Periods int
+	Worked  time.Duration
+	// LongestRest is the longest rest that started within 24 hours of
+	// FirstStart, however long after that it ended.
+	LongestRest time.Duration
+}
+
+// WorkWeek is the work of a driver during the week starting on Monday Start.
+type WorkWeek struct {
+	Start  time.Time
+	Worked time.Duration
+}
+
+// WorkSummary totals the work of a driver by day and by week.
+type WorkSummary struct {
+	IDDriver string
+	From     time.Time
+	To       time.Time
+	Days     []WorkDay
+	Weeks    []WorkWeek
+}
+
+// Timesheet is the work of a driver during a month, with the violations
+// found in it.
+type Timesheet struct {
+	Driver     DriverInfoResponse
+	Month      time.Time
+	Summary    WorkSummary
+	Violations []WorkViolation
+}
@@ -0,0 +1,52 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"syscall"
+)
+
+// ErrNonPublicAddress is returned when a webhook resolves to an address of
+// the private network the server runs in.
+var ErrNonPublicAddress = errors.New("webhook address is not public")
+
+// reservedPrefixes are ranges not covered by the netip predicates that are
+// still not reachable on the internet, such as carrier-grade NAT.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// IsPublicAddr reports whether addr is a unicast address on the internet:
+// not loopback, link-local (which includes cloud metadata services at
+// 169.254.169.254), private (RFC 1918 and unique local) or reserved.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, p := range reservedPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// dialPublicOnly is a net.Dialer control function that refuses connections
+// to addresses that are not public. It runs after name resolution, so a
+// host name cannot be pointed at an internal address once it was validated.
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !IsPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, addrPort.Addr())
+	}
+	return nil
+}
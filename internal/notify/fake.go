@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"context"
+	"sync"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Fake records the messages it is given instead of sending them, so that
+// routing and delivery can be exercised without any channel set up.
+type Fake struct {
+	mu       sync.Mutex
+	messages []models.Message
+	err      error
+}
+
+func (f *Fake) Send(ctx context.Context, m models.Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+	f.messages = append(f.messages, m)
+	return nil
+}
+
+// SetErr makes Send fail with err, or succeed again if err is nil.
+func (f *Fake) SetErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// Messages returns the messages sent so far.
+func (f *Fake) Messages() []models.Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]models.Message(nil), f.messages...)
+}
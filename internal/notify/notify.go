@@ -0,0 +1,156 @@
+// Package notify delivers notifications through the channels of routing
+// rules: email, webhooks, an SMS gateway and Telegram. It also delivers
+// signed fleet events to the webhook endpoints of companies.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// maxErrorBody is how much of the body of a failed response is kept in the
+// error.
+const maxErrorBody = 200
+
+// Webhook posts a notification as JSON to the URL of the target.
+type Webhook struct {
+	client *http.Client
+}
+
+func NewWebhook(timeout time.Duration) *Webhook {
+	return &Webhook{client: &http.Client{Timeout: timeout}}
+}
+
+// webhookPayload is the body of a webhook delivery.
+type webhookPayload struct {
+	NotificationID string    `json:"notification_id"`
+	Event          string    `json:"event"`
+	Severity       *string   `json:"severity,omitempty"`
+	Note           string    `json:"note"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (w *Webhook) Send(ctx context.Context, m models.Message) error {
+	return postJSON(ctx, w.client, m.Target, "", webhookPayload{
+		NotificationID: m.IDNotification,
+		Event:          m.Event,
+		Severity:       m.Severity,
+		Note:           m.Subject,
+		CreatedAt:      m.CreatedAt,
+	})
+}
+
+// SMS sends the text of a notification to the phone number of the target
+// through an HTTP gateway, which receives {"to": ..., "text": ...}.
+type SMS struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func NewSMS(gatewayURL, token string, timeout time.Duration) *SMS {
+	return &SMS{client: &http.Client{Timeout: timeout}, url: gatewayURL, token: token}
+}
+
+func (s *SMS) Send(ctx context.Context, m models.Message) error {
+	return postJSON(ctx, s.client, s.url, s.token, map[string]string{"to": m.Target, "text": m.Text})
+}
+
+// Telegram sends the text of a notification to the chat ID of the target
+// through the Bot API.
+type Telegram struct {
+	client *http.Client
+	url    string
+}
+
+func NewTelegram(apiURL, botToken string, timeout time.Duration) *Telegram {
+	return &Telegram{
+		client: &http.Client{Timeout: timeout},
+		url:    strings.TrimSuffix(apiURL, "/") + "/bot" + botToken + "/sendMessage",
+	}
+}
+
+func (t *Telegram) Send(ctx context.Context, m models.Message) error {
+	return postJSON(ctx, t.client, t.url, "", map[string]string{"chat_id": m.Target, "text": m.Text})
+}
+
+// Email sends a notification to the address of the target through an SMTP
+// server.
+type Email struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewEmail returns an Email sending from from through the server at addr,
+// host:port. The server is only authenticated with if username is set.
+func NewEmail(addr, username, password, from string) *Email {
+	e := &Email{addr: addr, from: from}
+	if username != "" {
+		host, _, _ := strings.Cut(addr, ":")
+		e.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return e
+}
+
+func (e *Email) Send(ctx context.Context, m models.Message) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", m.Target)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", m.Subject)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(m.Text, "\n", "\r\n"))
+
+	// net/smtp takes no context, so a cancelled delivery is only abandoned,
+	// not interrupted.
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(e.addr, e.auth, e.from, []string{m.Target}, msg.Bytes())
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// postJSON posts body as JSON to url, with token as a bearer token if set,
+// and reports a response other than 2xx as an error.
+func postJSON(ctx context.Context, client *http.Client, url string, token string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("%s responded %s: %s", req.URL.Host, resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSend(t *testing.T) {
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	severity := models.SeverityHigh
+
+	var got webhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	err := NewWebhook(time.Second).Send(context.Background(), models.Message{
+		Channel:        models.ChannelWebhook,
+		Target:         srv.URL,
+		Subject:        "Tire puncture",
+		IDNotification: "n1",
+		Event:          models.EventBreakage,
+		Severity:       &severity,
+		CreatedAt:      createdAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, webhookPayload{
+		NotificationID: "n1",
+		Event:          models.EventBreakage,
+		Severity:       &severity,
+		Note:           "Tire puncture",
+		CreatedAt:      createdAt,
+	}, got)
+}
+
+func TestSMSSendRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer gateway-token", r.Header.Get("Authorization"))
+		http.Error(w, "invalid number", http.StatusUnprocessableEntity)
+	}))
+	defer srv.Close()
+
+	err := NewSMS(srv.URL, "gateway-token", time.Second).Send(context.Background(), models.Message{
+		Channel: models.ChannelSMS,
+		Target:  "+79991234567",
+		Text:    "Tire puncture",
+	})
+	assert.ErrorContains(t, err, "422")
+	assert.ErrorContains(t, err, "invalid number")
+}
+
+func TestTelegramSend(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/botbot-token/sendMessage", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	err := NewTelegram(srv.URL+"/", "bot-token", time.Second).Send(context.Background(), models.Message{
+		Channel: models.ChannelTelegram,
+		Target:  "-100123",
+		Text:    "Tire puncture",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"chat_id": "-100123", "text": "Tire puncture"}, got)
+}
+
+func TestFake(t *testing.T) {
+	var f Fake
+	m := models.Message{Channel: models.ChannelEmail, Target: "fleet@example.com", Text: "Tire puncture"}
+
+	assert.NoError(t, f.Send(context.Background(), m))
+
+	f.SetErr(errors.New("unavailable"))
+	assert.EqualError(t, f.Send(context.Background(), m), "unavailable")
+
+	assert.Equal(t, []models.Message{m}, f.Messages())
+}
+
+func TestSignedWebhookDeliver(t *testing.T) {
+	ts := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	payload := []byte(`{"id":"e1","type":"breakage.created"}`)
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, payload, body)
+		assert.Equal(t, "d1", r.Header.Get(HeaderWebhookID))
+		assert.Equal(t, "breakage.created", r.Header.Get(HeaderWebhookEvent))
+		assert.Equal(t, "1772438400", r.Header.Get(HeaderWebhookTimestamp))
+
+		// A receiver recomputes the signature from the timestamp and body.
+		mac := hmac.New(sha256.New, []byte("whsec_test"))
+		mac.Write([]byte(r.Header.Get(HeaderWebhookTimestamp) + "." + string(body)))
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(HeaderWebhookSignature))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	// The test server listens on loopback, which NewSignedWebhook refuses.
+	webhook := &SignedWebhook{client: srv.Client()}
+	code, err := webhook.Deliver(context.Background(), models.WebhookRequest{
+		URL:        srv.URL,
+		Secret:     "whsec_test",
+		IDDelivery: "d1",
+		EventType:  models.WebhookBreakageCreated,
+		Payload:    payload,
+		Timestamp:  ts,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+}
+
+func TestSignedWebhookRefusesInternalTargets(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a loopback address")
+	}))
+	defer srv.Close()
+
+	webhook := NewSignedWebhook(time.Second)
+	req := models.WebhookRequest{Secret: "whsec_test", IDDelivery: "d1", Payload: []byte("{}")}
+
+	req.URL = srv.URL
+	code, err := webhook.Deliver(context.Background(), req)
+	assert.ErrorIs(t, err, ErrNonPublicAddress)
+	assert.Zero(t, code)
+
+	req.URL = "http://example.com/hook"
+	_, err = webhook.Deliver(context.Background(), req)
+	assert.ErrorIs(t, err, ErrInsecureWebhook)
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublicAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
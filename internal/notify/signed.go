@@ -0,0 +1,94 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Headers of a signed webhook delivery. The signature is the hex HMAC-SHA256,
+// keyed with the secret of the endpoint, of the timestamp, a dot and the body,
+// so that receivers can check both the sender and the age of a delivery.
+const (
+	HeaderWebhookID        = "X-Algalar-Delivery"
+	HeaderWebhookEvent     = "X-Algalar-Event"
+	HeaderWebhookTimestamp = "X-Algalar-Timestamp"
+	HeaderWebhookSignature = "X-Algalar-Signature"
+)
+
+// Sign returns the signature of a webhook payload sent at ts with secret.
+func Sign(secret string, ts time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ErrInsecureWebhook is returned for a webhook URL that is not https.
+var ErrInsecureWebhook = errors.New("webhook URL is not https")
+
+// SignedWebhook delivers fleet events to the webhook endpoints of companies,
+// signed with their secrets.
+type SignedWebhook struct {
+	client *http.Client
+}
+
+// NewSignedWebhook returns a SignedWebhook that only connects to public
+// addresses, without a proxy, and does not follow redirects, so that an
+// endpoint cannot make the server reach its own network.
+func NewSignedWebhook(timeout time.Duration) *SignedWebhook {
+	dialer := &net.Dialer{Timeout: timeout, Control: dialPublicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &SignedWebhook{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Deliver posts the payload of req to its URL and returns the status of the
+// response, 0 if there was none. A response other than 2xx is an error.
+func (s *SignedWebhook) Deliver(ctx context.Context, req models.WebhookRequest) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return 0, err
+	}
+	if httpReq.URL.Scheme != "https" {
+		return 0, ErrInsecureWebhook
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(HeaderWebhookID, req.IDDelivery)
+	httpReq.Header.Set(HeaderWebhookEvent, req.EventType)
+	httpReq.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(req.Timestamp.Unix(), 10))
+	httpReq.Header.Set(HeaderWebhookSignature, Sign(req.Secret, req.Timestamp, req.Payload))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, fmt.Errorf("%s responded %s: %s", httpReq.URL.Host, resp.Status, bytes.TrimSpace(msg))
+	}
+	// The body is drained so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Driver assignment
+// AssignDriver starts an assignment of a driver to a car of the company. The
+// open assignments of the driver and of the car end when it starts.
+func (r *Repository) AssignDriver(ctx context.Context, assignment models.DriverAssignment) (models.DriverAssignment, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	res, err := assignDriver(ctx, tx, assignment)
+	if err != nil {
+		return models.DriverAssignment{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Driver %s assigned to car %s from %s", res.IDDriver, res.IDCar, res.StartedAt)
+	return res, nil
+}
+
+// assignDriver runs the statements of AssignDriver on q, which must be a
+// transaction.
+func assignDriver(ctx context.Context, q queryRower, assignment models.DriverAssignment) (models.DriverAssignment, error) {
+	checkQuery := `
+		SELECT
+			EXISTS (SELECT 1 FROM drivers WHERE id = $1 AND id_company = $3),
+			(SELECT state_number FROM cars WHERE id = $2 AND id_company = $3),
+			EXISTS (
+				SELECT 1 FROM driver_assignments
+				WHERE (id_driver = $1 OR id_car = $2) AND (started_at > $4 OR ended_at > $4)
+			),
+			EXISTS (SELECT 1 FROM drivers WHERE id = $1 AND deactivated_at IS NOT NULL)`
+
+	var driverExists, conflict, deactivated bool
+	var stateNumber sql.NullString
+	err := q.QueryRowContext(ctx, checkQuery, assignment.IDDriver, assignment.IDCar, assignment.IDCompany, assignment.StartedAt).
+		Scan(&driverExists, &stateNumber, &conflict, &deactivated)
+	if err != nil {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	switch {
+	case !driverExists:
+		return models.DriverAssignment{}, models.ErrDriverNotFound
+	case deactivated:
+		return models.DriverAssignment{}, models.ErrDriverDeactivated
+	case !stateNumber.Valid:
+		return models.DriverAssignment{}, fmt.Errorf("%w: car %s", models.ErrNoContent, assignment.IDCar)
+	case conflict:
+		return models.DriverAssignment{}, models.ErrAssignmentConflict
+	}
+
+	closeQuery := `
+		WITH closed AS (
+			UPDATE driver_assignments
+			SET ended_at = $3
+			WHERE (id_driver = $1 OR id_car = $2) AND ended_at IS NULL
+			RETURNING id
+		)
+		SELECT COUNT(*) FROM closed`
+
+	var closed int
+	if err := q.QueryRowContext(ctx, closeQuery, assignment.IDDriver, assignment.IDCar, assignment.StartedAt).Scan(&closed); err != nil {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	insertQuery := `
+		INSERT INTO driver_assignments (id_company, id_driver, id_car, started_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, id_company, id_driver, id_car, started_at, ended_at`
+
+	res := models.DriverAssignment{StateNumber: stateNumber.String}
+	err = q.QueryRowContext(ctx, insertQuery, assignment.IDCompany, assignment.IDDriver, assignment.IDCar, assignment.StartedAt).
+		Scan(&res.ID, &res.IDCompany, &res.IDDriver, &res.IDCar, &res.StartedAt, &res.EndedAt)
+	if err != nil {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return res, nil
+}
+
+// UnassignDriver ends the open assignment of a driver of the company.
+func (r *Repository) UnassignDriver(ctx context.Context, companyID string, driverID string, endedAt time.Time) (models.DriverAssignment, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE driver_assignments a
+		SET ended_at = GREATEST(a.started_at, $3)
+		FROM cars c
+		WHERE c.id = a.id_car AND a.id_driver = $1 AND a.id_company = $2 AND a.ended_at IS NULL
+		RETURNING a.id, a.id_company, a.id_driver, a.id_car, COALESCE(c.state_number, ''), a.started_at, a.ended_at`
+
+	var res models.DriverAssignment
+	err := r.conn(ctx).QueryRowContext(ctx, query, driverID, companyID, endedAt).
+		Scan(&res.ID, &res.IDCompany, &res.IDDriver, &res.IDCar, &res.StateNumber, &res.StartedAt, &res.EndedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DriverAssignment{}, models.ErrDriverNotAssigned
+	}
+	if err != nil {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Driver %s unassigned from car %s", res.IDDriver, res.IDCar)
+	return res, nil
+}
+
+// GetAssignments returns a page of the company's assignments selected by
+// filter, the latest first by default.
+func (r *Repository) GetAssignments(ctx context.Context, filter models.AssignmentFilter, page models.PageRequest) (models.Page[models.DriverAssignment], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var f filterArgs
+	f.add("a.id_company = ?", filter.IDCompany)
+	if filter.IDDriver != nil {
+		f.add("a.id_driver = ?", *filter.IDDriver)
+	}
+	if filter.IDCar != nil {
+		f.add("a.id_car = ?", *filter.IDCar)
+	}
+	if filter.From != nil {
+		f.add("(a.ended_at IS NULL OR a.ended_at > ?)", *filter.From)
+	}
+	if filter.To != nil {
+		f.add("a.started_at < ?", *filter.To)
+	}
+
+	q := listQuery{
+		query: `
+			SELECT
+				a.id,
+				a.id_company,
+				a.id_driver,
+				a.id_car,
+				COALESCE(c.state_number, '') AS state_number,
+				a.started_at,
+				a.ended_at
+			FROM driver_assignments a
+			JOIN cars c ON c.id = a.id_car
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"started_at": {"started_at", "timestamp"},
+		},
+		defaultSort: "-started_at",
+	}
+
+	assignments, err := queryPage(ctx, r, q, page, func(a *models.DriverAssignment) []any {
+		return []any{&a.ID, &a.IDCompany, &a.IDDriver, &a.IDCar, &a.StateNumber, &a.StartedAt, &a.EndedAt}
+	})
+	if err != nil {
+		return assignments, fmt.Errorf("failed to get driver assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// GetDriverTrips returns the assignments of a driver of the company that
+// overlap the period from from to to, each with the positions its car
+// recorded while the driver was assigned within that period.
+func (r *Repository) GetDriverTrips(ctx context.Context, companyID string, driverID string, from time.Time, to time.Time) ([]models.DriverTrip, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	query := `
+		SELECT
+			a.id,
+			a.id_company,
+			a.id_driver,
+			a.id_car,
+			COALESCE(c.state_number, '') AS state_number,
+			a.started_at,
+			a.ended_at,
+			p.id,
+			p.device_number,
+			p.latitude,
+			p.longitude,
+			p.created_at
+		FROM driver_assignments a
+		JOIN cars c ON c.id = a.id_car
+		LEFT JOIN position_data p ON p.device_number = c.device_number
+			AND p.created_at >= GREATEST(a.started_at, $3)
+			AND p.created_at <= $4
+			AND (a.ended_at IS NULL OR p.created_at < a.ended_at)
+		WHERE a.id_driver = $1 AND a.id_company = $2
+			AND a.started_at <= $4 AND (a.ended_at IS NULL OR a.ended_at > $3)
+		ORDER BY a.started_at, p.created_at`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, driverID, companyID, from, to)
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query: %v", err)
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var trips []models.DriverTrip
+	for rows.Next() {
+		var a models.DriverAssignment
+		var positionID, deviceNumber sql.NullString
+		var latitude, longitude sql.NullFloat64
+		var createdAt sql.NullTime
+		if err := rows.Scan(&a.ID, &a.IDCompany, &a.IDDriver, &a.IDCar, &a.StateNumber, &a.StartedAt, &a.EndedAt,
+			&positionID, &deviceNumber, &latitude, &longitude, &createdAt); err != nil {
+			logging.FromContext(ctx, r.log).Errorf("Failed to scan row: %v", err)
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
+		}
+
+		if len(trips) == 0 || trips[len(trips)-1].Assignment.ID != a.ID {
+			trips = append(trips, models.DriverTrip{Assignment: a, Positions: []models.Position{}})
+		}
+		if !positionID.Valid {
+			continue
+		}
+		trip := &trips[len(trips)-1]
+		trip.Positions = append(trip.Positions, models.Position{
+			ID:           positionID.String,
+			DeviceNumber: deviceNumber.String,
+			Location:     models.Point{Latitude: float32(latitude.Float64), Longitude: float32(longitude.Float64)},
+			CreatedAt:    createdAt.Time,
+			IDDriver:     &a.IDDriver,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Error while iterating rows: %v", err)
+		return nil, fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Found %d trips of driver %s", len(trips), driverID)
+	return trips, nil
+}
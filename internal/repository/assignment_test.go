@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// expectAssignDriver expects the statements of a successful assignDriver.
+func expectAssignDriver(mock sqlmock.Sqlmock, a models.DriverAssignment) {
+	mock.ExpectQuery("SELECT EXISTS \\(SELECT 1 FROM drivers (.+) FROM driver_assignments").
+		WithArgs(a.IDDriver, a.IDCar, a.IDCompany, a.StartedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"driver", "state_number", "conflict", "deactivated"}).AddRow(true, "A123BC", false, false))
+	mock.ExpectQuery("UPDATE driver_assignments SET ended_at = \\$3").
+		WithArgs(a.IDDriver, a.IDCar, a.StartedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("INSERT INTO driver_assignments").
+		WithArgs(a.IDCompany, a.IDDriver, a.IDCar, a.StartedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "id_driver", "id_car", "started_at", "ended_at"}).
+			AddRow("a-"+a.IDDriver, a.IDCompany, a.IDDriver, a.IDCar, a.StartedAt, nil))
+}
+
+func TestAssignDriver(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	startedAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	assignment := models.DriverAssignment{IDCompany: "c1", IDDriver: "d1", IDCar: "car1", StartedAt: startedAt}
+
+	mock.ExpectBegin()
+	expectAssignDriver(mock, assignment)
+	mock.ExpectCommit()
+
+	res, err := repo.AssignDriver(context.Background(), assignment)
+	assert.NoError(t, err)
+	assert.Equal(t, models.DriverAssignment{
+		ID: "a-d1", IDCompany: "c1", IDDriver: "d1", IDCar: "car1", StateNumber: "A123BC", StartedAt: startedAt,
+	}, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAssignDriverRejectsOverlaps(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	assignment := models.DriverAssignment{IDCompany: "c1", IDDriver: "d1", IDCar: "car1", StartedAt: time.Now()}
+	columns := []string{"driver", "state_number", "conflict", "deactivated"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT EXISTS").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(true, "A123BC", true, false))
+	mock.ExpectRollback()
+
+	_, err = repo.AssignDriver(context.Background(), assignment)
+	assert.ErrorIs(t, err, models.ErrAssignmentConflict)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT EXISTS").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(true, nil, false, false))
+	mock.ExpectRollback()
+
+	_, err = repo.AssignDriver(context.Background(), assignment)
+	assert.ErrorIs(t, err, models.ErrNoContent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnassignDriverWithoutAssignment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	endedAt := time.Now()
+	mock.ExpectQuery("UPDATE driver_assignments a SET ended_at = GREATEST\\(a.started_at, \\$3\\)").
+		WithArgs("d1", "c1", endedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "id_driver", "id_car", "state_number", "started_at", "ended_at"}))
+
+	_, err = repo.UnassignDriver(context.Background(), "c1", "d1", endedAt)
+	assert.ErrorIs(t, err, models.ErrDriverNotAssigned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDriverByCaDviceNumAtTime(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	birthday := time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("FROM driver_assignments a (.+) WHERE c.device_number = \\$1 AND a.started_at <= \\$2 AND \\(a.ended_at IS NULL OR a.ended_at > \\$2\\)").
+		WithArgs("dev1", at).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "id_car", "name", "surname", "middle_name", "phone", "birthday", "rating", "worked_time", "created_at"}).
+			AddRow("d2", "c1", "car1", "Oleg", "Sidorov", "", "+79990000002", birthday, 10, 0, at))
+
+	driver, err := repo.GetDriverByCaDviceNum(context.Background(), "dev1", at)
+	assert.NoError(t, err)
+	assert.Equal(t, "d2", driver.ID)
+	assert.Equal(t, "car1", driver.IDCar)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDriverTrips(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+	firstStart := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	firstEnd := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	secondStart := time.Date(2026, 3, 2, 13, 0, 0, 0, time.UTC)
+	at := []time.Time{firstStart.Add(time.Hour), firstStart.Add(2 * time.Hour)}
+
+	columns := []string{"id", "id_company", "id_driver", "id_car", "state_number", "started_at", "ended_at",
+		"id", "device_number", "latitude", "longitude", "created_at"}
+	mock.ExpectQuery("FROM driver_assignments a JOIN cars c (.+) LEFT JOIN position_data p").
+		WithArgs("d1", "c1", from, to).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("a1", "c1", "d1", "car1", "A123BC", firstStart, firstEnd, "p1", "dev1", 61.5, 56.9, at[0]).
+			AddRow("a1", "c1", "d1", "car1", "A123BC", firstStart, firstEnd, "p2", "dev1", 61.6, 56.8, at[1]).
+			AddRow("a2", "c1", "d1", "car2", "B456CD", secondStart, nil, nil, nil, nil, nil, nil))
+
+	trips, err := repo.GetDriverTrips(context.Background(), "c1", "d1", from, to)
+	assert.NoError(t, err)
+	assert.Len(t, trips, 2)
+
+	assert.Equal(t, "a1", trips[0].Assignment.ID)
+	assert.Equal(t, &firstEnd, trips[0].Assignment.EndedAt)
+	assert.Len(t, trips[0].Positions, 2)
+	assert.Equal(t, "p1", trips[0].Positions[0].ID)
+	assert.Equal(t, at[1], trips[0].Positions[1].CreatedAt)
+	assert.Equal(t, "d1", *trips[0].Positions[0].IDDriver)
+
+	assert.Equal(t, "car2", trips[1].Assignment.IDCar)
+	assert.Nil(t, trips[1].Assignment.EndedAt)
+	assert.Empty(t, trips[1].Positions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Audit
+// CreateAuditEntry appends a record to audit_log. The table rejects updates and deletes.
+func (r *Repository) CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return models.AuditEntry{}, fmt.Errorf("failed to marshal audit changes: %w", err)
+	}
+	metadata, err := json.Marshal(entry.Metadata)
+	if err != nil {
+		return models.AuditEntry{}, fmt.Errorf("failed to marshal audit metadata: %w", err)
+	}
+
+	query := `
+		INSERT INTO audit_log (id_company, actor_id, action, resource_type, resource_id, changes, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+
+	err = r.conn(ctx).QueryRowContext(ctx, query,
+		nullString(entry.IDCompany),
+		nullString(entry.ActorID),
+		entry.Action,
+		entry.ResourceType,
+		entry.ResourceID,
+		changes,
+		metadata,
+	).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to create audit entry: %v", err)
+		return models.AuditEntry{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return entry, nil
+}
+
+// auditColumns are the columns of audit_log scanned by auditDest.
+const auditColumns = `
+	id,
+	COALESCE(id_company::text, '') AS id_company,
+	COALESCE(actor_id::text, '') AS actor_id,
+	action,
+	resource_type,
+	COALESCE(resource_id, '') AS resource_id,
+	COALESCE(changes, '{}'::jsonb) AS changes,
+	COALESCE(metadata, '{}'::jsonb) AS metadata,
+	created_at`
+
+func auditDest(e *models.AuditEntry) []any {
+	return []any{&e.ID, &e.IDCompany, &e.ActorID, &e.Action, &e.ResourceType, &e.ResourceID,
+		jsonValue{&e.Changes}, jsonValue{&e.Metadata}, &e.CreatedAt}
+}
+
+// jsonValue scans a jsonb column into the value dest points to.
+type jsonValue struct {
+	dest any
+}
+
+func (j jsonValue) Scan(src any) error {
+	raw, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("cannot scan %T as json", src)
+	}
+	return json.Unmarshal(raw, j.dest)
+}
+
+// auditQuery selects the audit entries of the company matching filter.
+func auditQuery(filter models.AuditFilter) (string, []any) {
+	var f filterArgs
+	f.add("id_company = ?", filter.IDCompany)
+	if filter.ActorID != nil {
+		f.add("actor_id = ?", *filter.ActorID)
+	}
+	if filter.Action != nil {
+		f.add("action = ?", *filter.Action)
+	}
+	if filter.ResourceType != nil {
+		f.add("resource_type = ?", *filter.ResourceType)
+	}
+	if filter.ResourceID != nil {
+		f.add("resource_id = ?", *filter.ResourceID)
+	}
+	if filter.From != nil {
+		f.add("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		f.add("created_at <= ?", *filter.To)
+	}
+	return `
+		SELECT ` + auditColumns + `
+		FROM audit_log
+		` + f.where(), f.args
+}
+
+// GetAuditLog returns a page of the audit entries of the company matching
+// filter, the newest first.
+func (r *Repository) GetAuditLog(ctx context.Context, filter models.AuditFilter, page models.PageRequest) (models.Page[models.AuditEntry], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	logging.FromContext(ctx, r.log).Debugf("Executing query to fetch audit log for company: %s", filter.IDCompany)
+
+	query, args := auditQuery(filter)
+	q := listQuery{
+		query:    query,
+		args:     args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"created_at": {"created_at", "timestamp"},
+		},
+		defaultSort: "-created_at",
+	}
+
+	return queryPage(ctx, r, q, page, auditDest)
+}
+
+// ExportAuditLog streams the audit entries of the company matching filter to
+// w, the newest first, one row at a time. Like ExportEntity it is bounded
+// only by the caller's context.
+func (r *Repository) ExportAuditLog(ctx context.Context, filter models.AuditFilter, w models.AuditWriter) error {
+	query, args := auditQuery(filter)
+	rows, err := r.conn(ctx).QueryContext(ctx, query+" ORDER BY created_at DESC, id DESC", args...)
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query: %v", err)
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var entry models.AuditEntry
+		if err := rows.Scan(auditDest(&entry)...); err != nil {
+			return fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		if err := w.WriteAuditEntry(entry); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Exported %d audit entries for company: %s", count, filter.IDCompany)
+	return nil
+}
+
+func nullString(val string) sql.NullString {
+	return sql.NullString{String: val, Valid: val != ""}
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAuditEntry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	entry := models.AuditEntry{
+		IDCompany:    "c1",
+		ActorID:      "c1",
+		Action:       models.AuditActionUpdate,
+		ResourceType: models.AuditResourceWheel,
+		ResourceID:   "w1",
+		Changes: map[string]models.AuditChange{
+			"MaxPressure": {Before: 8.5, After: 9.0},
+		},
+		Metadata: models.RequestMeta{IP: "10.0.0.1", Method: "PUT", Path: "/wheels"},
+	}
+	createdAt := time.Now()
+
+	mock.ExpectQuery("INSERT INTO audit_log").
+		WithArgs("c1", "c1", entry.Action, entry.ResourceType, entry.ResourceID,
+			[]byte(`{"MaxPressure":{"before":8.5,"after":9}}`),
+			[]byte(`{"ip":"10.0.0.1","method":"PUT","path":"/wheels"}`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("a1", createdAt))
+
+	res, err := repo.CreateAuditEntry(context.Background(), entry)
+	assert.NoError(t, err)
+	assert.Equal(t, "a1", res.ID)
+	assert.Equal(t, createdAt, res.CreatedAt)
+}
+
+func TestGetAuditLog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	action := models.AuditActionUpdate
+	filter := models.AuditFilter{IDCompany: "c1", Action: &action}
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	columns := []string{"id", "id_company", "actor_id", "action", "resource_type", "resource_id", "changes", "metadata", "created_at", "sort", "id"}
+
+	mock.ExpectQuery("FROM audit_log\\s+WHERE id_company = \\$1 AND action = \\$2\\) l\\s+ORDER BY l.created_at DESC, l.id::text DESC\\s+LIMIT \\$3").
+		WithArgs("c1", action, 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("a2", "c1", "c1", "update", "notification", "n1", []byte(`{"Status":{"before":"new","after":"read"}}`), []byte(`{"ip":"10.0.0.1"}`), createdAt, "2026-03-02 08:00:00", "a2").
+			AddRow("a1", "c1", "c1", "update", "notification", "n1", []byte(`{}`), []byte(`{}`), createdAt, "2026-03-02 08:00:00", "a1"))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM").
+		WithArgs("c1", action).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	entries, err := repo.GetAuditLog(context.Background(), filter, models.PageRequest{Limit: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, entries.Items, 1) {
+		assert.Equal(t, models.AuditChange{Before: "new", After: "read"}, entries.Items[0].Changes["Status"])
+		assert.Equal(t, "10.0.0.1", entries.Items[0].Metadata.IP)
+	}
+	assert.Equal(t, 2, entries.Total)
+	assert.NotEmpty(t, entries.NextCursor)
+
+	// Entries written at the same time are told apart by their IDs.
+	mock.ExpectQuery("WHERE \\(l.created_at, l.id::text\\) < \\(\\$3::timestamp, \\$4\\)").
+		WithArgs("c1", action, "2026-03-02 08:00:00", "a2", 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("a1", "c1", "c1", "update", "notification", "n1", []byte(`{}`), []byte(`{}`), createdAt, "2026-03-02 08:00:00", "a1"))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM").
+		WithArgs("c1", action).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	next, err := repo.GetAuditLog(context.Background(), filter, models.PageRequest{Limit: 1, Cursor: entries.NextCursor})
+	assert.NoError(t, err)
+	if assert.Len(t, next.Items, 1) {
+		assert.Equal(t, "a1", next.Items[0].ID)
+	}
+	assert.Empty(t, next.NextCursor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// auditRecorder collects the entries of an audit log export.
+type auditRecorder struct {
+	entries []models.AuditEntry
+}
+
+func (r *auditRecorder) WriteAuditEntry(entry models.AuditEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func TestExportAuditLog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM audit_log\\s+WHERE id_company = \\$1 AND created_at >= \\$2 ORDER BY created_at DESC, id DESC").
+		WithArgs("c1", from).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "actor_id", "action", "resource_type", "resource_id", "changes", "metadata", "created_at"}).
+			AddRow("a2", "c1", "c1", "update", "wheel", "w1", []byte(`{}`), []byte(`{}`), createdAt).
+			AddRow("a1", "c1", "c1", "create", "wheel", "w1", []byte(`{}`), []byte(`{}`), createdAt))
+
+	var out auditRecorder
+	err = repo.ExportAuditLog(context.Background(), models.AuditFilter{IDCompany: "c1", From: &from}, &out)
+	assert.NoError(t, err)
+	if assert.Len(t, out.entries, 2) {
+		assert.Equal(t, "a2", out.entries[0].ID)
+		assert.Equal(t, "a1", out.entries[1].ID)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,40 +1,51 @@
 package auth
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
+	"github.com/VikaPaz/algalar/internal/logging"
 	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/VikaPaz/algalar/internal/repository"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
 type Repository struct {
-	conn *sql.DB
-	log  *logrus.Logger
+	conn     *sql.DB
+	log      *logrus.Logger
+	timeouts repository.Timeouts
 }
 
-func NewRepository(conn *sql.DB, logger *logrus.Logger) *Repository {
+func NewRepository(conn *sql.DB, logger *logrus.Logger, timeouts repository.Timeouts) *Repository {
 	return &Repository{
-		conn: conn,
-		log:  logger,
+		conn:     conn,
+		log:      logger,
+		timeouts: timeouts,
 	}
 }
 
-func (r *Repository) CreateRefreshToken(userID string, refreshToken string, expiration time.Time) error {
+func (r *Repository) CreateRefreshToken(ctx context.Context, userID string, refreshToken string, expiration time.Time) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
 	query := `
         INSERT INTO refresh_store (user_id, token, expiration)
         VALUES ($1, $2, $3)
 		returning *`
 
-	_, err := r.conn.Exec(query, userID, refreshToken, expiration)
+	_, err := r.conn.ExecContext(ctx, query, userID, refreshToken, expiration)
 	if err != nil {
-		r.log.Errorf("failed to create token: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("failed to create token: %v", err)
 	}
 	return nil
 }
 
-func (r *Repository) GetRefresToken(userID string) (string, error) {
+func (r *Repository) GetRefresToken(ctx context.Context, userID string) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpRead)
+	defer cancel()
+
 	var storedToken string
 	var expiration time.Time
 
@@ -43,7 +54,7 @@ func (r *Repository) GetRefresToken(userID string) (string, error) {
 		return "", err
 	}
 
-	err = r.conn.QueryRow(`
+	err = r.conn.QueryRowContext(ctx, `
         SELECT token, expiration
         FROM refresh_store
         WHERE user_id = $1`,
@@ -60,25 +71,31 @@ func (r *Repository) GetRefresToken(userID string) (string, error) {
 	return storedToken, nil
 }
 
-func (r *Repository) UpdateRefreshToken(userID string, token string, expiration time.Time) error {
+func (r *Repository) UpdateRefreshToken(ctx context.Context, userID string, token string, expiration time.Time) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
 	query := `
 	update refresh_store 
 	set token = $1, expiration = $2
 	where user_id = $3 
 	`
 
-	_, err := r.conn.Exec(query, token, expiration, userID)
+	_, err := r.conn.ExecContext(ctx, query, token, expiration, userID)
 	return err
 }
 
-func (r *Repository) GetIDByLoginAndPassword(email, password string) (string, error) {
+func (r *Repository) GetIDByLoginAndPassword(ctx context.Context, email, password string) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpRead)
+	defer cancel()
+
 	var userID string
 	query := `
         SELECT id
         FROM  users
         WHERE login = $1 AND password = $2`
 
-	err := r.conn.QueryRow(query, email, password).Scan(&userID)
+	err := r.conn.QueryRowContext(ctx, query, email, password).Scan(&userID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return "", models.ErrNoContent
@@ -87,3 +104,266 @@ func (r *Repository) GetIDByLoginAndPassword(email, password string) (string, er
 	}
 	return userID, nil
 }
+
+// Two-factor
+func (r *Repository) GetUserLogin(ctx context.Context, userID string) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpRead)
+	defer cancel()
+
+	var login string
+	query := `
+        SELECT login
+        FROM users
+        WHERE id = $1`
+
+	err := r.conn.QueryRowContext(ctx, query, userID).Scan(&login)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", models.ErrNoContent
+		}
+		return "", err
+	}
+	return login, nil
+}
+
+func (r *Repository) GetTwoFactorPolicy(ctx context.Context, userID string) (bool, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpRead)
+	defer cancel()
+
+	var required sql.NullBool
+	query := `
+        SELECT require_2fa
+        FROM users
+        WHERE id = $1`
+
+	err := r.conn.QueryRowContext(ctx, query, userID).Scan(&required)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, models.ErrNoContent
+		}
+		return false, err
+	}
+	return required.Bool, nil
+}
+
+func (r *Repository) SetTwoFactorPolicy(ctx context.Context, userID string, required bool) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	query := `
+	update users
+	set require_2fa = $1
+	where id = $2
+	`
+
+	_, err := r.conn.ExecContext(ctx, query, required, userID)
+	return err
+}
+
+func (r *Repository) GetTOTP(ctx context.Context, userID string) (models.TOTP, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpRead)
+	defer cancel()
+
+	totp := models.TOTP{UserID: userID}
+	query := `
+        SELECT secret, enabled, last_used_step, locked_until
+        FROM user_totp
+        WHERE user_id = $1`
+
+	err := r.conn.QueryRowContext(ctx, query, userID).Scan(&totp.Secret, &totp.Enabled, &totp.LastUsedStep, &totp.LockedUntil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.TOTP{}, models.ErrNoContent
+		}
+		return models.TOTP{}, err
+	}
+	return totp, nil
+}
+
+// SaveTOTPSecret stores a new pending secret, replacing any previous unconfirmed one.
+func (r *Repository) SaveTOTPSecret(ctx context.Context, userID string, secret string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	query := `
+        INSERT INTO user_totp (user_id, secret, enabled, last_used_step)
+        VALUES ($1, $2, false, 0)
+        ON CONFLICT (user_id) DO UPDATE
+        SET secret = EXCLUDED.secret, enabled = false, last_used_step = 0,
+            failed_attempts = 0, locked_until = NULL,
+            created_at = CURRENT_TIMESTAMP, confirmed_at = NULL`
+
+	_, err := r.conn.ExecContext(ctx, query, userID, secret)
+	return err
+}
+
+// EnableTOTP marks the secret as confirmed and replaces the recovery codes in one transaction.
+func (r *Repository) EnableTOTP(ctx context.Context, userID string, step int64, codeHashes []string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+        UPDATE user_totp
+        SET enabled = true, last_used_step = $1, confirmed_at = CURRENT_TIMESTAMP
+        WHERE user_id = $2`, step, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, codeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateTOTPLastStep marks the step as used and clears the failed attempts.
+func (r *Repository) UpdateTOTPLastStep(ctx context.Context, userID string, step int64) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	query := `
+	update user_totp
+	set last_used_step = $1, failed_attempts = 0
+	where user_id = $2 and last_used_step < $1
+	`
+
+	res, err := r.conn.ExecContext(ctx, query, step, userID)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return models.ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+// FailTOTPAttempt counts a failed second factor attempt. The attempt that
+// makes maxAttempts in a row locks the second factor until lockedUntil and
+// starts the count over; locked reports whether it did.
+func (r *Repository) FailTOTPAttempt(ctx context.Context, userID string, maxAttempts int, lockedUntil time.Time) (locked bool, err error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	query := `
+	update user_totp
+	set failed_attempts = case when failed_attempts + 1 >= $2 then 0 else failed_attempts + 1 end,
+		locked_until = case when failed_attempts + 1 >= $2 then $3 else locked_until end
+	where user_id = $1
+	returning failed_attempts = 0
+	`
+
+	err = r.conn.QueryRowContext(ctx, query, userID, maxAttempts, lockedUntil).Scan(&locked)
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("failed to count totp attempt: %v", err)
+		return false, err
+	}
+	return locked, nil
+}
+
+// ResetTOTPAttempts clears the failed second factor attempts of the user.
+func (r *Repository) ResetTOTPAttempts(ctx context.Context, userID string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	_, err := r.conn.ExecContext(ctx, `UPDATE user_totp SET failed_attempts = 0 WHERE user_id = $1`, userID)
+	return err
+}
+
+func (r *Repository) DeleteTOTP(ctx context.Context, userID string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_totp WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	tx, err := r.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, codeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseRecoveryCode burns an unused recovery code and reports whether one matched.
+func (r *Repository) UseRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpWrite)
+	defer cancel()
+
+	query := `
+	update user_recovery_codes
+	set used_at = CURRENT_TIMESTAMP
+	where user_id = $1 and code_hash = $2 and used_at is null
+	`
+
+	res, err := r.conn.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *Repository) CountRecoveryCodes(ctx context.Context, userID string) (int, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, repository.OpRead)
+	defer cancel()
+
+	var count int
+	query := `
+        SELECT count(*)
+        FROM user_recovery_codes
+        WHERE user_id = $1 AND used_at IS NULL`
+
+	err := r.conn.QueryRowContext(ctx, query, userID).Scan(&count)
+	return count, err
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID string, codeHashes []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		_, err := tx.ExecContext(ctx, `
+        INSERT INTO user_recovery_codes (user_id, code_hash)
+        VALUES ($1, $2)`, userID, hash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
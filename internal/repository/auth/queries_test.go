@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/VikaPaz/algalar/internal/repository"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTOTP(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, repository.Timeouts{})
+
+	mock.ExpectQuery("SELECT secret, enabled, last_used_step, locked_until FROM user_totp").
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"secret", "enabled", "last_used_step", "locked_until"}).AddRow("JBSWY3DPEHPK3PXP", true, 42, nil))
+
+	totp, err := repo.GetTOTP(context.Background(), "1")
+	assert.NoError(t, err)
+	assert.Equal(t, models.TOTP{UserID: "1", Secret: "JBSWY3DPEHPK3PXP", Enabled: true, LastUsedStep: 42}, totp)
+}
+
+func TestGetTOTPNotEnrolled(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, repository.Timeouts{})
+
+	mock.ExpectQuery("SELECT secret, enabled, last_used_step, locked_until FROM user_totp").
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"secret", "enabled", "last_used_step", "locked_until"}))
+
+	_, err = repo.GetTOTP(context.Background(), "1")
+	assert.Equal(t, models.ErrNoContent, err)
+}
+
+func TestUpdateTOTPLastStepReplay(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, repository.Timeouts{})
+
+	mock.ExpectExec("update user_totp").
+		WithArgs(int64(100), "1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateTOTPLastStep(context.Background(), "1", 100)
+	assert.Equal(t, models.ErrInvalidTOTPCode, err)
+}
+
+func TestFailTOTPAttemptLocks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, repository.Timeouts{})
+
+	until := time.Date(2026, 3, 2, 8, 15, 0, 0, time.UTC)
+	mock.ExpectQuery("update user_totp set failed_attempts = case when failed_attempts \\+ 1 >= \\$2 then 0").
+		WithArgs("1", 5, until).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+
+	locked, err := repo.FailTOTPAttempt(context.Background(), "1", 5, until)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnableTOTP(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, repository.Timeouts{})
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE user_totp").
+		WithArgs(int64(100), "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM user_recovery_codes").
+		WithArgs("1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO user_recovery_codes").
+		WithArgs("1", "hash1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO user_recovery_codes").
+		WithArgs("1", "hash2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.EnableTOTP(context.Background(), "1", 100, []string{"hash1", "hash2"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, repository.Timeouts{})
+
+	mock.ExpectExec("update user_recovery_codes").
+		WithArgs("1", "hash1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	ok, err := repo.UseRecoveryCode(context.Background(), "1", "hash1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
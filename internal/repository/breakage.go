@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// breakageDowntime is the downtime of breakage b in minutes, until it was
+// resolved or until now.
+const breakageDowntime = `(EXTRACT(EPOCH FROM COALESCE(b.resolved_at, now()) - b.created_at) / 60)::int`
+
+// Breakage workflow
+// GetBreakage returns a breakage of the company with the state of its repair.
+func (r *Repository) GetBreakage(ctx context.Context, companyID string, breakageID string) (models.BreakageDetails, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT
+			b.id,
+			b.id_car,
+			COALESCE(c.state_number, ''),
+			CONCAT(d.name, ' ', d.surname, ' ', COALESCE(d.middle_name, '')),
+			COALESCE(b.latitude, 0),
+			COALESCE(b.longitude, 0),
+			COALESCE(b.type, ''),
+			b.id_type,
+			t.name,
+			t.severity,
+			COALESCE(t.must_stop, false),
+			COALESCE(b.description, ''),
+			b.status,
+			b.assignee,
+			b.repair_notes,
+			b.cost,
+			b.created_at,
+			b.resolved_at,
+			b.updated_at,
+			` + breakageDowntime + `
+		FROM breakages b
+		JOIN cars c ON b.id_car = c.id
+		LEFT JOIN breakage_types t ON t.id = b.id_type
+		LEFT JOIN driver_assignments a ON a.id_car = b.id_car
+			AND a.started_at <= b.created_at AND (a.ended_at IS NULL OR a.ended_at > b.created_at)
+		LEFT JOIN drivers d ON d.id = COALESCE(b.id_driver, a.id_driver)
+		WHERE b.id = $1 AND c.id_company = $2`
+
+	var b models.BreakageDetails
+	err := r.conn(ctx).QueryRowContext(ctx, query, breakageID, companyID).Scan(
+		&b.ID,
+		&b.IDCar,
+		&b.StateNumber,
+		&b.DriverName,
+		&b.Location.Latitude,
+		&b.Location.Longitude,
+		&b.Type,
+		&b.IDType,
+		&b.TypeName,
+		&b.Severity,
+		&b.MustStop,
+		&b.Description,
+		&b.Status,
+		&b.Assignee,
+		&b.RepairNotes,
+		&b.Cost,
+		&b.CreatedAt,
+		&b.ResolvedAt,
+		&b.UpdatedAt,
+		&b.DowntimeMinutes,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.BreakageDetails{}, models.ErrBreakageNotFound
+	}
+	if err != nil {
+		return models.BreakageDetails{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return b, nil
+}
+
+// UpdateBreakageRepair replaces the repair details of a breakage of the
+// company.
+func (r *Repository) UpdateBreakageRepair(ctx context.Context, companyID string, breakageID string, repair models.BreakageRepair) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE breakages b
+		SET assignee = $3, repair_notes = $4, cost = $5, updated_at = now()
+		FROM cars c
+		WHERE c.id = b.id_car AND b.id = $1 AND c.id_company = $2`
+
+	res, err := r.conn(ctx).ExecContext(ctx, query, breakageID, companyID, repair.Assignee, repair.RepairNotes, repair.Cost)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if n == 0 {
+		return models.ErrBreakageNotFound
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Repair details of breakage %s updated", breakageID)
+	return nil
+}
+
+// ChangeBreakageStatus moves a breakage of the company to change.Status if
+// models.BreakageTransitions allows it, and records the change in its
+// history. A breakage is resolved from the first time it is resolved or
+// closed until it is reopened.
+func (r *Repository) ChangeBreakageStatus(ctx context.Context, change models.BreakageStatusChange) (models.BreakageHistoryEntry, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.BreakageHistoryEntry{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	var from string
+	err = tx.QueryRowContext(ctx, `
+		SELECT b.status
+		FROM breakages b
+		JOIN cars c ON c.id = b.id_car
+		WHERE b.id = $1 AND c.id_company = $2
+		FOR UPDATE OF b`, change.IDBreakage, change.IDCompany).Scan(&from)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.BreakageHistoryEntry{}, models.ErrBreakageNotFound
+	}
+	if err != nil {
+		return models.BreakageHistoryEntry{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if !slices.Contains(models.BreakageTransitions[from], change.Status) {
+		return models.BreakageHistoryEntry{}, fmt.Errorf("%w: from %s to %s", models.ErrInvalidStatusTransition, from, change.Status)
+	}
+
+	resolved := change.Status == models.BreakageStatusResolved || change.Status == models.BreakageStatusClosed
+
+	query := `
+		WITH b AS (
+			UPDATE breakages
+			SET status = $2, updated_at = $3,
+				resolved_at = CASE WHEN $4 THEN COALESCE(resolved_at, $3) END
+			WHERE id = $1
+			RETURNING id, status
+		)
+		INSERT INTO breakage_status_history (id_breakage, from_status, to_status, note, changed_by, created_at)
+		SELECT id, $5, status, $6, $7, $3
+		FROM b
+		RETURNING id, id_breakage, from_status, to_status, note, changed_by, created_at`
+
+	var entry models.BreakageHistoryEntry
+	err = tx.QueryRowContext(ctx, query, change.IDBreakage, change.Status, change.ChangedAt, resolved, from, change.Note, change.ChangedBy).
+		Scan(&entry.ID, &entry.IDBreakage, &entry.FromStatus, &entry.ToStatus, &entry.Note, &entry.ChangedBy, &entry.CreatedAt)
+	if err != nil {
+		return models.BreakageHistoryEntry{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.BreakageHistoryEntry{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Breakage %s moved from %s to %s", entry.IDBreakage, entry.FromStatus, entry.ToStatus)
+	return entry, nil
+}
+
+// GetBreakageHistory returns the status changes of a breakage of the company,
+// the earliest first.
+func (r *Repository) GetBreakageHistory(ctx context.Context, companyID string, breakageID string) ([]models.BreakageHistoryEntry, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT h.id, h.id_breakage, h.from_status, h.to_status, h.note, h.changed_by, h.created_at
+		FROM breakage_status_history h
+		JOIN breakages b ON b.id = h.id_breakage
+		JOIN cars c ON c.id = b.id_car
+		WHERE h.id_breakage = $1 AND c.id_company = $2
+		ORDER BY h.created_at, h.id`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, breakageID, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	history := []models.BreakageHistoryEntry{}
+	for rows.Next() {
+		var entry models.BreakageHistoryEntry
+		if err := rows.Scan(&entry.ID, &entry.IDBreakage, &entry.FromStatus, &entry.ToStatus, &entry.Note, &entry.ChangedBy, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		history = append(history, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return history, nil
+}
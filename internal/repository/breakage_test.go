@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeBreakageStatusNotAllowed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT b.status (.+) FOR UPDATE OF b").
+		WithArgs("b1", "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(models.BreakageStatusClosed))
+	mock.ExpectRollback()
+
+	_, err = repo.ChangeBreakageStatus(context.Background(), models.BreakageStatusChange{
+		IDCompany:  "c1",
+		IDBreakage: "b1",
+		Status:     models.BreakageStatusInRepair,
+		ChangedBy:  "c1",
+		ChangedAt:  time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+	})
+	assert.ErrorIs(t, err, models.ErrInvalidStatusTransition)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeBreakageStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	note := "Tire replaced"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT b.status (.+) FOR UPDATE OF b").
+		WithArgs("b1", "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(models.BreakageStatusInRepair))
+	// Resolving the breakage ends its downtime.
+	mock.ExpectQuery("UPDATE breakages (.+) INSERT INTO breakage_status_history").
+		WithArgs("b1", models.BreakageStatusResolved, at, true, models.BreakageStatusInRepair, &note, "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_breakage", "from_status", "to_status", "note", "changed_by", "created_at"}).
+			AddRow("h1", "b1", models.BreakageStatusInRepair, models.BreakageStatusResolved, note, "c1", at))
+	mock.ExpectCommit()
+
+	entry, err := repo.ChangeBreakageStatus(context.Background(), models.BreakageStatusChange{
+		IDCompany:  "c1",
+		IDBreakage: "b1",
+		Status:     models.BreakageStatusResolved,
+		Note:       &note,
+		ChangedBy:  "c1",
+		ChangedAt:  at,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.BreakageHistoryEntry{
+		ID: "h1", IDBreakage: "b1", FromStatus: models.BreakageStatusInRepair, ToStatus: models.BreakageStatusResolved,
+		Note: &note, ChangedBy: "c1", CreatedAt: at,
+	}, entry)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateBreakageRepairOfAnotherCompany(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	assignee := "Service station 3"
+	cost := 4500.0
+	mock.ExpectExec("UPDATE breakages b").
+		WithArgs("b1", "c2", &assignee, nil, &cost).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateBreakageRepair(context.Background(), "c2", "b1", models.BreakageRepair{Assignee: &assignee, Cost: &cost})
+	assert.ErrorIs(t, err, models.ErrBreakageNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBreakagesByCarIdByStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	carID := "6f1c1d3e-5d0a-4d55-9d4c-3f1a9e2b7c10"
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	statuses := []string{models.BreakageStatusReported, models.BreakageStatusInRepair}
+
+	mock.ExpectQuery("FROM breakages b(.+)WHERE b.id_car = \\$1 AND c.id_company = \\$2 AND b.status = ANY\\(\\$3\\)").
+		WithArgs(sqlmock.AnyArg(), "c1", pq.Array(statuses), 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "full_name", "state_number", "type", "type_name", "severity", "must_stop", "description", "status", "assignee", "cost",
+			"created_at", "resolved_at", "downtime_minutes", "sort", "id",
+		}).AddRow("b1", "John Doe ", "A123BC", "Tire puncture", nil, nil, false, "Flat tire", models.BreakageStatusInRepair, "Service station 3", nil,
+			createdAt, nil, 240, createdAt, "b1"))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM").
+		WithArgs(sqlmock.AnyArg(), "c1", pq.Array(statuses)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	filter := models.BreakageFilter{IDCompany: "c1", IDCar: carID, Statuses: statuses}
+	res, err := repo.GetBreakagesByCarId(context.Background(), filter, models.PageRequest{Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, res.Items, 1) {
+		assignee := "Service station 3"
+		assert.Equal(t, models.BreakageInfo{
+			ID: "b1", DriverName: "John Doe ", StateNumber: "A123BC", Type: "Tire puncture", Description: "Flat tire",
+			Status: models.BreakageStatusInRepair, Assignee: &assignee, CreatedAt: createdAt, DowntimeMinutes: 240,
+		}, res.Items[0])
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
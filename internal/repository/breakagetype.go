@@ -0,0 +1,316 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+)
+
+// breakageTypeColumns are the columns of breakage_types scanned by
+// breakageTypeDest.
+const breakageTypeColumns = `id, id_company, code, name, severity, must_stop, component, wheel_position, device_codes, created_at, updated_at`
+
+func breakageTypeDest(t *models.BreakageType) []any {
+	return []any{&t.ID, &t.IDCompany, &t.Code, &t.Name, &t.Severity, &t.MustStop, &t.Component, &t.WheelPosition,
+		pq.Array(&t.DeviceCodes), &t.CreatedAt, &t.UpdatedAt}
+}
+
+// breakageStatsGroup are the expressions breakage statistics are grouped by.
+type breakageStatsGroup struct {
+	key      string
+	name     string
+	severity string
+}
+
+var breakageStatsGroups = map[string]breakageStatsGroup{
+	models.BreakageStatsByType: {
+		key:      "COALESCE(t.code, b.type, '')",
+		name:     "COALESCE(t.name, b.type, '')",
+		severity: "t.severity",
+	},
+	models.BreakageStatsByCarModel: {
+		key:      "COALESCE(c.brand, '')",
+		name:     "COALESCE(c.brand, '')",
+		severity: "NULL::varchar",
+	},
+	models.BreakageStatsByDriver: {
+		key:      "COALESCE(d.id::text, '')",
+		name:     "CONCAT(d.name, ' ', d.surname, ' ', COALESCE(d.middle_name, ''))",
+		severity: "NULL::varchar",
+	},
+}
+
+// Breakage catalogue
+// CreateBreakageType adds a type to the breakage catalogue of the company and
+// classifies the company's unclassified breakages of that type.
+func (r *Repository) CreateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	if err := checkBreakageCodes(ctx, tx, nil, t); err != nil {
+		return models.BreakageType{}, err
+	}
+
+	query := `
+		INSERT INTO breakage_types (id_company, code, name, severity, must_stop, component, wheel_position, device_codes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + breakageTypeColumns
+
+	var res models.BreakageType
+	err = tx.QueryRowContext(ctx, query, t.IDCompany, t.Code, t.Name, t.Severity, t.MustStop, t.Component, t.WheelPosition, pq.Array(t.DeviceCodes)).
+		Scan(breakageTypeDest(&res)...)
+	if err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	mapped, err := classifyBreakages(ctx, tx, res)
+	if err != nil {
+		return models.BreakageType{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Breakage type %s created, %d breakages classified", res.Code, mapped)
+	return res, nil
+}
+
+// UpdateBreakageType replaces a type of the breakage catalogue of the company
+// and classifies the company's unclassified breakages of that type. Breakages
+// classified before keep their type.
+func (r *Repository) UpdateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	if err := checkBreakageCodes(ctx, tx, &t.ID, t); err != nil {
+		return models.BreakageType{}, err
+	}
+
+	query := `
+		UPDATE breakage_types
+		SET code = $3, name = $4, severity = $5, must_stop = $6, component = $7, wheel_position = $8, device_codes = $9,
+			updated_at = now()
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + breakageTypeColumns
+
+	var res models.BreakageType
+	err = tx.QueryRowContext(ctx, query, t.ID, t.IDCompany, t.Code, t.Name, t.Severity, t.MustStop, t.Component, t.WheelPosition, pq.Array(t.DeviceCodes)).
+		Scan(breakageTypeDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.BreakageType{}, models.ErrBreakageTypeNotFound
+	}
+	if err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	mapped, err := classifyBreakages(ctx, tx, res)
+	if err != nil {
+		return models.BreakageType{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Breakage type %s updated, %d breakages classified", res.Code, mapped)
+	return res, nil
+}
+
+// checkBreakageCodes reports models.ErrBreakageCodeTaken if another type of
+// the company's catalogue than the one with ID id already maps the code or
+// one of the device codes of t. It locks the catalogue of the company until
+// the end of tx, so that two types written at once cannot both pass the
+// check with the same code.
+func checkBreakageCodes(ctx context.Context, tx dbtx, id *string, t models.BreakageType) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('breakage_types:' || $1))", t.IDCompany); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	query := `
+		SELECT code
+		FROM breakage_types
+		WHERE id_company = $1 AND ($2::uuid IS NULL OR id <> $2)
+			AND (code = ANY($3::varchar[]) OR device_codes && $3::varchar[])
+		LIMIT 1`
+
+	codes := append([]string{t.Code}, t.DeviceCodes...)
+	var taken string
+	err := tx.QueryRowContext(ctx, query, t.IDCompany, id, pq.Array(codes)).Scan(&taken)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return fmt.Errorf("%w: mapped by %s", models.ErrBreakageCodeTaken, taken)
+}
+
+// classifyBreakages sets t as the type of the company's unclassified
+// breakages whose device-reported type it maps and returns their number.
+func classifyBreakages(ctx context.Context, tx dbtx, t models.BreakageType) (int64, error) {
+	query := `
+		UPDATE breakages b
+		SET id_type = $1
+		FROM cars c
+		WHERE c.id = b.id_car AND c.id_company = $2 AND b.id_type IS NULL
+			AND (b.type = $3 OR b.type = ANY($4))`
+
+	res, err := tx.ExecContext(ctx, query, t.ID, t.IDCompany, t.Code, pq.Array(t.DeviceCodes))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return n, nil
+}
+
+// DeleteBreakageType removes a type from the breakage catalogue of the
+// company. Its breakages become unclassified.
+func (r *Repository) DeleteBreakageType(ctx context.Context, companyID string, typeID string) (models.BreakageType, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		DELETE FROM breakage_types
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + breakageTypeColumns
+
+	var res models.BreakageType
+	err := r.conn(ctx).QueryRowContext(ctx, query, typeID, companyID).Scan(breakageTypeDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.BreakageType{}, models.ErrBreakageTypeNotFound
+	}
+	if err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Breakage type %s deleted", res.Code)
+	return res, nil
+}
+
+// GetBreakageType returns a type of the breakage catalogue of the company.
+func (r *Repository) GetBreakageType(ctx context.Context, companyID string, typeID string) (models.BreakageType, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + breakageTypeColumns + `
+		FROM breakage_types
+		WHERE id = $1 AND id_company = $2`
+
+	var t models.BreakageType
+	err := r.conn(ctx).QueryRowContext(ctx, query, typeID, companyID).Scan(breakageTypeDest(&t)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.BreakageType{}, models.ErrBreakageTypeNotFound
+	}
+	if err != nil {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return t, nil
+}
+
+// GetBreakageTypes returns the breakage catalogue of the company ordered by
+// code.
+func (r *Repository) GetBreakageTypes(ctx context.Context, companyID string) ([]models.BreakageType, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + breakageTypeColumns + `
+		FROM breakage_types
+		WHERE id_company = $1
+		ORDER BY code`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	types := []models.BreakageType{}
+	for rows.Next() {
+		var t models.BreakageType
+		if err := rows.Scan(breakageTypeDest(&t)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		types = append(types, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return types, nil
+}
+
+// GetBreakageStats counts the breakages of the company selected by q in each
+// group, the most frequent first.
+func (r *Repository) GetBreakageStats(ctx context.Context, q models.BreakageStatsQuery) ([]models.BreakageStats, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	group, ok := breakageStatsGroups[q.GroupBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown grouping %q", models.ErrInvalidParameter, q.GroupBy)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			%[1]s AS key,
+			%[2]s AS name,
+			%[3]s AS severity,
+			COUNT(*) AS count,
+			COUNT(*) FILTER (WHERE t.must_stop) AS must_stop_count,
+			MAX(b.created_at) AS last_at
+		FROM breakages b
+		JOIN cars c ON c.id = b.id_car
+		LEFT JOIN breakage_types t ON t.id = b.id_type
+		LEFT JOIN driver_assignments a ON a.id_car = b.id_car
+			AND a.started_at <= b.created_at AND (a.ended_at IS NULL OR a.ended_at > b.created_at)
+		LEFT JOIN drivers d ON d.id = COALESCE(b.id_driver, a.id_driver)
+		WHERE c.id_company = $1
+			AND ($2::timestamp IS NULL OR b.created_at >= $2)
+			AND ($3::timestamp IS NULL OR b.created_at <= $3)
+		GROUP BY 1, 2, 3
+		ORDER BY count DESC, key`, group.key, group.name, group.severity)
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, q.IDCompany, q.From, q.To)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	stats := []models.BreakageStats{}
+	for rows.Next() {
+		var s models.BreakageStats
+		if err := rows.Scan(&s.Key, &s.Name, &s.Severity, &s.Count, &s.MustStopCount, &s.LastAt); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return stats, nil
+}
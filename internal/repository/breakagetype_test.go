@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateBreakageTypeCodeTaken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock").
+		WithArgs("c1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT code FROM breakage_types").
+		WithArgs("c1", sqlmock.AnyArg(), pq.Array([]string{"TIRE_LOW", "E12"})).
+		WillReturnRows(sqlmock.NewRows([]string{"code"}).AddRow("TIRE_FLAT"))
+	mock.ExpectRollback()
+
+	_, err = repo.CreateBreakageType(context.Background(), models.BreakageType{
+		IDCompany:   "c1",
+		Code:        "TIRE_LOW",
+		Name:        "Low tire pressure",
+		Severity:    models.SeverityMedium,
+		DeviceCodes: []string{"E12"},
+	})
+	assert.ErrorIs(t, err, models.ErrBreakageCodeTaken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateBreakageType(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	codes := []string{"E12"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock").
+		WithArgs("c1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT code FROM breakage_types").
+		WithArgs("c1", sqlmock.AnyArg(), pq.Array([]string{"TIRE_LOW", "E12"})).
+		WillReturnRows(sqlmock.NewRows([]string{"code"}))
+	mock.ExpectQuery("INSERT INTO breakage_types").
+		WithArgs("c1", "TIRE_LOW", "Low tire pressure", models.SeverityHigh, true, nil, nil, pq.Array(codes)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "id_company", "code", "name", "severity", "must_stop", "component", "wheel_position", "device_codes",
+			"created_at", "updated_at",
+		}).AddRow("t1", "c1", "TIRE_LOW", "Low tire pressure", models.SeverityHigh, true, nil, nil, "{E12}", createdAt, nil))
+	// Breakages registered before the type existed are classified as it.
+	mock.ExpectExec("UPDATE breakages b").
+		WithArgs("t1", "c1", "TIRE_LOW", pq.Array(codes)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	res, err := repo.CreateBreakageType(context.Background(), models.BreakageType{
+		IDCompany:   "c1",
+		Code:        "TIRE_LOW",
+		Name:        "Low tire pressure",
+		Severity:    models.SeverityHigh,
+		MustStop:    true,
+		DeviceCodes: codes,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.BreakageType{
+		ID: "t1", IDCompany: "c1", Code: "TIRE_LOW", Name: "Low tire pressure", Severity: models.SeverityHigh,
+		MustStop: true, DeviceCodes: codes, CreatedAt: createdAt,
+	}, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBreakageStatsByType(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	lastAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	severity := models.SeverityHigh
+
+	mock.ExpectQuery("COALESCE\\(t.code, b.type, ''\\) AS key(.+)GROUP BY 1, 2, 3").
+		WithArgs("c1", nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "name", "severity", "count", "must_stop_count", "last_at"}).
+			AddRow("TIRE_LOW", "Low tire pressure", severity, 4, 4, lastAt).
+			AddRow("Unknown noise", "Unknown noise", nil, 1, 0, lastAt))
+
+	stats, err := repo.GetBreakageStats(context.Background(), models.BreakageStatsQuery{IDCompany: "c1", GroupBy: models.BreakageStatsByType})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.BreakageStats{
+		{Key: "TIRE_LOW", Name: "Low tire pressure", Severity: &severity, Count: 4, MustStopCount: 4, LastAt: lastAt},
+		{Key: "Unknown noise", Name: "Unknown noise", Count: 1, LastAt: lastAt},
+	}, stats)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateBreakagePrefersTypeWithTheCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	typeID := "t1"
+
+	// A type whose code is the reported one wins over a type mapping it as a
+	// device code, and ties are broken the same way every time.
+	mock.ExpectQuery("INSERT INTO breakages(.+)ORDER BY t.code = \\$5 DESC, t.created_at, t.id\\s+LIMIT 1").
+		WithArgs("car1", "d1", float32(55.7), float32(37.6), "E12", "", createdAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_car", "id_driver", "latitude", "longitude", "type", "id_type", "description", "created_at"}).
+			AddRow("b1", "car1", "d1", 55.7, 37.6, "E12", typeID, "", createdAt))
+
+	res, err := repo.CreateBreakage(context.Background(), models.Breakage{
+		CarID:     "car1",
+		DriverID:  "d1",
+		Location:  models.Point{Latitude: 55.7, Longitude: 37.6},
+		Type:      "E12",
+		CreatedAt: createdAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &typeID, res.IDType)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,24 +1,82 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/XSAM/otelsql"
 	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
-type Config struct{ Host, Port, User, Password, Dbname string }
+type Config struct {
+	Host, Port, User, Password, Dbname string
+
+	// Pool limits; zero leaves the database/sql default.
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// Timeouts bound how long a single query may run, by class of operation.
+// A zero duration leaves the class bounded only by the caller's context.
+type Timeouts struct {
+	Read   time.Duration
+	Write  time.Duration
+	Report time.Duration
+}
+
+type OperationClass int
+
+const (
+	OpRead OperationClass = iota
+	OpWrite
+	OpReport
+)
+
+// WithTimeout derives the context a query of the given class runs with.
+func (t Timeouts) WithTimeout(ctx context.Context, class OperationClass) (context.Context, context.CancelFunc) {
+	var timeout time.Duration
+	switch class {
+	case OpRead:
+		timeout = t.Read
+	case OpWrite:
+		timeout = t.Write
+	case OpReport:
+		timeout = t.Report
+	}
+
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
 
 func Connection(conf Config) (*sql.DB, error) {
 	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s "+
 		"password=%s dbname=%s sslmode=disable",
 		conf.Host, conf.Port, conf.User, conf.Password, conf.Dbname)
 
-	db, err := sql.Open("postgres", psqlInfo)
+	// Every statement, including those run in transactions, gets its own span.
+	db, err := otelsql.Open("postgres", psqlInfo,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		return nil, models.ErrConnectionDBFailed
 	}
 
+	db.SetMaxOpenConns(conf.MaxOpenConns)
+	if conf.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(conf.MaxIdleConns)
+	}
+	db.SetConnMaxLifetime(conf.ConnMaxLifetime)
+
 	return db, db.Ping()
 }
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// documentColumns are the columns of driver_documents scanned by
+// documentDest, all but the content.
+const documentColumns = `id, id_company, id_driver, type, file_name, content_type, size, created_at`
+
+func documentDest(d *models.DriverDocument) []any {
+	return []any{&d.ID, &d.IDCompany, &d.IDDriver, &d.Type, &d.FileName, &d.ContentType, &d.Size, &d.CreatedAt}
+}
+
+// Driver documents
+// CreateDriverDocument attaches a document to a driver of the company. The
+// returned document has no content.
+func (r *Repository) CreateDriverDocument(ctx context.Context, doc models.DriverDocument) (models.DriverDocument, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		INSERT INTO driver_documents (id_company, id_driver, type, file_name, content_type, size, content)
+		SELECT id_company, id, $3, $4, $5, $6, $7
+		FROM drivers
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + documentColumns
+
+	var res models.DriverDocument
+	err := r.conn(ctx).QueryRowContext(ctx, query, doc.IDDriver, doc.IDCompany, doc.Type, doc.FileName, doc.ContentType, len(doc.Content), doc.Content).
+		Scan(documentDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DriverDocument{}, models.ErrDriverNotFound
+	}
+	if err != nil {
+		return models.DriverDocument{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Document %s of %d bytes attached to driver %s", res.ID, res.Size, res.IDDriver)
+	return res, nil
+}
+
+// GetDriverDocuments returns the documents of a driver of the company without
+// their content, the latest first.
+func (r *Repository) GetDriverDocuments(ctx context.Context, companyID string, driverID string) ([]models.DriverDocument, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + documentColumns + `
+		FROM driver_documents
+		WHERE id_driver = $1 AND id_company = $2
+		ORDER BY created_at DESC, id`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, driverID, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	docs := []models.DriverDocument{}
+	for rows.Next() {
+		var doc models.DriverDocument
+		if err := rows.Scan(documentDest(&doc)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		docs = append(docs, doc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return docs, nil
+}
+
+// GetDriverDocument returns a document of the company with its content.
+func (r *Repository) GetDriverDocument(ctx context.Context, companyID string, documentID string) (models.DriverDocument, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + documentColumns + `, content
+		FROM driver_documents
+		WHERE id = $1 AND id_company = $2`
+
+	var doc models.DriverDocument
+	err := r.conn(ctx).QueryRowContext(ctx, query, documentID, companyID).Scan(append(documentDest(&doc), &doc.Content)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DriverDocument{}, models.ErrDocumentNotFound
+	}
+	if err != nil {
+		return models.DriverDocument{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return doc, nil
+}
+
+// DeleteDriverDocument deletes a document of the company and returns it
+// without its content.
+func (r *Repository) DeleteDriverDocument(ctx context.Context, companyID string, documentID string) (models.DriverDocument, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		DELETE FROM driver_documents
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + documentColumns
+
+	var doc models.DriverDocument
+	err := r.conn(ctx).QueryRowContext(ctx, query, documentID, companyID).Scan(documentDest(&doc)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.DriverDocument{}, models.ErrDocumentNotFound
+	}
+	if err != nil {
+		return models.DriverDocument{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Document %s of driver %s deleted", doc.ID, doc.IDDriver)
+	return doc, nil
+}
+
+// Expiry alerts
+// GetExpiringDocuments returns the licences and medical certificates of
+// active drivers that expire before the time before and have not been alerted
+// about yet.
+func (r *Repository) GetExpiringDocuments(ctx context.Context, before time.Time) ([]models.ExpiryAlert, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	query := `
+		WITH expiring AS (
+			SELECT id_company, id AS id_driver, $2 AS type, licence_expires_at AS expires_at
+			FROM drivers
+			WHERE deactivated_at IS NULL AND licence_expires_at < $1
+			UNION ALL
+			SELECT id_company, id, $3, medical_expires_at
+			FROM drivers
+			WHERE deactivated_at IS NULL AND medical_expires_at < $1
+		)
+		SELECT x.id_company, x.id_driver, x.type, x.expires_at
+		FROM expiring x
+		WHERE NOT EXISTS (
+			SELECT 1 FROM driver_expiry_alerts e
+			WHERE e.id_driver = x.id_driver AND e.type = x.type AND e.expires_at = x.expires_at
+		)
+		ORDER BY x.expires_at, x.id_driver`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, before, models.ExpiryLicence, models.ExpiryMedicalCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var alerts []models.ExpiryAlert
+	for rows.Next() {
+		var a models.ExpiryAlert
+		if err := rows.Scan(&a.IDCompany, &a.IDDriver, &a.Type, &a.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		alerts = append(alerts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return alerts, nil
+}
+
+// SaveExpiryAlerts records the alerts and raises a notification for each one
+// that was not recorded yet. It returns the newly recorded alerts.
+func (r *Repository) SaveExpiryAlerts(ctx context.Context, alerts []models.ExpiryAlert) ([]models.ExpiryAlert, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		WITH alert AS (
+			INSERT INTO driver_expiry_alerts (id_company, id_driver, type, expires_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (id_driver, type, expires_at) DO NOTHING
+			RETURNING id, id_company, created_at
+		),
+		notification AS (
+			INSERT INTO notifications (id_user, id_expiry_alert, type, severity, note, status, created_at)
+			SELECT id_company, id, $7, $8, $5, $6, created_at
+			FROM alert
+		)
+		SELECT id, created_at FROM alert`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer stmt.Close()
+
+	var created []models.ExpiryAlert
+	for _, a := range alerts {
+		err := stmt.QueryRowContext(ctx, a.IDCompany, a.IDDriver, a.Type, a.ExpiresAt, a.Note, models.StatusNew,
+			models.EventDocumentExpiry, models.NotificationSeverity[models.EventDocumentExpiry]).
+			Scan(&a.ID, &a.CreatedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: driver %s: %v", models.ErrFailedToExecuteQuery, a.IDDriver, err)
+		}
+		created = append(created, a)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Recorded %d new of %d expiry alerts", len(created), len(alerts))
+	return created, nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDriverDocumentOfAnotherCompany(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	doc := models.DriverDocument{
+		IDCompany:   "c1",
+		IDDriver:    "d1",
+		Type:        models.DocumentTypeLicence,
+		FileName:    "licence.pdf",
+		ContentType: "application/pdf",
+		Content:     []byte("%PDF-1.7"),
+	}
+
+	mock.ExpectQuery("INSERT INTO driver_documents (.+) FROM drivers").
+		WithArgs("d1", "c1", doc.Type, doc.FileName, doc.ContentType, len(doc.Content), doc.Content).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.CreateDriverDocument(context.Background(), doc)
+	assert.ErrorIs(t, err, models.ErrDriverNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeactivateDriverTwice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT deactivated_at FROM drivers").
+		WithArgs("d1", "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"deactivated_at"}).AddRow(at.Add(-time.Hour)))
+	mock.ExpectRollback()
+
+	_, err = repo.DeactivateDriver(context.Background(), "c1", "d1", at)
+	assert.ErrorIs(t, err, models.ErrDriverDeactivated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetExpiringDocuments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	before := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("WITH expiring AS (.+) FROM driver_expiry_alerts").
+		WithArgs(before, models.ExpiryLicence, models.ExpiryMedicalCertificate).
+		WillReturnRows(sqlmock.NewRows([]string{"id_company", "id_driver", "type", "expires_at"}).
+			AddRow("c1", "d1", models.ExpiryLicence, expiresAt))
+
+	res, err := repo.GetExpiringDocuments(context.Background(), before)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExpiryAlert{
+		{IDCompany: "c1", IDDriver: "d1", Type: models.ExpiryLicence, ExpiresAt: expiresAt},
+	}, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveExpiryAlerts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	expiresAt := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	alerts := []models.ExpiryAlert{
+		{IDCompany: "c1", IDDriver: "d1", Type: models.ExpiryLicence, ExpiresAt: expiresAt, Note: "licence"},
+		{IDCompany: "c1", IDDriver: "d2", Type: models.ExpiryMedicalCertificate, ExpiresAt: expiresAt, Note: "medical"},
+	}
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare("INSERT INTO driver_expiry_alerts (.+) INSERT INTO notifications")
+	prep.ExpectQuery().
+		WithArgs("c1", "d1", models.ExpiryLicence, expiresAt, "licence", models.StatusNew, models.EventDocumentExpiry, models.SeverityLow).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("e1", createdAt))
+	// Already alerted about by a concurrent check.
+	prep.ExpectQuery().
+		WithArgs("c1", "d2", models.ExpiryMedicalCertificate, expiresAt, "medical", models.StatusNew, models.EventDocumentExpiry, models.SeverityLow).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}))
+	mock.ExpectCommit()
+
+	res, err := repo.SaveExpiryAlerts(context.Background(), alerts)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "e1", res[0].ID)
+		assert.Equal(t, createdAt, res[0].CreatedAt)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// geofenceColumns are the columns of geofences scanned by geofenceDest.
+const geofenceColumns = `id, id_company, name, latitude, longitude, radius, active, created_at, updated_at`
+
+func geofenceDest(g *models.Geofence) []any {
+	return []any{&g.ID, &g.IDCompany, &g.Name, &g.Center.Latitude, &g.Center.Longitude, &g.Radius, &g.Active, &g.CreatedAt, &g.UpdatedAt}
+}
+
+// CreateGeofence adds a geofence to the company.
+func (r *Repository) CreateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		INSERT INTO geofences (id_company, name, latitude, longitude, radius, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + geofenceColumns
+
+	var res models.Geofence
+	err := r.conn(ctx).QueryRowContext(ctx, query, g.IDCompany, g.Name, g.Center.Latitude, g.Center.Longitude, g.Radius, g.Active).
+		Scan(geofenceDest(&res)...)
+	if err != nil {
+		return models.Geofence{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Geofence %s created", res.ID)
+	return res, nil
+}
+
+// UpdateGeofence replaces a geofence of the company.
+func (r *Repository) UpdateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE geofences
+		SET name = $3, latitude = $4, longitude = $5, radius = $6, active = $7, updated_at = now()
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + geofenceColumns
+
+	var res models.Geofence
+	err := r.conn(ctx).QueryRowContext(ctx, query, g.ID, g.IDCompany, g.Name, g.Center.Latitude, g.Center.Longitude, g.Radius, g.Active).
+		Scan(geofenceDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Geofence{}, models.ErrGeofenceNotFound
+	}
+	if err != nil {
+		return models.Geofence{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Geofence %s updated", res.ID)
+	return res, nil
+}
+
+// DeleteGeofence removes a geofence of the company. The notifications it
+// raised are kept.
+func (r *Repository) DeleteGeofence(ctx context.Context, companyID string, geofenceID string) (models.Geofence, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		DELETE FROM geofences
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + geofenceColumns
+
+	var res models.Geofence
+	err := r.conn(ctx).QueryRowContext(ctx, query, geofenceID, companyID).Scan(geofenceDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Geofence{}, models.ErrGeofenceNotFound
+	}
+	if err != nil {
+		return models.Geofence{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Geofence %s deleted", res.ID)
+	return res, nil
+}
+
+// GetGeofence returns a geofence of the company.
+func (r *Repository) GetGeofence(ctx context.Context, companyID string, geofenceID string) (models.Geofence, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + geofenceColumns + `
+		FROM geofences
+		WHERE id = $1 AND id_company = $2`
+
+	var res models.Geofence
+	err := r.conn(ctx).QueryRowContext(ctx, query, geofenceID, companyID).Scan(geofenceDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Geofence{}, models.ErrGeofenceNotFound
+	}
+	if err != nil {
+		return models.Geofence{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return res, nil
+}
+
+// GetGeofences returns the geofences of the company ordered by name.
+func (r *Repository) GetGeofences(ctx context.Context, companyID string) ([]models.Geofence, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + geofenceColumns + `
+		FROM geofences
+		WHERE id_company = $1
+		ORDER BY name, created_at`
+
+	return r.queryGeofences(ctx, query, companyID)
+}
+
+// GetActiveGeofences returns the active geofences of the company.
+func (r *Repository) GetActiveGeofences(ctx context.Context, companyID string) ([]models.Geofence, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + geofenceColumns + `
+		FROM geofences
+		WHERE id_company = $1 AND active`
+
+	return r.queryGeofences(ctx, query, companyID)
+}
+
+func (r *Repository) queryGeofences(ctx context.Context, query string, args ...any) ([]models.Geofence, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	geofences := []models.Geofence{}
+	for rows.Next() {
+		var g models.Geofence
+		if err := rows.Scan(geofenceDest(&g)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		geofences = append(geofences, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return geofences, nil
+}
+
+// CreateGeofenceNotification notifies the company of a car crossing the
+// boundary of one of its geofences and returns the ID of the notification.
+func (r *Repository) CreateGeofenceNotification(ctx context.Context, n models.GeofenceNotification) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	payload, err := json.Marshal(n.Notice)
+	if err != nil {
+		return "", err
+	}
+
+	query := `
+		INSERT INTO notifications (id_user, id_car, type, severity, note, status, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`
+
+	var id string
+	err = r.conn(ctx).QueryRowContext(ctx, query, n.IDCompany, n.IDCar, models.EventGeofence,
+		models.NotificationSeverity[models.EventGeofence], n.Note, models.StatusNew, string(payload), n.CreatedAt).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Geofence notification %s created", id)
+	return id, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+var geofenceRows = []string{"id", "id_company", "name", "latitude", "longitude", "radius", "active", "created_at", "updated_at"}
+
+func TestGetActiveGeofences(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM geofences WHERE id_company = \\$1 AND active").
+		WithArgs("c1").
+		WillReturnRows(sqlmock.NewRows(geofenceRows).
+			AddRow("g1", "c1", "Depot", 55.75, 37.625, 500.0, true, createdAt, nil))
+
+	geofences, err := repo.GetActiveGeofences(context.Background(), "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Geofence{{
+		ID:        "g1",
+		IDCompany: "c1",
+		Name:      "Depot",
+		Center:    models.Point{Latitude: 55.75, Longitude: 37.625},
+		Radius:    500,
+		Active:    true,
+		CreatedAt: createdAt,
+	}}, geofences)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateGeofenceNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	// A geofence of another company is not found.
+	mock.ExpectQuery("UPDATE geofences(.+)WHERE id = \\$1 AND id_company = \\$2").
+		WithArgs("g1", "c2", "Depot", float32(55.75), float32(37.625), 500.0, true).
+		WillReturnRows(sqlmock.NewRows(geofenceRows))
+
+	_, err = repo.UpdateGeofence(context.Background(), models.Geofence{
+		ID:        "g1",
+		IDCompany: "c2",
+		Name:      "Depot",
+		Center:    models.Point{Latitude: 55.75, Longitude: 37.625},
+		Radius:    500,
+		Active:    true,
+	})
+	assert.ErrorIs(t, err, models.ErrGeofenceNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateGeofenceNotification(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	n := models.GeofenceNotification{
+		IDCompany: "c1",
+		IDCar:     "car1",
+		Note:      "entered Depot",
+		Notice:    models.GeofenceNotice{GeofenceID: "g1", Geofence: "Depot", Transition: models.GeofenceEnter, OccurredAt: now},
+		CreatedAt: now,
+	}
+
+	mock.ExpectQuery("INSERT INTO notifications").
+		WithArgs("c1", "car1", models.EventGeofence, models.SeverityMedium, "entered Depot", models.StatusNew,
+			`{"geofence_id":"g1","geofence":"Depot","transition":"enter","latitude":0,"longitude":0,"occurred_at":"2026-03-02T08:00:00Z"}`, now).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("n1"))
+
+	id, err := repo.CreateGeofenceNotification(context.Background(), n)
+	assert.NoError(t, err)
+	assert.Equal(t, "n1", id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCarPositionForUpdateNone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectQuery("FROM cars_positions WHERE id_car = \\$1 FOR UPDATE").
+		WithArgs("car1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "id_car", "latitude", "longitude", "updated_at"}))
+
+	_, err = repo.GetCarPositionForUpdate(context.Background(), "car1")
+	assert.ErrorIs(t, err, models.ErrNoContent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+)
+
+// schemaTables are the tables migrations/up.sql creates. Extend the list when
+// a migration adds a table the service depends on.
+var schemaTables = []string{
+	"users",
+	"cars",
+	"wheels",
+	"sensors_data",
+	"cars_positions",
+	"position_data",
+	"drivers",
+	"breakages",
+	"notifications",
+	"refresh_store",
+	"user_totp",
+	"user_recovery_codes",
+	"audit_log",
+	"driver_assignments",
+	"driver_ratings",
+	"work_sessions",
+	"work_violations",
+	"driver_documents",
+	"driver_expiry_alerts",
+	"breakage_status_history",
+	"breakage_types",
+	"maintenance_plans",
+	"maintenance_tasks",
+	"work_orders",
+	"notification_rules",
+	"notification_deliveries",
+	"webhook_endpoints",
+	"webhook_deliveries",
+	"webhook_attempts",
+	"idempotency_keys",
+	"geofences",
+}
+
+// Ping checks that the database accepts connections.
+func (r *Repository) Ping(ctx context.Context) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	return r.db.PingContext(ctx)
+}
+
+// CheckMigrations reports the tables from schemaTables that do not exist yet.
+func (r *Repository) CheckMigrations(ctx context.Context) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT name
+		FROM unnest($1::text[]) AS name
+		WHERE to_regclass(name) IS NULL
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(schemaTables))
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var missing []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
+		}
+		missing = append(missing, name)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: missing tables %s", models.ErrMigrationsNotApplied, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// idempotencyKeyColumns are the columns of idempotency_keys scanned by
+// idempotencyKeyDest.
+const idempotencyKeyColumns = `id_company, key, route, request_hash, status_code, COALESCE(content_type, ''), response,
+	created_at, expires_at`
+
+func idempotencyKeyDest(k *models.IdempotencyKey) []any {
+	return []any{&k.IDCompany, &k.Key, &k.Route, &k.RequestHash, &k.StatusCode, &k.ContentType, &k.Response,
+		&k.CreatedAt, &k.ExpiresAt}
+}
+
+// ClaimIdempotencyKey records the request of k as in progress, unless the
+// company already used the key and it has not expired by now. claimed reports
+// which; if the key was used before, the request it was used for is returned.
+func (r *Repository) ClaimIdempotencyKey(ctx context.Context, k models.IdempotencyKey, now time.Time) (res models.IdempotencyKey, claimed bool, err error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		INSERT INTO idempotency_keys (id_company, key, route, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id_company, key) DO UPDATE SET
+			route = EXCLUDED.route,
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+		RETURNING ` + idempotencyKeyColumns
+
+	err = r.conn(ctx).QueryRowContext(ctx, query, k.IDCompany, k.Key, k.Route, k.RequestHash, now, k.ExpiresAt).
+		Scan(idempotencyKeyDest(&res)...)
+	if err == nil {
+		return res, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return models.IdempotencyKey{}, false, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	query = `SELECT ` + idempotencyKeyColumns + ` FROM idempotency_keys WHERE id_company = $1 AND key = $2`
+	err = r.conn(ctx).QueryRowContext(ctx, query, k.IDCompany, k.Key).Scan(idempotencyKeyDest(&res)...)
+	if err != nil {
+		return models.IdempotencyKey{}, false, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return res, false, nil
+}
+
+// SaveIdempotentResponse stores the response to the request of a claimed key,
+// completing it.
+func (r *Repository) SaveIdempotentResponse(ctx context.Context, k models.IdempotencyKey) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $3, content_type = $4, response = $5
+		WHERE id_company = $1 AND key = $2`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, k.IDCompany, k.Key, k.StatusCode, k.ContentType, k.Response)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey forgets a claimed key whose request failed, so that
+// it can be retried with the same key.
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, companyID string, key string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `DELETE FROM idempotency_keys WHERE id_company = $1 AND key = $2 AND status_code IS NULL`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, companyID, key)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys deletes the keys expired by now.
+func (r *Repository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	res, err := r.conn(ctx).ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Deleted %d expired idempotency keys", n)
+	return n, nil
+}
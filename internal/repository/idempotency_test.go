@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+var idempotencyKeyRows = []string{"id_company", "key", "route", "request_hash", "status_code", "content_type", "response", "created_at", "expires_at"}
+
+func TestClaimIdempotencyKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	expires := now.Add(24 * time.Hour)
+	k := models.IdempotencyKey{IDCompany: "c1", Key: "k1", Route: "POST /breakage", RequestHash: "h1", ExpiresAt: expires}
+
+	mock.ExpectQuery("INSERT INTO idempotency_keys(.+)ON CONFLICT(.+)WHERE idempotency_keys.expires_at <= EXCLUDED.created_at").
+		WithArgs("c1", "k1", "POST /breakage", "h1", now, expires).
+		WillReturnRows(sqlmock.NewRows(idempotencyKeyRows).
+			AddRow("c1", "k1", "POST /breakage", "h1", nil, "", nil, now, expires))
+
+	res, claimed, err := repo.ClaimIdempotencyKey(context.Background(), k, now)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Nil(t, res.StatusCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimIdempotencyKeyUsed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	created := now.Add(-time.Minute)
+	expires := now.Add(24 * time.Hour)
+	k := models.IdempotencyKey{IDCompany: "c1", Key: "k1", Route: "POST /breakage", RequestHash: "h1", ExpiresAt: expires}
+
+	// The key has not expired, so the insert returns nothing and the request
+	// it was used for is read instead.
+	mock.ExpectQuery("INSERT INTO idempotency_keys").
+		WillReturnRows(sqlmock.NewRows(idempotencyKeyRows))
+	mock.ExpectQuery("SELECT (.+) FROM idempotency_keys WHERE id_company = \\$1 AND key = \\$2").
+		WithArgs("c1", "k1").
+		WillReturnRows(sqlmock.NewRows(idempotencyKeyRows).
+			AddRow("c1", "k1", "POST /breakage", "h1", 201, "", []byte{}, created, created.Add(24*time.Hour)))
+
+	res, claimed, err := repo.ClaimIdempotencyKey(context.Background(), k, now)
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+	if assert.NotNil(t, res.StatusCode) {
+		assert.Equal(t, 201, *res.StatusCode)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+)
+
+// Import
+// GetCarsByStateNumbers returns the cars of any company registered under one
+// of stateNumbers.
+func (r *Repository) GetCarsByStateNumbers(ctx context.Context, stateNumbers []string) ([]models.Car, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT id, id_company, state_number, COALESCE(brand, ''), COALESCE(device_number, ''),
+			COALESCE(id_unicum, ''), COALESCE(count_axis, 0), COALESCE(car_type, '')
+		FROM cars
+		WHERE state_number = ANY($1)`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(stateNumbers))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	cars := []models.Car{}
+	for rows.Next() {
+		var car models.Car
+		err := rows.Scan(&car.ID, &car.IDCompany, &car.StateNumber, &car.Brand, &car.DeviceNumber, &car.IDUnicum, &car.CountAxis, &car.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		cars = append(cars, car)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return cars, nil
+}
+
+// Import inserts every record of batch in one transaction and returns their
+// number. Either all of them are created or none. The records must already
+// be resolved to their company and car.
+func (r *Repository) Import(ctx context.Context, batch models.ImportBatch) (int, error) {
+	// A whole fleet is imported at once, which takes longer than a single write.
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	created := 0
+	for _, row := range batch.Cars {
+		if _, err := insertCar(ctx, tx, row.Car); err != nil {
+			return 0, fmt.Errorf("%w: row %d: %v", models.ErrFailedToExecuteQuery, row.Row, err)
+		}
+		created++
+	}
+	for _, row := range batch.Wheels {
+		if _, err := insertWheel(ctx, tx, row.Wheel); err != nil {
+			return 0, fmt.Errorf("%w: row %d: %v", models.ErrFailedToExecuteQuery, row.Row, err)
+		}
+		created++
+	}
+	for _, row := range batch.Drivers {
+		if _, err := insertDriver(ctx, tx, row.Driver); err != nil {
+			return 0, fmt.Errorf("%w: row %d: %v", models.ErrFailedToExecuteQuery, row.Row, err)
+		}
+		created++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Imported %d %s for userID=%s", created, batch.Kind, batch.IDCompany)
+	return created, nil
+}
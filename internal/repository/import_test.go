@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCarsByStateNumbers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectQuery("SELECT (.+) FROM cars WHERE state_number = ANY\\(\\$1\\)").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "state_number", "brand", "device_number", "id_unicum", "count_axis", "car_type"}).
+			AddRow("car1", "c1", "A123BC", "Volvo", "dev1", "u1", 3, "truck"))
+
+	cars, err := repo.GetCarsByStateNumbers(context.Background(), []string{"A123BC", "B456CD"})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Car{{
+		ID: "car1", IDCompany: "c1", StateNumber: "A123BC", Brand: "Volvo",
+		DeviceNumber: "dev1", IDUnicum: "u1", CountAxis: 3, Type: "truck",
+	}}, cars)
+}
+
+func TestImportDrivers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	birthday := time.Date(1985, 4, 12, 0, 0, 0, 0, time.UTC)
+	batch := models.ImportBatch{
+		Kind:      models.ImportKindDrivers,
+		IDCompany: "c1",
+		Drivers: []models.ImportDriver{
+			{Row: 2, StateNumber: "A123BC", Driver: models.Driver{IDCompany: "c1", IDCar: "car1", Name: "Ivan", Surname: "Petrov", Phone: "+79990000001", Birthday: birthday, Rating: 10}},
+			{Row: 3, StateNumber: "B456CD", Driver: models.Driver{IDCompany: "c1", IDCar: "car2", Name: "Oleg", Surname: "Sidorov", Phone: "+79990000002", Birthday: birthday, Rating: 10}},
+		},
+	}
+	columns := []string{"id", "id_company", "name", "surname", "middle_name", "phone", "birthday", "rating", "worked_time",
+		"licence_number", "licence_categories", "licence_expires_at", "medical_expires_at", "deactivated_at", "created_at"}
+	createdAt := time.Now()
+
+	mock.ExpectBegin()
+	for i, row := range batch.Drivers {
+		d := row.Driver
+		id := []string{"d1", "d2"}[i]
+		mock.ExpectQuery("INSERT INTO drivers").
+			WithArgs(d.IDCompany, d.Name, d.Surname, d.Middle, d.Phone, d.Birthday, d.Rating, d.WorkedTime, nil, nil, nil, nil).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(id, d.IDCompany, d.Name, d.Surname, d.Middle, d.Phone, d.Birthday, d.Rating, d.WorkedTime, nil, nil, nil, nil, nil, createdAt))
+		expectAssignDriver(mock, models.DriverAssignment{IDCompany: d.IDCompany, IDDriver: id, IDCar: d.IDCar, StartedAt: createdAt})
+	}
+	mock.ExpectCommit()
+
+	created, err := repo.Import(context.Background(), batch)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportRollsBackOnFailure(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	batch := models.ImportBatch{
+		Kind:      models.ImportKindCars,
+		IDCompany: "c1",
+		Cars: []models.ImportCar{
+			{Row: 2, Car: models.Car{IDCompany: "c1", StateNumber: "A123BC", Brand: "Volvo", CountAxis: 3}},
+			{Row: 3, Car: models.Car{IDCompany: "c1", StateNumber: "B456CD", Brand: "MAN", CountAxis: 2}},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO cars").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "state_number", "brand", "device_number", "id_unicum", "car_type", "count_axis"}).
+			AddRow("car1", "c1", "A123BC", "Volvo", "", "", "", 3))
+	mock.ExpectQuery("INSERT INTO cars").
+		WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	created, err := repo.Import(context.Background(), batch)
+	assert.ErrorIs(t, err, models.ErrFailedToExecuteQuery)
+	assert.ErrorContains(t, err, "row 3")
+	assert.Equal(t, 0, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,624 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// maintenancePlanColumns are the columns of maintenance_plans scanned by
+// maintenancePlanDest.
+const maintenancePlanColumns = `id, id_company, id_car, name, kind, trigger, mileage_interval, interval_days, condition, active, created_at, updated_at`
+
+func maintenancePlanDest(p *models.MaintenancePlan) []any {
+	return []any{&p.ID, &p.IDCompany, &p.IDCar, &p.Name, &p.Kind, &p.Trigger, &p.MileageInterval, &p.IntervalDays, &p.Condition,
+		&p.Active, &p.CreatedAt, &p.UpdatedAt}
+}
+
+// workOrderColumns are the columns of work_orders scanned by workOrderDest.
+const workOrderColumns = `id, id_company, id_car, id_wheel, id_breakage, id_task, title, status, assignee, notes, cost, created_by, created_at, updated_at, completed_at`
+
+func workOrderDest(o *models.WorkOrder) []any {
+	return []any{&o.ID, &o.IDCompany, &o.IDCar, &o.IDWheel, &o.IDBreakage, &o.IDTask, &o.Title, &o.Status, &o.Assignee, &o.Notes,
+		&o.Cost, &o.CreatedBy, &o.CreatedAt, &o.UpdatedAt, &o.CompletedAt}
+}
+
+// Maintenance plans
+// CreateMaintenancePlan adds a maintenance plan to the company.
+func (r *Repository) CreateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	if err := r.checkCompanyCar(ctx, p.IDCompany, p.IDCar); err != nil {
+		return models.MaintenancePlan{}, err
+	}
+
+	query := `
+		INSERT INTO maintenance_plans (id_company, id_car, name, kind, trigger, mileage_interval, interval_days, condition, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING ` + maintenancePlanColumns
+
+	var res models.MaintenancePlan
+	err := r.conn(ctx).QueryRowContext(ctx, query, p.IDCompany, p.IDCar, p.Name, p.Kind, p.Trigger, p.MileageInterval, p.IntervalDays,
+		p.Condition, p.Active).Scan(maintenancePlanDest(&res)...)
+	if err != nil {
+		return models.MaintenancePlan{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Maintenance plan %s created", res.ID)
+	return res, nil
+}
+
+// UpdateMaintenancePlan replaces a maintenance plan of the company. Tasks it
+// made due before stay open.
+func (r *Repository) UpdateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	if err := r.checkCompanyCar(ctx, p.IDCompany, p.IDCar); err != nil {
+		return models.MaintenancePlan{}, err
+	}
+
+	query := `
+		UPDATE maintenance_plans
+		SET id_car = $3, name = $4, kind = $5, trigger = $6, mileage_interval = $7, interval_days = $8, condition = $9, active = $10,
+			updated_at = now()
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + maintenancePlanColumns
+
+	var res models.MaintenancePlan
+	err := r.conn(ctx).QueryRowContext(ctx, query, p.ID, p.IDCompany, p.IDCar, p.Name, p.Kind, p.Trigger, p.MileageInterval, p.IntervalDays,
+		p.Condition, p.Active).Scan(maintenancePlanDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.MaintenancePlan{}, models.ErrMaintenancePlanNotFound
+	}
+	if err != nil {
+		return models.MaintenancePlan{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Maintenance plan %s updated", res.ID)
+	return res, nil
+}
+
+// checkCompanyCar reports models.ErrNoContent if carID is set and is not a
+// car of the company.
+func (r *Repository) checkCompanyCar(ctx context.Context, companyID string, carID *string) error {
+	if carID == nil {
+		return nil
+	}
+
+	var exists bool
+	err := r.conn(ctx).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM cars WHERE id = $1 AND id_company = $2)`, *carID, companyID).
+		Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: car %s", models.ErrNoContent, *carID)
+	}
+	return nil
+}
+
+// GetMaintenancePlan returns a maintenance plan of the company.
+func (r *Repository) GetMaintenancePlan(ctx context.Context, companyID string, planID string) (models.MaintenancePlan, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + maintenancePlanColumns + `
+		FROM maintenance_plans
+		WHERE id = $1 AND id_company = $2`
+
+	var p models.MaintenancePlan
+	err := r.conn(ctx).QueryRowContext(ctx, query, planID, companyID).Scan(maintenancePlanDest(&p)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.MaintenancePlan{}, models.ErrMaintenancePlanNotFound
+	}
+	if err != nil {
+		return models.MaintenancePlan{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return p, nil
+}
+
+// GetMaintenancePlans returns the maintenance plans of the company ordered by
+// name.
+func (r *Repository) GetMaintenancePlans(ctx context.Context, companyID string) ([]models.MaintenancePlan, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + maintenancePlanColumns + `
+		FROM maintenance_plans
+		WHERE id_company = $1
+		ORDER BY name, id`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	plans := []models.MaintenancePlan{}
+	for rows.Next() {
+		var p models.MaintenancePlan
+		if err := rows.Scan(maintenancePlanDest(&p)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		plans = append(plans, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return plans, nil
+}
+
+// Maintenance tasks
+// GetDueMaintenance returns the maintenance that active plans make due and
+// that has no open task yet: wheels within mileageNotice km of the mileage
+// interval since their last task of the plan, cars whose interval since
+// their last task of the plan, or since the plan was created, ends before
+// the time before, and wheels whose last sensor reading since their last task
+// of the plan meets the condition of the plan.
+func (r *Repository) GetDueMaintenance(ctx context.Context, now time.Time, before time.Time, mileageNotice float64) ([]models.MaintenanceDue, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	query := `
+		WITH plan_cars AS (
+			SELECT p.id AS id_plan, p.trigger, p.mileage_interval, p.interval_days, p.condition, p.created_at,
+				c.id AS id_car, c.device_number
+			FROM maintenance_plans p
+			JOIN cars c ON c.id_company = p.id_company AND (p.id_car IS NULL OR c.id = p.id_car)
+			WHERE p.active
+		),
+		due AS (
+			SELECT x.id_plan, x.id_car, w.id AS id_wheel, NULL::timestamp AS due_at,
+				COALESCE(last.mileage, 0) + x.mileage_interval AS due_mileage
+			FROM plan_cars x
+			JOIN wheels w ON w.id_car = x.id_car
+			LEFT JOIN LATERAL (
+				SELECT t.completed_mileage AS mileage
+				FROM maintenance_tasks t
+				WHERE t.id_plan = x.id_plan AND t.id_wheel = w.id AND t.status = 'done'
+				ORDER BY t.completed_at DESC
+				LIMIT 1
+			) last ON true
+			WHERE x.trigger = 'mileage' AND w.mileage >= COALESCE(last.mileage, 0) + x.mileage_interval - $3
+			UNION ALL
+			SELECT x.id_plan, x.id_car, NULL::uuid,
+				COALESCE(last.completed_at, x.created_at) + make_interval(days => x.interval_days), NULL::float
+			FROM plan_cars x
+			LEFT JOIN LATERAL (
+				SELECT t.completed_at
+				FROM maintenance_tasks t
+				WHERE t.id_plan = x.id_plan AND t.id_car = x.id_car AND t.status = 'done'
+				ORDER BY t.completed_at DESC
+				LIMIT 1
+			) last ON true
+			WHERE x.trigger = 'interval' AND COALESCE(last.completed_at, x.created_at) + make_interval(days => x.interval_days) <= $2
+			UNION ALL
+			SELECT x.id_plan, x.id_car, w.id, $1::timestamp, NULL::float
+			FROM plan_cars x
+			JOIN wheels w ON w.id_car = x.id_car
+			JOIN LATERAL (
+				SELECT s.pressure, s.temperature
+				FROM sensors_data s
+				WHERE s.device_number = x.device_number AND s.sensor_number = w.sensor_number
+					AND s.created_at > COALESCE((
+						SELECT MAX(t.completed_at) FROM maintenance_tasks t
+						WHERE t.id_plan = x.id_plan AND t.id_wheel = w.id AND t.status = 'done'
+					), '-infinity')
+				ORDER BY s.created_at DESC
+				LIMIT 1
+			) s ON true
+			WHERE x.trigger = 'condition' AND (
+				(x.condition = 'pressure_low' AND s.pressure < w.min_pressure) OR
+				(x.condition = 'pressure_high' AND s.pressure > w.max_pressure) OR
+				(x.condition = 'temperature_high' AND s.temperature > w.max_temperature))
+		)
+		SELECT p.id_company, d.id_plan, d.id_car, d.id_wheel, d.due_at, d.due_mileage,
+			p.name, p.kind, COALESCE(c.state_number, ''), w.position, w.mileage,
+			COALESCE(d.due_at <= $1 OR w.mileage >= d.due_mileage, false)
+		FROM due d
+		JOIN maintenance_plans p ON p.id = d.id_plan
+		JOIN cars c ON c.id = d.id_car
+		LEFT JOIN wheels w ON w.id = d.id_wheel
+		WHERE NOT EXISTS (
+			SELECT 1 FROM maintenance_tasks t
+			WHERE t.id_plan = d.id_plan AND t.id_car = d.id_car AND t.id_wheel IS NOT DISTINCT FROM d.id_wheel
+				AND t.status IN ('due', 'scheduled')
+		)
+		ORDER BY d.id_plan, d.id_car, d.id_wheel`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, now, before, mileageNotice)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var due []models.MaintenanceDue
+	for rows.Next() {
+		var d models.MaintenanceDue
+		err := rows.Scan(&d.IDCompany, &d.IDPlan, &d.IDCar, &d.IDWheel, &d.DueAt, &d.DueMileage,
+			&d.PlanName, &d.Kind, &d.StateNumber, &d.WheelPosition, &d.Mileage, &d.Overdue)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		due = append(due, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return due, nil
+}
+
+// SaveMaintenanceTasks records a task for each of due and raises a
+// notification with its reason for each one that has no open task yet. It
+// returns the newly recorded tasks.
+func (r *Repository) SaveMaintenanceTasks(ctx context.Context, due []models.MaintenanceDue) ([]models.MaintenanceDue, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		WITH task AS (
+			INSERT INTO maintenance_tasks (id_company, id_plan, id_car, id_wheel, reason, due_at, due_mileage)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (id_plan, id_car, COALESCE(id_wheel, id_car)) WHERE status IN ('due', 'scheduled') DO NOTHING
+			RETURNING id, id_company, id_car, reason, status, created_at
+		),
+		notification AS (
+			INSERT INTO notifications (id_user, id_maintenance_task, id_car, type, severity, note, status, created_at)
+			SELECT id_company, id, id_car, $9, $10, reason, $8, created_at
+			FROM task
+		)
+		SELECT id, status, created_at FROM task`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer stmt.Close()
+
+	var created []models.MaintenanceDue
+	for _, d := range due {
+		err := stmt.QueryRowContext(ctx, d.IDCompany, d.IDPlan, d.IDCar, d.IDWheel, d.Reason, d.DueAt, d.DueMileage, models.StatusNew,
+			models.EventMaintenanceDue, models.NotificationSeverity[models.EventMaintenanceDue]).
+			Scan(&d.ID, &d.Status, &d.CreatedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: plan %s: %v", models.ErrFailedToExecuteQuery, d.IDPlan, err)
+		}
+		created = append(created, d)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Recorded %d new of %d due maintenance tasks", len(created), len(due))
+	return created, nil
+}
+
+// GetUpcomingMaintenance returns the open maintenance tasks selected by
+// filter, the overdue ones first, then by when they became due.
+func (r *Repository) GetUpcomingMaintenance(ctx context.Context, filter models.MaintenanceFilter, now time.Time) ([]models.MaintenanceDue, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT t.id, t.id_company, t.id_plan, t.id_car, t.id_wheel, t.reason, t.due_at, t.due_mileage, t.status, t.created_at,
+			p.name, p.kind, COALESCE(c.state_number, ''), w.position, w.mileage,
+			COALESCE(t.due_at <= $2 OR w.mileage >= t.due_mileage, false) AS overdue,
+			o.id
+		FROM maintenance_tasks t
+		JOIN maintenance_plans p ON p.id = t.id_plan
+		JOIN cars c ON c.id = t.id_car
+		LEFT JOIN wheels w ON w.id = t.id_wheel
+		LEFT JOIN work_orders o ON o.id_task = t.id AND o.status = 'open'
+		WHERE t.id_company = $1 AND t.status IN ('due', 'scheduled') AND ($3::uuid IS NULL OR t.id_car = $3)
+		ORDER BY overdue DESC, COALESCE(t.due_at, t.created_at), t.id`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, filter.IDCompany, now, filter.IDCar)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	tasks := []models.MaintenanceDue{}
+	for rows.Next() {
+		var d models.MaintenanceDue
+		err := rows.Scan(&d.ID, &d.IDCompany, &d.IDPlan, &d.IDCar, &d.IDWheel, &d.Reason, &d.DueAt, &d.DueMileage, &d.Status,
+			&d.CreatedAt, &d.PlanName, &d.Kind, &d.StateNumber, &d.WheelPosition, &d.Mileage, &d.Overdue, &d.IDWorkOrder)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		tasks = append(tasks, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return tasks, nil
+}
+
+// Work orders
+// CreateWorkOrder opens a work order of the company. Opened from a
+// maintenance task, it is for the car and wheel of the task, which must be
+// due and becomes scheduled. Opened from a breakage, it is for the car of the
+// breakage and the wheel must be one of that car.
+func (r *Repository) CreateWorkOrder(ctx context.Context, o models.WorkOrder) (models.WorkOrder, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	switch {
+	case o.IDTask != nil:
+		var status string
+		err := tx.QueryRowContext(ctx, `
+			SELECT id_car, id_wheel, status
+			FROM maintenance_tasks
+			WHERE id = $1 AND id_company = $2
+			FOR UPDATE`, *o.IDTask, o.IDCompany).Scan(&o.IDCar, &o.IDWheel, &status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.WorkOrder{}, models.ErrMaintenanceTaskNotFound
+		}
+		if err != nil {
+			return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		}
+		if status != models.MaintenanceTaskDue {
+			return models.WorkOrder{}, fmt.Errorf("%w: the task is %s", models.ErrMaintenanceTaskNotDue, status)
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE maintenance_tasks SET status = $2 WHERE id = $1`, *o.IDTask, models.MaintenanceTaskScheduled)
+		if err != nil {
+			return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		}
+	case o.IDBreakage != nil:
+		err := tx.QueryRowContext(ctx, `
+			SELECT b.id_car
+			FROM breakages b
+			JOIN cars c ON c.id = b.id_car
+			WHERE b.id = $1 AND c.id_company = $2`, *o.IDBreakage, o.IDCompany).Scan(&o.IDCar)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.WorkOrder{}, models.ErrBreakageNotFound
+		}
+		if err != nil {
+			return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		}
+
+		if o.IDWheel != nil {
+			var exists bool
+			err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM wheels WHERE id = $1 AND id_car = $2)`, *o.IDWheel, o.IDCar).
+				Scan(&exists)
+			if err != nil {
+				return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+			}
+			if !exists {
+				return models.WorkOrder{}, fmt.Errorf("%w: wheel %s of car %s", models.ErrNoContent, *o.IDWheel, o.IDCar)
+			}
+		}
+	default:
+		return models.WorkOrder{}, fmt.Errorf("%w: a work order is opened from a breakage or a maintenance task", models.ErrInvalidParameter)
+	}
+
+	query := `
+		INSERT INTO work_orders (id_company, id_car, id_wheel, id_breakage, id_task, title, status, assignee, notes, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING ` + workOrderColumns
+
+	var res models.WorkOrder
+	err = tx.QueryRowContext(ctx, query, o.IDCompany, o.IDCar, o.IDWheel, o.IDBreakage, o.IDTask, o.Title, models.WorkOrderOpen,
+		o.Assignee, o.Notes, o.CreatedBy).Scan(workOrderDest(&res)...)
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Work order %s opened for car %s", res.ID, res.IDCar)
+	return res, nil
+}
+
+// GetWorkOrder returns a work order of the company.
+func (r *Repository) GetWorkOrder(ctx context.Context, companyID string, orderID string) (models.WorkOrder, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + workOrderColumns + `
+		FROM work_orders
+		WHERE id = $1 AND id_company = $2`
+
+	var o models.WorkOrder
+	err := r.conn(ctx).QueryRowContext(ctx, query, orderID, companyID).Scan(workOrderDest(&o)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WorkOrder{}, models.ErrWorkOrderNotFound
+	}
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return o, nil
+}
+
+// GetWorkOrders returns a page of the work orders of the company.
+func (r *Repository) GetWorkOrders(ctx context.Context, filter models.WorkOrderFilter, page models.PageRequest) (models.Page[models.WorkOrder], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var f filterArgs
+	f.add("id_company = ?", filter.IDCompany)
+	if filter.IDCar != nil {
+		f.add("id_car = ?", *filter.IDCar)
+	}
+	if filter.Status != nil {
+		f.add("status = ?", *filter.Status)
+	}
+
+	q := listQuery{
+		query: `
+			SELECT ` + workOrderColumns + `
+			FROM work_orders
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"created_at": {"created_at", "timestamp"},
+			"status":     {"status", "text"},
+		},
+		defaultSort: "-created_at",
+	}
+
+	return queryPage(ctx, r, q, page, workOrderDest)
+}
+
+// CompleteWorkOrder completes an open work order of the company. The tire and
+// mileage of the wheel are updated as c says, and the maintenance task of the
+// work order is done with the mileage the wheel has then.
+func (r *Repository) CompleteWorkOrder(ctx context.Context, c models.WorkOrderCompletion) (models.WorkOrder, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	wheelID, taskID, err := lockOpenWorkOrder(ctx, tx, c.IDCompany, c.IDWorkOrder)
+	if err != nil {
+		return models.WorkOrder{}, err
+	}
+
+	if (c.Tire != nil || c.ResetMileage) && wheelID == nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: the work order is not for a wheel", models.ErrInvalidParameter)
+	}
+	if c.Tire != nil {
+		_, err = tx.ExecContext(ctx, `UPDATE wheels SET brand = $2, model = $3, size = $4, cost = $5, mileage = 0 WHERE id = $1`,
+			*wheelID, c.Tire.Brand, c.Tire.Model, c.Tire.Size, c.Tire.Cost)
+	} else if c.ResetMileage {
+		_, err = tx.ExecContext(ctx, `UPDATE wheels SET mileage = 0 WHERE id = $1`, *wheelID)
+	}
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if taskID != nil {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE maintenance_tasks t
+			SET status = $2, completed_at = $3, completed_mileage = (SELECT w.mileage FROM wheels w WHERE w.id = t.id_wheel)
+			WHERE t.id = $1`, *taskID, models.MaintenanceTaskDone, c.CompletedAt)
+		if err != nil {
+			return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		}
+	}
+
+	query := `
+		UPDATE work_orders
+		SET status = $2, notes = COALESCE($3, notes), cost = COALESCE($4, cost), completed_at = $5, updated_at = $5
+		WHERE id = $1
+		RETURNING ` + workOrderColumns
+
+	var res models.WorkOrder
+	err = tx.QueryRowContext(ctx, query, c.IDWorkOrder, models.WorkOrderCompleted, c.Notes, c.Cost, c.CompletedAt).
+		Scan(workOrderDest(&res)...)
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Work order %s completed", res.ID)
+	return res, nil
+}
+
+// CancelWorkOrder cancels an open work order of the company. Its maintenance
+// task is due again.
+func (r *Repository) CancelWorkOrder(ctx context.Context, companyID string, orderID string, at time.Time) (models.WorkOrder, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	_, taskID, err := lockOpenWorkOrder(ctx, tx, companyID, orderID)
+	if err != nil {
+		return models.WorkOrder{}, err
+	}
+
+	if taskID != nil {
+		_, err := tx.ExecContext(ctx, `UPDATE maintenance_tasks SET status = $2 WHERE id = $1`, *taskID, models.MaintenanceTaskDue)
+		if err != nil {
+			return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		}
+	}
+
+	query := `
+		UPDATE work_orders
+		SET status = $2, updated_at = $3
+		WHERE id = $1
+		RETURNING ` + workOrderColumns
+
+	var res models.WorkOrder
+	err = tx.QueryRowContext(ctx, query, orderID, models.WorkOrderCancelled, at).Scan(workOrderDest(&res)...)
+	if err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Work order %s cancelled", res.ID)
+	return res, nil
+}
+
+// lockOpenWorkOrder locks a work order of the company for the rest of tx and
+// returns its wheel and maintenance task. It reports
+// models.ErrWorkOrderClosed if the work order is not open.
+func lockOpenWorkOrder(ctx context.Context, tx dbtx, companyID string, orderID string) (wheelID *string, taskID *string, err error) {
+	var status string
+	err = tx.QueryRowContext(ctx, `
+		SELECT status, id_wheel, id_task
+		FROM work_orders
+		WHERE id = $1 AND id_company = $2
+		FOR UPDATE`, orderID, companyID).Scan(&status, &wheelID, &taskID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, models.ErrWorkOrderNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if status != models.WorkOrderOpen {
+		return nil, nil, fmt.Errorf("%w: the work order is %s", models.ErrWorkOrderClosed, status)
+	}
+	return wheelID, taskID, nil
+}
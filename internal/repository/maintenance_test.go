@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWorkOrderTaskNotDue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	taskID := "t1"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM maintenance_tasks").
+		WithArgs(taskID, "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"id_car", "id_wheel", "status"}).
+			AddRow("car1", "w1", models.MaintenanceTaskScheduled))
+	mock.ExpectRollback()
+
+	_, err = repo.CreateWorkOrder(context.Background(), models.WorkOrder{
+		IDCompany: "c1",
+		IDTask:    &taskID,
+		Title:     "Rotate tires",
+		CreatedBy: "c1",
+	})
+	assert.ErrorIs(t, err, models.ErrMaintenanceTaskNotDue)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteWorkOrderWithTire(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	completedAt := createdAt.Add(48 * time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, id_wheel, id_task FROM work_orders").
+		WithArgs("o1", "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"status", "id_wheel", "id_task"}).AddRow(models.WorkOrderOpen, "w1", "t1"))
+	mock.ExpectExec("UPDATE wheels SET brand").
+		WithArgs("w1", "Nokian", "Hakka", float32(17), float32(120)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE maintenance_tasks t").
+		WithArgs("t1", models.MaintenanceTaskDone, completedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("UPDATE work_orders").
+		WithArgs("o1", models.WorkOrderCompleted, nil, nil, completedAt).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "id_company", "id_car", "id_wheel", "id_breakage", "id_task", "title", "status", "assignee", "notes", "cost",
+			"created_by", "created_at", "updated_at", "completed_at",
+		}).AddRow("o1", "c1", "car1", "w1", nil, "t1", "Replace tire", models.WorkOrderCompleted, nil, nil, nil,
+			"c1", createdAt, completedAt, completedAt))
+	mock.ExpectCommit()
+
+	res, err := repo.CompleteWorkOrder(context.Background(), models.WorkOrderCompletion{
+		IDCompany:   "c1",
+		IDWorkOrder: "o1",
+		Tire:        &models.TireReplacement{Brand: "Nokian", Model: "Hakka", Size: 17, Cost: 120},
+		CompletedAt: completedAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.WorkOrderCompleted, res.Status)
+	assert.Equal(t, &completedAt, res.CompletedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteWorkOrderClosed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, id_wheel, id_task FROM work_orders").
+		WithArgs("o1", "c1").
+		WillReturnRows(sqlmock.NewRows([]string{"status", "id_wheel", "id_task"}).AddRow(models.WorkOrderCancelled, nil, nil))
+	mock.ExpectRollback()
+
+	_, err = repo.CompleteWorkOrder(context.Background(), models.WorkOrderCompletion{
+		IDCompany:    "c1",
+		IDWorkOrder:  "o1",
+		ResetMileage: true,
+		CompletedAt:  time.Now(),
+	})
+	assert.ErrorIs(t, err, models.ErrWorkOrderClosed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
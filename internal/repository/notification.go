@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// notificationRef is what a notification refers to: the record it is about,
+// or the payload carrying its details for notifications without one.
+type notificationRef struct {
+	breakage        *string
+	workViolation   *string
+	expiryAlert     *string
+	maintenanceTask *string
+	payload         []byte
+}
+
+func (ref *notificationRef) dest() []any {
+	return []any{&ref.breakage, &ref.workViolation, &ref.expiryAlert, &ref.maintenanceTask, &ref.payload}
+}
+
+// severityRanks ranks the severities of notifications, from the least to the
+// most severe, by their position in the array.
+const severityRanks = `ARRAY['low', 'medium', 'high', 'critical']`
+
+const breakageNoticeQuery = `
+	SELECT b.id, COALESCE(b.type, ''), b.id_type, b.status, COALESCE(b.description, ''),
+		COALESCE(d.id::text, ''), CONCAT_WS(' ', d.surname, d.name, d.middle_name),
+		COALESCE(b.latitude, 0), COALESCE(b.longitude, 0)
+	FROM breakages b
+	LEFT JOIN drivers d ON d.id = b.id_driver
+	WHERE b.id = $1`
+
+func breakageNoticeDest(n *models.BreakageNotice) []any {
+	return []any{&n.ID, &n.Type, &n.TypeID, &n.Status, &n.Description, &n.DriverID, &n.DriverName, &n.Latitude, &n.Longitude}
+}
+
+const workViolationNoticeQuery = `
+	SELECT v.id, v.id_driver, CONCAT_WS(' ', d.surname, d.name, d.middle_name), v.type,
+		v.period_start, v.period_end, v.limit_minutes, v.actual_minutes
+	FROM work_violations v
+	JOIN drivers d ON d.id = v.id_driver
+	WHERE v.id = $1`
+
+func workViolationNoticeDest(n *models.WorkViolationNotice) []any {
+	return []any{&n.ID, &n.DriverID, &n.DriverName, &n.Type, &n.PeriodStart, &n.PeriodEnd, &n.LimitMinutes, &n.ActualMinutes}
+}
+
+const documentExpiryNoticeQuery = `
+	SELECT e.id, e.id_driver, CONCAT_WS(' ', d.surname, d.name, d.middle_name), e.type, e.expires_at
+	FROM driver_expiry_alerts e
+	JOIN drivers d ON d.id = e.id_driver
+	WHERE e.id = $1`
+
+func documentExpiryNoticeDest(n *models.DocumentExpiryNotice) []any {
+	return []any{&n.ID, &n.DriverID, &n.DriverName, &n.Document, &n.ExpiresAt}
+}
+
+const maintenanceDueNoticeQuery = `
+	SELECT t.id, t.id_plan, p.name, t.id_wheel, t.reason, t.due_at, t.due_mileage, t.status
+	FROM maintenance_tasks t
+	JOIN maintenance_plans p ON p.id = t.id_plan
+	WHERE t.id = $1`
+
+func maintenanceDueNoticeDest(n *models.MaintenanceDueNotice) []any {
+	return []any{&n.TaskID, &n.PlanID, &n.PlanName, &n.WheelID, &n.Reason, &n.DueAt, &n.DueMileage, &n.Status}
+}
+
+// loadNotice sets the details of the type of the notification from the
+// record it refers to or its payload, along with the fields of
+// NotificationInfo that predate them.
+func (r *Repository) loadNotice(ctx context.Context, info *models.NotificationInfo, ref notificationRef) error {
+	var err error
+	switch {
+	case ref.breakage != nil:
+		n := &models.BreakageNotice{}
+		if err = r.queryNotice(ctx, breakageNoticeQuery, *ref.breakage, breakageNoticeDest(n)); err == nil {
+			info.Breakage = n
+			info.DriverName = n.DriverName
+			info.Location = models.Point{Latitude: n.Latitude, Longitude: n.Longitude}
+			info.BreakageStatus = &n.Status
+		}
+	case ref.workViolation != nil:
+		n := &models.WorkViolationNotice{}
+		if err = r.queryNotice(ctx, workViolationNoticeQuery, *ref.workViolation, workViolationNoticeDest(n)); err == nil {
+			info.WorkViolation = n
+			info.DriverName = n.DriverName
+		}
+	case ref.expiryAlert != nil:
+		n := &models.DocumentExpiryNotice{}
+		if err = r.queryNotice(ctx, documentExpiryNoticeQuery, *ref.expiryAlert, documentExpiryNoticeDest(n)); err == nil {
+			info.DocumentExpiry = n
+			info.DriverName = n.DriverName
+		}
+	case ref.maintenanceTask != nil:
+		n := &models.MaintenanceDueNotice{}
+		if err = r.queryNotice(ctx, maintenanceDueNoticeQuery, *ref.maintenanceTask, maintenanceDueNoticeDest(n)); err == nil {
+			info.MaintenanceDue = n
+		}
+	case ref.payload != nil:
+		err = unmarshalNotice(info, ref.payload)
+	}
+	return err
+}
+
+func (r *Repository) queryNotice(ctx context.Context, query string, id string, dest []any) error {
+	if err := r.conn(ctx).QueryRowContext(ctx, query, id).Scan(dest...); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return nil
+}
+
+// unmarshalNotice sets the details of a notification carried in its payload.
+func unmarshalNotice(info *models.NotificationInfo, payload []byte) error {
+	var notice any
+	switch info.Type {
+	case models.EventSensorThreshold:
+		info.SensorThreshold = &models.SensorThresholdNotice{}
+		notice = info.SensorThreshold
+	case models.EventSensorOffline:
+		info.SensorOffline = &models.SensorOfflineNotice{}
+		notice = info.SensorOffline
+	case models.EventGeofence:
+		info.Geofence = &models.GeofenceNotice{}
+		notice = info.Geofence
+	default:
+		return nil
+	}
+	if err := json.Unmarshal(payload, notice); err != nil {
+		return fmt.Errorf("%w: payload of notification %s: %v", models.ErrFailedToScanRow, info.ID, err)
+	}
+	return nil
+}
+
+// CreateSensorThresholdNotification notifies the company of a sensor reading
+// out of the bounds of its wheel, unless a notification about the same wheel
+// and metric is still new. created reports whether it did.
+func (r *Repository) CreateSensorThresholdNotification(ctx context.Context, n models.SensorThresholdNotification) (created bool, err error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	payload, err := json.Marshal(n.Notice)
+	if err != nil {
+		return false, err
+	}
+
+	query := `
+		INSERT INTO notifications (id_user, id_car, type, severity, note, status, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id_user, (payload->>'wheel_id'), (payload->>'metric'))
+			WHERE type = 'sensor_threshold' AND status = 'new'
+		DO NOTHING`
+
+	res, err := r.conn(ctx).ExecContext(ctx, query, n.IDCompany, n.IDCar, models.EventSensorThreshold,
+		models.NotificationSeverity[models.EventSensorThreshold], n.Note, models.StatusNew, string(payload), n.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return count > 0, nil
+}
+
+// CreateSensorOfflineNotifications notifies the companies of their devices
+// that have not reported since since, once for every time a device goes
+// silent, and returns the number of notifications created.
+func (r *Repository) CreateSensorOfflineNotifications(ctx context.Context, since time.Time) (int64, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	query := `
+		INSERT INTO notifications (id_user, id_car, type, severity, note, status, payload, created_at)
+		SELECT c.id_company, c.id, $2, $3, 'Device ' || c.device_number || ' stopped reporting', $4,
+			jsonb_build_object('device_number', c.device_number, 'last_seen_at', to_char(l.last_seen, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')),
+			now()
+		FROM cars c
+		JOIN LATERAL (
+			SELECT GREATEST(
+				(SELECT MAX(s.created_at) FROM sensors_data s WHERE s.device_number = c.device_number),
+				(SELECT MAX(p.created_at) FROM position_data p WHERE p.device_number = c.device_number)
+			) AS last_seen
+		) l ON true
+		WHERE l.last_seen < $1
+			AND NOT EXISTS (
+				SELECT 1
+				FROM notifications n
+				WHERE n.id_car = c.id AND n.type = $2 AND n.created_at >= l.last_seen
+			)`
+
+	res, err := r.conn(ctx).ExecContext(ctx, query, since, models.EventSensorOffline,
+		models.NotificationSeverity[models.EventSensorOffline], models.StatusNew)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Created %d sensor offline notifications", count)
+	return count, nil
+}
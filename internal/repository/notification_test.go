@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+var notificationInfoRows = []string{"id", "type", "severity", "status", "id_car", "note", "created_at",
+	"id_breakages", "id_work_violation", "id_expiry_alert", "id_maintenance_task", "payload"}
+
+func TestGetNotificationInfoFromPayload(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	payload := `{"wheel_id":"w1","device_number":"d1","sensor_number":"s1","metric":"pressure","value":9.5,"min":6,"max":8,"measured_at":"2026-03-02T07:59:00Z"}`
+
+	mock.ExpectQuery("SELECT (.+) FROM notifications n WHERE n.id = \\$1 AND n.id_user = \\$2").
+		WithArgs("n1", "c1").
+		WillReturnRows(sqlmock.NewRows(notificationInfoRows).
+			AddRow("n1", models.EventSensorThreshold, models.SeverityHigh, models.StatusNew, "car1", "out of range", now,
+				nil, nil, nil, nil, []byte(payload)))
+
+	info, err := repo.GetNotificationInfo(context.Background(), "c1", "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, models.EventSensorThreshold, info.Type)
+	assert.Equal(t, "out of range", info.Description)
+	if assert.NotNil(t, info.SensorThreshold) {
+		assert.Equal(t, "w1", info.SensorThreshold.WheelID)
+		assert.Equal(t, models.MetricPressure, info.SensorThreshold.Metric)
+		assert.Equal(t, float32(9.5), info.SensorThreshold.Value)
+	}
+	assert.Nil(t, info.Breakage)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetNotificationInfoNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	// Notifications of other companies are not found.
+	mock.ExpectQuery("SELECT (.+) FROM notifications n").
+		WithArgs("n1", "c2").
+		WillReturnRows(sqlmock.NewRows(notificationInfoRows))
+
+	_, err = repo.GetNotificationInfo(context.Background(), "c2", "n1")
+	assert.ErrorIs(t, err, models.ErrNotificationNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSensorThresholdNotificationOpen(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	n := models.SensorThresholdNotification{
+		IDCompany: "c1",
+		IDCar:     "car1",
+		Note:      "out of range",
+		Notice:    models.SensorThresholdNotice{WheelID: "w1", Metric: models.MetricTemperature, MeasuredAt: now},
+		CreatedAt: now,
+	}
+
+	// A new notification about the same wheel and metric suppresses the insert.
+	mock.ExpectExec("INSERT INTO notifications(.+)ON CONFLICT(.+)DO NOTHING").
+		WithArgs("c1", "car1", models.EventSensorThreshold, models.SeverityHigh, "out of range", models.StatusNew,
+			sqlmock.AnyArg(), now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	created, err := repo.CreateSensorThresholdNotification(context.Background(), n)
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetWheelBySensorWithoutBounds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectQuery("SELECT (.+) FROM wheels w JOIN cars c ON c.id = w.id_car WHERE c.device_number = \\$1 AND w.sensor_number = \\$2").
+		WithArgs("d1", "s1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "id_car", "count_axis", "position",
+			"min_pressure", "max_pressure", "min_temperature", "max_temperature"}).
+			AddRow("w1", "c1", "car1", 1, 2, 6.0, nil, nil, nil))
+
+	wheel, err := repo.GetWheelBySensor(context.Background(), "d1", "s1")
+	assert.NoError(t, err)
+	if assert.NotNil(t, wheel.MinPressure) {
+		assert.Equal(t, float32(6), *wheel.MinPressure)
+	}
+	assert.Nil(t, wheel.MaxPressure)
+	assert.Nil(t, wheel.MinTemperature)
+	assert.Nil(t, wheel.MaxTemperature)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// sortField is a column of a list query's result that the list can be sorted by.
+type sortField struct {
+	column string
+	// cast is the SQL type a cursor value is converted back to before it is
+	// compared with the column.
+	cast string
+}
+
+// listQuery is a list with its filters applied, paged by queryPage.
+type listQuery struct {
+	// query selects every row of the list. Its result columns are scanned in
+	// order, so they must have unique names and match the scan destinations.
+	query string
+	args  []any
+	// idColumn is a unique column of the result breaking ties between rows
+	// with equal sort values.
+	idColumn    string
+	sortFields  map[string]sortField
+	defaultSort string
+}
+
+// cursor is the position after the last row of a page. It is handed to
+// clients base64 encoded and is opaque to them.
+type cursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+func encodeCursor(c cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, models.ErrInvalidCursor
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, models.ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// queryPage returns the page of q selected by page, together with the total
+// number of rows in q. Pages are read by keyset: a cursor resumes the list
+// after the row it was created from, so rows inserted meanwhile do not shift
+// later pages. dest returns the scan destinations of an item in the order of
+// q's result columns.
+func queryPage[T any](ctx context.Context, r *Repository, q listQuery, page models.PageRequest, dest func(item *T) []any) (models.Page[T], error) {
+	var res models.Page[T]
+
+	sortName := page.Sort
+	if sortName == "" {
+		sortName = q.defaultSort
+	}
+	desc := strings.HasPrefix(sortName, "-")
+	field, ok := q.sortFields[strings.TrimPrefix(sortName, "-")]
+	if !ok {
+		return res, fmt.Errorf("%w: %q, use one of %s", models.ErrInvalidSort, sortName, sortFieldNames(q.sortFields))
+	}
+
+	order, cmp := "ASC", ">"
+	if desc {
+		order, cmp = "DESC", "<"
+	}
+
+	args := append([]any{}, q.args...)
+	var where, offset string
+	if page.Cursor != "" {
+		c, err := decodeCursor(page.Cursor)
+		if err != nil {
+			return res, err
+		}
+		if c.Sort != sortName {
+			return res, fmt.Errorf("%w: the cursor was issued for sort %q", models.ErrInvalidCursor, c.Sort)
+		}
+		args = append(args, c.Value, c.ID)
+		where = fmt.Sprintf("WHERE (l.%s, l.%s::text) %s ($%d::%s, $%d)",
+			field.column, q.idColumn, cmp, len(args)-1, field.cast, len(args))
+	} else if page.Offset > 0 {
+		args = append(args, page.Offset)
+		offset = fmt.Sprintf("OFFSET $%d", len(args))
+	}
+	args = append(args, page.Limit+1)
+
+	query := fmt.Sprintf(`
+		SELECT l.*, l.%[1]s::text, l.%[2]s::text
+		FROM (%[3]s) l
+		%[4]s
+		ORDER BY l.%[1]s %[5]s, l.%[2]s::text %[5]s
+		LIMIT $%[6]d %[7]s`,
+		field.column, q.idColumn, q.query, where, order, len(args), offset)
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return res, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	res.Items = []T{}
+	var last cursor
+	hasMore := false
+	for rows.Next() {
+		if len(res.Items) == page.Limit {
+			hasMore = true
+			break
+		}
+
+		var item T
+		var sortValue, id string
+		if err := rows.Scan(append(dest(&item), &sortValue, &id)...); err != nil {
+			return res, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		res.Items = append(res.Items, item)
+		last = cursor{Sort: sortName, Value: sortValue, ID: id}
+	}
+	if err := rows.Err(); err != nil {
+		return res, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+	if hasMore && len(res.Items) > 0 {
+		res.NextCursor = encodeCursor(last)
+	}
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s) l", q.query)
+	if err := r.conn(ctx).QueryRowContext(ctx, countQuery, q.args...).Scan(&res.Total); err != nil {
+		return res, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return res, nil
+}
+
+func sortFieldNames(fields map[string]sortField) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// filterArgs builds the WHERE conditions of a list query from optional
+// filters, numbering placeholders after the arguments already present.
+type filterArgs struct {
+	conds []string
+	args  []any
+}
+
+func (f *filterArgs) add(cond string, arg any) {
+	f.args = append(f.args, arg)
+	f.conds = append(f.conds, strings.ReplaceAll(cond, "?", fmt.Sprintf("$%d", len(f.args))))
+}
+
+func (f *filterArgs) where() string {
+	return "WHERE " + strings.Join(f.conds, " AND ")
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// portableEntity describes how an entity of models.PortableEntities is
+// exported and restored.
+type portableEntity struct {
+	table string
+	// export selects the company's records; $1 is the company and $2 and $3
+	// the optional bounds of the time range.
+	export string
+	// companyColumn is set to the restoring company, so that an archive can
+	// be restored into another environment under a different company ID.
+	companyColumn string
+	// owned is the condition a restored record r must meet to be kept, so
+	// that an archive cannot attach records to another company's data. $2 is
+	// the restoring company.
+	owned string
+}
+
+const (
+	companyCarIDs     = "SELECT id FROM cars WHERE id_company = $2"
+	companyDevices    = "SELECT device_number FROM cars WHERE id_company = $2"
+	exportCompanyCars = "SELECT device_number FROM cars WHERE id_company = $1"
+	exportTimeRange   = "($2::timestamp IS NULL OR %[1]s >= $2) AND ($3::timestamp IS NULL OR %[1]s < $3)"
+)
+
+var portableEntities = map[string]portableEntity{
+	"cars": {
+		table:         "cars",
+		export:        "SELECT * FROM cars WHERE id_company = $1 ORDER BY id",
+		companyColumn: "id_company",
+		owned:         "TRUE",
+	},
+	"wheels": {
+		table:         "wheels",
+		export:        "SELECT * FROM wheels WHERE id_company = $1 ORDER BY id",
+		companyColumn: "id_company",
+		owned:         "r.id_car IS NULL OR r.id_car IN (" + companyCarIDs + ")",
+	},
+	"drivers": {
+		table:         "drivers",
+		export:        "SELECT * FROM drivers WHERE id_company = $1 ORDER BY id",
+		companyColumn: "id_company",
+		owned:         "TRUE",
+	},
+	"driver_assignments": {
+		table:         "driver_assignments",
+		export:        "SELECT * FROM driver_assignments WHERE id_company = $1 ORDER BY started_at, id",
+		companyColumn: "id_company",
+		owned:         "r.id_car IN (" + companyCarIDs + ") AND r.id_driver IN (SELECT id FROM drivers WHERE id_company = $2)",
+	},
+	"car_positions": {
+		table:         "cars_positions",
+		export:        "SELECT * FROM cars_positions WHERE id_company = $1 ORDER BY id",
+		companyColumn: "id_company",
+		owned:         "r.id_car IN (" + companyCarIDs + ")",
+	},
+	"sensor_data": {
+		table: "sensors_data",
+		export: "SELECT * FROM sensors_data WHERE device_number IN (" + exportCompanyCars + ") AND " +
+			fmt.Sprintf(exportTimeRange, "created_at") + " ORDER BY created_at, id",
+		owned: "r.device_number IN (" + companyDevices + ")",
+	},
+	"positions": {
+		table: "position_data",
+		export: "SELECT * FROM position_data WHERE device_number IN (" + exportCompanyCars + ") AND " +
+			fmt.Sprintf(exportTimeRange, "created_at") + " ORDER BY created_at, id",
+		owned: "r.device_number IN (" + companyDevices + ")",
+	},
+	"breakage_types": {
+		table:         "breakage_types",
+		export:        "SELECT * FROM breakage_types WHERE id_company = $1 ORDER BY id",
+		companyColumn: "id_company",
+		owned:         "TRUE",
+	},
+	"breakages": {
+		table: "breakages",
+		export: "SELECT b.* FROM breakages b JOIN cars c ON c.id = b.id_car WHERE c.id_company = $1 AND " +
+			fmt.Sprintf(exportTimeRange, "b.created_at") + " ORDER BY b.created_at, b.id",
+		owned: "r.id_car IN (" + companyCarIDs + ") AND " +
+			"(r.id_type IS NULL OR r.id_type IN (SELECT id FROM breakage_types WHERE id_company = $2))",
+	},
+	// Notifications are selected by the time of their breakage, so that the
+	// breakage they refer to is in the archive too.
+	"notifications": {
+		table: "notifications",
+		export: "SELECT n.* FROM notifications n JOIN breakages b ON b.id = n.id_breakages WHERE n.id_user = $1 AND " +
+			fmt.Sprintf(exportTimeRange, "b.created_at") + " ORDER BY n.created_at, n.id",
+		companyColumn: "id_user",
+		owned:         "r.id_breakages IN (SELECT b.id FROM breakages b JOIN cars c ON c.id = b.id_car WHERE c.id_company = $2)",
+	},
+}
+
+// Portability
+// ExportEntity streams the records of entity selected by filter to w, one
+// row at a time. It is bounded only by the caller's context, as the time it
+// takes depends on how fast the archive is read.
+func (r *Repository) ExportEntity(ctx context.Context, entity string, filter models.ExportFilter, w models.RecordWriter) error {
+	def, ok := portableEntities[entity]
+	if !ok {
+		return fmt.Errorf("%w: unknown entity %q", models.ErrInvalidParameter, entity)
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, def.export, filter.IDCompany, filter.From, filter.To)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if err := w.WriteHeader(columns); err != nil {
+		return err
+	}
+
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	count := 0
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		if err := w.WriteRecord(values); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Exported %d %s for userID=%s", count, entity, filter.IDCompany)
+	return nil
+}
+
+// Restore inserts the records of src for the company in one transaction,
+// keeping their IDs. Like ExportEntity it is bounded only by the caller's
+// context, as archives of a whole fleet take long to restore.
+func (r *Repository) Restore(ctx context.Context, companyID string, src models.RecordSource) ([]models.RestoreResult, error) {
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	var results []models.RestoreResult
+	for {
+		entity, record, err := src.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		def, ok := portableEntities[entity]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown entity %q", models.ErrInvalidParameter, entity)
+		}
+		if len(results) == 0 || results[len(results)-1].Entity != entity {
+			results = append(results, models.RestoreResult{Entity: entity})
+		}
+		res := &results[len(results)-1]
+		res.Read++
+
+		if def.companyColumn != "" {
+			record[def.companyColumn] = companyID
+		}
+		data, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s record %d: %v", models.ErrInvalidInput, entity, res.Read, err)
+		}
+
+		query := fmt.Sprintf(`
+			INSERT INTO %[1]s
+			SELECT r.* FROM json_populate_record(NULL::%[1]s, $1::json) r
+			WHERE (%[2]s)
+			ON CONFLICT DO NOTHING`, def.table, def.owned)
+		result, err := tx.ExecContext(ctx, query, string(data), companyID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s record %d: %v", models.ErrFailedToExecuteQuery, entity, res.Read, err)
+		}
+		created, err := result.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		}
+		if created > 0 {
+			res.Created++
+		} else {
+			res.Skipped++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Restored %d entities for userID=%s", len(results), companyID)
+	return results, nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordCollector struct {
+	columns []string
+	records [][]any
+}
+
+func (c *recordCollector) WriteHeader(columns []string) error {
+	c.columns = columns
+	return nil
+}
+
+func (c *recordCollector) WriteRecord(values []any) error {
+	c.records = append(c.records, append([]any(nil), values...))
+	return nil
+}
+
+type recordList struct {
+	entities []string
+	records  []map[string]any
+}
+
+func (l *recordList) Next() (string, map[string]any, error) {
+	if len(l.records) == 0 {
+		return "", nil, io.EOF
+	}
+	entity, record := l.entities[0], l.records[0]
+	l.entities, l.records = l.entities[1:], l.records[1:]
+	return entity, record, nil
+}
+
+func TestExportEntity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	filter := models.ExportFilter{IDCompany: "c1"}
+	mock.ExpectQuery("SELECT b.\\* FROM breakages b JOIN cars c ON c.id = b.id_car WHERE c.id_company = \\$1").
+		WithArgs("c1", nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_car", "type"}).
+			AddRow("b1", "car1", "pressure").
+			AddRow("b2", "car1", "temperature"))
+
+	collector := &recordCollector{}
+	err = repo.ExportEntity(context.Background(), "breakages", filter, collector)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"id", "id_car", "type"}, collector.columns)
+	assert.Equal(t, [][]any{{"b1", "car1", "pressure"}, {"b2", "car1", "temperature"}}, collector.records)
+
+	err = repo.ExportEntity(context.Background(), "users", filter, collector)
+	assert.True(t, errors.Is(err, models.ErrInvalidParameter))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRestore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	src := &recordList{
+		entities: []string{"cars", "cars", "sensor_data"},
+		records: []map[string]any{
+			{"id": "car1", "id_company": "old", "state_number": "A123BC"},
+			{"id": "car2", "id_company": "old", "state_number": "B456CD"},
+			{"id": "s1", "device_number": "dev1", "pressure": 7.5},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO cars SELECT r.\\* FROM json_populate_record\\(NULL::cars, \\$1::json\\) r WHERE \\(TRUE\\) ON CONFLICT DO NOTHING").
+		WithArgs(`{"id":"car1","id_company":"c1","state_number":"A123BC"}`, "c1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO cars").
+		WithArgs(`{"id":"car2","id_company":"c1","state_number":"B456CD"}`, "c1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO sensors_data (.+) WHERE \\(r.device_number IN \\(SELECT device_number FROM cars WHERE id_company = \\$2\\)\\)").
+		WithArgs(`{"device_number":"dev1","id":"s1","pressure":7.5}`, "c1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	results, err := repo.Restore(context.Background(), "c1", src)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.RestoreResult{
+		{Entity: "cars", Read: 2, Created: 1, Skipped: 1},
+		{Entity: "sensor_data", Read: 1, Created: 1},
+	}, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreRollsBackOnError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	src := &recordList{
+		entities: []string{"wheels"},
+		records:  []map[string]any{{"id": "w1", "id_car": "car1"}},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO wheels").
+		WillReturnError(errors.New("invalid input syntax for type uuid"))
+	mock.ExpectRollback()
+
+	_, err = repo.Restore(context.Background(), "c1", src)
+	assert.True(t, errors.Is(err, models.ErrFailedToExecuteQuery))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
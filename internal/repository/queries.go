@@ -7,32 +7,45 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/VikaPaz/algalar/internal/logging"
 	"github.com/VikaPaz/algalar/internal/models"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 )
 
 type Repository struct {
-	conn *sql.DB
-	log  *logrus.Logger
+	db       *sql.DB
+	log      *logrus.Logger
+	timeouts Timeouts
 }
 
-func NewRepository(conn *sql.DB, logger *logrus.Logger) *Repository {
+// queryRower is implemented by *sql.DB and *sql.Tx, so that inserts can run
+// on their own or as part of a transaction.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func NewRepository(conn *sql.DB, logger *logrus.Logger, timeouts Timeouts) *Repository {
 	return &Repository{
-		conn: conn,
-		log:  logger,
+		db:       conn,
+		log:      logger,
+		timeouts: timeouts,
 	}
 }
 
 // User
-func (r *Repository) CreateUser(user models.User) (string, error) {
+func (r *Repository) CreateUser(ctx context.Context, user models.User) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
         INSERT INTO users (inn, name, surname, gender, login, password, utc_timezone, phone)
         VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
         RETURNING id`
 
 	var userID string
-	err := r.conn.QueryRow(query, user.INN, user.Name, user.Surname, user.Gender, user.Login, user.Password, user.Timezone, user.Phone).Scan(&userID)
+	err := r.conn(ctx).QueryRowContext(ctx, query, user.INN, user.Name, user.Surname, user.Gender, user.Login, user.Password, user.Timezone, user.Phone).Scan(&userID)
 	if err != nil {
 		return "", err
 	}
@@ -41,7 +54,10 @@ func (r *Repository) CreateUser(user models.User) (string, error) {
 }
 
 // UpdateUser updates user information in the database and returns the updated user ID.
-func (r *Repository) UpdateUser(user models.User) (string, error) {
+func (r *Repository) UpdateUser(ctx context.Context, user models.User) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
         UPDATE users 
         SET inn = $1, 
@@ -54,31 +70,37 @@ func (r *Repository) UpdateUser(user models.User) (string, error) {
         WHERE id = $8
         RETURNING id`
 
-	r.log.Debugf("Executing query to update user with ID: %s", user.ID)
+	logging.FromContext(ctx, r.log).Debugf("Executing query to update user with ID: %s", user.ID)
 
 	var userID string
-	err := r.conn.QueryRow(query,
+	err := r.conn(ctx).QueryRowContext(ctx, query,
 		user.INN, user.Name, user.Surname, user.Gender,
 		user.Login, user.Timezone, user.Phone, user.ID,
 	).Scan(&userID)
 
+	if err == sql.ErrNoRows {
+		return "", models.ErrNoContent
+	}
 	if err != nil {
-		r.log.Errorf("Failed to update user: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to update user: %v", err)
 		return "", fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 
-	r.log.Debugf("User updated successfully: %s", userID)
+	logging.FromContext(ctx, r.log).Debugf("User updated successfully: %s", userID)
 	return userID, nil
 }
 
-func (r *Repository) GetById(userID string) (models.User, error) {
+func (r *Repository) GetById(ctx context.Context, userID string) (models.User, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
         SELECT inn, name, surname, gender, login, password, utc_timezone, phone
         FROM users
         WHERE id = $1`
 
 	user := models.User{}
-	err := r.conn.QueryRow(query, userID).Scan(&user.INN, &user.Name, &user.Surname, &user.Gender, &user.Login, &user.Password, &user.Timezone, &user.Phone)
+	err := r.conn(ctx).QueryRowContext(ctx, query, userID).Scan(&user.INN, &user.Name, &user.Surname, &user.Gender, &user.Login, &user.Password, &user.Timezone, &user.Phone)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return models.User{}, models.ErrNoContent
@@ -89,7 +111,10 @@ func (r *Repository) GetById(userID string) (models.User, error) {
 	return user, nil
 }
 
-func (r *Repository) ChangePassword(userID, newPassword string) error {
+func (r *Repository) ChangePassword(ctx context.Context, userID, newPassword string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	if newPassword == "" {
 		return errors.New("new password is required")
 	}
@@ -99,7 +124,7 @@ func (r *Repository) ChangePassword(userID, newPassword string) error {
         SET password = $1
         WHERE id = $2`
 
-	_, err := r.conn.Exec(query, newPassword, userID)
+	_, err := r.conn(ctx).ExecContext(ctx, query, newPassword, userID)
 	if err != nil {
 		return err
 	}
@@ -107,7 +132,10 @@ func (r *Repository) ChangePassword(userID, newPassword string) error {
 	return nil
 }
 
-func (r *Repository) GetIDByLoginAndPassword(email, password string) (string, error) {
+func (r *Repository) GetIDByLoginAndPassword(ctx context.Context, email, password string) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	if email == "" || password == "" {
 		return "", errors.New("email and password are required")
 	}
@@ -118,7 +146,7 @@ func (r *Repository) GetIDByLoginAndPassword(email, password string) (string, er
         WHERE login = $1 AND password = $2`
 
 	var userID string
-	err := r.conn.QueryRow(query, email, password).Scan(&userID)
+	err := r.conn(ctx).QueryRowContext(ctx, query, email, password).Scan(&userID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return "", models.ErrNoContent
@@ -130,13 +158,20 @@ func (r *Repository) GetIDByLoginAndPassword(email, password string) (string, er
 }
 
 // Auto
-func (r *Repository) CreateCar(car models.Car) (models.Car, error) {
+func (r *Repository) CreateCar(ctx context.Context, car models.Car) (models.Car, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	return insertCar(ctx, r.conn(ctx), car)
+}
+
+func insertCar(ctx context.Context, q queryRower, car models.Car) (models.Car, error) {
 	query := `
         INSERT INTO cars (id_company, state_number, brand, device_number, id_unicum, count_axis, car_type)
         VALUES ($1, $2, $3, $4, $5, $6, $7)
         RETURNING *`
 	resp := models.Car{}
-	err := r.conn.QueryRow(query, car.IDCompany, car.StateNumber, car.Brand, car.DeviceNumber, car.IDUnicum, car.CountAxis, car.Type).Scan(
+	err := q.QueryRowContext(ctx, query, car.IDCompany, car.StateNumber, car.Brand, car.DeviceNumber, car.IDUnicum, car.CountAxis, car.Type).Scan(
 		&resp.ID,
 		&resp.IDCompany,
 		&resp.StateNumber,
@@ -153,14 +188,17 @@ func (r *Repository) CreateCar(car models.Car) (models.Car, error) {
 	return resp, nil
 }
 
-func (r *Repository) GetCarById(carID string) (models.Car, error) {
+func (r *Repository) GetCarById(ctx context.Context, carID string) (models.Car, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 		SELECT id, id_company, state_number, brand, device_number, id_unicum, count_axis
 		FROM cars
 		WHERE id = $1`
 
 	car := models.Car{}
-	err := r.conn.QueryRow(query, carID).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, query, carID).Scan(
 		&car.ID,
 		&car.IDCompany,
 		&car.StateNumber,
@@ -181,16 +219,19 @@ func (r *Repository) GetCarById(carID string) (models.Car, error) {
 }
 
 func (r *Repository) GetCarByDeviceNumber(ctx context.Context, device string) (models.Car, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 		SELECT id, id_company, state_number, brand, device_number, id_unicum, car_type, count_axis 
 		FROM cars 
 		WHERE device_number = $1
 	`
 
-	r.log.Debugf("Executing query: %s with value: %s", query, device)
+	logging.FromContext(ctx, r.log).Debugf("Executing query: %s with value: %s", query, device)
 
 	var car models.Car
-	err := r.conn.QueryRowContext(ctx, query, device).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, query, device).Scan(
 		&car.ID,
 		&car.IDCompany,
 		&car.StateNumber,
@@ -202,22 +243,28 @@ func (r *Repository) GetCarByDeviceNumber(ctx context.Context, device string) (m
 	)
 
 	if err != nil {
-		r.log.Errorf("Failed to get car by device number: %v", err)
+		if err == sql.ErrNoRows {
+			return models.Car{}, models.ErrNoContent
+		}
+		logging.FromContext(ctx, r.log).Errorf("Failed to get car by device number: %v", err)
 		return models.Car{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 
-	r.log.Debugf("Car retrieved successfully: %+v", car)
+	logging.FromContext(ctx, r.log).Debugf("Car retrieved successfully: %+v", logging.Redact(car))
 	return car, nil
 }
 
-func (r *Repository) GetIdCarByStateNumber(stateNumber string) (string, error) {
+func (r *Repository) GetIdCarByStateNumber(ctx context.Context, stateNumber string) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 		SELECT id
 		FROM cars
 		WHERE state_number = $1`
 
 	var carID string
-	err := r.conn.QueryRow(query, stateNumber).Scan(&carID)
+	err := r.conn(ctx).QueryRowContext(ctx, query, stateNumber).Scan(&carID)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -229,26 +276,41 @@ func (r *Repository) GetIdCarByStateNumber(stateNumber string) (string, error) {
 	return carID, nil
 }
 
-func (r *Repository) GetCarsList(userID string, offset int, limit int) ([]models.Car, error) {
-	query := `
-		SELECT id, id_company, state_number, brand, device_number, id_unicum, count_axis
-		FROM cars
-		WHERE id_company = $1
-		LIMIT $2 OFFSET $3`
-
-	r.log.Debugf("Executing query to fetch car list: userID=%s, limit=%d, offset=%d", userID, limit, offset)
-
-	cars := []models.Car{}
-	rows, err := r.conn.Query(query, userID, limit, offset)
-	if err != nil {
-		r.log.Errorf("%v: %v", models.ErrFailedToExecuteQuery, err)
-		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var car models.Car
-		if err := rows.Scan(
+// GetCarsList returns a page of the company's cars matching filter.
+func (r *Repository) GetCarsList(ctx context.Context, filter models.CarFilter, page models.PageRequest) (models.Page[models.Car], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var f filterArgs
+	f.add("id_company = ?", filter.IDCompany)
+	if filter.Brand != nil {
+		f.add("brand = ?", *filter.Brand)
+	}
+	if filter.Type != nil {
+		f.add("car_type = ?", *filter.Type)
+	}
+
+	q := listQuery{
+		query: `
+			SELECT id, id_company, COALESCE(state_number, '') AS state_number, COALESCE(brand, '') AS brand,
+				COALESCE(device_number, '') AS device_number, COALESCE(id_unicum, '') AS id_unicum,
+				COALESCE(count_axis, 0) AS count_axis, COALESCE(car_type, '') AS car_type
+			FROM cars
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"state_number": {"state_number", "text"},
+			"brand":        {"brand", "text"},
+			"car_type":     {"car_type", "text"},
+		},
+		defaultSort: "state_number",
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Executing query to fetch car list: userID=%s, limit=%d, sort=%s", filter.IDCompany, page.Limit, page.Sort)
+
+	cars, err := queryPage(ctx, r, q, page, func(car *models.Car) []any {
+		return []any{
 			&car.ID,
 			&car.IDCompany,
 			&car.StateNumber,
@@ -256,35 +318,34 @@ func (r *Repository) GetCarsList(userID string, offset int, limit int) ([]models
 			&car.DeviceNumber,
 			&car.IDUnicum,
 			&car.CountAxis,
-		); err != nil {
-			r.log.Errorf("%v: %v", models.ErrFailedToScanRow, err)
-			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+			&car.Type,
 		}
-		cars = append(cars, car)
-	}
-
-	if err := rows.Err(); err != nil {
-		r.log.Errorf("%v: %v", models.ErrFailedToIterateRows, err)
-		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	})
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to fetch car list: %v", err)
+		return cars, err
 	}
 
-	if len(cars) == 0 {
-		r.log.Debugf("No cars found for userID=%s", userID)
-		return nil, models.ErrNoContent
+	if cars.Total == 0 {
+		logging.FromContext(ctx, r.log).Debugf("No cars found for userID=%s", filter.IDCompany)
+		return cars, models.ErrNoContent
 	}
 
-	r.log.Debugf("Successfully fetched %d cars for userID=%s", len(cars), userID)
+	logging.FromContext(ctx, r.log).Debugf("Successfully fetched %d of %d cars for userID=%s", len(cars.Items), cars.Total, filter.IDCompany)
 	return cars, nil
 }
 
-func (r *Repository) GetCarByStateNumber(stateNumber string) (models.Car, error) {
+func (r *Repository) GetCarByStateNumber(ctx context.Context, stateNumber string) (models.Car, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 	SELECT id, id_company, state_number, brand, device_number, id_unicum, count_axis
 	FROM cars
 	WHERE state_number = $1`
 
 	car := models.Car{}
-	err := r.conn.QueryRow(query, stateNumber).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, query, stateNumber).Scan(
 		&car.ID,
 		&car.IDCompany,
 		&car.StateNumber,
@@ -305,7 +366,12 @@ func (r *Repository) GetCarByStateNumber(stateNumber string) (models.Car, error)
 }
 
 // Mileage
-func (r *Repository) UpdateWheelsMilagelData(ctx context.Context, update models.UpdateMileage) error {
+// UpdateWheelsMilagelData adds the mileage of the update to every wheel of the
+// car with its device and returns the updated wheels.
+func (r *Repository) UpdateWheelsMilagelData(ctx context.Context, update models.UpdateMileage) ([]models.Wheel, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
 		WITH car_info AS (
 		SELECT id, id_company
@@ -316,44 +382,60 @@ func (r *Repository) UpdateWheelsMilagelData(ctx context.Context, update models.
 	SET mileage = mileage + $2
 	FROM car_info
 	WHERE wheels.id_car = car_info.id
-	AND wheels.id_company = car_info.id_company;
+	AND wheels.id_company = car_info.id_company
+	RETURNING wheels.id, wheels.id_company, wheels.id_car, wheels.position, wheels.mileage;
 	`
 
-	r.log.Debugf("Executing mileage update query for device number: %s, mileage increment: %f", update.DeviceNum, update.Mileage)
+	logging.FromContext(ctx, r.log).Debugf("Executing mileage update query for device number: %s, mileage increment: %f", update.DeviceNum, update.Mileage)
 
-	res, err := r.conn.ExecContext(ctx, query,
+	rows, err := r.conn(ctx).QueryContext(ctx, query,
 		update.DeviceNum,
 		update.Mileage,
 	)
 	if err != nil {
-		r.log.Errorf("Failed to execute query for device number %s: %v", update.DeviceNum, err)
-		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query for device number %s: %v", update.DeviceNum, err)
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
+	defer rows.Close()
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		r.log.Errorf("Failed to get affected rows count for device number %s: %v", update.DeviceNum, err)
-		return fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
+	var wheels []models.Wheel
+	for rows.Next() {
+		var w models.Wheel
+		if err := rows.Scan(&w.ID, &w.IDCompany, &w.IDCar, &w.Position, &w.Mileage); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		wheels = append(wheels, w)
+	}
+	if err := rows.Err(); err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to read updated wheels for device number %s: %v", update.DeviceNum, err)
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
 	}
 
-	if rowsAffected == 0 {
-		r.log.Infof("No rows were affected by the mileage update query for device number: %s", update.DeviceNum)
-		return models.ErrNoContent
+	if len(wheels) == 0 {
+		logging.FromContext(ctx, r.log).Infof("No rows were affected by the mileage update query for device number: %s", update.DeviceNum)
+		return nil, models.ErrNoContent
 	}
 
-	r.log.Debugf("Successfully updated mileage for device number: %s, affected rows: %d", update.DeviceNum, rowsAffected)
-	return nil
+	logging.FromContext(ctx, r.log).Debugf("Successfully updated mileage for device number: %s, affected rows: %d", update.DeviceNum, len(wheels))
+	return wheels, nil
 }
 
 // Wheel
-func (r *Repository) CreateWheel(wheel models.Wheel) (string, error) {
+func (r *Repository) CreateWheel(ctx context.Context, wheel models.Wheel) (string, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	return insertWheel(ctx, r.conn(ctx), wheel)
+}
+
+func insertWheel(ctx context.Context, q queryRower, wheel models.Wheel) (string, error) {
 	query := `
         INSERT INTO wheels (id_company, id_car, count_axis, position, sensor_number, size, cost, brand, model, mileage, min_temperature, min_pressure, max_temperature, max_pressure, ngp, tkvh)
         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
         RETURNING id`
 
 	var wheelID string
-	err := r.conn.QueryRow(query, wheel.IDCompany, wheel.IDCar, wheel.AxisNumber, wheel.Position, wheel.SensorNumber, wheel.Size, wheel.Cost, wheel.Brand, wheel.Model, wheel.Mileage, wheel.MinTemperature, wheel.MinPressure, wheel.MaxTemperature, wheel.MaxPressure, *wheel.Ngp, *wheel.Tkvh).Scan(&wheelID)
+	err := q.QueryRowContext(ctx, query, wheel.IDCompany, wheel.IDCar, wheel.AxisNumber, wheel.Position, wheel.SensorNumber, wheel.Size, wheel.Cost, wheel.Brand, wheel.Model, wheel.Mileage, wheel.MinTemperature, wheel.MinPressure, wheel.MaxTemperature, wheel.MaxPressure, *wheel.Ngp, *wheel.Tkvh).Scan(&wheelID)
 	if err != nil {
 		return "", err
 	}
@@ -361,7 +443,10 @@ func (r *Repository) CreateWheel(wheel models.Wheel) (string, error) {
 	return wheelID, nil
 }
 
-func (r *Repository) GetWheelsByStateNumber(stateNumber string) ([]models.Wheel, error) {
+func (r *Repository) GetWheelsByStateNumber(ctx context.Context, stateNumber string) ([]models.Wheel, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
     SELECT
         w.id AS wheel_id,
@@ -391,7 +476,7 @@ func (r *Repository) GetWheelsByStateNumber(stateNumber string) ([]models.Wheel,
 
 	var wheels []models.Wheel
 
-	rows, err := r.conn.Query(query, stateNumber)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, stateNumber)
 	if err != nil {
 		return nil, err
 	}
@@ -420,11 +505,11 @@ func (r *Repository) GetWheelsByStateNumber(stateNumber string) ([]models.Wheel,
 		)
 
 		if err != nil {
-			r.log.Errorf("scan faild: %v", err)
+			logging.FromContext(ctx, r.log).Errorf("scan faild: %v", err)
 			return nil, err
 		}
 
-		r.log.Debugf("wheel: %v", wheel)
+		logging.FromContext(ctx, r.log).Debugf("wheel: %v", logging.Redact(wheel))
 
 		wheels = append(wheels, wheel)
 	}
@@ -437,19 +522,22 @@ func (r *Repository) GetWheelsByStateNumber(stateNumber string) ([]models.Wheel,
 		return nil, models.ErrNoContent
 	}
 
-	r.log.Debugf("query resp: %v", wheels)
+	logging.FromContext(ctx, r.log).Debugf("query resp: %v", logging.Redact(wheels))
 
 	return wheels, nil
 }
 
-func (r *Repository) GetWheelById(wheelID string) (models.Wheel, error) {
+func (r *Repository) GetWheelById(ctx context.Context, wheelID string) (models.Wheel, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
         SELECT id_car, count_axis, position, size, cost, brand, model, mileage, min_temperature, min_pressure, max_temperature, max_pressure
         FROM wheels
         WHERE id = $1`
 
 	wheel := models.Wheel{}
-	err := r.conn.QueryRow(query, wheelID).Scan(&wheel.IDCar, &wheel.AxisNumber, &wheel.Position, &wheel.Size, &wheel.Cost, &wheel.Brand, &wheel.Model, &wheel.Mileage, &wheel.MinTemperature, &wheel.MinPressure, &wheel.MaxTemperature, &wheel.MaxPressure)
+	err := r.conn(ctx).QueryRowContext(ctx, query, wheelID).Scan(&wheel.IDCar, &wheel.AxisNumber, &wheel.Position, &wheel.Size, &wheel.Cost, &wheel.Brand, &wheel.Model, &wheel.Mileage, &wheel.MinTemperature, &wheel.MinPressure, &wheel.MaxTemperature, &wheel.MaxPressure)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return models.Wheel{}, models.ErrNoContent
@@ -460,7 +548,49 @@ func (r *Repository) GetWheelById(wheelID string) (models.Wheel, error) {
 	return wheel, nil
 }
 
-func (r *Repository) ChangeWheel(wheel models.Wheel) error {
+// GetWheelBySensor returns the wheel of the car with the device whose sensor
+// has the number, or models.ErrNoContent if there is none.
+func (r *Repository) GetWheelBySensor(ctx context.Context, deviceNumber string, sensorNumber string) (models.SensorWheel, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+        SELECT w.id, w.id_company, w.id_car, w.count_axis, w.position, w.min_pressure, w.max_pressure, w.min_temperature, w.max_temperature
+        FROM wheels w
+        JOIN cars c ON c.id = w.id_car
+        WHERE c.device_number = $1 AND w.sensor_number = $2`
+
+	var wheel models.SensorWheel
+	var minPressure, maxPressure, minTemperature, maxTemperature sql.NullFloat64
+	err := r.conn(ctx).QueryRowContext(ctx, query, deviceNumber, sensorNumber).Scan(&wheel.ID, &wheel.IDCompany, &wheel.IDCar, &wheel.AxisNumber, &wheel.Position,
+		&minPressure, &maxPressure, &minTemperature, &maxTemperature)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.SensorWheel{}, models.ErrNoContent
+		}
+		return models.SensorWheel{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	wheel.MinPressure = nullFloat32(minPressure)
+	wheel.MaxPressure = nullFloat32(maxPressure)
+	wheel.MinTemperature = nullFloat32(minTemperature)
+	wheel.MaxTemperature = nullFloat32(maxTemperature)
+
+	return wheel, nil
+}
+
+// nullFloat32 returns the value of a nullable column, nil if it is NULL.
+func nullFloat32(val sql.NullFloat64) *float32 {
+	if !val.Valid {
+		return nil
+	}
+	f := float32(val.Float64)
+	return &f
+}
+
+func (r *Repository) ChangeWheel(ctx context.Context, wheel models.Wheel) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	carID, err := uuid.Parse(wheel.IDCar)
 	if err != nil {
 		return fmt.Errorf("error parsing carID '%s' into UUID: %w", carID, err)
@@ -470,69 +600,109 @@ func (r *Repository) ChangeWheel(wheel models.Wheel) error {
 	UPDATE wheels
         SET id_car = $1, count_axis = $2, position = $3, size = $4, cost = $5, brand = $6, model = $7, mileage = $8, min_temperature = $9, min_pressure = $10, max_temperature = $11, max_pressure = $12, ngp = $13, tkvh = $14
         WHERE id_car = $15 AND position = $16`
-	err = r.conn.QueryRow(query, carID, wheel.AxisNumber, wheel.Position, wheel.Size, wheel.Cost, wheel.Brand, wheel.Model, wheel.Mileage, wheel.MinTemperature, wheel.MinPressure, wheel.MaxTemperature, wheel.MaxPressure, *wheel.Ngp, *wheel.Tkvh, carID, wheel.Position).Err()
+	err = r.conn(ctx).QueryRowContext(ctx, query, carID, wheel.AxisNumber, wheel.Position, wheel.Size, wheel.Cost, wheel.Brand, wheel.Model, wheel.Mileage, wheel.MinTemperature, wheel.MinPressure, wheel.MaxTemperature, wheel.MaxPressure, *wheel.Ngp, *wheel.Tkvh, carID, wheel.Position).Err()
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *Repository) GetBreakagesByCarId(carID string) ([]models.BreakageInfo, error) {
-	query := `
-        SELECT 
-			b.id, 
-			CONCAT(d.name, ' ', d.surname, ' ', COALESCE(d.middle_name, '')) AS full_name,
-			c.state_number, 
-			b.type, 
-			b.description, 
-			b.created_at
-        FROM breakages b
-        JOIN cars c ON b.id_car = c.id
-		JOIN drivers d ON d.id_car = c.id
-        WHERE b.id_car = $1
-		;
-    `
-
-	var breakages []models.BreakageInfo
-
-	parsedUUID, err := uuid.Parse(carID)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing carID '%s' into UUID: %w", carID, err)
-	}
+// GetBreakagesByCarId returns a page of the breakages of a car of the company.
+func (r *Repository) GetBreakagesByCarId(ctx context.Context, filter models.BreakageFilter, page models.PageRequest) (models.Page[models.BreakageInfo], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
 
-	rows, err := r.conn.Query(query, parsedUUID)
+	parsedUUID, err := uuid.Parse(filter.IDCar)
 	if err != nil {
-		return nil, fmt.Errorf("error executing query to get breakages: %w", err)
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var breakage models.BreakageInfo
-		if err := rows.Scan(
+		return models.Page[models.BreakageInfo]{}, fmt.Errorf("error parsing carID '%s' into UUID: %w", filter.IDCar, err)
+	}
+
+	var f filterArgs
+	f.add("b.id_car = ?", parsedUUID)
+	f.add("c.id_company = ?", filter.IDCompany)
+	if filter.Type != nil {
+		f.add("b.type = ?", *filter.Type)
+	}
+	if filter.Severity != nil {
+		f.add("t.severity = ?", *filter.Severity)
+	}
+	if len(filter.Statuses) > 0 {
+		f.add("b.status = ANY(?)", pq.Array(filter.Statuses))
+	}
+	if filter.From != nil {
+		f.add("b.created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		f.add("b.created_at <= ?", *filter.To)
+	}
+
+	q := listQuery{
+		query: `
+			SELECT
+				b.id,
+				CONCAT(d.name, ' ', d.surname, ' ', COALESCE(d.middle_name, '')) AS full_name,
+				COALESCE(c.state_number, '') AS state_number,
+				COALESCE(b.type, '') AS type,
+				t.name AS type_name,
+				t.severity,
+				COALESCE(t.must_stop, false) AS must_stop,
+				COALESCE(b.description, '') AS description,
+				b.status,
+				b.assignee,
+				b.cost,
+				b.created_at,
+				b.resolved_at,
+				` + breakageDowntime + ` AS downtime_minutes
+			FROM breakages b
+			JOIN cars c ON b.id_car = c.id
+			LEFT JOIN breakage_types t ON t.id = b.id_type
+			LEFT JOIN driver_assignments a ON a.id_car = b.id_car
+				AND a.started_at <= b.created_at AND (a.ended_at IS NULL OR a.ended_at > b.created_at)
+			LEFT JOIN drivers d ON d.id = COALESCE(b.id_driver, a.id_driver)
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"created_at": {"created_at", "timestamp"},
+			"type":       {"type", "text"},
+			"status":     {"status", "text"},
+		},
+		defaultSort: "-created_at",
+	}
+
+	breakages, err := queryPage(ctx, r, q, page, func(breakage *models.BreakageInfo) []any {
+		return []any{
 			&breakage.ID,
 			&breakage.DriverName,
 			&breakage.StateNumber,
 			&breakage.Type,
+			&breakage.TypeName,
+			&breakage.Severity,
+			&breakage.MustStop,
 			&breakage.Description,
+			&breakage.Status,
+			&breakage.Assignee,
+			&breakage.Cost,
 			&breakage.CreatedAt,
-		); err != nil {
-			return nil, fmt.Errorf("error scanning row into breakage: %w", err)
+			&breakage.ResolvedAt,
+			&breakage.DowntimeMinutes,
 		}
-		breakages = append(breakages, breakage)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over rows: %w", err)
+	})
+	if err != nil {
+		return breakages, fmt.Errorf("error executing query to get breakages: %w", err)
 	}
 
 	return breakages, nil
 }
 
-func (r *Repository) SelectAny(table string, key string, val any) (bool, error) {
+func (r *Repository) SelectAny(ctx context.Context, table string, key string, val any) (bool, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := fmt.Sprintf("SELECT 1 FROM %s WHERE %s = $1 LIMIT 1", table, key)
 
 	var exists int
-	err := r.conn.QueryRow(query, val).Scan(&exists)
+	err := r.conn(ctx).QueryRowContext(ctx, query, val).Scan(&exists)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -545,13 +715,16 @@ func (r *Repository) SelectAny(table string, key string, val any) (bool, error)
 }
 
 // Sensors
-func (r *Repository) CreateData(newData models.SensorData) (models.SensorData, error) {
+func (r *Repository) CreateData(ctx context.Context, newData models.SensorData) (models.SensorData, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `INSERT INTO sensors_data (device_number, sensor_number, pressure, temperature, created_at) 
 	VALUES ($1, $2, $3, $4, $5) 
 	RETURNING id, device_number, sensor_number, pressure, temperature, created_at`
 
 	var result models.SensorData
-	err := r.conn.QueryRow(query, newData.DeviceNumber, newData.SensorNumber, newData.Pressure, newData.Temperature, newData.Time).
+	err := r.conn(ctx).QueryRowContext(ctx, query, newData.DeviceNumber, newData.SensorNumber, newData.Pressure, newData.Temperature, newData.Time).
 		Scan(&result.ID, &result.DeviceNumber, &result.SensorNumber, &result.Pressure, &result.Temperature, &result.Time)
 	if err != nil {
 		return models.SensorData{}, err
@@ -560,8 +733,11 @@ func (r *Repository) CreateData(newData models.SensorData) (models.SensorData, e
 	return result, nil
 }
 
-func (r *Repository) SensorsDataByCarID(carID string) ([]models.SensorsData, error) {
-	r.log.Debugf("Querying for sensors data with carID: %v", carID)
+func (r *Repository) SensorsDataByCarID(ctx context.Context, carID string) ([]models.SensorsData, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	logging.FromContext(ctx, r.log).Debugf("Querying for sensors data with carID: %v", carID)
 
 	query := `WITH latest_data AS (
 		SELECT 
@@ -581,11 +757,11 @@ func (r *Repository) SensorsDataByCarID(carID string) ([]models.SensorsData, err
 	FROM latest_data
 	WHERE rn = 1`
 
-	r.log.Debugf("Executing query: %v", query)
+	logging.FromContext(ctx, r.log).Debugf("Executing query: %v", query)
 
-	rows, err := r.conn.Query(query, carID)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, carID)
 	if err != nil {
-		r.log.Errorf("Error executing query: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Error executing query: %v", err)
 		return []models.SensorsData{}, err
 	}
 	defer rows.Close()
@@ -601,11 +777,11 @@ func (r *Repository) SensorsDataByCarID(carID string) ([]models.SensorsData, err
 
 		err := rows.Scan(&id, &deviceNumber, &sensorNumber, &wheelPosition, &pressure, &temperature)
 		if err != nil {
-			r.log.Errorf("Error scanning row: %v", err)
+			logging.FromContext(ctx, r.log).Errorf("Error scanning row: %v", err)
 			return []models.SensorsData{}, err
 		}
 
-		r.log.Debugf("Fetched data for wheel position %v: Pressure = %v, Temperature = %v", wheelPosition, pressure, temperature)
+		logging.FromContext(ctx, r.log).Debugf("Fetched data for wheel position %v: Pressure = %v, Temperature = %v", wheelPosition, pressure, temperature)
 
 		sensorsData = append(sensorsData, models.SensorsData{
 			WheelPosition: wheelPosition,
@@ -614,13 +790,16 @@ func (r *Repository) SensorsDataByCarID(carID string) ([]models.SensorsData, err
 		})
 	}
 
-	r.log.Debugf("Fetched %d sensor data entries for carID: %v", len(sensorsData), carID)
+	logging.FromContext(ctx, r.log).Debugf("Fetched %d sensor data entries for carID: %v", len(sensorsData), carID)
 
 	return sensorsData, nil
 }
 
 // Data
-func (r *Repository) Temperaturedata(filter models.TemperatureDataByWheelIDFilter) ([]models.TemperatureData, error) {
+func (r *Repository) Temperaturedata(ctx context.Context, filter models.TemperatureDataByWheelIDFilter) ([]models.TemperatureData, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 	WITH Device AS (
 		SELECT c.device_number, w.sensor_number
@@ -635,7 +814,7 @@ func (r *Repository) Temperaturedata(filter models.TemperatureDataByWheelIDFilte
 	AND s.created_at BETWEEN $2 AND $3
 	ORDER BY s.created_at;`
 
-	rows, err := r.conn.Query(query, filter.IDWheel, filter.From, filter.To)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, filter.IDWheel, filter.From, filter.To)
 	if err != nil {
 		return []models.TemperatureData{}, err
 	}
@@ -656,7 +835,10 @@ func (r *Repository) Temperaturedata(filter models.TemperatureDataByWheelIDFilte
 	return temperatureData, nil
 }
 
-func (r *Repository) Pressuredata(filter models.PressureDataByWheelIDFilter) ([]models.PressureData, error) {
+func (r *Repository) Pressuredata(ctx context.Context, filter models.PressureDataByWheelIDFilter) ([]models.PressureData, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 	WITH Device AS (
 		SELECT c.device_number, w.sensor_number
@@ -672,7 +854,7 @@ func (r *Repository) Pressuredata(filter models.PressureDataByWheelIDFilter) ([]
 	ORDER BY s.created_at;
 	`
 
-	rows, err := r.conn.Query(query, filter.IDWheel, filter.From, filter.To)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, filter.IDWheel, filter.From, filter.To)
 	if err != nil {
 		return []models.PressureData{}, err
 	}
@@ -694,78 +876,240 @@ func (r *Repository) Pressuredata(filter models.PressureDataByWheelIDFilter) ([]
 }
 
 // Driver
-func (r *Repository) CreateDriver(driver models.Driver) (models.Driver, error) {
+// CreateDriver creates a driver and, if IDCar is set, assigns them to the car
+// from now on.
+func (r *Repository) CreateDriver(ctx context.Context, driver models.Driver) (models.Driver, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	resp, err := insertDriver(ctx, tx, driver)
+	if err != nil {
+		return models.Driver{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return resp, nil
+}
+
+// driverColumns are the columns of drivers scanned by driverDest.
+const driverColumns = `id, id_company, name, surname, middle_name, phone, birthday, rating, worked_time,
+	licence_number, licence_categories, licence_expires_at, medical_expires_at, deactivated_at, created_at`
+
+func driverDest(d *models.Driver) []any {
+	return []any{
+		&d.ID, &d.IDCompany, &d.Name, &d.Surname, &d.Middle, &d.Phone, &d.Birthday, &d.Rating, &d.WorkedTime,
+		&d.LicenceNumber, pq.Array(&d.LicenceCategories), &d.LicenceExpiresAt, &d.MedicalExpiresAt, &d.DeactivatedAt, &d.CreatedAt,
+	}
+}
+
+// insertDriver creates a driver and their assignment to IDCar on q, which
+// must be a transaction.
+func insertDriver(ctx context.Context, q queryRower, driver models.Driver) (models.Driver, error) {
 	query := `
-	INSERT INTO drivers (id_company, id_car, name, surname, middle_name, phone, birthday, rating, worked_time)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-	RETURNING id, id_company, id_car, name, surname, middle_name, phone, birthday, rating, worked_time, created_at
-	`
+	INSERT INTO drivers (id_company, name, surname, middle_name, phone, birthday, rating, worked_time,
+		licence_number, licence_categories, licence_expires_at, medical_expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	RETURNING ` + driverColumns
 
 	resp := models.Driver{}
-	err := r.conn.QueryRow(query, driver.IDCompany, driver.IDCar, driver.Name, driver.Surname, driver.Middle, driver.Phone, driver.Birthday, driver.Rating, driver.WorkedTime).Scan(
-		&resp.ID,
-		&resp.IDCompany,
-		&resp.IDCar,
-		&resp.Name,
-		&resp.Surname,
-		&resp.Middle,
-		&resp.Phone,
-		&resp.Birthday,
-		&resp.Rating,
-		&resp.WorkedTime,
-		&resp.CreatedAt,
-	)
+	err := q.QueryRowContext(ctx, query, driver.IDCompany, driver.Name, driver.Surname, driver.Middle, driver.Phone, driver.Birthday, driver.Rating, driver.WorkedTime,
+		driver.LicenceNumber, pq.Array(driver.LicenceCategories), driver.LicenceExpiresAt, driver.MedicalExpiresAt).
+		Scan(driverDest(&resp)...)
 	if err != nil {
-		return models.Driver{}, fmt.Errorf("failed to create driver or find car: %w", err)
+		return models.Driver{}, fmt.Errorf("failed to create driver: %w", err)
 	}
 
+	if driver.IDCar == "" {
+		return resp, nil
+	}
+	_, err = assignDriver(ctx, q, models.DriverAssignment{
+		IDCompany: resp.IDCompany,
+		IDDriver:  resp.ID,
+		IDCar:     driver.IDCar,
+		StartedAt: resp.CreatedAt,
+	})
+	if err != nil {
+		return models.Driver{}, fmt.Errorf("failed to assign driver to car: %w", err)
+	}
+	resp.IDCar = driver.IDCar
+
 	return resp, nil
 }
 
-func (r *Repository) GetDriversList(userID string, limit int, offset int) ([]models.DriverStatisticsResponse, error) {
+// GetDriver returns a driver of the company with the car they are assigned
+// to now, if any.
+func (r *Repository) GetDriver(ctx context.Context, companyID string, driverID string) (models.Driver, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
-	SELECT 
-	CONCAT(d.name, ' ', d.surname, ' ', COALESCE(d.middle_name, '')) AS full_name,
-	d.worked_time,
-	EXTRACT(YEAR FROM AGE(d.created_at)) * 12 + EXTRACT(MONTH FROM AGE(d.created_at)) AS experience_months,
-		d.rating,
-		COALESCE(COUNT(b.id), 0) AS breakages_count,
-		d.id AS driver_id
-	FROM drivers d
-	LEFT JOIN breakages b ON d.id_car = b.id_car
-	WHERE d.id_company = $1
-	GROUP BY d.id
-	ORDER BY d.created_at DESC
-	LIMIT $2 OFFSET $3
-	`
+		SELECT ` + driverColumns + `, COALESCE((
+			SELECT a.id_car::text FROM driver_assignments a WHERE a.id_driver = drivers.id AND a.ended_at IS NULL
+		), '')
+		FROM drivers
+		WHERE id = $1 AND id_company = $2`
 
-	rows, err := r.conn.Query(query, userID, limit, offset)
+	var driver models.Driver
+	err := r.conn(ctx).QueryRowContext(ctx, query, driverID, companyID).Scan(append(driverDest(&driver), &driver.IDCar)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Driver{}, models.ErrDriverNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get drivers list: %w", err)
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
-	defer rows.Close()
 
-	var drivers []models.DriverStatisticsResponse
+	return driver, nil
+}
 
-	for rows.Next() {
-		var driver models.DriverStatisticsResponse
-		err := rows.Scan(
+// UpdateDriver replaces the personal and licence details of a driver of the
+// company.
+func (r *Repository) UpdateDriver(ctx context.Context, driver models.Driver) (models.Driver, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE drivers
+		SET name = $3, surname = $4, middle_name = $5, phone = $6, birthday = $7,
+			licence_number = $8, licence_categories = $9, licence_expires_at = $10, medical_expires_at = $11
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + driverColumns
+
+	var res models.Driver
+	err := r.conn(ctx).QueryRowContext(ctx, query, driver.ID, driver.IDCompany,
+		driver.Name, driver.Surname, driver.Middle, driver.Phone, driver.Birthday,
+		driver.LicenceNumber, pq.Array(driver.LicenceCategories), driver.LicenceExpiresAt, driver.MedicalExpiresAt).
+		Scan(driverDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Driver{}, models.ErrDriverNotFound
+	}
+	if err != nil {
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Driver %s updated", res.ID)
+	return res, nil
+}
+
+// DeactivateDriver deactivates a driver of the company at the time at. The
+// open assignment and work session of the driver end then, the session
+// adding to the driver's worked time.
+func (r *Repository) DeactivateDriver(ctx context.Context, companyID string, driverID string, at time.Time) (models.Driver, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	var deactivatedAt *time.Time
+	err = tx.QueryRowContext(ctx, "SELECT deactivated_at FROM drivers WHERE id = $1 AND id_company = $2 FOR UPDATE", driverID, companyID).
+		Scan(&deactivatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Driver{}, models.ErrDriverNotFound
+	}
+	if err != nil {
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if deactivatedAt != nil {
+		return models.Driver{}, models.ErrDriverDeactivated
+	}
+
+	query := `
+		WITH assignment AS (
+			UPDATE driver_assignments
+			SET ended_at = GREATEST(started_at, $2)
+			WHERE id_driver = $1 AND ended_at IS NULL
+		),
+		session AS (
+			UPDATE work_sessions
+			SET ended_at = GREATEST(started_at, $2)
+			WHERE id_driver = $1 AND ended_at IS NULL
+			RETURNING started_at, ended_at
+		)
+		UPDATE drivers d
+		SET deactivated_at = $2,
+			worked_time = COALESCE(d.worked_time, 0) + COALESCE((
+				SELECT (EXTRACT(EPOCH FROM s.ended_at - s.started_at) / 60)::int FROM session s
+			), 0)
+		WHERE d.id = $1
+		RETURNING ` + driverColumns
+
+	var res models.Driver
+	if err := tx.QueryRowContext(ctx, query, driverID, at).Scan(driverDest(&res)...); err != nil {
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.Driver{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Driver %s deactivated at %s", res.ID, at)
+	return res, nil
+}
+
+// GetDriversList returns a page of the company's drivers with their statistics.
+// Deactivated drivers are left out unless filter.IncludeInactive is set.
+func (r *Repository) GetDriversList(ctx context.Context, filter models.DriverFilter, page models.PageRequest) (models.Page[models.DriverStatisticsResponse], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	q := listQuery{
+		query: `
+			SELECT
+				CONCAT(d.name, ' ', d.surname, ' ', COALESCE(d.middle_name, '')) AS full_name,
+				COALESCE(d.worked_time, 0) AS worked_time,
+				EXTRACT(YEAR FROM AGE(d.created_at)) * 12 + EXTRACT(MONTH FROM AGE(d.created_at)) AS experience_months,
+				COALESCE(d.rating, 0) AS rating,
+				COUNT(DISTINCT b.id) AS breakages_count,
+				d.id AS driver_id,
+				d.created_at,
+				RANK() OVER (ORDER BY COALESCE(d.rating, 0) DESC) AS rank,
+				d.deactivated_at IS NULL AS active
+			FROM drivers d
+			LEFT JOIN driver_assignments a ON a.id_driver = d.id
+			LEFT JOIN breakages b ON b.id_car = a.id_car
+				AND b.created_at >= a.started_at AND (a.ended_at IS NULL OR b.created_at < a.ended_at)
+			WHERE d.id_company = $1 AND ($2 OR d.deactivated_at IS NULL)
+			GROUP BY d.id`,
+		args:     []any{filter.IDCompany, filter.IncludeInactive},
+		idColumn: "driver_id",
+		sortFields: map[string]sortField{
+			"created_at":      {"created_at", "timestamp"},
+			"full_name":       {"full_name", "text"},
+			"worked_time":     {"worked_time", "int"},
+			"rating":          {"rating", "float8"},
+			"breakages_count": {"breakages_count", "bigint"},
+			"rank":            {"rank", "bigint"},
+		},
+		defaultSort: "-created_at",
+	}
+
+	var createdAt time.Time
+	drivers, err := queryPage(ctx, r, q, page, func(driver *models.DriverStatisticsResponse) []any {
+		return []any{
 			&driver.FullName,
 			&driver.WorkedTime,
 			&driver.Experience,
 			&driver.Rating,
 			&driver.BreakagesCount,
 			&driver.DriverID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan driver data: %w", err)
+			&createdAt,
+			&driver.Rank,
+			&driver.Active,
 		}
-
-		drivers = append(drivers, driver)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration error: %w", err)
+	})
+	if err != nil {
+		return drivers, fmt.Errorf("failed to get drivers list: %w", err)
 	}
 
 	return drivers, nil
@@ -807,20 +1151,50 @@ func (r *Repository) GetDriversList(userID string, limit int, offset int) ([]mod
 // 	return driver, nil
 // }
 
-func (r *Repository) GetDriverInfo(driverID string) (models.DriverInfoResponse, error) {
+func (r *Repository) GetDriverInfo(ctx context.Context, driverID string) (models.DriverInfoResponse, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
-		SELECT name, surname, middle_name, phone, birthday
-		FROM drivers
-		WHERE id = $1
+		SELECT
+			d.name, d.surname, d.middle_name, d.phone, d.birthday,
+			d.licence_number, d.licence_categories, d.licence_expires_at, d.medical_expires_at, d.deactivated_at,
+			r.rating, r.safety, r.smoothness, r.speed_discipline, r.tire_care, r.experience,
+			r.breakages, r.harsh_events, r.driven_seconds, r.speeding_seconds, r.distance_km, r.under_inflated_seconds,
+			r.worked_time, r.window_start, r.calculated_at
+		FROM drivers d
+		LEFT JOIN driver_ratings r ON r.id_driver = d.id
+		WHERE d.id = $1
 	`
 
 	var driverInfo models.DriverInfoResponse
-	err := r.conn.QueryRow(query, driverID).Scan(
+	var rating nullDriverRating
+	err := r.conn(ctx).QueryRowContext(ctx, query, driverID).Scan(
 		&driverInfo.Name,
 		&driverInfo.Surname,
 		&driverInfo.MiddleName,
 		&driverInfo.Phone,
 		&driverInfo.Birthday,
+		&driverInfo.LicenceNumber,
+		pq.Array(&driverInfo.LicenceCategories),
+		&driverInfo.LicenceExpiresAt,
+		&driverInfo.MedicalExpiresAt,
+		&driverInfo.DeactivatedAt,
+		&rating.Rating,
+		&rating.Safety,
+		&rating.Smoothness,
+		&rating.SpeedDiscipline,
+		&rating.TireCare,
+		&rating.Experience,
+		&rating.Breakages,
+		&rating.HarshEvents,
+		&rating.DrivenSeconds,
+		&rating.SpeedingSeconds,
+		&rating.DistanceKm,
+		&rating.UnderInflatedSeconds,
+		&rating.WorkedTime,
+		&rating.WindowStart,
+		&rating.CalculatedAt,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -828,27 +1202,28 @@ func (r *Repository) GetDriverInfo(driverID string) (models.DriverInfoResponse,
 		}
 		return models.DriverInfoResponse{}, fmt.Errorf("failed to fetch driver info: %w", err)
 	}
+	driverInfo.Rating = rating.driverRating(driverID)
 
 	return driverInfo, nil
 }
 
-func (r *Repository) GetDriverByCaDviceNum(ctx context.Context, deviceNum string) (models.Driver, error) {
+// GetDriverByCaDviceNum returns the driver assigned at the time at to the car
+// with the device.
+func (r *Repository) GetDriverByCaDviceNum(ctx context.Context, deviceNum string, at time.Time) (models.Driver, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
-		WITH car_info AS (
-			SELECT id
-			FROM cars 
-			WHERE device_number = $1
-			LIMIT 1
-		)
-		SELECT 
-			id, id_company, id_car, name, surname, middle_name, phone, birthday, rating, worked_time, created_at
-			FROM drivers
-		WHERE id_car = (SELECT id FROM car_info)
-		;
+		SELECT
+			d.id, d.id_company, a.id_car, d.name, d.surname, d.middle_name, d.phone, d.birthday, d.rating, d.worked_time, d.created_at
+		FROM driver_assignments a
+		JOIN cars c ON c.id = a.id_car
+		JOIN drivers d ON d.id = a.id_driver
+		WHERE c.device_number = $1 AND a.started_at <= $2 AND (a.ended_at IS NULL OR a.ended_at > $2)
 	`
 
 	var driverInfo models.Driver
-	err := r.conn.QueryRow(query, deviceNum).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, query, deviceNum, at).Scan(
 		&driverInfo.ID,
 		&driverInfo.IDCompany,
 		&driverInfo.IDCar,
@@ -871,42 +1246,21 @@ func (r *Repository) GetDriverByCaDviceNum(ctx context.Context, deviceNum string
 	return driverInfo, nil
 }
 
-func (r *Repository) UpdateDriverWorktime(deviceNum string, workedTime int) error {
-	query := `
-		UPDATE drivers
-		SET worked_time = worked_time + $1
-		WHERE id_car = (SELECT id FROM cars WHERE device_number = $2)
-		`
-
-	res, err := r.conn.Exec(query, workedTime, deviceNum)
-	if err != nil {
-		return fmt.Errorf("failed to update driver worktime: %w", err)
-	}
-
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return models.ErrDriverNotFound
-	}
-
-	return nil
-}
-
 // Position
 // CreatePosition creates a new position entry in the database and returns the created position.
 func (r *Repository) CreatePosition(ctx context.Context, position models.Position) (models.Position, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
 		INSERT INTO position_data (device_number, latitude, longitude, created_at) 
 		VALUES ($1, $2, $3, $4) 
 		RETURNING id, device_number, latitude, longitude, created_at
 	`
-	r.log.Debugf("Executing query: %s with values: %s, %f, %f, %v", query, position.DeviceNumber, position.Location.Latitude, position.Location.Longitude, position.CreatedAt)
+	logging.FromContext(ctx, r.log).Debugf("Executing query: %s with values: %s, %f, %f, %v", query, position.DeviceNumber, position.Location.Latitude, position.Location.Longitude, position.CreatedAt)
 
 	var newPosition models.Position
-	err := r.conn.QueryRowContext(ctx, query, position.DeviceNumber, position.Location.Latitude, position.Location.Longitude, position.CreatedAt).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, query, position.DeviceNumber, position.Location.Latitude, position.Location.Longitude, position.CreatedAt).Scan(
 		&newPosition.ID,
 		&newPosition.DeviceNumber,
 		&newPosition.Location.Latitude,
@@ -915,15 +1269,18 @@ func (r *Repository) CreatePosition(ctx context.Context, position models.Positio
 	)
 
 	if err != nil {
-		r.log.Errorf("Failed to create position: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to create position: %v", err)
 		return models.Position{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 
-	r.log.Debugf("Position created successfully: %+v", newPosition)
+	logging.FromContext(ctx, r.log).Debugf("Position created successfully: %+v", logging.Redact(newPosition))
 	return newPosition, nil
 }
 
 func (r *Repository) CreateOrUpdateCarsPosition(ctx context.Context, position models.CurrentPosition) (models.CurrentPosition, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
 		INSERT INTO cars_positions (id_company, id_car, latitude, longitude, updated_at) 
 		VALUES ($1, $2, $3, $4, $5) 
@@ -935,11 +1292,11 @@ func (r *Repository) CreateOrUpdateCarsPosition(ctx context.Context, position mo
 		RETURNING id, id_company, id_car, latitude, longitude, updated_at
 	`
 
-	r.log.Debugf("Executing query: %s with values: %s, %s, %f, %f, %v",
+	logging.FromContext(ctx, r.log).Debugf("Executing query: %s with values: %s, %s, %f, %f, %v",
 		query, position.IDCompany, position.IDCar, position.Location.Latitude, position.Location.Longitude, position.UpdateAt)
 
 	var newPosition models.CurrentPosition
-	err := r.conn.QueryRowContext(ctx, query,
+	err := r.conn(ctx).QueryRowContext(ctx, query,
 		position.IDCompany, position.IDCar, position.Location.Latitude, position.Location.Longitude, position.UpdateAt).Scan(
 		&newPosition.ID,
 		&newPosition.IDCompany,
@@ -950,37 +1307,76 @@ func (r *Repository) CreateOrUpdateCarsPosition(ctx context.Context, position mo
 	)
 
 	if err != nil {
-		r.log.Errorf("Failed to create or update car position: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to create or update car position: %v", err)
 		return models.CurrentPosition{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 
-	r.log.Debugf("Car position created or updated successfully: %+v", newPosition)
+	logging.FromContext(ctx, r.log).Debugf("Car position created or updated successfully: %+v", logging.Redact(newPosition))
 	return newPosition, nil
 }
 
-// GetCarRoutePositions retrieves the positions of a car within a specific time range.
+// GetCarPositionForUpdate returns the current position of the car and locks
+// it until the transaction ends, so that positions of the car are stored one
+// after another. It returns models.ErrNoContent if the car has no position yet.
+func (r *Repository) GetCarPositionForUpdate(ctx context.Context, carID string) (models.CurrentPosition, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT id, id_company, id_car, latitude, longitude, updated_at
+		FROM cars_positions
+		WHERE id_car = $1
+		FOR UPDATE`
+
+	var position models.CurrentPosition
+	err := r.conn(ctx).QueryRowContext(ctx, query, carID).Scan(
+		&position.ID,
+		&position.IDCompany,
+		&position.IDCar,
+		&position.Location.Latitude,
+		&position.Location.Longitude,
+		&position.UpdateAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.CurrentPosition{}, models.ErrNoContent
+	}
+	if err != nil {
+		return models.CurrentPosition{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return position, nil
+}
+
+// GetCarRoutePositions retrieves the positions of a car within a specific time range,
+// each with the driver assigned to the car when it was recorded.
 func (r *Repository) GetCarRoutePositions(ctx context.Context, carID string, from time.Time, to time.Time) ([]models.Position, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
 	var positions []models.Position
 
-	r.log.Debugf("Querying route positions for carID: %s from %v to %v", carID, from, to)
+	logging.FromContext(ctx, r.log).Debugf("Querying route positions for carID: %s from %v to %v", carID, from, to)
 
 	query := `
 	WITH car_info AS (
-		SELECT device_number 
+		SELECT id, device_number 
 		FROM cars 
 		WHERE id = $1
 		LIMIT 1
 	)
-	SELECT id, device_number, latitude, longitude, created_at
-	FROM position_data
-		WHERE device_number = (select device_number from car_info)
-		AND created_at BETWEEN $2 AND $3
-		ORDER BY created_at ASC;
+	SELECT p.id, p.device_number, p.latitude, p.longitude, p.created_at, a.id_driver
+	FROM position_data p
+	LEFT JOIN driver_assignments a ON a.id_car = (select id from car_info)
+		AND a.started_at <= p.created_at
+		AND (a.ended_at IS NULL OR a.ended_at > p.created_at)
+		WHERE p.device_number = (select device_number from car_info)
+		AND p.created_at BETWEEN $2 AND $3
+		ORDER BY p.created_at ASC;
 	`
 
-	rows, err := r.conn.Query(query, carID, from, to)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, carID, from, to)
 	if err != nil {
-		r.log.Errorf("Failed to execute query: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query: %v", err)
 		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 	defer rows.Close()
@@ -988,8 +1384,8 @@ func (r *Repository) GetCarRoutePositions(ctx context.Context, carID string, fro
 	for rows.Next() {
 		var position models.Position
 
-		if err := rows.Scan(&position.ID, &position.DeviceNumber, &position.Location.Latitude, &position.Location.Longitude, &position.CreatedAt); err != nil {
-			r.log.Errorf("Failed to scan row: %v", err)
+		if err := rows.Scan(&position.ID, &position.DeviceNumber, &position.Location.Latitude, &position.Location.Longitude, &position.CreatedAt, &position.IDDriver); err != nil {
+			logging.FromContext(ctx, r.log).Errorf("Failed to scan row: %v", err)
 			return nil, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
 		}
 
@@ -997,16 +1393,19 @@ func (r *Repository) GetCarRoutePositions(ctx context.Context, carID string, fro
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Errorf("Error while iterating rows: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Error while iterating rows: %v", err)
 		return nil, fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
 	}
 
-	r.log.Debugf("Found %d positions for carID %s", len(positions), carID)
+	logging.FromContext(ctx, r.log).Debugf("Found %d positions for carID %s", len(positions), carID)
 
 	return positions, nil
 }
 
 func (r *Repository) GetCurrentCarPositions(ctx context.Context, id string) ([]models.CurrentPositionResponse, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 	SELECT 
 		c.id,
@@ -1018,11 +1417,11 @@ func (r *Repository) GetCurrentCarPositions(ctx context.Context, id string) ([]m
 	WHERE c.id_company = $1;
 	`
 
-	r.log.Debugf("Executing query to fetch current car positions for company_id=%s", id)
+	logging.FromContext(ctx, r.log).Debugf("Executing query to fetch current car positions for company_id=%s", id)
 
-	rows, err := r.conn.Query(query, id)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, id)
 	if err != nil {
-		r.log.Errorf("%v: %v", models.ErrFailedToExecuteQuery, err)
+		logging.FromContext(ctx, r.log).Errorf("%v: %v", models.ErrFailedToExecuteQuery, err)
 		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 	defer rows.Close()
@@ -1037,32 +1436,35 @@ func (r *Repository) GetCurrentCarPositions(ctx context.Context, id string) ([]m
 			&position.Point.Longitude,
 			&position.IDUnicum,
 		); err != nil {
-			r.log.Errorf("%v: %v", models.ErrFailedToProcessRow, err)
+			logging.FromContext(ctx, r.log).Errorf("%v: %v", models.ErrFailedToProcessRow, err)
 			return nil, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
 		}
 		positions = append(positions, position)
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Errorf("%v: %v", models.ErrRowsIterationError, err)
+		logging.FromContext(ctx, r.log).Errorf("%v: %v", models.ErrRowsIterationError, err)
 		return nil, fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
 	}
 
 	if len(positions) == 0 {
-		r.log.Debugf("%v: No car positions found for company_id=%s", models.ErrNoContent, id)
+		logging.FromContext(ctx, r.log).Debugf("%v: No car positions found for company_id=%s", models.ErrNoContent, id)
 		return nil, models.ErrNoContent
 	}
 
-	r.log.Debugf("Successfully fetched %d current car positions for company_id=%s", len(positions), id)
+	logging.FromContext(ctx, r.log).Debugf("Successfully fetched %d current car positions for company_id=%s", len(positions), id)
 
 	return positions, nil
 }
 
 // GetCurrentCarPositionsByPoints retrieves cars in the area between two points.
 func (r *Repository) GetCurrentCarPositionsByPoints(ctx context.Context, pointA models.Point, pointB models.Point) ([]models.CurrentPositionResponse, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	var positions []models.CurrentPositionResponse
 
-	r.log.Debugf("Querying car positions in area: [%f, %f] (lat) x [%f, %f] (lng)", pointA.Latitude, pointB.Latitude, pointA.Longitude, pointB.Longitude)
+	logging.FromContext(ctx, r.log).Debugf("Querying car positions in area: [%f, %f] (lat) x [%f, %f] (lng)", pointA.Latitude, pointB.Latitude, pointA.Longitude, pointB.Longitude)
 
 	if pointA.Latitude > pointB.Latitude {
 		pointA.Latitude, pointB.Latitude = pointB.Latitude, pointA.Latitude
@@ -1078,9 +1480,9 @@ func (r *Repository) GetCurrentCarPositionsByPoints(ctx context.Context, pointA
 		AND longitude BETWEEN $3 AND $4
 	`
 
-	rows, err := r.conn.Query(query, pointA.Latitude, pointB.Latitude, pointA.Longitude, pointB.Longitude)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pointA.Latitude, pointB.Latitude, pointA.Longitude, pointB.Longitude)
 	if err != nil {
-		r.log.Errorf("Failed to execute query: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query: %v", err)
 		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 	defer rows.Close()
@@ -1092,35 +1494,90 @@ func (r *Repository) GetCurrentCarPositionsByPoints(ctx context.Context, pointA
 			&position.Point.Longitude,
 			&position.IDCar,
 		); err != nil {
-			r.log.Errorf("Failed to scan row: %v", err)
+			logging.FromContext(ctx, r.log).Errorf("Failed to scan row: %v", err)
 			return nil, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
 		}
 		positions = append(positions, position)
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Errorf("Error while iterating rows: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Error while iterating rows: %v", err)
 		return nil, fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
 	}
 
-	r.log.Debugf("Found %d positions in the specified area.", len(positions))
+	logging.FromContext(ctx, r.log).Debugf("Found %d positions in the specified area.", len(positions))
 
 	return positions, nil
 }
 
+// CountSilentDevices returns, per company, the number of devices whose last
+// sensor reading or position is older than since. Devices that never reported are not counted.
+func (r *Repository) CountSilentDevices(ctx context.Context, since time.Time) (map[string]int, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	query := `
+		SELECT c.id_company, COUNT(*)
+		FROM cars c
+		JOIN LATERAL (
+			SELECT GREATEST(
+				(SELECT MAX(s.created_at) FROM sensors_data s WHERE s.device_number = c.device_number),
+				(SELECT MAX(p.created_at) FROM position_data p WHERE p.device_number = c.device_number)
+			) AS last_seen
+		) l ON true
+		WHERE l.last_seen < $1
+		GROUP BY c.id_company
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, since)
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to count silent devices: %v", err)
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var companyID string
+		var count int
+		if err := rows.Scan(&companyID, &count); err != nil {
+			logging.FromContext(ctx, r.log).Errorf("Failed to scan row: %v", err)
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
+		}
+		counts[companyID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Error while iterating rows: %v", err)
+		return nil, fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
+	}
+
+	return counts, nil
+}
+
 // Breakage
 // CreateBreakage inserts a new breakage record into the database and returns the created breakage ID.
 func (r *Repository) CreateBreakage(ctx context.Context, breakage models.Breakage) (models.Breakage, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
-		INSERT INTO breakages (id_car, id_driver, latitude, longitude, type, description, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, id_car, id_driver, latitude, longitude, type, description, created_at`
+		INSERT INTO breakages (id_car, id_driver, latitude, longitude, type, description, created_at, id_type)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, (
+			SELECT t.id
+			FROM breakage_types t
+			JOIN cars c ON c.id_company = t.id_company
+			WHERE c.id = $1 AND (t.code = $5 OR $5 = ANY(t.device_codes))
+			ORDER BY t.code = $5 DESC, t.created_at, t.id
+			LIMIT 1
+		))
+		RETURNING id, id_car, id_driver, latitude, longitude, type, id_type, description, created_at`
 
-	r.log.Debugf("Executing query to create breakage with values: car_id=%s, driver=%s, latitude=%f, longitude=%f, type=%s, description=%s, created_at=%v",
+	logging.FromContext(ctx, r.log).Debugf("Executing query to create breakage with values: car_id=%s, driver=%s, latitude=%f, longitude=%f, type=%s, description=%s, created_at=%v",
 		breakage.CarID, breakage.DriverID, breakage.Location.Latitude, breakage.Location.Longitude, breakage.Type, breakage.Description, breakage.CreatedAt)
 
 	var newBreakage models.Breakage
-	err := r.conn.QueryRowContext(ctx, query,
+	err := r.conn(ctx).QueryRowContext(ctx, query,
 		breakage.CarID,
 		breakage.DriverID,
 		breakage.Location.Latitude,
@@ -1135,16 +1592,17 @@ func (r *Repository) CreateBreakage(ctx context.Context, breakage models.Breakag
 			&newBreakage.Location.Latitude,
 			&newBreakage.Location.Longitude,
 			&newBreakage.Type,
+			&newBreakage.IDType,
 			&newBreakage.Description,
 			&newBreakage.CreatedAt,
 		)
 
 	if err != nil {
-		r.log.Errorf("Failed to create breakage: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to create breakage: %v", err)
 		return models.Breakage{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 
-	r.log.Debugf("Breakage created successfully with ID: %s", breakage.ID)
+	logging.FromContext(ctx, r.log).Debugf("Breakage created successfully with ID: %s", breakage.ID)
 	return newBreakage, nil
 }
 
@@ -1152,7 +1610,7 @@ func (r *Repository) CreateBreakage(ctx context.Context, breakage models.Breakag
 // func (r *Repository) CreateBreakageFromMqtt(ctx context.Context, breakage models.BreakageFromMqtt) (models.Breakage, error) {
 // 	// datetime, err := time.Parse(time.RFC3339, breakage.CreatedAt)
 // 	// if err != nil {
-// 	// 	r.log.Errorf("Failed to parse created_at: %v", err)
+// 	// 	logging.FromContext(ctx, r.log).Errorf("Failed to parse created_at: %v", err)
 // 	// 	return models.Breakage{}, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
 // 	// }
 
@@ -1184,12 +1642,12 @@ func (r *Repository) CreateBreakage(ctx context.Context, breakage models.Breakag
 // 	RETURNING id, id_car, latitude, longitude, type, description, created_at;
 // 	`
 
-// 	r.log.Debugf("Executing query to create breakage with values: device_number=%s, latitude=%f, longitude=%f, type=%s, description=%s, created_at=%v",
+// 	logging.FromContext(ctx, r.log).Debugf("Executing query to create breakage with values: device_number=%s, latitude=%f, longitude=%f, type=%s, description=%s, created_at=%v",
 // 		breakage.DeviceNum, breakage.Point[0], breakage.Point[1], breakage.Type, breakage.Description, breakage.CreatedAt)
 
 // 	var createdBreakage models.Breakage
 
-// 	err := r.conn.QueryRowContext(ctx, query,
+// 	err := r.conn(ctx).QueryRowContext(ctx, query,
 // 		breakage.DeviceNum,
 // 		breakage.Point[0],
 // 		breakage.Point[1],
@@ -1208,17 +1666,20 @@ func (r *Repository) CreateBreakage(ctx context.Context, breakage models.Breakag
 // 	)
 
 // 	if err != nil {
-// 		r.log.Errorf("Failed to create breakage: %v", err)
+// 		logging.FromContext(ctx, r.log).Errorf("Failed to create breakage: %v", err)
 // 		return models.Breakage{}, fmt.Errorf("%w: %w", models.ErrFailedToExecuteQuery, err)
 // 	}
 
-// 	r.log.Debugf("Breakage created successfully: %+v", createdBreakage)
+// 	logging.FromContext(ctx, r.log).Debugf("Breakage created successfully: %+v", createdBreakage)
 // 	return createdBreakage, nil
 // }
 
 func (r *Repository) CreateBreakageFromMqtt(ctx context.Context, breakage models.BreakageFromMqtt) (models.Breakage, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	if breakage.DeviceNum == "" {
-		r.log.Errorf("Device number is empty, cannot proceed with the operation")
+		logging.FromContext(ctx, r.log).Errorf("Device number is empty, cannot proceed with the operation")
 		return models.Breakage{}, fmt.Errorf("device number cannot be empty")
 	}
 
@@ -1246,32 +1707,41 @@ func (r *Repository) CreateBreakageFromMqtt(ctx context.Context, breakage models
 
 	query := `
 	WITH car_info AS (
-			SELECT id 
+			SELECT id, id_company
 			FROM cars 
 			WHERE device_number = $1
 			LIMIT 1
 		),
 		driver_info AS (
-			SELECT id 
-			FROM drivers
+			SELECT id_driver AS id
+			FROM driver_assignments
 			WHERE id_car = (SELECT id FROM car_info)
+				AND started_at <= $6 AND (ended_at IS NULL OR ended_at > $6)
+		),
+		type_info AS (
+			SELECT id
+			FROM breakage_types
+			WHERE id_company = (SELECT id_company FROM car_info)
+				AND (code = $4 OR $4 = ANY(device_codes))
+			ORDER BY code = $4 DESC, created_at, id
 			LIMIT 1
 		)
-	INSERT INTO breakages (id_car, id_driver, latitude, longitude, type, description, created_at)
+	INSERT INTO breakages (id_car, id_driver, latitude, longitude, type, description, created_at, id_type)
 	VALUES (
 		(SELECT car_info.id FROM car_info),
-		(SELECT driver_info.id FROM driver_info LIMIT 1), -- Если водителя нет, вставится NULL
-		$2, $3, $4, $5, $6
+		(SELECT driver_info.id FROM driver_info), -- Если водителя нет, вставится NULL
+		$2, $3, $4, $5, $6,
+		(SELECT type_info.id FROM type_info)
 	)
-	RETURNING id, id_car, id_driver, latitude, longitude, type, description, created_at;
+	RETURNING id, id_car, id_driver, latitude, longitude, type, id_type, description, created_at;
 	`
 
-	r.log.Debugf("Executing query to create breakage: device_number=%s, latitude=%f, longitude=%f, type=%s, description=%s, created_at=%v",
+	logging.FromContext(ctx, r.log).Debugf("Executing query to create breakage: device_number=%s, latitude=%f, longitude=%f, type=%s, description=%s, created_at=%v",
 		breakage.DeviceNum, breakage.Point[0], breakage.Point[1], breakage.Type, breakage.Description, breakage.CreatedAt)
 
 	var createdBreakage models.Breakage
 
-	err := r.conn.QueryRowContext(ctx, query,
+	err := r.conn(ctx).QueryRowContext(ctx, query,
 		breakage.DeviceNum,
 		breakage.Point[0],
 		breakage.Point[1],
@@ -1285,25 +1755,31 @@ func (r *Repository) CreateBreakageFromMqtt(ctx context.Context, breakage models
 		&createdBreakage.Location.Latitude,
 		&createdBreakage.Location.Longitude,
 		&createdBreakage.Type,
+		&createdBreakage.IDType,
 		&createdBreakage.Description,
 		&createdBreakage.CreatedAt,
 	)
 
 	if err == sql.ErrNoRows {
-		r.log.Debugf("%v: no breakage found for device_number=%s", models.ErrNoContent, breakage.DeviceNum)
+		logging.FromContext(ctx, r.log).Debugf("%v: no breakage found for device_number=%s", models.ErrNoContent, breakage.DeviceNum)
 		return models.Breakage{}, models.ErrNoContent
 	}
 
 	if err != nil {
-		r.log.Errorf("%v: %v", models.ErrFailedToExecuteQuery, err)
+		logging.FromContext(ctx, r.log).Errorf("%v: %v", models.ErrFailedToExecuteQuery, err)
 		return models.Breakage{}, fmt.Errorf("%w: %w", models.ErrFailedToExecuteQuery, err)
 	}
 
-	r.log.Debugf("Breakage created successfully: %+v", createdBreakage)
+	logging.FromContext(ctx, r.log).Debugf("Breakage created successfully: %+v", logging.Redact(createdBreakage))
 	return createdBreakage, nil
 }
 
-func (r *Repository) CheckDriverExists(ctx context.Context, deviceNumber string) (bool, error) {
+// CheckDriverExists reports whether a driver is assigned at the time at to the
+// car with the device.
+func (r *Repository) CheckDriverExists(ctx context.Context, deviceNumber string, at time.Time) (bool, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
 		WITH car_info AS (
 			SELECT id
@@ -1313,31 +1789,35 @@ func (r *Repository) CheckDriverExists(ctx context.Context, deviceNumber string)
 		)
 		SELECT EXISTS (
 			SELECT 1
-			FROM drivers
+			FROM driver_assignments
 			WHERE id_car = (SELECT id FROM car_info)
+				AND started_at <= $2 AND (ended_at IS NULL OR ended_at > $2)
 		);
 	`
 
-	r.log.Debugf("Executing query to check driver existence for device_number: %s", deviceNumber)
+	logging.FromContext(ctx, r.log).Debugf("Executing query to check driver existence for device_number: %s", deviceNumber)
 
 	var driverExists bool
-	err := r.conn.QueryRowContext(ctx, query, deviceNumber).Scan(&driverExists)
+	err := r.conn(ctx).QueryRowContext(ctx, query, deviceNumber, at).Scan(&driverExists)
 	if err != nil {
-		r.log.Errorf("Failed to execute query: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query: %v", err)
 		return false, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 
 	if driverExists {
-		r.log.Debugf("Driver exists for device_number: %s", deviceNumber)
+		logging.FromContext(ctx, r.log).Debugf("Driver exists for device_number: %s", deviceNumber)
 	} else {
-		r.log.Debugf("No driver found for device_number: %s", deviceNumber)
+		logging.FromContext(ctx, r.log).Debugf("No driver found for device_number: %s", deviceNumber)
 	}
 
 	return driverExists, nil
 }
 
 // Notification
-func (r *Repository) CreateNotification(new models.Notification) (models.Notification, error) {
+func (r *Repository) CreateNotification(ctx context.Context, new models.Notification) (models.Notification, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
 		WITH car_info AS (
 			SELECT id, id_company
@@ -1345,17 +1825,24 @@ func (r *Repository) CreateNotification(new models.Notification) (models.Notific
 			WHERE id = $1
 			LIMIT 1
 		)
-        INSERT INTO notifications (id_user, id_breakages, note, status, created_at)
-        VALUES ((SELECT id_company FROM car_info), $2, $3, $4, $5)
+        INSERT INTO notifications (id_user, id_breakages, id_car, type, severity, note, status, created_at)
+        VALUES ((SELECT id_company FROM car_info), $2, $1, $6, COALESCE((
+			SELECT t.severity
+			FROM breakages b
+			JOIN breakage_types t ON t.id = b.id_type
+			WHERE b.id = $2
+		), $7), $3, $4, $5)
         RETURNING id, id_user, id_breakages, note, status, created_at`
 
 	var createdNotification models.Notification
-	err := r.conn.QueryRow(query,
+	err := r.conn(ctx).QueryRowContext(ctx, query,
 		new.IDCar,
 		new.IDBreakage,
 		new.Note,
 		new.Status,
-		new.CreatedAt).
+		new.CreatedAt,
+		models.EventBreakage,
+		models.NotificationSeverity[models.EventBreakage]).
 		Scan(
 			&createdNotification.ID,
 			&createdNotification.IDCar,
@@ -1371,13 +1858,40 @@ func (r *Repository) CreateNotification(new models.Notification) (models.Notific
 	return createdNotification, nil
 }
 
+// GetNotificationForUpdate returns the status and the company of a
+// notification and locks it until the end of the transaction.
+func (r *Repository) GetNotificationForUpdate(ctx context.Context, id string) (models.Notification, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var status, companyID sql.NullString
+	query := `
+		SELECT status, id_user
+		FROM notifications
+		WHERE id = $1
+		FOR UPDATE`
+
+	err := r.conn(ctx).QueryRowContext(ctx, query, id).Scan(&status, &companyID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.Notification{}, models.ErrNoContent
+		}
+		return models.Notification{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return models.Notification{ID: id, IDCompany: companyID.String, Status: status.String}, nil
+}
+
 func (r *Repository) UpdateNotificationStatus(ctx context.Context, id string, status string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
 		UPDATE notifications
 		SET status = $1
 		WHERE id = $2`
 
-	result, err := r.conn.ExecContext(ctx, query, status, id)
+	result, err := r.conn(ctx).ExecContext(ctx, query, status, id)
 	if err != nil {
 		return fmt.Errorf("failed to execute update query: %w", err)
 	}
@@ -1395,12 +1909,15 @@ func (r *Repository) UpdateNotificationStatus(ctx context.Context, id string, st
 }
 
 func (r *Repository) UpdateAllNotificationsStatus(ctx context.Context, userID string, status string) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
 	query := `
 		UPDATE notifications
 		SET status = $1
 		WHERE id_user = $2`
 
-	result, err := r.conn.ExecContext(ctx, query, status, userID)
+	result, err := r.conn(ctx).ExecContext(ctx, query, status, userID)
 	if err != nil {
 		return fmt.Errorf("failed to execute update query: %w", err)
 	}
@@ -1417,98 +1934,140 @@ func (r *Repository) UpdateAllNotificationsStatus(ctx context.Context, userID st
 	return nil
 }
 
-// GetNotificationInfo retrieves detailed notification information from the database based on the notification ID.
-func (r *Repository) GetNotificationInfo(ctx context.Context, notificationID string) (models.NotificationInfo, error) {
-	query := `
-	SELECT 
-		n.note,
-    	CONCAT_WS(' ', d.surname, d.name, d.middle_name) AS driver_name,
-		b.latitude, 
-		b.longitude,
-		n.created_at
-	FROM notifications n
-	INNER JOIN breakages b ON n.id_breakages = b.id
-	INNER JOIN drivers d ON b.id_driver = d.id
-	WHERE n.id = $1;
-	`
+// GetNotificationInfo returns the notification of the company with the
+// details of its type.
+func (r *Repository) GetNotificationInfo(ctx context.Context, companyID string, notificationID string) (models.NotificationInfo, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
 
-	r.log.Debugf("Executing query to fetch notification info for notificationID: %s", notificationID)
+	query := `
+		SELECT n.id, n.type, n.severity, COALESCE(n.status, ''), n.id_car, COALESCE(n.note, ''), n.created_at,
+			n.id_breakages, n.id_work_violation, n.id_expiry_alert, n.id_maintenance_task, n.payload
+		FROM notifications n
+		WHERE n.id = $1 AND n.id_user = $2`
 
-	var notificationInfo models.NotificationInfo
-	err := r.conn.QueryRowContext(ctx, query, notificationID).Scan(
-		&notificationInfo.Description,
-		&notificationInfo.DriverName,
-		&notificationInfo.Location.Latitude,
-		&notificationInfo.Location.Longitude,
-		&notificationInfo.CreatedAt,
-	)
+	logging.FromContext(ctx, r.log).Debugf("Executing query to fetch notification info for notificationID: %s", notificationID)
 
-	if err == sql.ErrNoRows {
-		r.log.Errorf("No rows found for notificationID: %s", notificationID)
-		return models.NotificationInfo{}, models.ErrNoContent
+	var info models.NotificationInfo
+	var ref notificationRef
+	dest := append([]any{&info.ID, &info.Type, &info.Severity, &info.Status, &info.IDCar, &info.Description, &info.CreatedAt},
+		ref.dest()...)
+	err := r.conn(ctx).QueryRowContext(ctx, query, notificationID, companyID).Scan(dest...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.NotificationInfo{}, models.ErrNotificationNotFound
 	}
-
 	if err != nil {
-		r.log.Errorf("Failed to execute query for notificationID: %s, error: %v", notificationID, err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query for notificationID: %s, error: %v", notificationID, err)
 		return models.NotificationInfo{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 
-	r.log.Debugf("Successfully fetched notification info for notificationID: %s", notificationID)
-	return notificationInfo, nil
-}
-
-// GetNotificationList retrieves a list of notifications based on the provided status, limit, and offset.
-func (r *Repository) GetNotificationList(ctx context.Context, userID string, status *string, limit, offset int) ([]models.NotificationListItem, error) {
-	query := `
-		SELECT 
-			n.id,
-			c.state_number,
-			c.brand,
-			b.type AS breakage_type,
-			n.created_at
-		FROM notifications n
-		INNER JOIN breakages b ON n.id_breakages = b.id
-		INNER JOIN cars c ON b.id_car = c.id
-		WHERE n.id_user = $1 AND n.status = COALESCE($2, n.status)
-		ORDER BY n.created_at DESC
-		LIMIT $3 OFFSET $4`
-
-	r.log.Debugf("Executing query to fetch notifications with user_id: %s status: %v, limit: %d, offset: %d", userID, status, limit, offset)
-
-	rows, err := r.conn.QueryContext(ctx, query, userID, status, limit, offset)
-	if err != nil {
-		r.log.Errorf("Failed to execute query: %v", err)
-		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	if err := r.loadNotice(ctx, &info, ref); err != nil {
+		return models.NotificationInfo{}, err
 	}
-	defer rows.Close()
 
-	var notifications []models.NotificationListItem
-	for rows.Next() {
-		var item models.NotificationListItem
-		if err := rows.Scan(
+	logging.FromContext(ctx, r.log).Debugf("Successfully fetched notification info for notificationID: %s", notificationID)
+	return info, nil
+}
+
+// GetNotificationList returns a page of the user's notifications matching filter.
+// Notifications of work violations and expiry alerts have their type as
+// breakage type. Sorted by severity, the most severe come last unless the
+// order is descending.
+func (r *Repository) GetNotificationList(ctx context.Context, filter models.NotificationFilter, page models.PageRequest) (models.Page[models.NotificationListItem], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var f filterArgs
+	f.add("n.id_user = ?", filter.IDUser)
+	if filter.Status != nil {
+		f.add("n.status = ?", *filter.Status)
+	}
+	if filter.Type != nil {
+		f.add("n.type = ?", *filter.Type)
+	}
+	if filter.MinSeverity != nil {
+		f.add("array_position("+severityRanks+", n.severity::text) >= array_position("+severityRanks+", ?::text)",
+			*filter.MinSeverity)
+	}
+	if filter.BreakageType != nil {
+		f.add("COALESCE(b.type, v.type, e.type) = ?", *filter.BreakageType)
+	}
+	if filter.BreakageStatus != nil {
+		f.add("b.status = ?", *filter.BreakageStatus)
+	}
+	if filter.From != nil {
+		f.add("n.created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		f.add("n.created_at <= ?", *filter.To)
+	}
+
+	q := listQuery{
+		query: `
+			SELECT
+				n.id,
+				n.type,
+				n.severity,
+				array_position(` + severityRanks + `, n.severity::text) AS severity_rank,
+				COALESCE(n.status, '') AS status,
+				n.id_car,
+				COALESCE(n.note, '') AS note,
+				COALESCE(c.state_number, '') AS state_number,
+				COALESCE(c.brand, '') AS brand,
+				COALESCE(b.type, v.type, e.type, '') AS breakage_type,
+				b.status AS breakage_status,
+				n.created_at
+			FROM notifications n
+			LEFT JOIN breakages b ON n.id_breakages = b.id
+			LEFT JOIN work_violations v ON n.id_work_violation = v.id
+			LEFT JOIN driver_expiry_alerts e ON n.id_expiry_alert = e.id
+			LEFT JOIN cars c ON n.id_car = c.id
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"created_at":    {"created_at", "timestamp"},
+			"state_number":  {"state_number", "text"},
+			"breakage_type": {"breakage_type", "text"},
+			"type":          {"type", "text"},
+			"severity":      {"severity_rank", "int"},
+		},
+		defaultSort: "-created_at",
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Executing query to fetch notifications with user_id: %s status: %v, limit: %d, sort: %s", filter.IDUser, filter.Status, page.Limit, page.Sort)
+
+	notifications, err := queryPage(ctx, r, q, page, func(item *models.NotificationListItem) []any {
+		var severityRank *int
+		return []any{
 			&item.ID,
+			&item.Type,
+			&item.Severity,
+			&severityRank,
+			&item.Status,
+			&item.IDCar,
+			&item.Note,
 			&item.StateNumber,
 			&item.Brand,
 			&item.BreakageType,
+			&item.BreakageStatus,
 			&item.CreatedAt,
-		); err != nil {
-			r.log.Errorf("Failed to scan row: %v", err)
-			return nil, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
 		}
-		notifications = append(notifications, item)
-	}
-
-	if err := rows.Err(); err != nil {
-		r.log.Errorf("Error while iterating rows: %v", err)
-		return nil, fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
+	})
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to fetch notifications: %v", err)
+		return notifications, err
 	}
 
-	r.log.Debugf("Successfully fetched %d notifications", len(notifications))
+	logging.FromContext(ctx, r.log).Debugf("Successfully fetched %d of %d notifications", len(notifications.Items), notifications.Total)
 	return notifications, nil
 }
 
 // Report
-func (r *Repository) GetReportData(userId string) ([]models.ReportData, error) {
+func (r *Repository) GetReportData(ctx context.Context, userId string) ([]models.ReportData, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
 	query := `
 		SELECT
 			w.id AS wheel_id,             
@@ -1534,11 +2093,11 @@ func (r *Repository) GetReportData(userId string) ([]models.ReportData, error) {
 			c.state_number, w.position; 
 	`
 
-	r.log.Debugf("Executing query: %s with userId: %s", query, userId)
+	logging.FromContext(ctx, r.log).Debugf("Executing query: %s with userId: %s", query, userId)
 
-	rows, err := r.conn.Query(query, userId)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, userId)
 	if err != nil {
-		r.log.Errorf("Failed to execute query: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute query: %v", err)
 		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
 	}
 	defer rows.Close()
@@ -1555,22 +2114,25 @@ func (r *Repository) GetReportData(userId string) ([]models.ReportData, error) {
 			&data.TempOutOfBounds,
 			&data.PressureOutOfBounds,
 		); err != nil {
-			r.log.Errorf("Failed to scan row: %v", err)
+			logging.FromContext(ctx, r.log).Errorf("Failed to scan row: %v", err)
 			return nil, fmt.Errorf("%w: %v", models.ErrFailedToProcessRow, err)
 		}
 		reportData = append(reportData, data)
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Errorf("Rows iteration error: %v", err)
+		logging.FromContext(ctx, r.log).Errorf("Rows iteration error: %v", err)
 		return nil, fmt.Errorf("%w: %v", models.ErrRowsIterationError, err)
 	}
 
-	r.log.Debugf("Successfully retrieved %d report records", len(reportData))
+	logging.FromContext(ctx, r.log).Debugf("Successfully retrieved %d report records", len(reportData))
 	return reportData, nil
 }
 
-func (r *Repository) GetCarWheelData(carID string) (models.CarWithWheels, error) {
+func (r *Repository) GetCarWheelData(ctx context.Context, carID string) (models.CarWithWheels, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
 	query := `
         SELECT
             c.id AS car_id,
@@ -1604,7 +2166,7 @@ func (r *Repository) GetCarWheelData(carID string) (models.CarWithWheels, error)
 	var wheels []models.Wheel
 	var car models.CarWithWheels
 
-	rows, err := r.conn.Query(query, carID)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, carID)
 	if err != nil {
 		return car, fmt.Errorf("error executing query: %w", err)
 	}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/VikaPaz/algalar/internal/models"
@@ -16,7 +17,7 @@ func TestCreateUser(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	user := models.User{
 		INN:      "1234567890",
@@ -33,7 +34,7 @@ func TestCreateUser(t *testing.T) {
 		WithArgs(user.INN, user.Name, user.Surname, user.Gender, user.Login, user.Password, user.Timezone, user.Phone).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
 
-	userID, err := repo.CreateUser(user)
+	userID, err := repo.CreateUser(context.Background(), user)
 	assert.NoError(t, err)
 	assert.Equal(t, "1", userID)
 }
@@ -44,7 +45,7 @@ func TestUpdateUser(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	user := models.User{
 		ID:       "1",
@@ -61,7 +62,7 @@ func TestUpdateUser(t *testing.T) {
 		WithArgs(user.INN, user.Name, user.Surname, user.Gender, user.Login, user.Timezone, user.Phone, user.ID).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
 
-	userID, err := repo.UpdateUser(user)
+	userID, err := repo.UpdateUser(context.Background(), user)
 	assert.NoError(t, err)
 	assert.Equal(t, "1", userID)
 }
@@ -72,7 +73,7 @@ func TestGetById(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	userID := "1"
 	expectedUser := models.User{
@@ -91,7 +92,7 @@ func TestGetById(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"inn", "name", "surname", "gender", "login", "password", "utc_timezone", "phone"}).
 			AddRow(expectedUser.INN, expectedUser.Name, expectedUser.Surname, expectedUser.Gender, expectedUser.Login, expectedUser.Password, expectedUser.Timezone, expectedUser.Phone))
 
-	user, err := repo.GetById(userID)
+	user, err := repo.GetById(context.Background(), userID)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUser, user)
 }
@@ -102,7 +103,7 @@ func TestChangePassword(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	userID := "1"
 	newPassword := "newpassword"
@@ -111,7 +112,7 @@ func TestChangePassword(t *testing.T) {
 		WithArgs(newPassword, userID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err = repo.ChangePassword(userID, newPassword)
+	err = repo.ChangePassword(context.Background(), userID, newPassword)
 	assert.NoError(t, err)
 }
 
@@ -121,7 +122,7 @@ func TestGetIDByLoginAndPassword(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	email := "johndoe"
 	password := "password"
@@ -131,7 +132,7 @@ func TestGetIDByLoginAndPassword(t *testing.T) {
 		WithArgs(email, password).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(expectedUserID))
 
-	userID, err := repo.GetIDByLoginAndPassword(email, password)
+	userID, err := repo.GetIDByLoginAndPassword(context.Background(), email, password)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUserID, userID)
 }
@@ -142,7 +143,7 @@ func TestCreateCar(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	car := models.Car{
 		IDCompany:    "1",
@@ -159,7 +160,7 @@ func TestCreateCar(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "state_number", "brand", "device_number", "id_unicum", "car_type", "count_axis"}).
 			AddRow("1", car.IDCompany, car.StateNumber, car.Brand, car.DeviceNumber, car.IDUnicum, car.Type, car.CountAxis))
 
-	createdCar, err := repo.CreateCar(car)
+	createdCar, err := repo.CreateCar(context.Background(), car)
 	assert.NoError(t, err)
 	assert.Equal(t, car.IDCompany, createdCar.IDCompany)
 	assert.Equal(t, car.StateNumber, createdCar.StateNumber)
@@ -176,7 +177,7 @@ func TestGetCarById(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	carID := "1"
 	expectedCar := models.Car{
@@ -194,7 +195,7 @@ func TestGetCarById(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "state_number", "brand", "device_number", "id_unicum", "count_axis"}).
 			AddRow(expectedCar.ID, expectedCar.IDCompany, expectedCar.StateNumber, expectedCar.Brand, expectedCar.DeviceNumber, expectedCar.IDUnicum, expectedCar.CountAxis))
 
-	car, err := repo.GetCarById(carID)
+	car, err := repo.GetCarById(context.Background(), carID)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedCar, car)
 }
@@ -205,7 +206,7 @@ func TestGetCarByDeviceNumber(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	deviceNumber := "12345"
 	expectedCar := models.Car{
@@ -229,13 +230,32 @@ func TestGetCarByDeviceNumber(t *testing.T) {
 	assert.Equal(t, expectedCar, car)
 }
 
+func TestGetCarByIdTimeout(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{Read: 10 * time.Millisecond})
+
+	mock.ExpectQuery("SELECT id, id_company, state_number, brand, device_number, id_unicum, count_axis FROM cars WHERE id = \\$1").
+		WithArgs("1").
+		WillDelayFor(time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	start := time.Now()
+	_, err = repo.GetCarById(context.Background(), "1")
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
 func TestGetIdCarByStateNumber(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	stateNumber := "ABC123"
 	expectedCarID := "1"
@@ -244,7 +264,7 @@ func TestGetIdCarByStateNumber(t *testing.T) {
 		WithArgs(stateNumber).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(expectedCarID))
 
-	carID, err := repo.GetIdCarByStateNumber(stateNumber)
+	carID, err := repo.GetIdCarByStateNumber(context.Background(), stateNumber)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedCarID, carID)
 }
@@ -255,11 +275,10 @@ func TestGetCarsList(t *testing.T) {
 	defer db.Close()
 
 	logger := logrus.New()
-	repo := NewRepository(db, logger)
+	repo := NewRepository(db, logger, Timeouts{})
 
 	userID := "1"
-	offset := 0
-	limit := 10
+	brand := "Toyota"
 	expectedCars := []models.Car{
 		{
 			ID:           "1",
@@ -269,15 +288,102 @@ func TestGetCarsList(t *testing.T) {
 			DeviceNumber: "12345",
 			IDUnicum:     "unique123",
 			CountAxis:    4,
+			Type:         "truck",
 		},
 	}
+	columns := []string{"id", "id_company", "state_number", "brand", "device_number", "id_unicum", "count_axis", "car_type", "sort", "id"}
+
+	mock.ExpectQuery("FROM cars\\s+WHERE id_company = \\$1 AND brand = \\$2\\) l\\s+ORDER BY l.state_number ASC, l.id::text ASC\\s+LIMIT \\$3").
+		WithArgs(userID, brand, 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("1", userID, "ABC123", "Toyota", "12345", "unique123", 4, "truck", "ABC123", "1").
+			AddRow("2", userID, "XYZ789", "Toyota", "67890", "unique456", 2, "truck", "XYZ789", "2"))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM").
+		WithArgs(userID, brand).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	cars, err := repo.GetCarsList(context.Background(), models.CarFilter{IDCompany: userID, Brand: &brand}, models.PageRequest{Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCars, cars.Items)
+	assert.Equal(t, 2, cars.Total)
+	assert.NotEmpty(t, cars.NextCursor)
+
+	// The next page resumes after the last car of the first one.
+	mock.ExpectQuery("FROM cars\\s+WHERE id_company = \\$1 AND brand = \\$2\\) l\\s+WHERE \\(l.state_number, l.id::text\\) > \\(\\$3::text, \\$4\\)").
+		WithArgs(userID, brand, "ABC123", "1", 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("2", userID, "XYZ789", "Toyota", "67890", "unique456", 2, "truck", "XYZ789", "2"))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM").
+		WithArgs(userID, brand).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	next, err := repo.GetCarsList(context.Background(), models.CarFilter{IDCompany: userID, Brand: &brand}, models.PageRequest{Limit: 1, Cursor: cars.NextCursor})
+	assert.NoError(t, err)
+	assert.Len(t, next.Items, 1)
+	assert.Equal(t, "2", next.Items[0].ID)
+	assert.Empty(t, next.NextCursor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
 
-	mock.ExpectQuery("SELECT id, id_company, state_number, brand, device_number, id_unicum, count_axis FROM cars WHERE id_company = \\$1 LIMIT \\$2 OFFSET \\$3").
-		WithArgs(userID, limit, offset).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "state_number", "brand", "device_number", "id_unicum", "count_axis"}).
-			AddRow(expectedCars[0].ID, expectedCars[0].IDCompany, expectedCars[0].StateNumber, expectedCars[0].Brand, expectedCars[0].DeviceNumber, expectedCars[0].IDUnicum, expectedCars[0].CountAxis))
+func TestGetCarsListRejectsBadPageRequests(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
 
-	cars, err := repo.GetCarsList(userID, offset, limit)
+	repo := NewRepository(db, logrus.New(), Timeouts{})
+	filter := models.CarFilter{IDCompany: "1"}
+
+	_, err = repo.GetCarsList(context.Background(), filter, models.PageRequest{Limit: 10, Sort: "device_number"})
+	assert.ErrorIs(t, err, models.ErrInvalidSort)
+
+	_, err = repo.GetCarsList(context.Background(), filter, models.PageRequest{Limit: 10, Cursor: "not a cursor"})
+	assert.ErrorIs(t, err, models.ErrInvalidCursor)
+
+	brandCursor := encodeCursor(cursor{Sort: "brand", Value: "Toyota", ID: "1"})
+	_, err = repo.GetCarsList(context.Background(), filter, models.PageRequest{Limit: 10, Cursor: brandCursor})
+	assert.ErrorIs(t, err, models.ErrInvalidCursor)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountSilentDevices(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	since := time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT c.id_company, COUNT\\(\\*\\) FROM cars c").
+		WithArgs(since).
+		WillReturnRows(sqlmock.NewRows([]string{"id_company", "count"}).
+			AddRow("1", 2).
+			AddRow("2", 1))
+
+	counts, err := repo.CountSilentDevices(context.Background(), since)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"1": 2, "2": 1}, counts)
+}
+
+func TestCheckMigrations(t *testing.T) {
+	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
-	assert.Equal(t, expectedCars, cars)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectQuery("FROM unnest").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}))
+
+	assert.NoError(t, repo.CheckMigrations(context.Background()))
+
+	mock.ExpectQuery("FROM unnest").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("audit_log"))
+
+	err = repo.CheckMigrations(context.Background())
+	assert.ErrorIs(t, err, models.ErrMigrationsNotApplied)
+	assert.Contains(t, err.Error(), "audit_log")
 }
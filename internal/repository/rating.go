@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// minMovingSpeed is the speed in km/h below which a car is taken to be
+// standing, so that parking with the device on does not count as driving.
+const minMovingSpeed = 5
+
+// Rating
+// GetDriverBehaviour measures the behaviour of every driver during their
+// assignments since q.Since. Speeds are derived from consecutive positions
+// of the car's device no further apart than q.MaxGap.
+func (r *Repository) GetDriverBehaviour(ctx context.Context, q models.BehaviourQuery) ([]models.DriverBehaviour, error) {
+	// All companies are measured at once, over months of positions.
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	query := `
+		WITH points AS (
+			SELECT
+				a.id AS id_assignment,
+				a.id_driver,
+				p.created_at,
+				p.latitude,
+				p.longitude,
+				LAG(p.created_at) OVER w AS prev_at,
+				LAG(p.latitude) OVER w AS prev_latitude,
+				LAG(p.longitude) OVER w AS prev_longitude
+			FROM driver_assignments a
+			JOIN cars c ON c.id = a.id_car
+			JOIN position_data p ON p.device_number = c.device_number
+				AND p.created_at >= GREATEST(a.started_at, $1) AND (a.ended_at IS NULL OR p.created_at < a.ended_at)
+			WINDOW w AS (PARTITION BY a.id ORDER BY p.created_at)
+		),
+		segments AS (
+			SELECT
+				id_assignment,
+				id_driver,
+				created_at,
+				EXTRACT(EPOCH FROM created_at - prev_at) AS seconds,
+				2 * 6371 * asin(sqrt(
+					power(sin(radians(latitude - prev_latitude) / 2), 2) +
+					cos(radians(prev_latitude)) * cos(radians(latitude)) * power(sin(radians(longitude - prev_longitude) / 2), 2)
+				)) AS km
+			FROM points
+			WHERE prev_at IS NOT NULL AND created_at > prev_at AND created_at - prev_at <= make_interval(secs => $2)
+		),
+		speeds AS (
+			SELECT
+				id_assignment,
+				id_driver,
+				seconds,
+				km,
+				km / seconds * 3600 AS kmh,
+				(km / seconds * 3600 - LAG(km / seconds * 3600) OVER (PARTITION BY id_assignment ORDER BY created_at)) / 3.6 / seconds AS acceleration
+			FROM segments
+		),
+		driving AS (
+			SELECT
+				id_driver,
+				COALESCE(SUM(seconds) FILTER (WHERE kmh >= $5), 0) AS driven_seconds,
+				COALESCE(SUM(seconds) FILTER (WHERE kmh > $3), 0) AS speeding_seconds,
+				COALESCE(SUM(km), 0) AS distance_km,
+				COUNT(*) FILTER (WHERE abs(acceleration) > $4) AS harsh_events
+			FROM speeds
+			GROUP BY id_driver
+		),
+		attributed_breakages AS (
+			SELECT COALESCE(b.id_driver, a.id_driver) AS id_driver, COUNT(DISTINCT b.id) AS breakages
+			FROM breakages b
+			LEFT JOIN driver_assignments a ON a.id_car = b.id_car
+				AND a.started_at <= b.created_at AND (a.ended_at IS NULL OR a.ended_at > b.created_at)
+			WHERE b.created_at >= $1
+			GROUP BY 1
+		),
+		readings AS (
+			SELECT
+				a.id_driver,
+				a.id_car,
+				a.started_at,
+				s.created_at,
+				s.pressure < w.min_pressure AS under_inflated,
+				LEAD(s.created_at) OVER (PARTITION BY a.id, s.sensor_number ORDER BY s.created_at) AS next_at
+			FROM driver_assignments a
+			JOIN cars c ON c.id = a.id_car
+			JOIN wheels w ON w.id_car = c.id
+			JOIN sensors_data s ON s.device_number = c.device_number AND s.sensor_number = w.sensor_number
+				AND s.created_at >= GREATEST(a.started_at, $1) AND (a.ended_at IS NULL OR s.created_at < a.ended_at)
+		),
+		tire_care AS (
+			SELECT
+				rd.id_driver,
+				SUM(EXTRACT(EPOCH FROM LEAST(rd.next_at - rd.created_at, make_interval(secs => $2)))) AS under_inflated_seconds
+			FROM readings rd
+			WHERE rd.under_inflated AND rd.next_at IS NOT NULL
+				AND EXISTS (
+					SELECT 1
+					FROM notifications n
+					JOIN breakages b ON b.id = n.id_breakages
+					WHERE b.id_car = rd.id_car AND n.created_at >= rd.started_at AND n.created_at <= rd.created_at
+				)
+			GROUP BY rd.id_driver
+		)
+		SELECT
+			d.id,
+			d.id_company,
+			COALESCE(d.worked_time, 0),
+			COALESCE(ab.breakages, 0),
+			COALESCE(dr.harsh_events, 0),
+			COALESCE(dr.driven_seconds, 0)::float8,
+			COALESCE(dr.speeding_seconds, 0)::float8,
+			COALESCE(dr.distance_km, 0)::float8,
+			COALESCE(tc.under_inflated_seconds, 0)::float8
+		FROM drivers d
+		LEFT JOIN driving dr ON dr.id_driver = d.id
+		LEFT JOIN attributed_breakages ab ON ab.id_driver = d.id
+		LEFT JOIN tire_care tc ON tc.id_driver = d.id
+		WHERE d.id_company IS NOT NULL`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, q.Since, q.MaxGap.Seconds(), q.SpeedLimit, q.HarshAcceleration, minMovingSpeed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var behaviour []models.DriverBehaviour
+	for rows.Next() {
+		var b models.DriverBehaviour
+		err := rows.Scan(&b.IDDriver, &b.IDCompany, &b.WorkedTime, &b.Breakages, &b.HarshEvents,
+			&b.DrivenSeconds, &b.SpeedingSeconds, &b.DistanceKm, &b.UnderInflatedSeconds)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		behaviour = append(behaviour, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return behaviour, nil
+}
+
+// SaveDriverRatings stores the ratings with their breakdown and updates the
+// rating of each driver, all in one transaction.
+func (r *Repository) SaveDriverRatings(ctx context.Context, ratings []models.DriverRating) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		WITH rating AS (
+			INSERT INTO driver_ratings (
+				id_driver, id_company, rating, safety, smoothness, speed_discipline, tire_care, experience,
+				breakages, harsh_events, driven_seconds, speeding_seconds, distance_km, under_inflated_seconds,
+				worked_time, window_start, calculated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			ON CONFLICT (id_driver) DO UPDATE SET
+				rating = EXCLUDED.rating,
+				safety = EXCLUDED.safety,
+				smoothness = EXCLUDED.smoothness,
+				speed_discipline = EXCLUDED.speed_discipline,
+				tire_care = EXCLUDED.tire_care,
+				experience = EXCLUDED.experience,
+				breakages = EXCLUDED.breakages,
+				harsh_events = EXCLUDED.harsh_events,
+				driven_seconds = EXCLUDED.driven_seconds,
+				speeding_seconds = EXCLUDED.speeding_seconds,
+				distance_km = EXCLUDED.distance_km,
+				under_inflated_seconds = EXCLUDED.under_inflated_seconds,
+				worked_time = EXCLUDED.worked_time,
+				window_start = EXCLUDED.window_start,
+				calculated_at = EXCLUDED.calculated_at
+			RETURNING id_driver, rating
+		)
+		UPDATE drivers d
+		SET rating = rating.rating
+		FROM rating
+		WHERE d.id = rating.id_driver`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer stmt.Close()
+
+	for _, rt := range ratings {
+		_, err := stmt.ExecContext(ctx,
+			rt.IDDriver, rt.IDCompany, rt.Rating, rt.Safety, rt.Smoothness, rt.SpeedDiscipline, rt.TireCare, rt.Experience,
+			rt.Breakages, rt.HarshEvents, rt.DrivenSeconds, rt.SpeedingSeconds, rt.DistanceKm, rt.UnderInflatedSeconds,
+			rt.WorkedTime, rt.WindowStart, rt.CalculatedAt)
+		if err != nil {
+			return fmt.Errorf("%w: driver %s: %v", models.ErrFailedToExecuteQuery, rt.IDDriver, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Saved ratings of %d drivers", len(ratings))
+	return nil
+}
+
+// nullDriverRating scans the columns of a driver_ratings row that may be
+// missing from a LEFT JOIN.
+type nullDriverRating struct {
+	Rating, Safety, Smoothness, SpeedDiscipline, TireCare, Experience sql.NullFloat64
+	Breakages, HarshEvents, WorkedTime                                sql.NullInt64
+	DrivenSeconds, SpeedingSeconds, DistanceKm, UnderInflatedSeconds  sql.NullFloat64
+	WindowStart, CalculatedAt                                         sql.NullTime
+}
+
+// driverRating returns the scanned rating of the driver, nil if there was none.
+func (n nullDriverRating) driverRating(driverID string) *models.DriverRating {
+	if !n.Rating.Valid {
+		return nil
+	}
+
+	return &models.DriverRating{
+		DriverBehaviour: models.DriverBehaviour{
+			IDDriver:             driverID,
+			WorkedTime:           int(n.WorkedTime.Int64),
+			Breakages:            int(n.Breakages.Int64),
+			HarshEvents:          int(n.HarshEvents.Int64),
+			DrivenSeconds:        n.DrivenSeconds.Float64,
+			SpeedingSeconds:      n.SpeedingSeconds.Float64,
+			DistanceKm:           n.DistanceKm.Float64,
+			UnderInflatedSeconds: n.UnderInflatedSeconds.Float64,
+		},
+		Rating:          float32(n.Rating.Float64),
+		Safety:          float32(n.Safety.Float64),
+		Smoothness:      float32(n.Smoothness.Float64),
+		SpeedDiscipline: float32(n.SpeedDiscipline.Float64),
+		TireCare:        float32(n.TireCare.Float64),
+		Experience:      float32(n.Experience.Float64),
+		WindowStart:     n.WindowStart.Time,
+		CalculatedAt:    n.CalculatedAt.Time,
+	}
+}
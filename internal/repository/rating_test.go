@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDriverBehaviour(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	q := models.BehaviourQuery{
+		Since:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		SpeedLimit:        90,
+		HarshAcceleration: 3.5,
+		MaxGap:            5 * time.Minute,
+	}
+
+	mock.ExpectQuery("WITH points AS (.+) FROM drivers d").
+		WithArgs(q.Since, 300.0, 90.0, 3.5, minMovingSpeed).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "id_company", "worked_time", "breakages", "harsh_events",
+			"driven_seconds", "speeding_seconds", "distance_km", "under_inflated_seconds",
+		}).
+			AddRow("d1", "c1", 600, 2, 5, 36000.0, 1800.0, 720.5, 0.0).
+			AddRow("d2", "c1", 0, 0, 0, 0.0, 0.0, 0.0, 0.0))
+
+	res, err := repo.GetDriverBehaviour(context.Background(), q)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.DriverBehaviour{
+		{IDDriver: "d1", IDCompany: "c1", WorkedTime: 600, Breakages: 2, HarshEvents: 5,
+			DrivenSeconds: 36000, SpeedingSeconds: 1800, DistanceKm: 720.5},
+		{IDDriver: "d2", IDCompany: "c1"},
+	}, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveDriverRatings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	now := time.Now()
+	ratings := []models.DriverRating{
+		{
+			DriverBehaviour: models.DriverBehaviour{IDDriver: "d1", IDCompany: "c1", WorkedTime: 600, Breakages: 2},
+			Rating:          9.5, Safety: 2.5, Smoothness: 2, SpeedDiscipline: 2, TireCare: 2, Experience: 1,
+			WindowStart: now.AddDate(0, 0, -90), CalculatedAt: now,
+		},
+		{
+			DriverBehaviour: models.DriverBehaviour{IDDriver: "d2", IDCompany: "c1"},
+			Rating:          9, Safety: 3, Smoothness: 2, SpeedDiscipline: 2, TireCare: 2,
+			WindowStart: now.AddDate(0, 0, -90), CalculatedAt: now,
+		},
+	}
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare("INSERT INTO driver_ratings (.+) UPDATE drivers d SET rating")
+	for _, rt := range ratings {
+		prep.ExpectExec().
+			WithArgs(rt.IDDriver, rt.IDCompany, rt.Rating, rt.Safety, rt.Smoothness, rt.SpeedDiscipline, rt.TireCare, rt.Experience,
+				rt.Breakages, rt.HarshEvents, rt.DrivenSeconds, rt.SpeedingSeconds, rt.DistanceKm, rt.UnderInflatedSeconds,
+				rt.WorkedTime, rt.WindowStart, rt.CalculatedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	err = repo.SaveDriverRatings(context.Background(), ratings)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDriverInfoWithoutRating(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	birthday := time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{
+		"name", "surname", "middle_name", "phone", "birthday",
+		"licence_number", "licence_categories", "licence_expires_at", "medical_expires_at", "deactivated_at",
+		"rating", "safety", "smoothness", "speed_discipline", "tire_care", "experience",
+		"breakages", "harsh_events", "driven_seconds", "speeding_seconds", "distance_km", "under_inflated_seconds",
+		"worked_time", "window_start", "calculated_at",
+	}
+
+	mock.ExpectQuery("FROM drivers d LEFT JOIN driver_ratings r").
+		WithArgs("d1").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("Ivan", "Petrov", "", "+70000000000", birthday,
+			nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+
+	res, err := repo.GetDriverInfo(context.Background(), "d1")
+	assert.NoError(t, err)
+	assert.Nil(t, res.Rating)
+	assert.Equal(t, "Ivan", res.Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
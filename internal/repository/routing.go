@@ -0,0 +1,399 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+)
+
+// notificationRuleColumns are the columns of notification_rules scanned by
+// notificationRuleDest.
+const notificationRuleColumns = `id, id_company, name, event_types, min_severity, car_ids, channel, target, quiet_from, quiet_to, active, created_at, updated_at`
+
+func notificationRuleDest(n *models.NotificationRule) []any {
+	return []any{&n.ID, &n.IDCompany, &n.Name, pq.Array(&n.EventTypes), &n.MinSeverity, pq.Array(&n.CarIDs), &n.Channel, &n.Target,
+		&n.QuietFrom, &n.QuietTo, &n.Active, &n.CreatedAt, &n.UpdatedAt}
+}
+
+// notificationDeliveryColumns are the columns of notification_deliveries
+// scanned by notificationDeliveryDest.
+const notificationDeliveryColumns = `d.id, d.id_notification, d.id_rule, d.channel, d.target, d.status, d.attempts, d.next_attempt_at, d.last_error, d.sent_at, d.created_at`
+
+func notificationDeliveryDest(d *models.NotificationDelivery) []any {
+	return []any{&d.ID, &d.IDNotification, &d.IDRule, &d.Channel, &d.Target, &d.Status, &d.Attempts, &d.NextAttemptAt, &d.LastError,
+		&d.SentAt, &d.CreatedAt}
+}
+
+// routedNotificationColumns are the columns of a notification n scanned by
+// routedNotificationDest.
+const routedNotificationColumns = `n.id, n.id_user, n.type, n.severity, n.id_car, COALESCE(n.note, ''), n.created_at`
+
+func routedNotificationDest(n *models.RoutedNotification) []any {
+	return []any{&n.ID, &n.IDCompany, &n.Event, &n.Severity, &n.IDCar, &n.Note, &n.CreatedAt}
+}
+
+// Notification rules
+// CreateNotificationRule adds a notification rule to the company.
+func (r *Repository) CreateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	if err := r.checkCompanyCars(ctx, n.IDCompany, n.CarIDs); err != nil {
+		return models.NotificationRule{}, err
+	}
+
+	query := `
+		INSERT INTO notification_rules (id_company, name, event_types, min_severity, car_ids, channel, target, quiet_from, quiet_to, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING ` + notificationRuleColumns
+
+	var res models.NotificationRule
+	err := r.conn(ctx).QueryRowContext(ctx, query, n.IDCompany, n.Name, pq.Array(n.EventTypes), n.MinSeverity, pq.Array(n.CarIDs),
+		n.Channel, n.Target, n.QuietFrom, n.QuietTo, n.Active).Scan(notificationRuleDest(&res)...)
+	if err != nil {
+		return models.NotificationRule{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Notification rule %s created", res.ID)
+	return res, nil
+}
+
+// UpdateNotificationRule replaces a notification rule of the company.
+func (r *Repository) UpdateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	if err := r.checkCompanyCars(ctx, n.IDCompany, n.CarIDs); err != nil {
+		return models.NotificationRule{}, err
+	}
+
+	query := `
+		UPDATE notification_rules
+		SET name = $3, event_types = $4, min_severity = $5, car_ids = $6, channel = $7, target = $8,
+			quiet_from = $9, quiet_to = $10, active = $11, updated_at = now()
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + notificationRuleColumns
+
+	var res models.NotificationRule
+	err := r.conn(ctx).QueryRowContext(ctx, query, n.ID, n.IDCompany, n.Name, pq.Array(n.EventTypes), n.MinSeverity, pq.Array(n.CarIDs),
+		n.Channel, n.Target, n.QuietFrom, n.QuietTo, n.Active).Scan(notificationRuleDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.NotificationRule{}, models.ErrNotificationRuleNotFound
+	}
+	if err != nil {
+		return models.NotificationRule{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Notification rule %s updated", res.ID)
+	return res, nil
+}
+
+// DeleteNotificationRule removes a notification rule of the company. The
+// deliveries it made are kept.
+func (r *Repository) DeleteNotificationRule(ctx context.Context, companyID string, ruleID string) (models.NotificationRule, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		DELETE FROM notification_rules
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + notificationRuleColumns
+
+	var res models.NotificationRule
+	err := r.conn(ctx).QueryRowContext(ctx, query, ruleID, companyID).Scan(notificationRuleDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.NotificationRule{}, models.ErrNotificationRuleNotFound
+	}
+	if err != nil {
+		return models.NotificationRule{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Notification rule %s deleted", res.ID)
+	return res, nil
+}
+
+// GetNotificationRule returns a notification rule of the company.
+func (r *Repository) GetNotificationRule(ctx context.Context, companyID string, ruleID string) (models.NotificationRule, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + notificationRuleColumns + `
+		FROM notification_rules
+		WHERE id = $1 AND id_company = $2`
+
+	var res models.NotificationRule
+	err := r.conn(ctx).QueryRowContext(ctx, query, ruleID, companyID).Scan(notificationRuleDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.NotificationRule{}, models.ErrNotificationRuleNotFound
+	}
+	if err != nil {
+		return models.NotificationRule{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return res, nil
+}
+
+// GetNotificationRules returns the notification rules of the company ordered
+// by name.
+func (r *Repository) GetNotificationRules(ctx context.Context, companyID string) ([]models.NotificationRule, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + notificationRuleColumns + `
+		FROM notification_rules
+		WHERE id_company = $1
+		ORDER BY name, created_at`
+
+	return r.queryNotificationRules(ctx, query, companyID)
+}
+
+// GetActiveNotificationRules returns the active notification rules of the
+// companies.
+func (r *Repository) GetActiveNotificationRules(ctx context.Context, companyIDs []string) ([]models.NotificationRule, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + notificationRuleColumns + `
+		FROM notification_rules
+		WHERE id_company = ANY($1::uuid[]) AND active`
+
+	return r.queryNotificationRules(ctx, query, pq.Array(companyIDs))
+}
+
+func (r *Repository) queryNotificationRules(ctx context.Context, query string, args ...any) ([]models.NotificationRule, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	rules := []models.NotificationRule{}
+	for rows.Next() {
+		var n models.NotificationRule
+		if err := rows.Scan(notificationRuleDest(&n)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		rules = append(rules, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return rules, nil
+}
+
+// checkCompanyCars reports models.ErrNoContent if one of carIDs is not a car
+// of the company.
+func (r *Repository) checkCompanyCars(ctx context.Context, companyID string, carIDs []string) error {
+	if len(carIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		SELECT u.id
+		FROM unnest($1::uuid[]) AS u (id)
+		WHERE NOT EXISTS (SELECT 1 FROM cars c WHERE c.id = u.id AND c.id_company = $2)
+		LIMIT 1`
+
+	var missing string
+	err := r.conn(ctx).QueryRowContext(ctx, query, pq.Array(carIDs), companyID).Scan(&missing)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return fmt.Errorf("%w: car %s", models.ErrNoContent, missing)
+}
+
+// Routing
+// GetUnroutedNotifications returns up to limit notifications not routed yet,
+// the oldest first.
+func (r *Repository) GetUnroutedNotifications(ctx context.Context, limit int) ([]models.RoutedNotification, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + routedNotificationColumns + `
+		FROM notifications n
+		WHERE n.routed_at IS NULL AND n.id_user IS NOT NULL
+		ORDER BY n.created_at
+		LIMIT $1`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var notifications []models.RoutedNotification
+	for rows.Next() {
+		var n models.RoutedNotification
+		if err := rows.Scan(routedNotificationDest(&n)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return notifications, nil
+}
+
+// RouteNotifications records the deliveries of routing, queues its webhook
+// events and marks its notifications routed at once. A delivery of a
+// notification to a channel and target that was already recorded is skipped.
+func (r *Repository) RouteNotifications(ctx context.Context, routing models.Routing) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	if len(routing.Deliveries) > 0 {
+		stmt, err := tx.PrepareContext(ctx, `
+			INSERT INTO notification_deliveries (id_notification, id_rule, channel, target, status, next_attempt_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id_notification, channel, target) DO NOTHING`)
+		if err != nil {
+			return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+		}
+		defer stmt.Close()
+
+		for _, d := range routing.Deliveries {
+			_, err := stmt.ExecContext(ctx, d.IDNotification, d.IDRule, d.Channel, d.Target, models.DeliveryPending, d.NextAttemptAt)
+			if err != nil {
+				return fmt.Errorf("%w: notification %s: %v", models.ErrFailedToExecuteQuery, d.IDNotification, err)
+			}
+		}
+	}
+
+	for _, e := range routing.Events {
+		if _, err := enqueueWebhookEvent(ctx, tx, e); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE notifications SET routed_at = now() WHERE id = ANY($1::uuid[])`,
+		pq.Array(routing.NotificationIDs))
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Routed %d notifications to %d deliveries", len(routing.NotificationIDs), len(routing.Deliveries))
+	return nil
+}
+
+// Deliveries
+// GetPendingDeliveries returns up to limit pending deliveries due for an
+// attempt at now, the longest waiting first.
+func (r *Repository) GetPendingDeliveries(ctx context.Context, now time.Time, limit int) ([]models.PendingDelivery, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + notificationDeliveryColumns + `, ` + routedNotificationColumns + `
+		FROM notification_deliveries d
+		JOIN notifications n ON n.id = d.id_notification
+		WHERE d.status = $1 AND d.next_attempt_at <= $2
+		ORDER BY d.next_attempt_at
+		LIMIT $3`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, models.DeliveryPending, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var deliveries []models.PendingDelivery
+	for rows.Next() {
+		var d models.PendingDelivery
+		dest := append(notificationDeliveryDest(&d.NotificationDelivery), routedNotificationDest(&d.Notification)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return deliveries, nil
+}
+
+// SaveDeliveryAttempt records the outcome of an attempt of a delivery.
+func (r *Repository) SaveDeliveryAttempt(ctx context.Context, d models.NotificationDelivery) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE notification_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, sent_at = $6
+		WHERE id = $1`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, d.ID, d.Status, d.Attempts, d.NextAttemptAt, d.LastError, d.SentAt)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return nil
+}
+
+// GetNotificationDeliveries returns the deliveries of a notification of the
+// company, one per channel and target.
+func (r *Repository) GetNotificationDeliveries(ctx context.Context, companyID string, notificationID string) ([]models.NotificationDelivery, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var exists bool
+	err := r.conn(ctx).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM notifications WHERE id = $1 AND id_user = $2)`,
+		notificationID, companyID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if !exists {
+		return nil, models.ErrNotificationNotFound
+	}
+
+	query := `
+		SELECT ` + notificationDeliveryColumns + `
+		FROM notification_deliveries d
+		WHERE d.id_notification = $1
+		ORDER BY d.channel, d.target`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, notificationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	deliveries := []models.NotificationDelivery{}
+	for rows.Next() {
+		var d models.NotificationDelivery
+		if err := rows.Scan(notificationDeliveryDest(&d)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return deliveries, nil
+}
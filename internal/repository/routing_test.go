@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUnroutedNotifications(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	createdAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	severity := models.SeverityCritical
+	low := models.SeverityLow
+	carID := "car1"
+
+	mock.ExpectQuery("FROM notifications n(.+)WHERE n.routed_at IS NULL").
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_user", "type", "severity", "id_car", "note", "created_at"}).
+			AddRow("n1", "c1", models.EventBreakage, severity, carID, "Tire puncture", createdAt).
+			AddRow("n2", "c1", models.EventDocumentExpiry, models.SeverityLow, nil, "Licence expires", createdAt))
+
+	notifications, err := repo.GetUnroutedNotifications(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.RoutedNotification{
+		{ID: "n1", IDCompany: "c1", Event: models.EventBreakage, Severity: &severity, IDCar: &carID, Note: "Tire puncture", CreatedAt: createdAt},
+		{ID: "n2", IDCompany: "c1", Event: models.EventDocumentExpiry, Severity: &low, Note: "Licence expires", CreatedAt: createdAt},
+	}, notifications)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRouteNotifications(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	ruleID := "r1"
+	at := time.Date(2026, 3, 2, 7, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare("INSERT INTO notification_deliveries")
+	prep.ExpectExec().
+		WithArgs("n1", &ruleID, models.ChannelEmail, "fleet@example.com", models.DeliveryPending, at).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Notifications no rule matched are marked routed too.
+	mock.ExpectExec("UPDATE notifications SET routed_at").
+		WithArgs(pq.Array([]string{"n1", "n2"})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = repo.RouteNotifications(context.Background(), models.Routing{
+		Deliveries: []models.NotificationDelivery{{
+			IDNotification: "n1",
+			IDRule:         &ruleID,
+			Channel:        models.ChannelEmail,
+			Target:         "fleet@example.com",
+			NextAttemptAt:  at,
+		}},
+		NotificationIDs: []string{"n1", "n2"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetNotificationDeliveriesNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs("n1", "c2").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	_, err = repo.GetNotificationDeliveries(context.Background(), "c2", "n1")
+	assert.ErrorIs(t, err, models.ErrNotificationNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
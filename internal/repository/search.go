@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// searchField is a column a search matches the query against.
+type searchField struct {
+	name   string
+	column string
+	// fullText additionally matches the column's words with Postgres full-text
+	// search, for free text such as descriptions.
+	fullText bool
+}
+
+// searchSource describes how one kind of record is searched. Its expressions
+// may refer to the company as $1.
+type searchSource struct {
+	from     string
+	company  string
+	id       string
+	title    string
+	subtitle string
+	idCar    string
+	fields   []searchField
+}
+
+var searchSources = map[string]searchSource{
+	models.SearchTypeCar: {
+		from:     "cars c",
+		company:  "c.id_company = $1",
+		id:       "c.id",
+		title:    "c.state_number",
+		subtitle: "concat_ws(' ', c.brand, c.car_type)",
+		idCar:    "c.id",
+		fields: []searchField{
+			{name: "state_number", column: "c.state_number"},
+			{name: "brand", column: "c.brand"},
+			{name: "device_number", column: "c.device_number"},
+		},
+	},
+	models.SearchTypeDriver: {
+		from:     "drivers d",
+		company:  "d.id_company = $1",
+		id:       "d.id",
+		title:    "concat_ws(' ', d.surname, d.name, d.middle_name)",
+		subtitle: "d.phone",
+		idCar:    "(SELECT a.id_car FROM driver_assignments a WHERE a.id_driver = d.id AND a.ended_at IS NULL)",
+		fields: []searchField{
+			{name: "name", column: "concat_ws(' ', d.surname, d.name, d.middle_name)"},
+			{name: "phone", column: "d.phone"},
+		},
+	},
+	models.SearchTypeWheel: {
+		from:     "wheels w LEFT JOIN cars c ON c.id = w.id_car",
+		company:  "w.id_company = $1",
+		id:       "w.id",
+		title:    "concat_ws(' ', w.brand, w.model)",
+		subtitle: "c.state_number",
+		idCar:    "w.id_car",
+		fields: []searchField{
+			{name: "brand", column: "w.brand"},
+			{name: "model", column: "w.model"},
+		},
+	},
+	models.SearchTypeBreakage: {
+		from:     "breakages b JOIN cars c ON c.id = b.id_car",
+		company:  "c.id_company = $1",
+		id:       "b.id",
+		title:    "b.type",
+		subtitle: "c.state_number",
+		idCar:    "b.id_car",
+		fields: []searchField{
+			{name: "type", column: "b.type"},
+			{name: "description", column: "b.description", fullText: true},
+		},
+	},
+}
+
+// Search looks for the company's records matching q.Text and returns them best
+// match first. A field matches when it contains the text or, to tolerate
+// typos, is similar to it by trigrams; exact and prefix matches rank highest.
+func (r *Repository) Search(ctx context.Context, q models.SearchQuery) ([]models.SearchResult, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	types := q.Types
+	if len(types) == 0 {
+		types = models.SearchTypes
+	}
+
+	parts := make([]string, 0, len(types))
+	for _, typ := range types {
+		source, ok := searchSources[typ]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown search type %q", models.ErrInvalidParameter, typ)
+		}
+		parts = append(parts, searchSourceQuery(typ, source))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT type, id, title, subtitle, id_car, field, score
+		FROM (%s) s
+		ORDER BY score DESC, title, id
+		LIMIT $5`, strings.Join(parts, " UNION ALL "))
+
+	text := strings.ToLower(strings.TrimSpace(q.Text))
+	pattern := escapeLike(text)
+
+	logging.FromContext(ctx, r.log).Debugf("Executing search: userID=%s, types=%v, limit=%d", q.IDCompany, types, q.Limit)
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, q.IDCompany, text, "%"+pattern+"%", pattern+"%", q.Limit)
+	if err != nil {
+		logging.FromContext(ctx, r.log).Errorf("Failed to execute search: %v", err)
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results := []models.SearchResult{}
+	for rows.Next() {
+		var res models.SearchResult
+		if err := rows.Scan(&res.Type, &res.ID, &res.Title, &res.Subtitle, &res.IDCar, &res.MatchedField, &res.Score); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		results = append(results, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Search found %d results", len(results))
+	return results, nil
+}
+
+// searchSourceQuery selects the matching records of one source with the name
+// and score of their best matching field. The query text is $2, and $3 and $4
+// are its LIKE patterns for containing and starting with it.
+func searchSourceQuery(typ string, source searchSource) string {
+	scores := make([]string, len(source.fields))
+	conds := make([]string, len(source.fields))
+	for i, f := range source.fields {
+		score := fmt.Sprintf(`COALESCE(CASE
+				WHEN lower(%[1]s) = $2 THEN 1.0
+				WHEN lower(%[1]s) LIKE $4 ESCAPE '\' THEN 0.9
+				WHEN lower(%[1]s) LIKE $3 ESCAPE '\' THEN 0.8
+				ELSE word_similarity($2, lower(%[1]s)) * 0.7
+			END, 0)`, f.column)
+		cond := fmt.Sprintf(`lower(%[1]s) LIKE $3 ESCAPE '\' OR $2 <%% lower(%[1]s)`, f.column)
+		if f.fullText {
+			tsv := fmt.Sprintf("to_tsvector('simple', COALESCE(%s, ''))", f.column)
+			score = fmt.Sprintf("GREATEST(%s, ts_rank(%s, plainto_tsquery('simple', $2)))", score, tsv)
+			cond += fmt.Sprintf(" OR %s @@ plainto_tsquery('simple', $2)", tsv)
+		}
+		scores[i] = fmt.Sprintf("('%s', %s)", f.name, score)
+		conds[i] = cond
+	}
+
+	return fmt.Sprintf(`
+		SELECT '%s' AS type, %s::text AS id, COALESCE(%s, '') AS title, COALESCE(%s, '') AS subtitle,
+			COALESCE(%s::text, '') AS id_car, m.field, m.score::float8 AS score
+		FROM %s
+		CROSS JOIN LATERAL (
+			SELECT field, score
+			FROM (VALUES %s) v(field, score)
+			ORDER BY score DESC
+			LIMIT 1
+		) m
+		WHERE %s AND (%s)`,
+		typ, source.id, source.title, source.subtitle, source.idCar, source.from,
+		strings.Join(scores, ", "), source.company, strings.Join(conds, " OR "))
+}
+
+// escapeLike makes the LIKE wildcards in s match literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
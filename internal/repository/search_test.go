@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	query := models.SearchQuery{
+		IDCompany: "c1",
+		Text:      " Michelin_X ",
+		Types:     []string{models.SearchTypeWheel, models.SearchTypeCar},
+		Limit:     20,
+	}
+
+	mock.ExpectQuery("SELECT 'wheel' AS type(.+)FROM wheels w(.+)UNION ALL SELECT 'car' AS type(.+)FROM cars c(.+)ORDER BY score DESC").
+		WithArgs("c1", "michelin_x", `%michelin\_x%`, `michelin\_x%`, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"type", "id", "title", "subtitle", "id_car", "field", "score"}).
+			AddRow("wheel", "w1", "Michelin X Multi", "A123BC", "car1", "brand", 0.9))
+
+	results, err := repo.Search(context.Background(), query)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SearchResult{{
+		Type:         models.SearchTypeWheel,
+		ID:           "w1",
+		Title:        "Michelin X Multi",
+		Subtitle:     "A123BC",
+		IDCar:        "car1",
+		MatchedField: "brand",
+		Score:        0.9,
+	}}, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchUnknownType(t *testing.T) {
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db, logrus.New(), Timeouts{})
+
+	_, err = repo.Search(context.Background(), models.SearchQuery{IDCompany: "c1", Text: "abc", Types: []string{"tire"}, Limit: 20})
+	assert.ErrorIs(t, err, models.ErrInvalidParameter)
+}
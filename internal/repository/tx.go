@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// dbtx is implemented by *sql.DB and *sql.Tx, so that queries run the same
+// on their own and in a unit of work.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// txConn is a transaction begun by begin.
+type txConn interface {
+	dbtx
+	Commit() error
+	Rollback() error
+}
+
+// txKey is the context key of the transaction of a unit of work.
+type txKey struct{}
+
+// conn returns the transaction of the unit of work ctx runs in, or the
+// database outside of one.
+func (r *Repository) conn(ctx context.Context) dbtx {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return r.db
+}
+
+// begin starts a transaction, or a savepoint of the transaction of the unit
+// of work ctx runs in, so that methods with transactions of their own can be
+// part of a unit of work and still roll back their own statements on error.
+func (r *Repository) begin(ctx context.Context) (txConn, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return newSavepoint(ctx, tx)
+	}
+	return r.db.BeginTx(ctx, nil)
+}
+
+// InTx runs fn as a unit of work: the repository methods fn calls with the
+// context it is given run in one transaction, committed if fn returns nil and
+// rolled back otherwise. Called within a unit of work, fn runs in a savepoint
+// of it instead.
+func (r *Repository) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	return r.inTx(ctx, fn)
+}
+
+// InLongTx is InTx without a timeout of its own, for units of work that take
+// as long as their statements allow, like imports of a whole fleet and
+// restores streamed from the client.
+func (r *Repository) InLongTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.inTx(ctx, fn)
+}
+
+func (r *Repository) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		ctx = context.WithValue(ctx, txKey{}, sqlTx)
+	}
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return nil
+}
+
+// savepointSeq numbers savepoints so that nested ones do not share a name.
+var savepointSeq atomic.Uint64
+
+// savepoint is a nested transaction within a unit of work. Like *sql.Tx, it
+// can only be committed or rolled back once.
+type savepoint struct {
+	*sql.Tx
+	ctx  context.Context
+	name string
+	done bool
+}
+
+func newSavepoint(ctx context.Context, tx *sql.Tx) (*savepoint, error) {
+	sp := &savepoint{Tx: tx, ctx: ctx, name: fmt.Sprintf("sp_%d", savepointSeq.Add(1))}
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+sp.name); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+func (sp *savepoint) Commit() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+	sp.done = true
+	_, err := sp.Tx.ExecContext(sp.ctx, "RELEASE SAVEPOINT "+sp.name)
+	return err
+}
+
+func (sp *savepoint) Rollback() error {
+	if sp.done {
+		return sql.ErrTxDone
+	}
+	sp.done = true
+	_, err := sp.Tx.ExecContext(sp.ctx, "ROLLBACK TO SAVEPOINT "+sp.name)
+	return err
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInTxCommits(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	code := 500
+	msg := "example.com responded 500 Internal Server Error"
+
+	// A method with a transaction of its own runs in a savepoint of the unit.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO webhook_deliveries").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("SAVEPOINT sp_[0-9]+").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE webhook_deliveries").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO webhook_attempts").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("RELEASE SAVEPOINT sp_[0-9]+").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err = repo.InTx(context.Background(), func(ctx context.Context) error {
+		err := repo.EnqueueWebhookEvent(ctx, models.WebhookEvent{ID: "e1", IDCompany: "c1", Type: models.WebhookBreakageCreated,
+			Payload: []byte("{}"), CreatedAt: at})
+		if err != nil {
+			return err
+		}
+		return repo.SaveWebhookAttempt(ctx,
+			models.WebhookDelivery{ID: "d1", Status: models.WebhookPending, Attempts: 1, NextAttemptAt: at, LastError: &msg, LastStatusCode: &code},
+			models.WebhookAttempt{IDDelivery: "d1", AttemptedAt: at, StatusCode: &code, Error: &msg})
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInTxRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	failed := errors.New("notification failed")
+
+	// The breakage is not kept if a later step of the unit fails.
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO breakages").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_car", "id_driver", "latitude", "longitude", "type", "id_type", "description", "created_at"}).
+			AddRow("b1", "car1", "d1", 55.7, 37.6, "flat_tire", nil, "", at))
+	mock.ExpectRollback()
+
+	err = repo.InTx(context.Background(), func(ctx context.Context) error {
+		if _, err := repo.CreateBreakage(ctx, models.Breakage{CarID: "car1", DriverID: "d1", Type: "flat_tire", CreatedAt: at}); err != nil {
+			return err
+		}
+		return failed
+	})
+	assert.ErrorIs(t, err, failed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
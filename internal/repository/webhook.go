@@ -0,0 +1,384 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+)
+
+// execer is implemented by *sql.DB and *sql.Tx, so that statements can run on
+// their own or as part of a transaction.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// webhookEndpointColumns are the columns of webhook_endpoints scanned by
+// webhookEndpointDest.
+const webhookEndpointColumns = `id, id_company, url, description, event_types, secret, active, created_at, updated_at`
+
+func webhookEndpointDest(e *models.WebhookEndpoint) []any {
+	return []any{&e.ID, &e.IDCompany, &e.URL, &e.Description, pq.Array(&e.EventTypes), &e.Secret, &e.Active, &e.CreatedAt, &e.UpdatedAt}
+}
+
+// webhookDeliveryColumns are the columns of webhook_deliveries scanned by
+// webhookDeliveryDest.
+const webhookDeliveryColumns = `d.id, d.id_endpoint, d.id_company, d.id_event, d.event_type, d.payload, d.status, d.attempts,
+	d.next_attempt_at, d.last_error, d.last_status_code, d.delivered_at, d.created_at`
+
+func webhookDeliveryDest(d *models.WebhookDelivery) []any {
+	return []any{&d.ID, &d.IDEndpoint, &d.IDCompany, &d.IDEvent, &d.EventType, &d.Payload, &d.Status, &d.Attempts,
+		&d.NextAttemptAt, &d.LastError, &d.LastStatusCode, &d.DeliveredAt, &d.CreatedAt}
+}
+
+// Webhook endpoints
+// CreateWebhook adds a webhook endpoint to the company.
+func (r *Repository) CreateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		INSERT INTO webhook_endpoints (id_company, url, description, event_types, secret, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + webhookEndpointColumns
+
+	var res models.WebhookEndpoint
+	err := r.conn(ctx).QueryRowContext(ctx, query, e.IDCompany, e.URL, e.Description, pq.Array(e.EventTypes), e.Secret, e.Active).
+		Scan(webhookEndpointDest(&res)...)
+	if err != nil {
+		return models.WebhookEndpoint{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Webhook %s created", res.ID)
+	return res, nil
+}
+
+// UpdateWebhook replaces a webhook endpoint of the company. The secret is
+// kept.
+func (r *Repository) UpdateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_endpoints
+		SET url = $3, description = $4, event_types = $5, active = $6, updated_at = now()
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + webhookEndpointColumns
+
+	var res models.WebhookEndpoint
+	err := r.conn(ctx).QueryRowContext(ctx, query, e.ID, e.IDCompany, e.URL, e.Description, pq.Array(e.EventTypes), e.Active).
+		Scan(webhookEndpointDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WebhookEndpoint{}, models.ErrWebhookNotFound
+	}
+	if err != nil {
+		return models.WebhookEndpoint{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Webhook %s updated", res.ID)
+	return res, nil
+}
+
+// DeleteWebhook removes a webhook endpoint of the company with its
+// deliveries.
+func (r *Repository) DeleteWebhook(ctx context.Context, companyID string, webhookID string) (models.WebhookEndpoint, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		DELETE FROM webhook_endpoints
+		WHERE id = $1 AND id_company = $2
+		RETURNING ` + webhookEndpointColumns
+
+	var res models.WebhookEndpoint
+	err := r.conn(ctx).QueryRowContext(ctx, query, webhookID, companyID).Scan(webhookEndpointDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WebhookEndpoint{}, models.ErrWebhookNotFound
+	}
+	if err != nil {
+		return models.WebhookEndpoint{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Webhook %s deleted", res.ID)
+	return res, nil
+}
+
+// GetWebhook returns a webhook endpoint of the company.
+func (r *Repository) GetWebhook(ctx context.Context, companyID string, webhookID string) (models.WebhookEndpoint, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE id = $1 AND id_company = $2`
+
+	var res models.WebhookEndpoint
+	err := r.conn(ctx).QueryRowContext(ctx, query, webhookID, companyID).Scan(webhookEndpointDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WebhookEndpoint{}, models.ErrWebhookNotFound
+	}
+	if err != nil {
+		return models.WebhookEndpoint{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	return res, nil
+}
+
+// GetWebhooks returns the webhook endpoints of the company, the oldest first.
+func (r *Repository) GetWebhooks(ctx context.Context, companyID string) ([]models.WebhookEndpoint, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT ` + webhookEndpointColumns + `
+		FROM webhook_endpoints
+		WHERE id_company = $1
+		ORDER BY created_at, id`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	endpoints := []models.WebhookEndpoint{}
+	for rows.Next() {
+		var e models.WebhookEndpoint
+		if err := rows.Scan(webhookEndpointDest(&e)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		endpoints = append(endpoints, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return endpoints, nil
+}
+
+// Outbox
+// EnqueueWebhookEvent queues an event for every active endpoint of its
+// company subscribed to its type.
+func (r *Repository) EnqueueWebhookEvent(ctx context.Context, e models.WebhookEvent) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	n, err := enqueueWebhookEvent(ctx, r.conn(ctx), e)
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Webhook event %s %s queued for %d endpoints", e.Type, e.ID, n)
+	return nil
+}
+
+// enqueueWebhookEvent queues e like EnqueueWebhookEvent and returns the
+// number of deliveries queued.
+func enqueueWebhookEvent(ctx context.Context, q execer, e models.WebhookEvent) (int64, error) {
+	query := `
+		INSERT INTO webhook_deliveries (id_endpoint, id_company, id_event, event_type, payload, status, next_attempt_at)
+		SELECT id, id_company, $2, $3, $4, $5, $6
+		FROM webhook_endpoints
+		WHERE id_company = $1 AND active AND (cardinality(event_types) = 0 OR $3 = ANY(event_types))
+		ON CONFLICT (id_endpoint, id_event) DO NOTHING`
+
+	res, err := q.ExecContext(ctx, query, e.IDCompany, e.ID, e.Type, string(e.Payload), models.WebhookPending, e.CreatedAt)
+	if err != nil {
+		return 0, fmt.Errorf("%w: event %s: %v", models.ErrFailedToExecuteQuery, e.ID, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return n, nil
+}
+
+// ClaimPendingWebhooks claims up to limit pending webhook deliveries due for
+// an attempt at now, the longest waiting first. A claimed delivery is not due
+// again until leaseUntil, so that concurrent workers skip it, and it is
+// picked up again should the worker die before saving the attempt.
+func (r *Repository) ClaimPendingWebhooks(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.PendingWebhook, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = $3
+		FROM webhook_endpoints e
+		WHERE e.id = d.id_endpoint AND d.id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $1 AND next_attempt_at <= $2
+			ORDER BY next_attempt_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED)
+		RETURNING ` + webhookDeliveryColumns + `, e.url, e.secret`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, models.WebhookPending, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var deliveries []models.PendingWebhook
+	for rows.Next() {
+		var d models.PendingWebhook
+		dest := append(webhookDeliveryDest(&d.WebhookDelivery), &d.URL, &d.Secret)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return deliveries, nil
+}
+
+// SaveWebhookAttempt records an attempt of a webhook delivery and its
+// outcome at once.
+func (r *Repository) SaveWebhookAttempt(ctx context.Context, d models.WebhookDelivery, a models.WebhookAttempt) error {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, last_status_code = $6, delivered_at = $7
+		WHERE id = $1`,
+		d.ID, d.Status, d.Attempts, d.NextAttemptAt, d.LastError, d.LastStatusCode, d.DeliveredAt)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO webhook_attempts (id_delivery, attempted_at, status_code, error, duration_ms)
+		VALUES ($1, $2, $3, $4, $5)`,
+		d.ID, a.AttemptedAt, a.StatusCode, a.Error, a.Duration.Milliseconds())
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	return nil
+}
+
+// Delivery log
+// GetWebhookDeliveries returns a page of the webhook deliveries of the
+// company.
+func (r *Repository) GetWebhookDeliveries(ctx context.Context, filter models.WebhookDeliveryFilter, page models.PageRequest) (models.Page[models.WebhookDelivery], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var f filterArgs
+	f.add("d.id_company = ?", filter.IDCompany)
+	if filter.IDEndpoint != nil {
+		f.add("d.id_endpoint = ?", *filter.IDEndpoint)
+	}
+	if filter.Status != nil {
+		f.add("d.status = ?", *filter.Status)
+	}
+	if filter.EventType != nil {
+		f.add("d.event_type = ?", *filter.EventType)
+	}
+
+	q := listQuery{
+		query: `
+			SELECT ` + webhookDeliveryColumns + `
+			FROM webhook_deliveries d
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"created_at":      {"created_at", "timestamp"},
+			"next_attempt_at": {"next_attempt_at", "timestamp"},
+		},
+		defaultSort: "-created_at",
+	}
+
+	return queryPage(ctx, r, q, page, webhookDeliveryDest)
+}
+
+// GetWebhookAttempts returns the attempts of a webhook delivery of the
+// company, the first first.
+func (r *Repository) GetWebhookAttempts(ctx context.Context, companyID string, deliveryID string) ([]models.WebhookAttempt, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var exists bool
+	err := r.conn(ctx).QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM webhook_deliveries WHERE id = $1 AND id_company = $2)`,
+		deliveryID, companyID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	if !exists {
+		return nil, models.ErrWebhookDeliveryNotFound
+	}
+
+	query := `
+		SELECT id, id_delivery, attempted_at, status_code, error, duration_ms
+		FROM webhook_attempts
+		WHERE id_delivery = $1
+		ORDER BY attempted_at, id`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	attempts := []models.WebhookAttempt{}
+	for rows.Next() {
+		var a models.WebhookAttempt
+		var ms int64
+		if err := rows.Scan(&a.ID, &a.IDDelivery, &a.AttemptedAt, &a.StatusCode, &a.Error, &ms); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		a.Duration = time.Duration(ms) * time.Millisecond
+		attempts = append(attempts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return attempts, nil
+}
+
+// ReplayWebhookDelivery queues a webhook delivery of the company again with
+// its attempts reset, whatever its status. The attempts made are kept in the
+// log.
+func (r *Repository) ReplayWebhookDelivery(ctx context.Context, companyID string, deliveryID string) (models.WebhookDelivery, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_deliveries d
+		SET status = $3, attempts = 0, next_attempt_at = now(), delivered_at = NULL
+		WHERE d.id = $1 AND d.id_company = $2
+		RETURNING ` + webhookDeliveryColumns
+
+	var res models.WebhookDelivery
+	err := r.conn(ctx).QueryRowContext(ctx, query, deliveryID, companyID, models.WebhookPending).Scan(webhookDeliveryDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WebhookDelivery{}, models.ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return models.WebhookDelivery{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Webhook delivery %s replayed", res.ID)
+	return res, nil
+}
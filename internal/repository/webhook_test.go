@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueueWebhookEvent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	payload := `{"id":"e1","type":"breakage.created"}`
+
+	mock.ExpectExec("INSERT INTO webhook_deliveries(.+)FROM webhook_endpoints(.+)ANY\\(event_types\\)").
+		WithArgs("c1", "e1", models.WebhookBreakageCreated, payload, models.WebhookPending, at).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.EnqueueWebhookEvent(context.Background(), models.WebhookEvent{
+		ID:        "e1",
+		IDCompany: "c1",
+		Type:      models.WebhookBreakageCreated,
+		Payload:   []byte(payload),
+		CreatedAt: at,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRouteNotificationsWithWebhookEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+
+	// The events are queued in the transaction marking the notifications
+	// routed, so that a notification is announced exactly once.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO webhook_deliveries").
+		WithArgs("c1", "e1", models.WebhookNotificationCreated, "{}", models.WebhookPending, at).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE notifications SET routed_at").
+		WithArgs(pq.Array([]string{"n1"})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.RouteNotifications(context.Background(), models.Routing{
+		Events: []models.WebhookEvent{{
+			ID:        "e1",
+			IDCompany: "c1",
+			Type:      models.WebhookNotificationCreated,
+			Payload:   []byte("{}"),
+			CreatedAt: at,
+		}},
+		NotificationIDs: []string{"n1"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimPendingWebhooks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	lease := now.Add(20 * time.Minute)
+
+	mock.ExpectQuery("UPDATE webhook_deliveries d\\s+SET next_attempt_at = \\$3(.+)FOR UPDATE SKIP LOCKED\\)\\s+RETURNING").
+		WithArgs(models.WebhookPending, now, lease, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_endpoint", "id_company", "id_event", "event_type", "payload", "status", "attempts",
+			"next_attempt_at", "last_error", "last_status_code", "delivered_at", "created_at", "url", "secret"}).
+			AddRow("d1", "w1", "c1", "e1", models.WebhookBreakageCreated, []byte("{}"), models.WebhookPending, 1,
+				lease, nil, nil, nil, now, "https://example.com/hook", "secret"))
+
+	pending, err := repo.ClaimPendingWebhooks(context.Background(), now, lease, 100)
+	assert.NoError(t, err)
+	if assert.Len(t, pending, 1) {
+		assert.Equal(t, "d1", pending[0].ID)
+		assert.Equal(t, lease, pending[0].NextAttemptAt)
+		assert.Equal(t, "https://example.com/hook", pending[0].URL)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveWebhookAttempt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	at := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	next := at.Add(time.Minute)
+	code := 503
+	msg := "example.com responded 503 Service Unavailable"
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE webhook_deliveries").
+		WithArgs("d1", models.WebhookPending, 2, next, &msg, &code, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO webhook_attempts").
+		WithArgs("d1", at, &code, &msg, int64(250)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.SaveWebhookAttempt(context.Background(),
+		models.WebhookDelivery{ID: "d1", Status: models.WebhookPending, Attempts: 2, NextAttemptAt: next, LastError: &msg, LastStatusCode: &code},
+		models.WebhookAttempt{IDDelivery: "d1", AttemptedAt: at, StatusCode: &code, Error: &msg, Duration: 250 * time.Millisecond})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReplayWebhookDeliveryNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	mock.ExpectQuery("UPDATE webhook_deliveries d").
+		WithArgs("d1", "c2", models.WebhookPending).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.ReplayWebhookDelivery(context.Background(), "c2", "d1")
+	assert.ErrorIs(t, err, models.ErrWebhookDeliveryNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
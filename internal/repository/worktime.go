@@ -0,0 +1,420 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/lib/pq"
+)
+
+// workSessionColumns are the columns of a work session joined with its car,
+// in the order of workSessionDest.
+const workSessionColumns = `
+	w.id,
+	w.id_company,
+	w.id_driver,
+	w.id_car,
+	COALESCE(c.state_number, '') AS state_number,
+	w.source,
+	w.started_at,
+	w.ended_at`
+
+func workSessionDest(s *models.WorkSession) []any {
+	return []any{&s.ID, &s.IDCompany, &s.IDDriver, &s.IDCar, &s.StateNumber, &s.Source, &s.StartedAt, &s.EndedAt}
+}
+
+// Work sessions
+// UpdateDriverWorktime records workedTime minutes of work ending at endedAt,
+// reported by the device of a car, as a session of the driver assigned to the
+// car then. The session starts no earlier than the end of the driver's
+// previous one, so that overlapping reports are not counted twice. It returns
+// the recorded session.
+func (r *Repository) UpdateDriverWorktime(ctx context.Context, deviceNum string, workedTime int, endedAt time.Time) (models.WorkSession, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		WITH assignment AS (
+			SELECT a.id_company, a.id_driver, a.id_car
+			FROM driver_assignments a
+			JOIN cars c ON c.id = a.id_car
+			WHERE c.device_number = $2 AND a.started_at <= $3 AND (a.ended_at IS NULL OR a.ended_at >= $3)
+		),
+		w AS (
+			INSERT INTO work_sessions (id_company, id_driver, id_car, source, started_at, ended_at)
+			SELECT a.id_company, a.id_driver, a.id_car, $4,
+				LEAST($3, GREATEST($3 - make_interval(mins => $1), COALESCE((
+					SELECT MAX(p.ended_at) FROM work_sessions p WHERE p.id_driver = a.id_driver AND p.ended_at <= $3
+				), '-infinity'))),
+				$3
+			FROM assignment a
+			RETURNING id, id_company, id_driver, id_car, source, started_at, ended_at
+		)
+		UPDATE drivers d
+		SET worked_time = COALESCE(d.worked_time, 0) + (EXTRACT(EPOCH FROM w.ended_at - w.started_at) / 60)::int
+		FROM w
+		WHERE d.id = w.id_driver
+		RETURNING w.id, w.id_company, w.id_driver, w.id_car, w.source, w.started_at, w.ended_at`
+
+	var session models.WorkSession
+	err := r.conn(ctx).QueryRowContext(ctx, query, workedTime, deviceNum, endedAt, models.WorkSourceDevice).Scan(
+		&session.ID, &session.IDCompany, &session.IDDriver, &session.IDCar, &session.Source, &session.StartedAt, &session.EndedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WorkSession{}, models.ErrDriverNotFound
+	}
+	if err != nil {
+		return models.WorkSession{}, fmt.Errorf("failed to update driver worktime: %w", err)
+	}
+
+	return session, nil
+}
+
+// StartWorkSession records a session of a driver of the company. Without a
+// car, the session is on the car the driver was assigned to when it started.
+// A session with EndedAt set is recorded as ended and adds to the driver's
+// worked time right away.
+func (r *Repository) StartWorkSession(ctx context.Context, session models.WorkSession) (models.WorkSession, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return models.WorkSession{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	checkQuery := `
+		SELECT
+			EXISTS (SELECT 1 FROM drivers WHERE id = $1 AND id_company = $3),
+			$2::uuid IS NULL OR EXISTS (SELECT 1 FROM cars WHERE id = $2 AND id_company = $3),
+			EXISTS (
+				SELECT 1 FROM work_sessions
+				WHERE id_driver = $1 AND (ended_at IS NULL OR ended_at > $4) AND ($5::timestamp IS NULL OR started_at < $5)
+			),
+			EXISTS (SELECT 1 FROM drivers WHERE id = $1 AND deactivated_at IS NOT NULL)`
+
+	var driverExists, carExists, conflict, deactivated bool
+	err = tx.QueryRowContext(ctx, checkQuery, session.IDDriver, session.IDCar, session.IDCompany, session.StartedAt, session.EndedAt).
+		Scan(&driverExists, &carExists, &conflict, &deactivated)
+	if err != nil {
+		return models.WorkSession{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	switch {
+	case !driverExists:
+		return models.WorkSession{}, models.ErrDriverNotFound
+	case deactivated:
+		return models.WorkSession{}, models.ErrDriverDeactivated
+	case !carExists:
+		return models.WorkSession{}, fmt.Errorf("%w: car %s", models.ErrNoContent, *session.IDCar)
+	case conflict:
+		return models.WorkSession{}, models.ErrWorkSessionConflict
+	}
+
+	insertQuery := `
+		WITH w AS (
+			INSERT INTO work_sessions (id_company, id_driver, id_car, source, started_at, ended_at)
+			VALUES ($1, $2, COALESCE($3, (
+				SELECT id_car FROM driver_assignments
+				WHERE id_driver = $2 AND started_at <= $5 AND (ended_at IS NULL OR ended_at > $5)
+			)), $4, $5, $6)
+			RETURNING *
+		),
+		worked AS (
+			UPDATE drivers d
+			SET worked_time = COALESCE(d.worked_time, 0) + (EXTRACT(EPOCH FROM w.ended_at - w.started_at) / 60)::int
+			FROM w
+			WHERE d.id = w.id_driver AND w.ended_at IS NOT NULL
+		)
+		SELECT ` + workSessionColumns + `
+		FROM w
+		LEFT JOIN cars c ON c.id = w.id_car`
+
+	var res models.WorkSession
+	err = tx.QueryRowContext(ctx, insertQuery,
+		session.IDCompany, session.IDDriver, session.IDCar, session.Source, session.StartedAt, session.EndedAt).
+		Scan(workSessionDest(&res)...)
+	if err != nil {
+		return models.WorkSession{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.WorkSession{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Work session %s of driver %s started at %s", res.ID, res.IDDriver, res.StartedAt)
+	return res, nil
+}
+
+// EndWorkSession ends the open session of a driver of the company and adds it
+// to the driver's worked time.
+func (r *Repository) EndWorkSession(ctx context.Context, companyID string, driverID string, endedAt time.Time) (models.WorkSession, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	query := `
+		WITH w AS (
+			UPDATE work_sessions
+			SET ended_at = GREATEST(started_at, $3)
+			WHERE id_driver = $1 AND id_company = $2 AND ended_at IS NULL
+			RETURNING *
+		),
+		worked AS (
+			UPDATE drivers d
+			SET worked_time = COALESCE(d.worked_time, 0) + (EXTRACT(EPOCH FROM w.ended_at - w.started_at) / 60)::int
+			FROM w
+			WHERE d.id = w.id_driver
+		)
+		SELECT ` + workSessionColumns + `
+		FROM w
+		LEFT JOIN cars c ON c.id = w.id_car`
+
+	var res models.WorkSession
+	err := r.conn(ctx).QueryRowContext(ctx, query, driverID, companyID, endedAt).Scan(workSessionDest(&res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WorkSession{}, models.ErrWorkSessionNotOpen
+	}
+	if err != nil {
+		return models.WorkSession{}, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Work session %s of driver %s ended", res.ID, res.IDDriver)
+	return res, nil
+}
+
+// workSessionFilter returns the conditions of filter. Without a company, the
+// sessions of all companies are selected.
+func workSessionFilter(filter models.WorkSessionFilter) filterArgs {
+	var f filterArgs
+	if filter.IDCompany != "" {
+		f.add("w.id_company = ?", filter.IDCompany)
+	}
+	if filter.IDDriver != nil {
+		f.add("w.id_driver = ?", *filter.IDDriver)
+	}
+	if filter.From != nil {
+		f.add("(w.ended_at IS NULL OR w.ended_at > ?)", *filter.From)
+	}
+	if filter.To != nil {
+		f.add("w.started_at < ?", *filter.To)
+	}
+	return f
+}
+
+// GetWorkSessions returns a page of the company's work sessions selected by
+// filter, the latest first by default.
+func (r *Repository) GetWorkSessions(ctx context.Context, filter models.WorkSessionFilter, page models.PageRequest) (models.Page[models.WorkSession], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	f := workSessionFilter(filter)
+	q := listQuery{
+		query: `
+			SELECT ` + workSessionColumns + `
+			FROM work_sessions w
+			LEFT JOIN cars c ON c.id = w.id_car
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"started_at": {"started_at", "timestamp"},
+		},
+		defaultSort: "-started_at",
+	}
+
+	sessions, err := queryPage(ctx, r, q, page, workSessionDest)
+	if err != nil {
+		return sessions, fmt.Errorf("failed to get work sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// GetWorkSessionsInPeriod returns all the work sessions selected by filter,
+// ordered by driver and start. filter.From is required.
+func (r *Repository) GetWorkSessionsInPeriod(ctx context.Context, filter models.WorkSessionFilter) ([]models.WorkSession, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpReport)
+	defer cancel()
+
+	f := workSessionFilter(filter)
+	query := `
+		SELECT ` + workSessionColumns + `
+		FROM work_sessions w
+		LEFT JOIN cars c ON c.id = w.id_car
+		` + f.where() + `
+		ORDER BY w.id_driver, w.started_at`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, f.args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	var sessions []models.WorkSession
+	for rows.Next() {
+		var s models.WorkSession
+		if err := rows.Scan(workSessionDest(&s)...); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return sessions, nil
+}
+
+// GetCompanyTimezones returns the UTC offsets in hours of the companies.
+func (r *Repository) GetCompanyTimezones(ctx context.Context, companyIDs []string) (map[string]int, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	query := `
+		SELECT id, COALESCE(utc_timezone, 0)
+		FROM users
+		WHERE id = ANY($1::uuid[])`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(companyIDs))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	timezones := make(map[string]int, len(companyIDs))
+	for rows.Next() {
+		var id string
+		var offset int
+		if err := rows.Scan(&id, &offset); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrFailedToScanRow, err)
+		}
+		timezones[id] = offset
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToIterateRows, err)
+	}
+
+	return timezones, nil
+}
+
+// Work violations
+// SaveWorkViolations records the violations and raises a notification for
+// each one that was not recorded yet. A violation already recorded for the
+// same driver, type and period start is extended instead. It returns the
+// newly recorded violations.
+func (r *Repository) SaveWorkViolations(ctx context.Context, violations []models.WorkViolation) ([]models.WorkViolation, error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpWrite)
+	defer cancel()
+
+	tx, err := r.begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		WITH violation AS (
+			INSERT INTO work_violations (id_company, id_driver, type, period_start, period_end, limit_minutes, actual_minutes)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (id_driver, type, period_start) DO UPDATE SET
+				period_end = GREATEST(work_violations.period_end, EXCLUDED.period_end),
+				actual_minutes = GREATEST(work_violations.actual_minutes, EXCLUDED.actual_minutes)
+			RETURNING id, id_company, created_at, xmax = 0 AS inserted
+		),
+		notification AS (
+			INSERT INTO notifications (id_user, id_work_violation, type, severity, note, status, created_at)
+			SELECT id_company, id, $10, $11, $8, $9, created_at
+			FROM violation
+			WHERE inserted
+		)
+		SELECT id, created_at, inserted FROM violation`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+	defer stmt.Close()
+
+	var created []models.WorkViolation
+	for _, v := range violations {
+		var inserted bool
+		err := stmt.QueryRowContext(ctx,
+			v.IDCompany, v.IDDriver, v.Type, v.PeriodStart, v.PeriodEnd, v.LimitMinutes, v.ActualMinutes, v.Note, models.StatusNew,
+			models.EventWorkViolation, models.NotificationSeverity[models.EventWorkViolation]).
+			Scan(&v.ID, &v.CreatedAt, &inserted)
+		if err != nil {
+			return nil, fmt.Errorf("%w: driver %s: %v", models.ErrFailedToExecuteQuery, v.IDDriver, err)
+		}
+		if inserted {
+			created = append(created, v)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrFailedToExecuteQuery, err)
+	}
+
+	logging.FromContext(ctx, r.log).Debugf("Recorded %d new of %d work violations", len(created), len(violations))
+	return created, nil
+}
+
+// GetWorkViolations returns a page of the company's work violations selected
+// by filter, the latest first by default.
+func (r *Repository) GetWorkViolations(ctx context.Context, filter models.WorkViolationFilter, page models.PageRequest) (models.Page[models.WorkViolation], error) {
+	ctx, cancel := r.timeouts.WithTimeout(ctx, OpRead)
+	defer cancel()
+
+	var f filterArgs
+	f.add("v.id_company = ?", filter.IDCompany)
+	if filter.IDDriver != nil {
+		f.add("v.id_driver = ?", *filter.IDDriver)
+	}
+	if filter.Type != nil {
+		f.add("v.type = ?", *filter.Type)
+	}
+	if filter.From != nil {
+		f.add("v.period_end > ?", *filter.From)
+	}
+	if filter.To != nil {
+		f.add("v.period_start < ?", *filter.To)
+	}
+
+	q := listQuery{
+		query: `
+			SELECT
+				v.id,
+				v.id_company,
+				v.id_driver,
+				v.type,
+				v.period_start,
+				v.period_end,
+				v.limit_minutes,
+				v.actual_minutes,
+				COALESCE(n.note, '') AS note,
+				v.created_at
+			FROM work_violations v
+			LEFT JOIN notifications n ON n.id_work_violation = v.id
+			` + f.where(),
+		args:     f.args,
+		idColumn: "id",
+		sortFields: map[string]sortField{
+			"period_start": {"period_start", "timestamp"},
+			"created_at":   {"created_at", "timestamp"},
+		},
+		defaultSort: "-period_start",
+	}
+
+	violations, err := queryPage(ctx, r, q, page, func(v *models.WorkViolation) []any {
+		return []any{&v.ID, &v.IDCompany, &v.IDDriver, &v.Type, &v.PeriodStart, &v.PeriodEnd,
+			&v.LimitMinutes, &v.ActualMinutes, &v.Note, &v.CreatedAt}
+	})
+	if err != nil {
+		return violations, fmt.Errorf("failed to get work violations: %w", err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateDriverWorktimeWithoutAssignment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	endedAt := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("WITH assignment AS (.+) INSERT INTO work_sessions (.+) UPDATE drivers d").
+		WithArgs(30, "dev1", endedAt, models.WorkSourceDevice).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = repo.UpdateDriverWorktime(context.Background(), "dev1", 30, endedAt)
+	assert.ErrorIs(t, err, models.ErrDriverNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStartWorkSessionConflict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	session := models.WorkSession{
+		IDCompany: "c1",
+		IDDriver:  "d1",
+		Source:    models.WorkSourceManual,
+		StartedAt: time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC),
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM work_sessions").
+		WithArgs("d1", nil, "c1", session.StartedAt, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"driver", "car", "conflict", "deactivated"}).AddRow(true, true, true, false))
+	mock.ExpectRollback()
+
+	_, err = repo.StartWorkSession(context.Background(), session)
+	assert.ErrorIs(t, err, models.ErrWorkSessionConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStartWorkSession(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	startedAt := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	session := models.WorkSession{
+		IDCompany: "c1",
+		IDDriver:  "d1",
+		Source:    models.WorkSourceManual,
+		StartedAt: startedAt,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM work_sessions").
+		WithArgs("d1", nil, "c1", startedAt, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"driver", "car", "conflict", "deactivated"}).AddRow(true, true, false, false))
+	mock.ExpectQuery("WITH w AS \\(\\s*INSERT INTO work_sessions").
+		WithArgs("c1", "d1", nil, models.WorkSourceManual, startedAt, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_company", "id_driver", "id_car", "state_number", "source", "started_at", "ended_at"}).
+			AddRow("w1", "c1", "d1", "car1", "A123BC", models.WorkSourceManual, startedAt, nil))
+	mock.ExpectCommit()
+
+	res, err := repo.StartWorkSession(context.Background(), session)
+	assert.NoError(t, err)
+	car := "car1"
+	assert.Equal(t, models.WorkSession{
+		ID: "w1", IDCompany: "c1", IDDriver: "d1", IDCar: &car, StateNumber: "A123BC",
+		Source: models.WorkSourceManual, StartedAt: startedAt,
+	}, res)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEndWorkSessionNotOpen(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	endedAt := time.Date(2026, 3, 2, 17, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("UPDATE work_sessions").
+		WithArgs("d1", "c1", endedAt).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.EndWorkSession(context.Background(), "c1", "d1", endedAt)
+	assert.ErrorIs(t, err, models.ErrWorkSessionNotOpen)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveWorkViolations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	logger := logrus.New()
+	repo := NewRepository(db, logger, Timeouts{})
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 3, 3, 0, 15, 0, 0, time.UTC)
+	violations := []models.WorkViolation{
+		{IDCompany: "c1", IDDriver: "d1", Type: models.WorkViolationDailyDriving,
+			PeriodStart: start, PeriodEnd: start.Add(24 * time.Hour), LimitMinutes: 540, ActualMinutes: 600, Note: "daily"},
+		{IDCompany: "c1", IDDriver: "d2", Type: models.WorkViolationContinuousDriving,
+			PeriodStart: start, PeriodEnd: start.Add(5 * time.Hour), LimitMinutes: 270, ActualMinutes: 300, Note: "continuous"},
+	}
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare("INSERT INTO work_violations (.+) INSERT INTO notifications")
+	prep.ExpectQuery().
+		WithArgs("c1", "d1", models.WorkViolationDailyDriving, start, start.Add(24*time.Hour), 540, 600, "daily", models.StatusNew,
+			models.EventWorkViolation, models.SeverityMedium).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "inserted"}).AddRow("v1", createdAt, true))
+	prep.ExpectQuery().
+		WithArgs("c1", "d2", models.WorkViolationContinuousDriving, start, start.Add(5*time.Hour), 270, 300, "continuous", models.StatusNew,
+			models.EventWorkViolation, models.SeverityMedium).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "inserted"}).AddRow("v2", createdAt, false))
+	mock.ExpectCommit()
+
+	res, err := repo.SaveWorkViolations(context.Background(), violations)
+	assert.NoError(t, err)
+	if assert.Len(t, res, 1) {
+		assert.Equal(t, "v1", res[0].ID)
+		assert.Equal(t, createdAt, res[0].CreatedAt)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
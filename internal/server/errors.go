@@ -0,0 +1,168 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/VikaPaz/algalar/internal/tracing"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrorResponse is the body of every failed request.
+type ErrorResponse struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Details   any    `json:"details,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	TraceID   string `json:"trace_id,omitempty"`
+}
+
+type errorMapping struct {
+	err    error
+	status int
+	code   string
+}
+
+// errorMappings is checked in order, so the more specific client errors come
+// before the generic "failed to ..." ones that usually wrap them.
+var errorMappings = []errorMapping{
+	{models.ErrInvalidCredentials, http.StatusUnauthorized, "invalid_credentials"},
+	{models.ErrUnauthorized, http.StatusUnauthorized, "unauthorized"},
+	{models.ErrUnauthorizedRequest, http.StatusUnauthorized, "unauthorized"},
+	{models.ErrInvalidContext, http.StatusUnauthorized, "unauthorized"},
+	{models.ErrInvalidRefreshToken, http.StatusUnauthorized, "invalid_token"},
+	{models.ErrInvalidChallengeToken, http.StatusUnauthorized, "invalid_token"},
+	{models.ErrInvalidTOTPCode, http.StatusUnauthorized, "invalid_totp_code"},
+	{models.ErrTwoFactorRequired, http.StatusForbidden, "two_factor_required"},
+	{models.ErrTwoFactorNotEnabled, http.StatusConflict, "two_factor_not_enabled"},
+	{models.ErrTwoFactorAlreadyEnabled, http.StatusConflict, "two_factor_already_enabled"},
+	{models.ErrTooManyTOTPAttempts, http.StatusTooManyRequests, "too_many_totp_attempts"},
+	{models.ErrNoContent, http.StatusNotFound, "not_found"},
+	{models.ErrDriverNotFound, http.StatusNotFound, "not_found"},
+	{sql.ErrNoRows, http.StatusNotFound, "not_found"},
+	{models.ErrAlreadyExists, http.StatusConflict, "already_exists"},
+	{models.ErrDriverNotAssigned, http.StatusConflict, "driver_not_assigned"},
+	{models.ErrAssignmentConflict, http.StatusConflict, "assignment_conflict"},
+	{models.ErrWorkSessionConflict, http.StatusConflict, "work_session_conflict"},
+	{models.ErrWorkSessionNotOpen, http.StatusConflict, "work_session_not_open"},
+	{models.ErrDriverDeactivated, http.StatusConflict, "driver_deactivated"},
+	{models.ErrDocumentNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrBreakageNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrInvalidStatusTransition, http.StatusConflict, "invalid_status_transition"},
+	{models.ErrBreakageTypeNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrBreakageCodeTaken, http.StatusConflict, "breakage_code_taken"},
+	{models.ErrMaintenancePlanNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrMaintenanceTaskNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrMaintenanceTaskNotDue, http.StatusConflict, "maintenance_task_not_due"},
+	{models.ErrWorkOrderNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrWorkOrderClosed, http.StatusConflict, "work_order_closed"},
+	{models.ErrNotificationRuleNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrNotificationNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrGeofenceNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrWebhookNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrWebhookDeliveryNotFound, http.StatusNotFound, "not_found"},
+	{models.ErrIdempotencyKeyInProgress, http.StatusConflict, "idempotency_key_in_progress"},
+	{models.ErrIdempotencyKeyReused, http.StatusUnprocessableEntity, "idempotency_key_reused"},
+	{models.ErrLoginOrPassword, http.StatusBadRequest, "invalid_input"},
+	{models.ErrInvalidInput, http.StatusBadRequest, "invalid_input"},
+	{models.ErrInvalidRequestBody, http.StatusBadRequest, "invalid_request_body"},
+	{models.ErrInvalidParameter, http.StatusBadRequest, "invalid_parameter"},
+	{models.ErrInvalidCursor, http.StatusBadRequest, "invalid_cursor"},
+	{models.ErrInvalidSort, http.StatusBadRequest, "invalid_sort"},
+	{models.ErrImportRejected, http.StatusUnprocessableEntity, "import_rejected"},
+	{models.ErrInvalidPointFormat, http.StatusBadRequest, "invalid_input"},
+	{models.ErrInvalidPoints, http.StatusBadRequest, "invalid_input"},
+	{models.ErrInvalidUUID, http.StatusBadRequest, "invalid_input"},
+	{models.ErrInvalidCarID, http.StatusBadRequest, "invalid_input"},
+}
+
+// internalErrors are safe to show as the message of a 500 response. Anything
+// else, including raw database errors, is reported as "internal server error".
+var internalErrors = []error{
+	models.ErrFailedToFetchBreakages,
+	models.ErrFailedToCreateBreakage,
+	models.ErrFailedToCreateNotification,
+	models.ErrFailedToFetchCars,
+	models.ErrFailedToFetchPositions,
+	models.ErrFailedToFetchCarData,
+	models.ErrFailedToFetchRoutePositions,
+	models.ErrFailedToFetchCarPositions,
+	models.ErrFailedToFetchDriver,
+	models.ErrFailedToFetchCar,
+	models.ErrFailedToCreatePosition,
+	models.ErrFailedToUpdateCurrentPosition,
+	models.ErrFailedToRetrieveNotifications,
+	models.ErrFailedToUpdateMileage,
+	models.ErrUserUpdateFailed,
+}
+
+// detailedError attaches client-facing details to an error.
+type detailedError struct {
+	err     error
+	details any
+}
+
+func (e *detailedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *detailedError) Unwrap() error {
+	return e.err
+}
+
+func withDetails(err error, details any) error {
+	return &detailedError{err: err, details: details}
+}
+
+// writeError logs err and writes it as an ErrorResponse with the status mapped from its sentinel.
+func (s *ServImplemented) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, res := toErrorResponse(err)
+	res.RequestID, _ = r.Context().Value(models.RequestIDKey).(string)
+	res.TraceID = tracing.TraceID(r.Context())
+
+	span := trace.SpanFromContext(r.Context())
+	span.RecordError(err)
+	if status >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, err.Error())
+		logging.FromContext(r.Context(), s.log).Errorf("%s %s: %v", r.Method, r.URL.Path, err)
+	} else {
+		logging.FromContext(r.Context(), s.log).Warnf("%s %s: %v", r.Method, r.URL.Path, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(res)
+}
+
+// HandleParamError reports parameter binding errors of the generated router.
+func (s *ServImplemented) HandleParamError(w http.ResponseWriter, r *http.Request, err error) {
+	s.writeError(w, r, withDetails(models.ErrInvalidParameter, err.Error()))
+}
+
+func toErrorResponse(err error) (int, ErrorResponse) {
+	var details any
+	var detailed *detailedError
+	if errors.As(err, &detailed) {
+		details = detailed.details
+	}
+
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			return m.status, ErrorResponse{Code: m.code, Message: m.err.Error(), Details: details}
+		}
+	}
+
+	message := "internal server error"
+	for _, internal := range internalErrors {
+		if errors.Is(err, internal) {
+			message = internal.Error()
+			break
+		}
+	}
+	return http.StatusInternalServerError, ErrorResponse{Code: "internal_error", Message: message}
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var errDraining = errors.New("server is shutting down")
+
+// ReadinessCheck is a dependency that must be available before the
+// orchestrator routes traffic to this instance.
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type HealthResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Health serves the liveness and readiness probes.
+type Health struct {
+	checks   []ReadinessCheck
+	timeout  time.Duration
+	log      *logrus.Logger
+	draining atomic.Bool
+}
+
+func NewHealth(timeout time.Duration, logger *logrus.Logger, checks ...ReadinessCheck) *Health {
+	return &Health{
+		checks:  checks,
+		timeout: timeout,
+		log:     logger,
+	}
+}
+
+// SetDraining fails readiness so that the orchestrator stops routing new
+// requests while in-flight ones finish.
+func (h *Health) SetDraining() {
+	h.draining.Store(true)
+}
+
+// Liveness reports that the process is running.
+// (GET /healthz)
+func (h *Health) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, http.StatusOK, HealthResponse{Status: "ok"})
+}
+
+// Readiness runs every readiness check and fails if any of them does. The
+// probe is unauthenticated, so failures are logged and reported only as
+// unavailable.
+// (GET /readyz)
+func (h *Health) Readiness(w http.ResponseWriter, r *http.Request) {
+	if h.draining.Load() {
+		writeHealth(w, http.StatusServiceUnavailable, HealthResponse{Status: errDraining.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	res := HealthResponse{
+		Status: "ok",
+		Checks: make(map[string]string, len(h.checks)),
+	}
+	status := http.StatusOK
+	for _, check := range h.checks {
+		if err := check.Check(ctx); err != nil {
+			h.log.Warnf("Readiness check %s failed: %v", check.Name, err)
+			res.Checks[check.Name] = "unavailable"
+			res.Status = "unavailable"
+			status = http.StatusServiceUnavailable
+			continue
+		}
+		res.Checks[check.Name] = "ok"
+	}
+
+	writeHealth(w, status, res)
+}
+
+func writeHealth(w http.ResponseWriter, status int, res HealthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(res)
+}
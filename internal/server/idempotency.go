@@ -0,0 +1,125 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKey        = 255
+)
+
+// idempotentRoutes are the ingestion endpoints that honour the
+// Idempotency-Key header, as devices retry them on network errors.
+var idempotentRoutes = map[string]bool{
+	"POST /breakage":   true,
+	"POST /position":   true,
+	"POST /sensordata": true,
+}
+
+// IdempotencyMiddleware makes requests to the ingestion endpoints sent with an
+// Idempotency-Key header safe to retry: the first request with a key is
+// handled and its response stored, and later ones with the same key and body
+// get that response back without being handled again. Server errors are not
+// stored, so that the request can be retried with the same key.
+//
+// It relies on the route pattern, so it must run as a handler middleware.
+func (s *ServImplemented) IdempotencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		route := r.Method + " " + chi.RouteContext(r.Context()).RoutePattern()
+		if key == "" || !idempotentRoutes[route] {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKey {
+			s.writeError(w, r, withDetails(models.ErrInvalidInput,
+				fmt.Sprintf("%s must be at most %d characters", idempotencyKeyHeader, maxIdempotencyKey)))
+			return
+		}
+
+		ctx, err := s.getUserID(r)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		hash := sha256.Sum256(body)
+		k, err := s.service.ClaimIdempotencyKey(ctx, models.IdempotencyKey{
+			Key:         key,
+			Route:       route,
+			RequestHash: hex.EncodeToString(hash[:]),
+		}, s.conf.IdempotencyTTL)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		if k.StatusCode != nil {
+			if k.ContentType != "" {
+				w.Header().Set("Content-Type", k.ContentType)
+			}
+			w.Header().Set(idempotentReplayedHeader, "true")
+			w.WriteHeader(*k.StatusCode)
+			if _, err := w.Write(k.Response); err != nil {
+				logging.FromContext(ctx, s.log).Errorf("Failed to replay response: %v", err)
+			}
+			return
+		}
+
+		// The key is released unless the response is stored, also if the
+		// handler panics, so that it does not block retries until it expires.
+		stored := false
+		defer func() {
+			if stored {
+				return
+			}
+			if err := s.service.ReleaseIdempotencyKey(ctx, k); err != nil {
+				logging.FromContext(ctx, s.log).Errorf("Failed to release idempotency key %s: %v", key, err)
+			}
+		}()
+
+		rec := &responseCapture{statusRecorder: statusRecorder{ResponseWriter: w, status: http.StatusOK}}
+		next.ServeHTTP(rec, r)
+		if rec.status >= http.StatusInternalServerError {
+			return
+		}
+
+		k.StatusCode = &rec.status
+		k.ContentType = rec.Header().Get("Content-Type")
+		k.Response = rec.body.Bytes()
+		if err := s.service.SaveIdempotentResponse(ctx, k); err != nil {
+			logging.FromContext(ctx, s.log).Errorf("Failed to save response to idempotency key %s: %v", key, err)
+			return
+		}
+		stored = true
+	})
+}
+
+// responseCapture keeps a copy of the response it writes.
+type responseCapture struct {
+	statusRecorder
+	body bytes.Buffer
+}
+
+func (c *responseCapture) Write(b []byte) (int, error) {
+	c.body.Write(b)
+	return c.statusRecorder.Write(b)
+}
@@ -0,0 +1,406 @@
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/VikaPaz/algalar/internal/server/rest"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/tealeg/xlsx"
+)
+
+const (
+	maxImportFileSize = 10 << 20
+	maxImportRows     = 5000
+	xlsxContentType   = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	importDateLayout  = "2006-01-02"
+)
+
+// importColumn is a column of an import file that fills a field of T. The
+// columns are named after the fields of the JSON request creating a single
+// record, so that both share their validation messages.
+type importColumn[T any] struct {
+	name string
+	set  func(row *T, value string) error
+}
+
+// wheelImportRow is a wheel registration referring to its car by state
+// number instead of ID.
+type wheelImportRow struct {
+	rest.WheelRegistration
+	StateNumber string
+}
+
+var carImportColumns = []importColumn[rest.AutoRegistration]{
+	{"stateNumber", importString(func(r *rest.AutoRegistration) *string { return &r.StateNumber })},
+	{"brand", importString(func(r *rest.AutoRegistration) *string { return &r.Brand })},
+	{"autoType", importString(func(r *rest.AutoRegistration) *string { return &r.AutoType })},
+	{"axleCount", importInt(func(r *rest.AutoRegistration) *int { return &r.AxleCount })},
+	{"deviceNumber", importString(func(r *rest.AutoRegistration) *string { return &r.DeviceNumber })},
+	{"uniqueId", importString(func(r *rest.AutoRegistration) *string { return &r.UniqueId })},
+}
+
+var wheelImportColumns = []importColumn[wheelImportRow]{
+	{"stateNumber", importString(func(r *wheelImportRow) *string { return &r.StateNumber })},
+	{"axleNumber", importInt(func(r *wheelImportRow) *int { return &r.AxleNumber })},
+	{"wheelPosition", importInt(func(r *wheelImportRow) *int { return &r.WheelPosition })},
+	{"sensorNumber", importString(func(r *wheelImportRow) *string { return &r.SensorNumber })},
+	{"tireBrand", importString(func(r *wheelImportRow) *string { return &r.TireBrand })},
+	{"tireModel", importString(func(r *wheelImportRow) *string { return &r.TireModel })},
+	{"tireSize", importFloat(func(r *wheelImportRow) *float32 { return &r.TireSize })},
+	{"tireCost", importFloat(func(r *wheelImportRow) *float32 { return &r.TireCost })},
+	{"mileage", importFloat(func(r *wheelImportRow) *float32 { return &r.Mileage })},
+	{"minPressure", importFloat(func(r *wheelImportRow) *float32 { return &r.MinPressure })},
+	{"maxPressure", importFloat(func(r *wheelImportRow) *float32 { return &r.MaxPressure })},
+	{"minTemperature", importFloat(func(r *wheelImportRow) *float32 { return &r.MinTemperature })},
+	{"maxTemperature", importFloat(func(r *wheelImportRow) *float32 { return &r.MaxTemperature })},
+	{"ngp", importFloat(func(r *wheelImportRow) *float32 { return &r.Ngp })},
+	{"tkvh", importFloat(func(r *wheelImportRow) *float32 { return &r.Tkvh })},
+}
+
+var driverImportColumns = []importColumn[rest.DriverRegistration]{
+	{"state_number", importString(func(r *rest.DriverRegistration) *string { return &r.StateNumber })},
+	{"surname", importString(func(r *rest.DriverRegistration) *string { return &r.Surname })},
+	{"name", importString(func(r *rest.DriverRegistration) *string { return &r.Name })},
+	{"middle_name", importString(func(r *rest.DriverRegistration) *string { return &r.MiddleName })},
+	{"phone", importString(func(r *rest.DriverRegistration) *string { return &r.Phone })},
+	{"birthday", importDate(func(r *rest.DriverRegistration) *openapi_types.Date { return &r.Birthday })},
+}
+
+func importString[T any](field func(*T) *string) func(*T, string) error {
+	return func(row *T, v string) error {
+		*field(row) = v
+		return nil
+	}
+}
+
+func importInt[T any](field func(*T) *int) func(*T, string) error {
+	return func(row *T, v string) error {
+		if v == "" {
+			return nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("must be an integer, got %q", v)
+		}
+		*field(row) = n
+		return nil
+	}
+}
+
+// importFloat also accepts a decimal comma, as written by spreadsheets in
+// many locales.
+func importFloat[T any](field func(*T) *float32) func(*T, string) error {
+	return func(row *T, v string) error {
+		if v == "" {
+			return nil
+		}
+		f, err := strconv.ParseFloat(strings.Replace(v, ",", ".", 1), 32)
+		if err != nil {
+			return fmt.Errorf("must be a number, got %q", v)
+		}
+		*field(row) = float32(f)
+		return nil
+	}
+}
+
+func importDate[T any](field func(*T) *openapi_types.Date) func(*T, string) error {
+	return func(row *T, v string) error {
+		if v == "" {
+			return nil
+		}
+		t, err := time.Parse(importDateLayout, v)
+		if err != nil {
+			return fmt.Errorf("must be a date like %s, got %q", importDateLayout, v)
+		}
+		*field(row) = openapi_types.Date{Time: t}
+		return nil
+	}
+}
+
+func importColumnNames[T any](columns []importColumn[T]) []string {
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.name
+	}
+	return names
+}
+
+// importTemplate returns the header row of the import file of kind.
+func importTemplate(kind string) ([]string, error) {
+	switch kind {
+	case models.ImportKindCars:
+		return importColumnNames(carImportColumns), nil
+	case models.ImportKindWheels:
+		return importColumnNames(wheelImportColumns), nil
+	case models.ImportKindDrivers:
+		return importColumnNames(driverImportColumns), nil
+	}
+	return nil, withDetails(models.ErrInvalidParameter, fmt.Sprintf("kind must be one of %s, %s, %s",
+		models.ImportKindCars, models.ImportKindWheels, models.ImportKindDrivers))
+}
+
+// importRow is a data row of an import file decoded into T. v holds the
+// problems found with the row so far.
+type importRow[T any] struct {
+	row   int
+	value T
+	v     validator
+}
+
+// decodeImport decodes the data rows of records, whose first row must name
+// every column of the template. Blank rows are skipped.
+func decodeImport[T any](records [][]string, columns []importColumn[T]) ([]*importRow[T], error) {
+	if len(records) == 0 {
+		return nil, withDetails(models.ErrInvalidInput, "the file is empty")
+	}
+	if len(records)-1 > maxImportRows {
+		return nil, withDetails(models.ErrInvalidInput, fmt.Sprintf("the file has more than %d rows", maxImportRows))
+	}
+
+	index := make(map[string]int, len(records[0]))
+	var v validator
+	for i, name := range records[0] {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := index[name]; ok {
+			v.add(name, "appears more than once")
+		}
+		index[name] = i
+	}
+	known := make(map[string]bool, len(columns))
+	for _, col := range columns {
+		known[col.name] = true
+		if _, ok := index[col.name]; !ok {
+			v.add(col.name, "column is missing")
+		}
+	}
+	for name := range index {
+		if !known[name] {
+			v.add(name, "is not a column of the template")
+		}
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	var rows []*importRow[T]
+	for i, record := range records[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+		row := &importRow[T]{row: i + 2}
+		for _, col := range columns {
+			var value string
+			if j := index[col.name]; j < len(record) {
+				value = strings.TrimSpace(record[j])
+			}
+			if err := col.set(&row.value, value); err != nil {
+				row.v.add(col.name, "%s", err)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func isBlankRecord(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// rowErrors returns the problems found with row. The fields of validation
+// errors are column names.
+func (row *importRow[T]) rowErrors() []models.ImportRowError {
+	errs := make([]models.ImportRowError, len(row.v.errs))
+	for i, e := range row.v.errs {
+		errs[i] = models.ImportRowError{Row: row.row, Column: e.Field, Message: e.Message}
+	}
+	return errs
+}
+
+// parseImport decodes and validates the rows of an import file of kind on
+// their own. Rows are only validated if all of their cells could be read.
+func parseImport(kind string, records [][]string) (models.ImportBatch, error) {
+	batch := models.ImportBatch{Kind: kind}
+
+	switch kind {
+	case models.ImportKindCars:
+		rows, err := decodeImport(records, carImportColumns)
+		if err != nil {
+			return batch, err
+		}
+		for _, row := range rows {
+			if row.v.errs == nil {
+				validateAuto(&row.v, row.value)
+			}
+			if row.v.errs != nil {
+				batch.Errors = append(batch.Errors, row.rowErrors()...)
+				continue
+			}
+			batch.Cars = append(batch.Cars, models.ImportCar{Row: row.row, Car: ToCar("", row.value)})
+		}
+		batch.Rows = len(rows)
+
+	case models.ImportKindWheels:
+		rows, err := decodeImport(records, wheelImportColumns)
+		if err != nil {
+			return batch, err
+		}
+		for _, row := range rows {
+			wheel := ToNewWheel(row.value.WheelRegistration)
+			if row.v.errs == nil {
+				row.v.required("stateNumber", row.value.StateNumber)
+				validateWheel(&row.v, wheel)
+			}
+			if row.v.errs != nil {
+				batch.Errors = append(batch.Errors, row.rowErrors()...)
+				continue
+			}
+			batch.Wheels = append(batch.Wheels, models.ImportWheel{Row: row.row, StateNumber: row.value.StateNumber, Wheel: wheel})
+		}
+		batch.Rows = len(rows)
+
+	case models.ImportKindDrivers:
+		rows, err := decodeImport(records, driverImportColumns)
+		if err != nil {
+			return batch, err
+		}
+		for _, row := range rows {
+			if row.v.errs == nil {
+				validateDriver(&row.v, row.value)
+			}
+			if row.v.errs != nil {
+				batch.Errors = append(batch.Errors, row.rowErrors()...)
+				continue
+			}
+			batch.Drivers = append(batch.Drivers, models.ImportDriver{Row: row.row, StateNumber: row.value.StateNumber, Driver: ToNewDriver("", row.value)})
+		}
+		batch.Rows = len(rows)
+
+	default:
+		_, err := importTemplate(kind)
+		return batch, err
+	}
+
+	return batch, nil
+}
+
+// readImportFile reads the rows of a CSV or XLSX request body. The format is
+// taken from the Content-Type header, or guessed from the content when the
+// client sent a generic one.
+func readImportFile(r *http.Request, w http.ResponseWriter) ([][]string, error) {
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportFileSize))
+	if err != nil {
+		return nil, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("the file must not exceed %d MB", maxImportFileSize>>20))
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	isXLSX := mediaType == xlsxContentType ||
+		(mediaType != "text/csv" && bytes.HasPrefix(data, []byte("PK\x03\x04")))
+
+	var records [][]string
+	if isXLSX {
+		records, err = readXLSX(data)
+	} else {
+		records, err = readCSV(data)
+	}
+	if err != nil {
+		return nil, withDetails(models.ErrInvalidRequestBody, err.Error())
+	}
+	return records, nil
+}
+
+// readCSV reads comma or semicolon separated values, the latter being what
+// spreadsheets write in locales with a decimal comma.
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV: %w", err)
+	}
+	return records, nil
+}
+
+// readXLSX reads the first sheet of a workbook. Date cells are converted to
+// the layout the date columns expect.
+func readXLSX(data []byte) ([][]string, error) {
+	file, err := xlsx.OpenBinaryWithRowLimit(data, maxImportRows+2)
+	if err != nil {
+		return nil, fmt.Errorf("reading XLSX: %w", err)
+	}
+	if len(file.Sheets) == 0 {
+		return nil, nil
+	}
+
+	var records [][]string
+	for _, row := range file.Sheets[0].Rows {
+		if row == nil {
+			records = append(records, nil)
+			continue
+		}
+		record := make([]string, len(row.Cells))
+		for i, cell := range row.Cells {
+			if cell == nil {
+				continue
+			}
+			record[i] = cell.Value
+			if cell.IsTime() {
+				if t, err := cell.GetTime(file.Date1904); err == nil {
+					record[i] = t.Format(importDateLayout)
+				}
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func writeImportTemplate(w http.ResponseWriter, kind, format string, header []string) error {
+	switch format {
+	case "xlsx":
+		file := xlsx.NewFile()
+		sheet, err := file.AddSheet(kind)
+		if err != nil {
+			return err
+		}
+		row := sheet.AddRow()
+		for _, name := range header {
+			row.AddCell().Value = name
+		}
+
+		w.Header().Set("Content-Type", xlsxContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.xlsx", kind))
+		return file.Write(w)
+	default:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", kind))
+
+		writer := csv.NewWriter(w)
+		writer.Write(header)
+		writer.Flush()
+		return writer.Error()
+	}
+}
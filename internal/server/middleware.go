@@ -1,9 +1,29 @@
 package server
 
 import (
+	"context"
+	"net"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/VikaPaz/algalar/internal/server")
+
+const requestIDHeader = "X-Request-ID"
+
 func AccessControlMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
@@ -18,3 +38,169 @@ func AccessControlMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// RequestIDMiddleware propagates the caller's X-Request-ID, or generates a new
+// one, so that error responses and logs can be correlated with the request.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		ctx := context.WithValue(r.Context(), models.RequestIDKey, requestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequestLogMiddleware gives every request a logger carrying its request ID,
+// retrievable with logging.FromContext, and writes one line to accessLog per
+// request once it completes.
+func RequestLogMiddleware(logger *logrus.Logger, accessLog *logrus.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			requestID, _ := r.Context().Value(models.RequestIDKey).(string)
+
+			ctx := logging.NewContext(r.Context(), logger.WithField("request_id", requestID))
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			route := "unmatched"
+			if rctx := chi.RouteContext(ctx); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+			}
+
+			entry := accessLog.WithContext(ctx).WithFields(logrus.Fields{
+				"method":     r.Method,
+				"route":      route,
+				"status":     rec.status,
+				"latency_ms": float64(time.Since(start).Microseconds()) / 1000,
+				"request_id": requestID,
+			})
+			if companyID := logging.CompanyID(ctx); companyID != "" {
+				entry = entry.WithField("company_id", companyID)
+			}
+
+			switch {
+			case rec.status >= http.StatusInternalServerError:
+				entry.Error("request completed")
+			case rec.status >= http.StatusBadRequest:
+				entry.Warn("request completed")
+			default:
+				entry.Info("request completed")
+			}
+		})
+	}
+}
+
+// RequestMetaMiddleware stores the caller's address and client in the request
+// context so that services can attach them to audit records.
+func RequestMetaMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		meta := models.RequestMeta{
+			IP:        clientIP(r),
+			UserAgent: r.UserAgent(),
+			Method:    r.Method,
+			Path:      r.URL.Path,
+		}
+
+		ctx := context.WithValue(r.Context(), models.RequestMetaKey, meta)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func clientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// TracingMiddleware starts the server span of every request, continuing the
+// trace of the caller when it sent W3C trace context headers.
+func TracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.URLPath(r.URL.Path),
+				semconv.ClientAddress(clientIP(r)),
+				semconv.UserAgentOriginal(r.UserAgent()),
+			),
+		)
+		defer span.End()
+
+		if requestID, ok := ctx.Value(models.RequestIDKey).(string); ok {
+			span.SetAttributes(attribute.String("request.id", requestID))
+		}
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		if rctx := chi.RouteContext(ctx); rctx != nil && rctx.RoutePattern() != "" {
+			span.SetName(r.Method + " " + rctx.RoutePattern())
+			span.SetAttributes(semconv.HTTPRoute(rctx.RoutePattern()))
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(rec.status))
+		if rec.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rec.status))
+		}
+	})
+}
+
+type HTTPMetrics interface {
+	ObserveRequest(method, route string, status int, duration time.Duration)
+}
+
+// MetricsMiddleware reports the latency and status of every request by its
+// chi route pattern rather than the raw path, to keep label cardinality bounded.
+func MetricsMiddleware(m HTTPMetrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			route := "unmatched"
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+			}
+			m.ObserveRequest(r.Method, route, rec.status, time.Since(start))
+		})
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the connection, e.g. to lift the
+// write deadline of long streamed responses.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// defaultBreakagePageLimit is the page size of /breakage/list, which used to
+// return every breakage of a car and so has no required limit.
+const defaultBreakagePageLimit = 50
+
+// pageRequest validates the pagination parameters of a list request. A cursor
+// continues the list on its own, so it cannot be combined with an offset.
+func pageRequest(limit int, offset *int, cursor, sort *string) (models.PageRequest, error) {
+	page := models.PageRequest{Limit: limit}
+	if offset != nil {
+		page.Offset = *offset
+	}
+	if cursor != nil {
+		page.Cursor = *cursor
+	}
+	if sort != nil {
+		page.Sort = *sort
+	}
+
+	var v validator
+	v.page(page.Limit, page.Offset)
+	v.check(page.Cursor == "" || offset == nil, "offset", "must not be used together with cursor")
+	return page, v.err()
+}
+
+// validateOptionalPeriod checks that a filter period is not reversed when
+// both of its ends are given.
+func validateOptionalPeriod(from, to *time.Time) error {
+	if from == nil || to == nil {
+		return nil
+	}
+	return validatePeriod("from", *from, "to", *to)
+}
+
+// writePageHeaders describes the returned page in headers so that list bodies
+// stay plain arrays: the total number of items and, unless this is the last
+// page, the cursor and URL of the next one.
+func writePageHeaders[T any](w http.ResponseWriter, r *http.Request, page models.Page[T]) {
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
+	if page.NextCursor == "" {
+		return
+	}
+	w.Header().Set("X-Next-Cursor", page.NextCursor)
+
+	next := *r.URL
+	query := next.Query()
+	query.Del("offset")
+	query.Set("cursor", page.NextCursor)
+	next.RawQuery = query.Encode()
+	w.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.RequestURI()))
+}
@@ -0,0 +1,263 @@
+package server
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+const (
+	exportArchiveVersion = 1
+	exportManifestName   = "manifest.json"
+	maxArchiveSize       = 512 << 20
+)
+
+// exportManifest describes an export archive. It is written after the
+// entity files, once their records are counted.
+type exportManifest struct {
+	Version    int                    `json:"version"`
+	Format     string                 `json:"format"`
+	CompanyID  string                 `json:"company_id"`
+	From       *time.Time             `json:"from,omitempty"`
+	To         *time.Time             `json:"to,omitempty"`
+	ExportedAt time.Time              `json:"exported_at"`
+	Entities   []exportManifestEntity `json:"entities"`
+}
+
+type exportManifestEntity struct {
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Records int    `json:"records"`
+}
+
+func exportFileName(entity, format string) string {
+	return entity + "." + format
+}
+
+// exportValue converts a value returned by the database driver into one that
+// reads back the same through json_populate_record.
+func exportValue(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+// jsonlRecordWriter writes each record as a JSON object on its own line.
+type jsonlRecordWriter struct {
+	enc     *json.Encoder
+	columns []string
+	count   int
+}
+
+func (w *jsonlRecordWriter) WriteHeader(columns []string) error {
+	w.columns = columns
+	return nil
+}
+
+func (w *jsonlRecordWriter) WriteRecord(values []any) error {
+	record := make(map[string]any, len(values))
+	for i, value := range values {
+		record[w.columns[i]] = exportValue(value)
+	}
+	w.count++
+	return w.enc.Encode(record)
+}
+
+// csvRecordWriter writes the column names and then one row per record. NULL
+// is written as an empty cell.
+type csvRecordWriter struct {
+	csv   *csv.Writer
+	count int
+}
+
+func (w *csvRecordWriter) WriteHeader(columns []string) error {
+	return w.csv.Write(columns)
+}
+
+func (w *csvRecordWriter) WriteRecord(values []any) error {
+	row := make([]string, len(values))
+	for i, value := range values {
+		switch v := exportValue(value).(type) {
+		case nil:
+		case string:
+			row[i] = v
+		case float64:
+			row[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			row[i] = fmt.Sprint(v)
+		}
+	}
+	w.count++
+	if err := w.csv.Write(row); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// newRecordWriter returns the writer of an entity file in format and a
+// function returning the number of records written to it.
+func newRecordWriter(format string, out io.Writer) (models.RecordWriter, func() int) {
+	if format == models.ExportFormatCSV {
+		w := &csvRecordWriter{csv: csv.NewWriter(out)}
+		return w, func() int { return w.count }
+	}
+	w := &jsonlRecordWriter{enc: json.NewEncoder(out)}
+	return w, func() int { return w.count }
+}
+
+// spoolArchive copies an uploaded archive to a temporary file, as zip files
+// are read from their end. The caller removes the file once done.
+func spoolArchive(body io.Reader) (*os.File, *zip.Reader, error) {
+	file, err := os.CreateTemp("", "algalar-import-*.zip")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	size, err := io.Copy(file, body)
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, nil, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("the archive must not exceed %d MB", maxArchiveSize>>20))
+	}
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, nil, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("reading archive: %v", err))
+	}
+	return file, archive, nil
+}
+
+// readManifest reads and checks the manifest of an export archive.
+func readManifest(archive *zip.Reader) (exportManifest, error) {
+	var manifest exportManifest
+	file, err := archive.Open(exportManifestName)
+	if err != nil {
+		return manifest, withDetails(models.ErrInvalidRequestBody, "the archive has no "+exportManifestName)
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&manifest); err != nil {
+		return manifest, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("reading %s: %v", exportManifestName, err))
+	}
+	if manifest.Version != exportArchiveVersion {
+		return manifest, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("unsupported archive version %d", manifest.Version))
+	}
+	if manifest.Format != models.ExportFormatJSONL && manifest.Format != models.ExportFormatCSV {
+		return manifest, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("unsupported archive format %q", manifest.Format))
+	}
+	return manifest, nil
+}
+
+// archiveSource reads the records of an export archive entity by entity, in
+// the order of models.PortableEntities. Entities missing from the archive are
+// skipped.
+type archiveSource struct {
+	archive  *zip.Reader
+	format   string
+	entities []string
+
+	file    io.ReadCloser
+	entity  string
+	jsonl   *json.Decoder
+	csv     *csv.Reader
+	columns []string
+}
+
+func newArchiveSource(archive *zip.Reader, format string) *archiveSource {
+	return &archiveSource{archive: archive, format: format, entities: models.PortableEntities}
+}
+
+func (s *archiveSource) Next() (string, map[string]any, error) {
+	for {
+		if s.file == nil {
+			if err := s.open(); err != nil {
+				return "", nil, err
+			}
+		}
+
+		record, err := s.read()
+		if errors.Is(err, io.EOF) {
+			s.file.Close()
+			s.file = nil
+			continue
+		}
+		if err != nil {
+			return "", nil, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("reading %s: %v", exportFileName(s.entity, s.format), err))
+		}
+		return s.entity, record, nil
+	}
+}
+
+// open opens the file of the next entity in the archive, or returns io.EOF
+// once all of them are read.
+func (s *archiveSource) open() error {
+	for len(s.entities) > 0 {
+		entity := s.entities[0]
+		s.entities = s.entities[1:]
+
+		file, err := s.archive.Open(exportFileName(entity, s.format))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("reading archive: %v", err))
+		}
+
+		s.file, s.entity = file, entity
+		if s.format == models.ExportFormatCSV {
+			s.csv = csv.NewReader(file)
+			s.columns = nil
+		} else {
+			s.jsonl = json.NewDecoder(file)
+			s.jsonl.UseNumber()
+		}
+		return nil
+	}
+	return io.EOF
+}
+
+func (s *archiveSource) read() (map[string]any, error) {
+	if s.format != models.ExportFormatCSV {
+		var record map[string]any
+		if err := s.jsonl.Decode(&record); err != nil {
+			return nil, err
+		}
+		return record, nil
+	}
+
+	if s.columns == nil {
+		columns, err := s.csv.Read()
+		if err != nil {
+			return nil, err
+		}
+		s.columns = columns
+	}
+	row, err := s.csv.Read()
+	if err != nil {
+		return nil, err
+	}
+	record := make(map[string]any, len(row))
+	for i, value := range row {
+		if value == "" {
+			record[s.columns[i]] = nil
+		} else {
+			record[s.columns[i]] = value
+		}
+	}
+	return record, nil
+}
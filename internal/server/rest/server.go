@@ -21,6 +21,36 @@ const (
 	AuthorizationScopes = "Authorization.Scopes"
 )
 
+// AuditChange defines model for AuditChange.
+type AuditChange struct {
+	After  interface{} `json:"after"`
+	Before interface{} `json:"before"`
+}
+
+// AuditEntryResponse defines model for AuditEntryResponse.
+type AuditEntryResponse struct {
+	Action string `json:"action"`
+
+	// ActorId User who made the change
+	ActorId *string `json:"actor_id,omitempty"`
+
+	// Changes Changed fields with their previous and new values
+	Changes      map[string]AuditChange `json:"changes"`
+	CreatedAt    time.Time              `json:"created_at"`
+	Id           openapi_types.UUID     `json:"id"`
+	Metadata     *AuditMetadata         `json:"metadata,omitempty"`
+	ResourceId   *string                `json:"resource_id,omitempty"`
+	ResourceType string                 `json:"resource_type"`
+}
+
+// AuditMetadata defines model for AuditMetadata.
+type AuditMetadata struct {
+	Ip        *string `json:"ip,omitempty"`
+	Method    *string `json:"method,omitempty"`
+	Path      *string `json:"path,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
 // AutoRegistration defines model for AutoRegistration.
 type AutoRegistration struct {
 	AutoType     string `json:"autoType"`
@@ -29,6 +59,9 @@ type AutoRegistration struct {
 	DeviceNumber string `json:"deviceNumber"`
 	StateNumber  string `json:"stateNumber"`
 	UniqueId     string `json:"uniqueId"`
+
+	// Wheels Wheels mounted on the car, registered together with it. Either the car and all its wheels are registered or none of them.
+	Wheels *[]AutoWheel `json:"wheels,omitempty"`
 }
 
 // AutoResponse defines model for AutoResponse.
@@ -40,6 +73,34 @@ type AutoResponse struct {
 	Id           *string `json:"id,omitempty"`
 	StateNumber  *string `json:"stateNumber,omitempty"`
 	UniqueId     *string `json:"uniqueId,omitempty"`
+
+	// Wheels Wheels registered together with the car, only returned on registration.
+	Wheels *[]WheelResponse `json:"wheels,omitempty"`
+}
+
+// AutoWheel defines model for AutoWheel.
+type AutoWheel struct {
+	// AxleNumber Must not exceed the axle count of the car.
+	AxleNumber int `json:"axleNumber"`
+
+	// MaxPressure Must be greater than minPressure.
+	MaxPressure float32 `json:"maxPressure"`
+
+	// MaxTemperature Must be greater than minTemperature.
+	MaxTemperature float32 `json:"maxTemperature"`
+	Mileage        float32 `json:"mileage"`
+	MinPressure    float32 `json:"minPressure"`
+	MinTemperature float32 `json:"minTemperature"`
+	Ngp            float32 `json:"ngp"`
+	SensorNumber   string  `json:"sensorNumber"`
+	TireBrand      string  `json:"tireBrand"`
+	TireCost       float32 `json:"tireCost"`
+	TireModel      string  `json:"tireModel"`
+	TireSize       float32 `json:"tireSize"`
+	Tkvh           float32 `json:"tkvh"`
+
+	// WheelPosition No two wheels of the car may share an axle and position.
+	WheelPosition int `json:"wheelPosition"`
 }
 
 // BreakageFromMqttRequest defines model for BreakageFromMqttRequest.
@@ -53,7 +114,7 @@ type BreakageFromMqttRequest struct {
 	// DeviceNum The device number of the car
 	DeviceNum string `json:"device_num"`
 
-	// Point Latitude and longitude of the breakage location
+	// Point Latitude in [-90, 90] and longitude in [-180, 180] of the breakage location
 	Point []float32 `json:"point"`
 
 	// Type Type of the breakage (e.g., "Engine failure", "Tire puncture")
@@ -62,23 +123,199 @@ type BreakageFromMqttRequest struct {
 
 // BreakageListResponse defines model for BreakageListResponse.
 type BreakageListResponse struct {
+	// Assignee Mechanic or workshop repairing the breakage
+	Assignee *string `json:"assignee,omitempty"`
+
+	// Cost Cost of the repair
+	Cost *float64 `json:"cost,omitempty"`
+
 	// Datetime Date and time when the breakage occurred
 	Datetime *time.Time `json:"datetime,omitempty"`
 
 	// Description Detailed description of the breakage
 	Description *string `json:"description,omitempty"`
 
+	// DowntimeMinutes Minutes from the breakage until it was resolved, or until now
+	DowntimeMinutes *int `json:"downtime_minutes,omitempty"`
+
 	// DriverName Full name of the driver associated with the breakage
 	DriverName *string `json:"driver_name,omitempty"`
 
 	// Id Unique identifier for the breakage
 	Id *openapi_types.UUID `json:"id,omitempty"`
 
+	// MustStop Whether the vehicle must stop
+	MustStop *bool `json:"must_stop,omitempty"`
+
+	// ResolvedAt When the breakage was resolved or closed
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	Severity   *string    `json:"severity,omitempty"`
+
 	// StateNumber State number of the car
 	StateNumber *string `json:"stateNumber,omitempty"`
+	Status      *string `json:"status,omitempty"`
 
 	// Type Type of the breakage (e.g., "Engine failure", "Tire puncture")
 	Type *string `json:"type,omitempty"`
+
+	// TypeName Name of the catalogue type of the breakage, absent if it is not in the catalogue
+	TypeName *string `json:"type_name,omitempty"`
+}
+
+// BreakageNotice defines model for BreakageNotice.
+type BreakageNotice struct {
+	Description string `json:"description"`
+
+	// DriverId Driver at the time of the breakage, empty if none
+	DriverId   string             `json:"driver_id"`
+	DriverName string             `json:"driver_name"`
+	Id         openapi_types.UUID `json:"id"`
+	Latitude   float32            `json:"latitude"`
+	Longitude  float32            `json:"longitude"`
+	Status     string             `json:"status"`
+
+	// Type Type of the breakage
+	Type string `json:"type"`
+
+	// TypeId Breakage type of the catalogue, absent for free-form types
+	TypeId *openapi_types.UUID `json:"type_id,omitempty"`
+}
+
+// BreakageResponse defines model for BreakageResponse.
+type BreakageResponse struct {
+	// Assignee Mechanic or workshop repairing the breakage
+	Assignee *string            `json:"assignee,omitempty"`
+	CarId    openapi_types.UUID `json:"car_id"`
+
+	// Cost Cost of the repair
+	Cost *float64 `json:"cost,omitempty"`
+
+	// Datetime Date and time when the breakage occurred
+	Datetime    time.Time `json:"datetime"`
+	Description string    `json:"description"`
+
+	// DowntimeMinutes Minutes from the breakage until it was resolved, or until now
+	DowntimeMinutes int                `json:"downtime_minutes"`
+	DriverName      string             `json:"driver_name"`
+	Id              openapi_types.UUID `json:"id"`
+
+	// MustStop Whether the vehicle must stop
+	MustStop bool `json:"must_stop"`
+
+	// Point Latitude and longitude of the breakage location
+	Point       []float32 `json:"point"`
+	RepairNotes *string   `json:"repair_notes,omitempty"`
+
+	// ResolvedAt When the breakage was resolved or closed
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+	Severity    *string    `json:"severity,omitempty"`
+	StateNumber string     `json:"state_number"`
+	Status      string     `json:"status"`
+
+	// Type Type of the breakage as reported by the device
+	Type string `json:"type"`
+
+	// TypeId Catalogue type of the breakage, absent if it is not in the catalogue
+	TypeId    *openapi_types.UUID `json:"type_id,omitempty"`
+	TypeName  *string             `json:"type_name,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// BreakageStatsResponse defines model for BreakageStatsResponse.
+type BreakageStatsResponse struct {
+	Count int `json:"count"`
+
+	// Key Catalogue code or device-reported type, car brand or driver ID, empty for breakages without a driver
+	Key string `json:"key"`
+
+	// LastAt Time of the latest breakage of the group
+	LastAt time.Time `json:"last_at"`
+
+	// MustStopCount Number of breakages whose type requires the vehicle to stop
+	MustStopCount int `json:"must_stop_count"`
+
+	// Name Name of the type, car brand or full name of the driver
+	Name string `json:"name"`
+
+	// Severity Severity of the catalogue type, only when grouped by type
+	Severity *string `json:"severity,omitempty"`
+}
+
+// BreakageStatusChangeResponse defines model for BreakageStatusChangeResponse.
+type BreakageStatusChangeResponse struct {
+	// ChangedBy User who changed the status
+	ChangedBy  openapi_types.UUID `json:"changed_by"`
+	CreatedAt  time.Time          `json:"created_at"`
+	FromStatus string             `json:"from_status"`
+	Id         openapi_types.UUID `json:"id"`
+	Note       *string            `json:"note,omitempty"`
+	ToStatus   string             `json:"to_status"`
+}
+
+// BreakageStatusRequest defines model for BreakageStatusRequest.
+type BreakageStatusRequest struct {
+	Id     openapi_types.UUID `json:"id"`
+	Note   *string            `json:"note,omitempty"`
+	Status string             `json:"status"`
+}
+
+// BreakageTypeRequest defines model for BreakageTypeRequest.
+type BreakageTypeRequest struct {
+	// Code Code of the type, also matched against the device-reported type
+	Code      string  `json:"code"`
+	Component *string `json:"component,omitempty"`
+
+	// DeviceCodes Other device-reported types classified as this type
+	DeviceCodes *[]string `json:"device_codes,omitempty"`
+
+	// MustStop Whether the vehicle must stop
+	MustStop *bool  `json:"must_stop,omitempty"`
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+
+	// WheelPosition Position of the wheel for wheel breakages of a specific wheel
+	WheelPosition *int `json:"wheel_position,omitempty"`
+}
+
+// BreakageTypeResponse defines model for BreakageTypeResponse.
+type BreakageTypeResponse struct {
+	Code          string             `json:"code"`
+	Component     *string            `json:"component,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	DeviceCodes   []string           `json:"device_codes"`
+	Id            openapi_types.UUID `json:"id"`
+	MustStop      bool               `json:"must_stop"`
+	Name          string             `json:"name"`
+	Severity      string             `json:"severity"`
+	UpdatedAt     *time.Time         `json:"updated_at,omitempty"`
+	WheelPosition *int               `json:"wheel_position,omitempty"`
+}
+
+// BreakageTypeUpdateRequest defines model for BreakageTypeUpdateRequest.
+type BreakageTypeUpdateRequest struct {
+	// Code Code of the type, also matched against the device-reported type
+	Code      string  `json:"code"`
+	Component *string `json:"component,omitempty"`
+
+	// DeviceCodes Other device-reported types classified as this type
+	DeviceCodes *[]string          `json:"device_codes,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// MustStop Whether the vehicle must stop
+	MustStop *bool  `json:"must_stop,omitempty"`
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+
+	// WheelPosition Position of the wheel for wheel breakages of a specific wheel
+	WheelPosition *int `json:"wheel_position,omitempty"`
+}
+
+// BreakageUpdateRequest defines model for BreakageUpdateRequest.
+type BreakageUpdateRequest struct {
+	Assignee    *string            `json:"assignee,omitempty"`
+	Cost        *float64           `json:"cost,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	RepairNotes *string            `json:"repair_notes,omitempty"`
 }
 
 // ChangeAllNotificationsStatusRequest defines model for ChangeAllNotificationsStatusRequest.
@@ -96,13 +333,109 @@ type ChangeNotificationStatusRequest struct {
 	Status string `json:"status"`
 }
 
+// DocumentExpiryNotice defines model for DocumentExpiryNotice.
+type DocumentExpiryNotice struct {
+	// Document Document about to expire
+	Document   string             `json:"document"`
+	DriverId   openapi_types.UUID `json:"driver_id"`
+	DriverName string             `json:"driver_name"`
+	ExpiresAt  time.Time          `json:"expires_at"`
+	Id         openapi_types.UUID `json:"id"`
+}
+
+// DriverAssignmentRequest defines model for DriverAssignmentRequest.
+type DriverAssignmentRequest struct {
+	CarId    openapi_types.UUID `json:"car_id"`
+	DriverId openapi_types.UUID `json:"driver_id"`
+
+	// StartedAt Start of the assignment, now by default
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// DriverAssignmentResponse defines model for DriverAssignmentResponse.
+type DriverAssignmentResponse struct {
+	CarId    openapi_types.UUID `json:"car_id"`
+	DriverId openapi_types.UUID `json:"driver_id"`
+
+	// EndedAt Absent while the driver is still assigned
+	EndedAt     *time.Time         `json:"ended_at,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	StartedAt   time.Time          `json:"started_at"`
+	StateNumber string             `json:"state_number"`
+}
+
+// DriverDeactivateRequest defines model for DriverDeactivateRequest.
+type DriverDeactivateRequest struct {
+	DriverId openapi_types.UUID `json:"driver_id"`
+}
+
+// DriverDocumentResponse defines model for DriverDocumentResponse.
+type DriverDocumentResponse struct {
+	ContentType string             `json:"content_type"`
+	CreatedAt   time.Time          `json:"created_at"`
+	DriverId    openapi_types.UUID `json:"driver_id"`
+	FileName    string             `json:"file_name"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// Size Size of the file in bytes
+	Size int    `json:"size"`
+	Type string `json:"type"`
+}
+
 // DriverInfoResponse defines model for DriverInfoResponse.
 type DriverInfoResponse struct {
-	Birthday   time.Time `json:"birthday"`
-	MiddleName string    `json:"middle_name"`
-	Name       string    `json:"name"`
-	Phone      string    `json:"phone"`
-	Surname    string    `json:"surname"`
+	Birthday time.Time `json:"birthday"`
+
+	// DeactivatedAt Absent while the driver is active
+	DeactivatedAt *time.Time         `json:"deactivated_at,omitempty"`
+	Id            openapi_types.UUID `json:"id"`
+
+	// LicenceCategories Categories of vehicles the licence allows to drive
+	LicenceCategories *[]string `json:"licence_categories,omitempty"`
+
+	// LicenceExpiresAt Expiry date of the driving licence
+	LicenceExpiresAt *openapi_types.Date `json:"licence_expires_at,omitempty"`
+
+	// LicenceNumber Driving licence number
+	LicenceNumber *string `json:"licence_number,omitempty"`
+
+	// MedicalExpiresAt Expiry date of the medical certificate
+	MedicalExpiresAt *openapi_types.Date   `json:"medical_expires_at,omitempty"`
+	MiddleName       string                `json:"middle_name"`
+	Name             string                `json:"name"`
+	Phone            string                `json:"phone"`
+	Rating           *DriverRatingResponse `json:"rating,omitempty"`
+	Surname          string                `json:"surname"`
+}
+
+// DriverRatingResponse Breakdown of the driver's latest rating out of 10, calculated periodically from their behaviour since window_start
+type DriverRatingResponse struct {
+	Breakages    int       `json:"breakages"`
+	CalculatedAt time.Time `json:"calculated_at"`
+	DistanceKm   float64   `json:"distance_km"`
+	DrivenHours  float64   `json:"driven_hours"`
+
+	// Experience Out of 1, by worked time
+	Experience  float32 `json:"experience"`
+	HarshEvents int     `json:"harsh_events"`
+
+	// Rating Sum of the components
+	Rating float32 `json:"rating"`
+
+	// Safety Out of 3, by breakages per hour driven
+	Safety float32 `json:"safety"`
+
+	// Smoothness Out of 2, by harsh accelerations and brakings per hour driven
+	Smoothness float32 `json:"smoothness"`
+
+	// SpeedDiscipline Out of 2, by the share of the driven time spent speeding
+	SpeedDiscipline float32 `json:"speed_discipline"`
+	SpeedingHours   float64 `json:"speeding_hours"`
+
+	// TireCare Out of 2, by the time driven on under-inflated tires after an alert
+	TireCare           float32   `json:"tire_care"`
+	UnderInflatedHours float64   `json:"under_inflated_hours"`
+	WindowStart        time.Time `json:"window_start"`
 }
 
 // DriverRegistration defines model for DriverRegistration.
@@ -110,6 +443,18 @@ type DriverRegistration struct {
 	// Birthday Driver's birth date
 	Birthday openapi_types.Date `json:"birthday"`
 
+	// LicenceCategories Categories of vehicles the licence allows to drive
+	LicenceCategories *[]string `json:"licence_categories,omitempty"`
+
+	// LicenceExpiresAt Expiry date of the driving licence
+	LicenceExpiresAt *openapi_types.Date `json:"licence_expires_at,omitempty"`
+
+	// LicenceNumber Driving licence number
+	LicenceNumber *string `json:"licence_number,omitempty"`
+
+	// MedicalExpiresAt Expiry date of the medical certificate
+	MedicalExpiresAt *openapi_types.Date `json:"medical_expires_at,omitempty"`
+
 	// MiddleName Driver's middle name
 	MiddleName string `json:"middle_name"`
 
@@ -128,12 +473,146 @@ type DriverRegistration struct {
 
 // DriverStatisticsResponse defines model for DriverStatisticsResponse.
 type DriverStatisticsResponse struct {
+	// Active False once the driver is deactivated
+	Active bool `json:"active"`
+
+	// BreakagesCount Number of breakages of the cars the driver was assigned to, while assigned
 	BreakagesCount int                `json:"breakages_count"`
 	DriverId       openapi_types.UUID `json:"driver_id"`
 	Experience     float32            `json:"experience"`
 	FullName       string             `json:"full_name"`
-	Rating         float32            `json:"rating"`
-	WorkedTime     int                `json:"worked_time"`
+
+	// Rank Place of the driver among the company's drivers by rating, tied drivers sharing a place
+	Rank       int     `json:"rank"`
+	Rating     float32 `json:"rating"`
+	WorkedTime int     `json:"worked_time"`
+}
+
+// DriverTripResponse defines model for DriverTripResponse.
+type DriverTripResponse struct {
+	Assignment DriverAssignmentResponse `json:"assignment"`
+
+	// Positions Positions recorded during the trip
+	Positions []Position `json:"positions"`
+}
+
+// DriverUnassignRequest defines model for DriverUnassignRequest.
+type DriverUnassignRequest struct {
+	DriverId openapi_types.UUID `json:"driver_id"`
+
+	// EndedAt End of the assignment, now by default
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+}
+
+// DriverUpdateRequest defines model for DriverUpdateRequest.
+type DriverUpdateRequest struct {
+	Birthday openapi_types.Date `json:"birthday"`
+	DriverId openapi_types.UUID `json:"driver_id"`
+
+	// LicenceCategories Categories of vehicles the licence allows to drive
+	LicenceCategories *[]string `json:"licence_categories,omitempty"`
+
+	// LicenceExpiresAt Expiry date of the driving licence
+	LicenceExpiresAt *openapi_types.Date `json:"licence_expires_at,omitempty"`
+
+	// LicenceNumber Driving licence number
+	LicenceNumber *string `json:"licence_number,omitempty"`
+
+	// MedicalExpiresAt Expiry date of the medical certificate
+	MedicalExpiresAt *openapi_types.Date `json:"medical_expires_at,omitempty"`
+	MiddleName       string              `json:"middle_name"`
+	Name             string              `json:"name"`
+	Phone            string              `json:"phone"`
+	Surname          string              `json:"surname"`
+}
+
+// GeofenceNotice defines model for GeofenceNotice.
+type GeofenceNotice struct {
+	// Geofence Name of the geofence when the car crossed it
+	Geofence string `json:"geofence"`
+
+	// GeofenceId Geofence the car crossed, which may have been removed since
+	GeofenceId openapi_types.UUID `json:"geofence_id"`
+
+	// Latitude Latitude of the first position on the other side
+	Latitude float32 `json:"latitude"`
+
+	// Longitude Longitude of the first position on the other side
+	Longitude float32 `json:"longitude"`
+
+	// OccurredAt Time of the first position on the other side
+	OccurredAt time.Time `json:"occurred_at"`
+	Transition string    `json:"transition"`
+}
+
+// GeofenceRequest defines model for GeofenceRequest.
+type GeofenceRequest struct {
+	Active *bool `json:"active,omitempty"`
+
+	// Latitude Latitude of the center
+	Latitude float64 `json:"latitude"`
+
+	// Longitude Longitude of the center
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name"`
+
+	// Radius Radius in meters
+	Radius float64 `json:"radius"`
+}
+
+// GeofenceResponse defines model for GeofenceResponse.
+type GeofenceResponse struct {
+	Active    bool               `json:"active"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Latitude Latitude of the center
+	Latitude float64 `json:"latitude"`
+
+	// Longitude Longitude of the center
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name"`
+
+	// Radius Radius in meters
+	Radius    float64    `json:"radius"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// GeofenceUpdateRequest defines model for GeofenceUpdateRequest.
+type GeofenceUpdateRequest struct {
+	Active *bool              `json:"active,omitempty"`
+	Id     openapi_types.UUID `json:"id"`
+
+	// Latitude Latitude of the center
+	Latitude float64 `json:"latitude"`
+
+	// Longitude Longitude of the center
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name"`
+
+	// Radius Radius in meters
+	Radius float64 `json:"radius"`
+}
+
+// ImportResultResponse defines model for ImportResultResponse.
+type ImportResultResponse struct {
+	// Created Number of created records, 0 on a dry run
+	Created int              `json:"created"`
+	DryRun  bool             `json:"dry_run"`
+	Errors  []ImportRowError `json:"errors"`
+	Kind    string           `json:"kind"`
+
+	// Rows Number of data rows in the file
+	Rows int `json:"rows"`
+}
+
+// ImportRowError defines model for ImportRowError.
+type ImportRowError struct {
+	Column  string `json:"column"`
+	Message string `json:"message"`
+
+	// Row Row number in the file, the header being row 1
+	Row int `json:"row"`
 }
 
 // LoginRequest defines model for LoginRequest.
@@ -142,7 +621,124 @@ type LoginRequest struct {
 	Password string              `json:"password"`
 }
 
-// NewSensorData defines model for NewSensorData.
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	AccessToken    *string `json:"accessToken,omitempty"`
+	ChallengeToken *string `json:"challengeToken,omitempty"`
+
+	// EnrollmentRequired Company policy requires 2FA but no authenticator is enrolled yet, see /login/totp/enroll.
+	EnrollmentRequired *bool   `json:"enrollmentRequired,omitempty"`
+	RefreshToken       *string `json:"refreshToken,omitempty"`
+
+	// TwoFactorRequired When true the tokens are omitted and the challenge token must be passed to /login/totp.
+	TwoFactorRequired bool `json:"twoFactorRequired"`
+}
+
+// MaintenanceDueNotice defines model for MaintenanceDueNotice.
+type MaintenanceDueNotice struct {
+	DueAt      *time.Time         `json:"due_at,omitempty"`
+	DueMileage *float64           `json:"due_mileage,omitempty"`
+	PlanId     openapi_types.UUID `json:"plan_id"`
+	PlanName   string             `json:"plan_name"`
+
+	// Reason What made the task fall due
+	Reason string `json:"reason"`
+
+	// Status Status of the task
+	Status string             `json:"status"`
+	TaskId openapi_types.UUID `json:"task_id"`
+
+	// WheelId Wheel the task is about, absent for tasks about the whole car
+	WheelId *openapi_types.UUID `json:"wheel_id,omitempty"`
+}
+
+// MaintenancePlanRequest defines model for MaintenancePlanRequest.
+type MaintenancePlanRequest struct {
+	Active *bool `json:"active,omitempty"`
+
+	// CarId The car the plan is for, every car of the company if absent
+	CarId *openapi_types.UUID `json:"car_id,omitempty"`
+
+	// Condition Sensor reading of a wheel that makes it due, required for plans by condition
+	Condition *string `json:"condition,omitempty"`
+
+	// IntervalDays Days between maintenance of a car, required for plans by interval
+	IntervalDays *int   `json:"interval_days,omitempty"`
+	Kind         string `json:"kind"`
+
+	// MileageInterval Km between maintenance of a wheel, required for plans by mileage
+	MileageInterval *float64 `json:"mileage_interval,omitempty"`
+	Name            string   `json:"name"`
+	Trigger         string   `json:"trigger"`
+}
+
+// MaintenancePlanResponse defines model for MaintenancePlanResponse.
+type MaintenancePlanResponse struct {
+	Active          bool                `json:"active"`
+	CarId           *openapi_types.UUID `json:"car_id,omitempty"`
+	Condition       *string             `json:"condition,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+	Id              openapi_types.UUID  `json:"id"`
+	IntervalDays    *int                `json:"interval_days,omitempty"`
+	Kind            string              `json:"kind"`
+	MileageInterval *float64            `json:"mileage_interval,omitempty"`
+	Name            string              `json:"name"`
+	Trigger         string              `json:"trigger"`
+	UpdatedAt       *time.Time          `json:"updated_at,omitempty"`
+}
+
+// MaintenancePlanUpdateRequest defines model for MaintenancePlanUpdateRequest.
+type MaintenancePlanUpdateRequest struct {
+	Active *bool `json:"active,omitempty"`
+
+	// CarId The car the plan is for, every car of the company if absent
+	CarId *openapi_types.UUID `json:"car_id,omitempty"`
+
+	// Condition Sensor reading of a wheel that makes it due, required for plans by condition
+	Condition *string            `json:"condition,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// IntervalDays Days between maintenance of a car, required for plans by interval
+	IntervalDays *int   `json:"interval_days,omitempty"`
+	Kind         string `json:"kind"`
+
+	// MileageInterval Km between maintenance of a wheel, required for plans by mileage
+	MileageInterval *float64 `json:"mileage_interval,omitempty"`
+	Name            string   `json:"name"`
+	Trigger         string   `json:"trigger"`
+}
+
+// MaintenanceTaskResponse defines model for MaintenanceTaskResponse.
+type MaintenanceTaskResponse struct {
+	CarId     openapi_types.UUID `json:"car_id"`
+	CreatedAt time.Time          `json:"created_at"`
+
+	// DueAt When the task is due, for plans by interval or condition
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// DueMileage Mileage of the wheel the task is due at, for plans by mileage
+	DueMileage *float64           `json:"due_mileage,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	Kind       string             `json:"kind"`
+
+	// Mileage Current mileage of the wheel
+	Mileage     *float64           `json:"mileage,omitempty"`
+	Overdue     bool               `json:"overdue"`
+	PlanId      openapi_types.UUID `json:"plan_id"`
+	PlanName    string             `json:"plan_name"`
+	Reason      string             `json:"reason"`
+	StateNumber string             `json:"state_number"`
+
+	// Status Scheduled while a work order for the task is open
+	Status        string              `json:"status"`
+	WheelId       *openapi_types.UUID `json:"wheel_id,omitempty"`
+	WheelPosition *int                `json:"wheel_position,omitempty"`
+
+	// WorkOrderId The open work order for the task
+	WorkOrderId *openapi_types.UUID `json:"work_order_id,omitempty"`
+}
+
+// NewSensorData A sensor reading, every field is required for it to be stored.
 type NewSensorData struct {
 	DeviceNumber *string    `json:"device_number,omitempty"`
 	Pressure     *float32   `json:"pressure,omitempty"`
@@ -151,19 +747,66 @@ type NewSensorData struct {
 	Time         *time.Time `json:"time,omitempty"`
 }
 
-// NotificationInfoResponse defines model for NotificationInfoResponse.
+// NotificationDeliveryResponse defines model for NotificationDeliveryResponse.
+type NotificationDeliveryResponse struct {
+	Attempts  int                `json:"attempts"`
+	Channel   string             `json:"channel"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastError Error of the last failed attempt
+	LastError *string `json:"last_error,omitempty"`
+
+	// NextAttemptAt When a pending delivery is attempted next
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// RuleId The rule that routed the notification, absent once the rule is removed
+	RuleId *openapi_types.UUID `json:"rule_id,omitempty"`
+	SentAt *time.Time          `json:"sent_at,omitempty"`
+	Status string              `json:"status"`
+	Target string              `json:"target"`
+}
+
+// NotificationInfoResponse A notification with the details of its type: exactly one of breakage, work_violation, document_expiry, maintenance_due, sensor_threshold, sensor_offline and geofence is set, the one named by type. description, driver_name, location and breakage_status are kept for clients that predate the details.
+
 type NotificationInfoResponse struct {
+	Breakage *BreakageNotice `json:"breakage,omitempty"`
+
+	// BreakageStatus Current status of the breakage, absent for notifications that are not about a breakage
+	BreakageStatus *string `json:"breakage_status,omitempty"`
+
+	// CarId Car the notification is about, absent for notifications that are not about a car
+	CarId *openapi_types.UUID `json:"car_id,omitempty"`
+
 	// CreatedAt Date and time when the notification was created
 	CreatedAt time.Time `json:"created_at"`
 
-	// Description Detailed description of the breakage
-	Description string `json:"description"`
+	// Description Detailed description of the notification
+	Description    string                `json:"description"`
+	DocumentExpiry *DocumentExpiryNotice `json:"document_expiry,omitempty"`
 
-	// DriverName Full name of the driver associated with the breakage
-	DriverName string `json:"driver_name"`
+	// DriverName Full name of the driver the notification is about, empty if none
+	DriverName string          `json:"driver_name"`
+	Geofence   *GeofenceNotice `json:"geofence,omitempty"`
+
+	// Id Unique identifier of the notification
+	Id openapi_types.UUID `json:"id"`
+
+	// Location Latitude and longitude of the breakage location, zero for notifications that are not about a breakage
+	Location        []float32              `json:"location"`
+	MaintenanceDue  *MaintenanceDueNotice  `json:"maintenance_due,omitempty"`
+	SensorOffline   *SensorOfflineNotice   `json:"sensor_offline,omitempty"`
+	SensorThreshold *SensorThresholdNotice `json:"sensor_threshold,omitempty"`
+
+	// Severity Severity of the notification
+	Severity string `json:"severity"`
 
-	// Location Latitude and longitude of the breakage location
-	Location []float32 `json:"location"`
+	// Status Status of the notification
+	Status string `json:"status"`
+
+	// Type What the notification is about
+	Type          string               `json:"type"`
+	WorkViolation *WorkViolationNotice `json:"work_violation,omitempty"`
 }
 
 // NotificationListResponse defines model for NotificationListResponse.
@@ -171,17 +814,114 @@ type NotificationListResponse struct {
 	// Brand Brand of the car
 	Brand string `json:"brand"`
 
+	// BreakageStatus Current status of the breakage, absent for notifications that are not about a breakage
+	BreakageStatus *string `json:"breakage_status,omitempty"`
+
 	// BreakageType Type of the breakage
 	BreakageType string `json:"breakage_type"`
 
+	// CarId Car the notification is about, absent for notifications that are not about a car
+	CarId *openapi_types.UUID `json:"car_id,omitempty"`
+
 	// CreatedAt Date and time when the notification was created
 	CreatedAt time.Time `json:"created_at"`
 
 	// Id Unique identifier for the notification
 	Id openapi_types.UUID `json:"id"`
 
+	// Note Short description of the notification
+	Note string `json:"note"`
+
+	// Severity Severity of the notification
+	Severity string `json:"severity"`
+
 	// StateNumber State number of the car
 	StateNumber string `json:"state_number"`
+
+	// Status Status of the notification
+	Status string `json:"status"`
+
+	// Type What the notification is about
+	Type string `json:"type"`
+}
+
+// NotificationRuleRequest defines model for NotificationRuleRequest.
+type NotificationRuleRequest struct {
+	Active *bool `json:"active,omitempty"`
+
+	// CarIds Group of cars the rule routes notifications about, every car if empty
+	CarIds  *[]openapi_types.UUID `json:"car_ids,omitempty"`
+	Channel string                `json:"channel"`
+
+	// EventTypes Events the rule routes, every event if empty
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// MinSeverity Only notifications at least this severe
+	MinSeverity *string `json:"min_severity,omitempty"`
+	Name        string  `json:"name"`
+
+	// QuietFrom Start of the quiet hours in minutes after local midnight
+	QuietFrom *int `json:"quiet_from,omitempty"`
+
+	// QuietTo End of the quiet hours in minutes after local midnight, may be before quiet_from
+	QuietTo *int `json:"quiet_to,omitempty"`
+
+	// Target Email address, webhook URL, phone number or Telegram chat ID, by channel
+	Target string `json:"target"`
+}
+
+// NotificationRuleResponse defines model for NotificationRuleResponse.
+type NotificationRuleResponse struct {
+	Active bool `json:"active"`
+
+	// CarIds Group of cars the rule routes notifications about, every car if empty
+	CarIds    []openapi_types.UUID `json:"car_ids"`
+	Channel   string               `json:"channel"`
+	CreatedAt time.Time            `json:"created_at"`
+
+	// EventTypes Events the rule routes, every event if empty
+	EventTypes []string           `json:"event_types"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// MinSeverity Only notifications at least this severe
+	MinSeverity *string `json:"min_severity,omitempty"`
+	Name        string  `json:"name"`
+
+	// QuietFrom Start of the quiet hours in minutes after local midnight
+	QuietFrom *int `json:"quiet_from,omitempty"`
+
+	// QuietTo End of the quiet hours in minutes after local midnight, may be before quiet_from
+	QuietTo *int `json:"quiet_to,omitempty"`
+
+	// Target Email address, webhook URL, phone number or Telegram chat ID, by channel
+	Target    string     `json:"target"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// NotificationRuleUpdateRequest defines model for NotificationRuleUpdateRequest.
+type NotificationRuleUpdateRequest struct {
+	Active *bool `json:"active,omitempty"`
+
+	// CarIds Group of cars the rule routes notifications about, every car if empty
+	CarIds  *[]openapi_types.UUID `json:"car_ids,omitempty"`
+	Channel string                `json:"channel"`
+
+	// EventTypes Events the rule routes, every event if empty
+	EventTypes *[]string          `json:"event_types,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// MinSeverity Only notifications at least this severe
+	MinSeverity *string `json:"min_severity,omitempty"`
+	Name        string  `json:"name"`
+
+	// QuietFrom Start of the quiet hours in minutes after local midnight
+	QuietFrom *int `json:"quiet_from,omitempty"`
+
+	// QuietTo End of the quiet hours in minutes after local midnight, may be before quiet_from
+	QuietTo *int `json:"quiet_to,omitempty"`
+
+	// Target Email address, webhook URL, phone number or Telegram chat ID, by channel
+	Target string `json:"target"`
 }
 
 // Position defines model for Position.
@@ -189,6 +929,9 @@ type Position struct {
 	// CreatedAt Timestamp when the position was recorded
 	CreatedAt time.Time `json:"created_at"`
 
+	// DriverId Driver assigned to the car when the position was recorded, absent if there was none
+	DriverId *openapi_types.UUID `json:"driver_id,omitempty"`
+
 	// Point Coordinates [latitude, longitude]
 	Point []float32 `json:"point"`
 }
@@ -224,8 +967,10 @@ type PositionRequest struct {
 	CreatedAt time.Time `json:"created_at"`
 
 	// DeviceNumber The device number of the car
-	DeviceNumber string    `json:"device_number"`
-	Point        []float32 `json:"point"`
+	DeviceNumber string `json:"device_number"`
+
+	// Point Latitude in [-90, 90] and longitude in [-180, 180]
+	Point []float32 `json:"point"`
 }
 
 // PressureData defines model for PressureData.
@@ -234,9 +979,26 @@ type PressureData struct {
 	Time     *time.Time `json:"time,omitempty"`
 }
 
+// RecoveryCodesResponse defines model for RecoveryCodesResponse.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
 // ReportResponse defines model for ReportResponse.
 type ReportResponse = []byte
 
+// RestoreResultResponse defines model for RestoreResultResponse.
+type RestoreResultResponse struct {
+	Created int    `json:"created"`
+	Entity  string `json:"entity"`
+
+	// Read Number of records of the entity in the archive
+	Read int `json:"read"`
+
+	// Skipped Number of records that already existed or belong to another company
+	Skipped int `json:"skipped"`
+}
+
 // RouteCarResponse defines model for RouteCarResponse.
 type RouteCarResponse struct {
 	// Brand Brand of the car
@@ -255,11 +1017,55 @@ type RouteCarResponse struct {
 	UniqueId string `json:"unique_id"`
 }
 
-// SensorsData defines model for SensorsData.
-type SensorsData struct {
-	Pressure      *float32 `json:"pressure,omitempty"`
-	Temperature   *float32 `json:"temperature,omitempty"`
-	WheelPosition *int     `json:"wheel_position,omitempty"`
+// SearchResultResponse defines model for SearchResultResponse.
+type SearchResultResponse struct {
+	// CarId Car the record belongs to
+	CarId        *string            `json:"car_id,omitempty"`
+	Id           openapi_types.UUID `json:"id"`
+	MatchedField string             `json:"matched_field"`
+
+	// Score Relevance from 0 to 1
+	Score float64 `json:"score"`
+
+	// Subtitle Car brand and type, driver phone or state number of the car
+	Subtitle *string `json:"subtitle,omitempty"`
+
+	// Title State number, driver name, tire brand and model or breakage type
+	Title string `json:"title"`
+	Type  string `json:"type"`
+}
+
+// SensorOfflineNotice defines model for SensorOfflineNotice.
+type SensorOfflineNotice struct {
+	DeviceNumber string `json:"device_number"`
+
+	// LastSeenAt Time of the last reading or position of the device
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// SensorThresholdNotice defines model for SensorThresholdNotice.
+type SensorThresholdNotice struct {
+	DeviceNumber string `json:"device_number"`
+
+	// Max Upper bound of the wheel, absent if not set
+	Max        *float32  `json:"max,omitempty"`
+	MeasuredAt time.Time `json:"measured_at"`
+	Metric     string    `json:"metric"`
+
+	// Min Lower bound of the wheel, absent if not set
+	Min          *float32 `json:"min,omitempty"`
+	SensorNumber string   `json:"sensor_number"`
+
+	// Value Reading out of the bounds of the wheel
+	Value   float32            `json:"value"`
+	WheelId openapi_types.UUID `json:"wheel_id"`
+}
+
+// SensorsData defines model for SensorsData.
+type SensorsData struct {
+	Pressure      *float32 `json:"pressure,omitempty"`
+	Temperature   *float32 `json:"temperature,omitempty"`
+	WheelPosition *int     `json:"wheel_position,omitempty"`
 }
 
 // TemperatureData defines model for TemperatureData.
@@ -268,12 +1074,72 @@ type TemperatureData struct {
 	Time        *time.Time `json:"time,omitempty"`
 }
 
+// TireReplacement defines model for TireReplacement.
+type TireReplacement struct {
+	Brand string   `json:"brand"`
+	Cost  *float32 `json:"cost,omitempty"`
+	Model string   `json:"model"`
+	Size  float32  `json:"size"`
+}
+
 // TokenResponse defines model for TokenResponse.
 type TokenResponse struct {
 	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken"`
 }
 
+// TotpChallengeRequest defines model for TotpChallengeRequest.
+type TotpChallengeRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+}
+
+// TotpCodeRequest defines model for TotpCodeRequest.
+type TotpCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// TotpEnrollResponse defines model for TotpEnrollResponse.
+type TotpEnrollResponse struct {
+	// QrCode PNG image of the provisioning URI.
+	QrCode []byte `json:"qrCode"`
+	Secret string `json:"secret"`
+
+	// Url otpauth:// provisioning URI.
+	Url string `json:"url"`
+}
+
+// TotpLoginRequest defines model for TotpLoginRequest.
+type TotpLoginRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+
+	// Code Six digit code from the authenticator app.
+	Code *string `json:"code,omitempty"`
+
+	// RecoveryCode One of the single-use recovery codes, used instead of code.
+	RecoveryCode *string `json:"recoveryCode,omitempty"`
+}
+
+// TotpLoginResponse defines model for TotpLoginResponse.
+type TotpLoginResponse struct {
+	AccessToken string `json:"accessToken"`
+
+	// RecoveryCodes Returned once when enrollment was completed during login.
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+	RefreshToken  string    `json:"refreshToken"`
+}
+
+// TotpPolicyRequest defines model for TotpPolicyRequest.
+type TotpPolicyRequest struct {
+	Required bool `json:"required"`
+}
+
+// TotpStatusResponse defines model for TotpStatusResponse.
+type TotpStatusResponse struct {
+	Enabled           bool `json:"enabled"`
+	RecoveryCodesLeft int  `json:"recoveryCodesLeft"`
+	Required          bool `json:"required"`
+}
+
 // UpdateMileageRequest defines model for UpdateMileageRequest.
 type UpdateMileageRequest struct {
 	// DeviceNum The device number of the car to update mileage for
@@ -288,8 +1154,9 @@ type UpdatePassword struct {
 	NewPassword string `json:"newPassword"`
 }
 
-// UserDetails defines model for UserDetails.
+// UserDetails Partial update, fields that are omitted keep their current values.
 type UserDetails struct {
+	// Email A valid email address, used as the login.
 	Email     *string `json:"email,omitempty"`
 	FirstName *string `json:"firstName,omitempty"`
 	Gender    *string `json:"gender,omitempty"`
@@ -302,6 +1169,7 @@ type UserDetails struct {
 
 // UserRegistration defines model for UserRegistration.
 type UserRegistration struct {
+	// Email A valid email address, used as the login.
 	Email     string `json:"email"`
 	FirstName string `json:"firstName"`
 	Gender    string `json:"gender"`
@@ -312,12 +1180,103 @@ type UserRegistration struct {
 	TimeZone  int    `json:"timeZone"`
 }
 
+// WebhookAttemptResponse defines model for WebhookAttemptResponse.
+type WebhookAttemptResponse struct {
+	AttemptedAt time.Time          `json:"attempted_at"`
+	DurationMs  int                `json:"duration_ms"`
+	Error       *string            `json:"error,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// StatusCode Status the endpoint answered with, absent if it did not answer
+	StatusCode *int `json:"status_code,omitempty"`
+}
+
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+
+	// EventId Identifier of the event, the same in the deliveries of the event to every endpoint
+	EventId   openapi_types.UUID `json:"event_id"`
+	EventType string             `json:"event_type"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastError Error of the last failed attempt
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastStatusCode Status the endpoint answered the last attempt with
+	LastStatusCode *int `json:"last_status_code,omitempty"`
+
+	// NextAttemptAt When a pending delivery is attempted next
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// Payload Body posted to the endpoint
+	Payload   map[string]interface{} `json:"payload"`
+	Status    string                 `json:"status"`
+	WebhookId openapi_types.UUID     `json:"webhook_id"`
+}
+
+// WebhookReplayRequest defines model for WebhookReplayRequest.
+type WebhookReplayRequest struct {
+	// Id Unique identifier of the delivery
+	Id openapi_types.UUID `json:"id"`
+}
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	Active      *bool   `json:"active,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes Events delivered to the endpoint, every event if empty
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// Url HTTPS URL deliveries are posted to. Private, loopback and link-local hosts are refused
+	Url string `json:"url"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description *string   `json:"description,omitempty"`
+
+	// EventTypes Events delivered to the endpoint, every event if empty
+	EventTypes []string           `json:"event_types"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Secret Key of the signatures of the deliveries, only returned when the endpoint is registered
+	Secret    *string    `json:"secret,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Url       string     `json:"url"`
+}
+
+// WebhookUpdateRequest defines model for WebhookUpdateRequest.
+type WebhookUpdateRequest struct {
+	Active      *bool   `json:"active,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// EventTypes Events delivered to the endpoint, every event if empty
+	EventTypes *[]string          `json:"event_types,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Url HTTPS URL deliveries are posted to. Private, loopback and link-local hosts are refused
+	Url string `json:"url"`
+}
+
 // WheelChange defines model for WheelChange.
 type WheelChange struct {
-	AutoId         string  `json:"autoId"`
-	AxleNumber     int     `json:"axleNumber"`
-	Id             string  `json:"id"`
-	MaxPressure    float32 `json:"maxPressure"`
+	// AutoId UUID of the car.
+	AutoId string `json:"autoId"`
+
+	// AxleNumber Must not exceed the axle count of the car.
+	AxleNumber int    `json:"axleNumber"`
+	Id         string `json:"id"`
+
+	// MaxPressure Must be greater than minPressure.
+	MaxPressure float32 `json:"maxPressure"`
+
+	// MaxTemperature Must be greater than minTemperature.
 	MaxTemperature float32 `json:"maxTemperature"`
 	Mileage        float32 `json:"mileage"`
 	MinPressure    float32 `json:"minPressure"`
@@ -334,9 +1293,16 @@ type WheelChange struct {
 
 // WheelRegistration defines model for WheelRegistration.
 type WheelRegistration struct {
-	AutoId         string  `json:"autoId"`
-	AxleNumber     int     `json:"axleNumber"`
-	MaxPressure    float32 `json:"maxPressure"`
+	// AutoId UUID of the car.
+	AutoId string `json:"autoId"`
+
+	// AxleNumber Must not exceed the axle count of the car.
+	AxleNumber int `json:"axleNumber"`
+
+	// MaxPressure Must be greater than minPressure.
+	MaxPressure float32 `json:"maxPressure"`
+
+	// MaxTemperature Must be greater than minTemperature.
 	MaxTemperature float32 `json:"maxTemperature"`
 	Mileage        float32 `json:"mileage"`
 	MinPressure    float32 `json:"minPressure"`
@@ -381,10 +1347,215 @@ type WheelsDataForDevice struct {
 	WheelPosition  *int     `json:"wheel_position,omitempty"`
 }
 
+// WorkDayResponse defines model for WorkDayResponse.
+type WorkDayResponse struct {
+	Date openapi_types.Date `json:"date"`
+
+	// FirstStart Absent on days off
+	FirstStart *time.Time `json:"first_start,omitempty"`
+
+	// LastEnd Absent on days off
+	LastEnd *time.Time `json:"last_end,omitempty"`
+
+	// LongestRestMinutes Longest rest that started within 24 hours of first_start
+	LongestRestMinutes int `json:"longest_rest_minutes"`
+
+	// Periods Number of separate periods of work
+	Periods       int `json:"periods"`
+	WorkedMinutes int `json:"worked_minutes"`
+}
+
+// WorkOrderCancelRequest defines model for WorkOrderCancelRequest.
+type WorkOrderCancelRequest struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+// WorkOrderCompleteRequest defines model for WorkOrderCompleteRequest.
+type WorkOrderCompleteRequest struct {
+	Cost  *float64           `json:"cost,omitempty"`
+	Id    openapi_types.UUID `json:"id"`
+	Notes *string            `json:"notes,omitempty"`
+
+	// ResetMileage Reset the mileage of the wheel without a new tire
+	ResetMileage *bool            `json:"reset_mileage,omitempty"`
+	Tire         *TireReplacement `json:"tire,omitempty"`
+}
+
+// WorkOrderRequest defines model for WorkOrderRequest.
+type WorkOrderRequest struct {
+	Assignee *string `json:"assignee,omitempty"`
+
+	// BreakageId The breakage the work order is opened from, exclusive with task_id
+	BreakageId *openapi_types.UUID `json:"breakage_id,omitempty"`
+	Notes      *string             `json:"notes,omitempty"`
+
+	// TaskId The maintenance task the work order is opened from, exclusive with breakage_id
+	TaskId *openapi_types.UUID `json:"task_id,omitempty"`
+	Title  string              `json:"title"`
+
+	// WheelId The wheel of the car of the breakage the work order is for
+	WheelId *openapi_types.UUID `json:"wheel_id,omitempty"`
+}
+
+// WorkOrderResponse defines model for WorkOrderResponse.
+type WorkOrderResponse struct {
+	Assignee    *string             `json:"assignee,omitempty"`
+	BreakageId  *openapi_types.UUID `json:"breakage_id,omitempty"`
+	CarId       openapi_types.UUID  `json:"car_id"`
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+	Cost        *float64            `json:"cost,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	Id          openapi_types.UUID  `json:"id"`
+	Notes       *string             `json:"notes,omitempty"`
+	Status      string              `json:"status"`
+	TaskId      *openapi_types.UUID `json:"task_id,omitempty"`
+	Title       string              `json:"title"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+	WheelId     *openapi_types.UUID `json:"wheel_id,omitempty"`
+}
+
+// WorkSessionEndRequest defines model for WorkSessionEndRequest.
+type WorkSessionEndRequest struct {
+	DriverId openapi_types.UUID `json:"driver_id"`
+
+	// EndedAt End of the session, now by default
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+}
+
+// WorkSessionRequest defines model for WorkSessionRequest.
+type WorkSessionRequest struct {
+	CarId    *openapi_types.UUID `json:"car_id,omitempty"`
+	DriverId openapi_types.UUID  `json:"driver_id"`
+
+	// EndedAt End of a past session, absent to start an open one
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+
+	// StartedAt Start of the session, now by default
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// WorkSessionResponse defines model for WorkSessionResponse.
+type WorkSessionResponse struct {
+	CarId    *openapi_types.UUID `json:"car_id,omitempty"`
+	DriverId openapi_types.UUID  `json:"driver_id"`
+
+	// EndedAt Absent while the session is open
+	EndedAt     *time.Time         `json:"ended_at,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	Source      string             `json:"source"`
+	StartedAt   time.Time          `json:"started_at"`
+	StateNumber *string            `json:"state_number,omitempty"`
+}
+
+// WorkTimeSummaryResponse defines model for WorkTimeSummaryResponse.
+type WorkTimeSummaryResponse struct {
+	Days     []WorkDayResponse  `json:"days"`
+	DriverId openapi_types.UUID `json:"driver_id"`
+	Weeks    []WorkWeekResponse `json:"weeks"`
+}
+
 // WorkTimeUpdateRequest defines model for WorkTimeUpdateRequest.
 type WorkTimeUpdateRequest struct {
-	DeviceNum  string `json:"device_num"`
-	WorkedTime int    `json:"worked_time"`
+	DeviceNum string `json:"device_num"`
+
+	// EndedAt End of the reported work, now by default
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+
+	// WorkedTime Worked minutes
+	WorkedTime int `json:"worked_time"`
+}
+
+// WorkViolationNotice defines model for WorkViolationNotice.
+type WorkViolationNotice struct {
+	ActualMinutes int                `json:"actual_minutes"`
+	DriverId      openapi_types.UUID `json:"driver_id"`
+	DriverName    string             `json:"driver_name"`
+	Id            openapi_types.UUID `json:"id"`
+	LimitMinutes  int                `json:"limit_minutes"`
+	PeriodEnd     time.Time          `json:"period_end"`
+	PeriodStart   time.Time          `json:"period_start"`
+
+	// Type Limit that was exceeded
+	Type string `json:"type"`
+}
+
+// WorkViolationResponse defines model for WorkViolationResponse.
+type WorkViolationResponse struct {
+	// ActualMinutes Minutes driven, or of rest for daily_rest
+	ActualMinutes int                `json:"actual_minutes"`
+	CreatedAt     time.Time          `json:"created_at"`
+	DriverId      openapi_types.UUID `json:"driver_id"`
+	Id            openapi_types.UUID `json:"id"`
+	LimitMinutes  int                `json:"limit_minutes"`
+
+	// Note Text of the notification raised for the violation
+	Note      string    `json:"note"`
+	PeriodEnd time.Time `json:"period_end"`
+
+	// PeriodStart Start of the stretch of driving, day or week checked
+	PeriodStart time.Time `json:"period_start"`
+	Type        string    `json:"type"`
+}
+
+// WorkWeekResponse defines model for WorkWeekResponse.
+type WorkWeekResponse struct {
+	// Start Monday of the week
+	Start         openapi_types.Date `json:"start"`
+	WorkedMinutes int                `json:"worked_minutes"`
+}
+
+// GetAuditExportParams defines parameters for GetAuditExport.
+type GetAuditExportParams struct {
+	// ActorId User who made the change
+	ActorId *openapi_types.UUID `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+
+	// Action Action, e.g. create or update
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// ResourceType Resource type, e.g. wheel, car, driver, notification
+	ResourceType *string `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// ResourceId Identifier of the changed resource
+	ResourceId *string `form:"resource_id,omitempty" json:"resource_id,omitempty"`
+
+	// From Start of the period
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the period
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetAuditListParams defines parameters for GetAuditList.
+type GetAuditListParams struct {
+	// ActorId User who made the change
+	ActorId *openapi_types.UUID `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+
+	// Action Action, e.g. create or update
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// ResourceType Resource type, e.g. wheel, car, driver, notification
+	ResourceType *string `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// ResourceId Identifier of the changed resource
+	ResourceId *string `form:"resource_id,omitempty" json:"resource_id,omitempty"`
+
+	// From Start of the period
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the period
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset for pagination
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: created_at. Defaults to -created_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // GetAutoParams defines parameters for GetAuto.
@@ -399,14 +1570,125 @@ type GetAutoInfoParams struct {
 
 // GetAutoListParams defines parameters for GetAutoList.
 type GetAutoListParams struct {
-	Offset int `form:"offset" json:"offset"`
-	Limit  int `form:"limit" json:"limit"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  int  `form:"limit" json:"limit"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: state_number, brand, car_type. Defaults to state_number
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Brand Only cars of this brand
+	Brand *string `form:"brand,omitempty" json:"brand,omitempty"`
+
+	// CarType Only cars of this type
+	CarType *string `form:"car_type,omitempty" json:"car_type,omitempty"`
+}
+
+// GetBreakageParams defines parameters for GetBreakage.
+type GetBreakageParams struct {
+	BreakageId openapi_types.UUID `form:"breakage_id" json:"breakage_id"`
+}
+
+// GetBreakageHistoryParams defines parameters for GetBreakageHistory.
+type GetBreakageHistoryParams struct {
+	BreakageId openapi_types.UUID `form:"breakage_id" json:"breakage_id"`
 }
 
 // GetBreakageListParams defines parameters for GetBreakageList.
 type GetBreakageListParams struct {
 	// CarId Unique identifier for the car
 	CarId openapi_types.UUID `form:"car_id" json:"car_id"`
+
+	// Limit Limit for pagination
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset for pagination
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: created_at, type, status. Defaults to -created_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Type Only breakages of this type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Status Comma separated statuses the breakages may be in, any of reported, acknowledged, in_repair, resolved, closed
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Severity Only breakages whose catalogue type has this severity
+	Severity *string `form:"severity,omitempty" json:"severity,omitempty"`
+
+	// From Only breakages registered at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only breakages registered at or before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetBreakageStatsParams defines parameters for GetBreakageStats.
+type GetBreakageStatsParams struct {
+	GroupBy string `form:"group_by" json:"group_by"`
+
+	// From Only breakages registered at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only breakages registered at or before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// DeleteBreakageTypeParams defines parameters for DeleteBreakageType.
+type DeleteBreakageTypeParams struct {
+	TypeId openapi_types.UUID `form:"type_id" json:"type_id"`
+}
+
+// GetDriverAssignmentListParams defines parameters for GetDriverAssignmentList.
+type GetDriverAssignmentListParams struct {
+	DriverId *openapi_types.UUID `form:"driver_id,omitempty" json:"driver_id,omitempty"`
+	CarId    *openapi_types.UUID `form:"car_id,omitempty" json:"car_id,omitempty"`
+
+	// From Only assignments that had not ended by this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only assignments that started before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Offset Pagination offset
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Pagination limit
+	Limit int `form:"limit" json:"limit"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: started_at. Defaults to -started_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// DeleteDriverDocumentParams defines parameters for DeleteDriverDocument.
+type DeleteDriverDocumentParams struct {
+	DocumentId openapi_types.UUID `form:"document_id" json:"document_id"`
+}
+
+// GetDriverDocumentParams defines parameters for GetDriverDocument.
+type GetDriverDocumentParams struct {
+	DocumentId openapi_types.UUID `form:"document_id" json:"document_id"`
+}
+
+// PostDriverDocumentParams defines parameters for PostDriverDocument.
+type PostDriverDocumentParams struct {
+	DriverId openapi_types.UUID `form:"driver_id" json:"driver_id"`
+	Type     string             `form:"type" json:"type"`
+	FileName string             `form:"file_name" json:"file_name"`
+}
+
+// GetDriverDocumentListParams defines parameters for GetDriverDocumentList.
+type GetDriverDocumentListParams struct {
+	DriverId openapi_types.UUID `form:"driver_id" json:"driver_id"`
 }
 
 // GetDriverInfoParams defines parameters for GetDriverInfo.
@@ -418,10 +1700,109 @@ type GetDriverInfoParams struct {
 // GetDriverListParams defines parameters for GetDriverList.
 type GetDriverListParams struct {
 	// Offset Pagination offset
-	Offset int `form:"offset" json:"offset"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Pagination limit
+	Limit int `form:"limit" json:"limit"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: created_at, full_name, worked_time, rating, breakages_count, rank. Defaults to -created_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IncludeInactive Include deactivated drivers
+	IncludeInactive *bool `form:"include_inactive,omitempty" json:"include_inactive,omitempty"`
+}
+
+// GetDriverTimesheetParams defines parameters for GetDriverTimesheet.
+type GetDriverTimesheetParams struct {
+	DriverId openapi_types.UUID `form:"driver_id" json:"driver_id"`
+
+	// Month Month in the company's time zone
+	Month string `form:"month" json:"month"`
+}
+
+// GetDriverWorkSessionListParams defines parameters for GetDriverWorkSessionList.
+type GetDriverWorkSessionListParams struct {
+	DriverId *openapi_types.UUID `form:"driver_id,omitempty" json:"driver_id,omitempty"`
+
+	// From Only sessions that had not ended by this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only sessions that started before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Offset Pagination offset
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Pagination limit
+	Limit int `form:"limit" json:"limit"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: started_at. Defaults to -started_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetDriverWorkTimeParams defines parameters for GetDriverWorkTime.
+type GetDriverWorkTimeParams struct {
+	DriverId openapi_types.UUID `form:"driver_id" json:"driver_id"`
+
+	// From First day of the period
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To Last day of the period, at most 366 days after from
+	To openapi_types.Date `form:"to" json:"to"`
+}
+
+// GetDriverWorkViolationListParams defines parameters for GetDriverWorkViolationList.
+type GetDriverWorkViolationListParams struct {
+	DriverId *openapi_types.UUID `form:"driver_id,omitempty" json:"driver_id,omitempty"`
+	Type     *string             `form:"type,omitempty" json:"type,omitempty"`
+
+	// From Only violations of periods that ended after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only violations of periods that started before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Offset Pagination offset
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 
 	// Limit Pagination limit
 	Limit int `form:"limit" json:"limit"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: period_start, created_at. Defaults to -period_start
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// PostImportKindParams defines parameters for PostImportKind.
+type PostImportKindParams struct {
+	// DryRun Validate the file without creating anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetImportKindTemplateParams defines parameters for GetImportKindTemplate.
+type GetImportKindTemplateParams struct {
+	// Format File format, csv or xlsx
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetMaintenanceUpcomingParams defines parameters for GetMaintenanceUpcoming.
+type GetMaintenanceUpcomingParams struct {
+	// CarId Only tasks of this car
+	CarId *openapi_types.UUID `form:"car_id,omitempty" json:"car_id,omitempty"`
+}
+
+// GetNotificationDeliveriesParams defines parameters for GetNotificationDeliveries.
+type GetNotificationDeliveriesParams struct {
+	// Id Unique identifier of the notification
+	Id openapi_types.UUID `form:"id" json:"id"`
 }
 
 // GetNotificationInfoParams defines parameters for GetNotificationInfo.
@@ -439,7 +1820,44 @@ type GetNotificationListParams struct {
 	Limit int `form:"limit" json:"limit"`
 
 	// Offset Offset for pagination
-	Offset int `form:"offset" json:"offset"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: created_at, state_number, breakage_type, type, severity. Defaults to -created_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Type Only notifications of this type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// MinSeverity Only notifications at least this severe
+	MinSeverity *string `form:"min_severity,omitempty" json:"min_severity,omitempty"`
+
+	// BreakageType Only notifications about breakages of this type
+	BreakageType *string `form:"breakage_type,omitempty" json:"breakage_type,omitempty"`
+
+	// BreakageStatus Only notifications about breakages currently in this status
+	BreakageStatus *string `form:"breakage_status,omitempty" json:"breakage_status,omitempty"`
+
+	// From Only notifications created at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only notifications created at or before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// DeleteNotificationRuleParams defines parameters for DeleteNotificationRule.
+type DeleteNotificationRuleParams struct {
+	RuleId openapi_types.UUID `form:"rule_id" json:"rule_id"`
+}
+
+// GetPortabilityExportParams defines parameters for GetPortabilityExport.
+type GetPortabilityExportParams struct {
+	// Format Format of the entity files, jsonl or csv
+	Format *string    `form:"format,omitempty" json:"format,omitempty"`
+	From   *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
 }
 
 // GetPositionCarrouteParams defines parameters for GetPositionCarroute.
@@ -454,13 +1872,42 @@ type GetPositionCarrouteParams struct {
 	TimeTo time.Time `form:"time_to" json:"time_to"`
 }
 
+// GetPositionDrivertripsParams defines parameters for GetPositionDrivertrips.
+type GetPositionDrivertripsParams struct {
+	// DriverId Unique identifier of the driver
+	DriverId openapi_types.UUID `form:"driver_id" json:"driver_id"`
+
+	// TimeFrom Start of the period
+	TimeFrom time.Time `form:"time_from" json:"time_from"`
+
+	// TimeTo End of the period
+	TimeTo time.Time `form:"time_to" json:"time_to"`
+}
+
+// DeletePositionGeofenceParams defines parameters for DeletePositionGeofence.
+type DeletePositionGeofenceParams struct {
+	GeofenceId openapi_types.UUID `form:"geofence_id" json:"geofence_id"`
+}
+
 // GetPositionsListcarsParams defines parameters for GetPositionsListcars.
 type GetPositionsListcarsParams struct {
 	// Limit Limit for pagination
 	Limit int `form:"limit" json:"limit"`
 
 	// Offset Offset for pagination
-	Offset int `form:"offset" json:"offset"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: state_number, brand, car_type. Defaults to state_number
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Brand Only cars of this brand
+	Brand *string `form:"brand,omitempty" json:"brand,omitempty"`
+
+	// CarType Only cars of this type
+	CarType *string `form:"car_type,omitempty" json:"car_type,omitempty"`
 }
 
 // GetPressuredataParams defines parameters for GetPressuredata.
@@ -470,6 +1917,18 @@ type GetPressuredataParams struct {
 	To      time.Time `form:"to" json:"to"`
 }
 
+// GetSearchParams defines parameters for GetSearch.
+type GetSearchParams struct {
+	// Q Text to search for, at least 2 characters
+	Q string `form:"q" json:"q"`
+
+	// Types Comma separated kinds of records to search, any of car, driver, wheel, breakage. Defaults to all
+	Types *string `form:"types,omitempty" json:"types,omitempty"`
+
+	// Limit Maximum number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetSensorsParams defines parameters for GetSensors.
 type GetSensorsParams struct {
 	CarId string `form:"car_id" json:"car_id"`
@@ -482,41 +1941,167 @@ type GetTemperaturedataParams struct {
 	To      time.Time `form:"to" json:"to"`
 }
 
-// GetWheelsParams defines parameters for GetWheels.
-type GetWheelsParams struct {
-	Id string `form:"id" json:"id"`
+// DeleteWebhookParams defines parameters for DeleteWebhook.
+type DeleteWebhookParams struct {
+	WebhookId openapi_types.UUID `form:"webhook_id" json:"webhook_id"`
 }
 
-// PostAutoJSONRequestBody defines body for PostAuto for application/json ContentType.
+// GetWebhookDeliveryAttemptsParams defines parameters for GetWebhookDeliveryAttempts.
+type GetWebhookDeliveryAttemptsParams struct {
+	DeliveryId openapi_types.UUID `form:"delivery_id" json:"delivery_id"`
+}
+
+// GetWebhookDeliveryListParams defines parameters for GetWebhookDeliveryList.
+type GetWebhookDeliveryListParams struct {
+	// WebhookId Only deliveries to this endpoint
+	WebhookId *openapi_types.UUID `form:"webhook_id,omitempty" json:"webhook_id,omitempty"`
+
+	// Status Only deliveries in this status
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// EventType Only deliveries of this event
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset for pagination
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: created_at, next_attempt_at. Defaults to -created_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetWheelsParams defines parameters for GetWheels.
+type GetWheelsParams struct {
+	Id string `form:"id" json:"id"`
+}
+
+// GetWorkOrderParams defines parameters for GetWorkOrder.
+type GetWorkOrderParams struct {
+	WorkOrderId openapi_types.UUID `form:"work_order_id" json:"work_order_id"`
+}
+
+// GetWorkOrderListParams defines parameters for GetWorkOrderList.
+type GetWorkOrderListParams struct {
+	// CarId Only work orders of this car
+	CarId *openapi_types.UUID `form:"car_id,omitempty" json:"car_id,omitempty"`
+
+	// Status Only work orders in this status
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Limit for pagination
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Offset for pagination
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from the X-Next-Cursor header of the previous page, used instead of offset
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort Sort field, prefixed with - for descending order: created_at, status. Defaults to -created_at
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// PostAutoJSONRequestBody defines body for PostAuto for application/json ContentType.
 type PostAutoJSONRequestBody = AutoRegistration
 
 // PostBreakageJSONRequestBody defines body for PostBreakage for application/json ContentType.
 type PostBreakageJSONRequestBody = BreakageFromMqttRequest
 
+// PutBreakageJSONRequestBody defines body for PutBreakage for application/json ContentType.
+type PutBreakageJSONRequestBody = BreakageUpdateRequest
+
+// PutBreakageStatusJSONRequestBody defines body for PutBreakageStatus for application/json ContentType.
+type PutBreakageStatusJSONRequestBody = BreakageStatusRequest
+
+// PostBreakageTypeJSONRequestBody defines body for PostBreakageType for application/json ContentType.
+type PostBreakageTypeJSONRequestBody = BreakageTypeRequest
+
+// PutBreakageTypeJSONRequestBody defines body for PutBreakageType for application/json ContentType.
+type PutBreakageTypeJSONRequestBody = BreakageTypeUpdateRequest
+
 // PostDriverJSONRequestBody defines body for PostDriver for application/json ContentType.
 type PostDriverJSONRequestBody = DriverRegistration
 
+// PutDriverJSONRequestBody defines body for PutDriver for application/json ContentType.
+type PutDriverJSONRequestBody = DriverUpdateRequest
+
+// PostDriverAssignmentJSONRequestBody defines body for PostDriverAssignment for application/json ContentType.
+type PostDriverAssignmentJSONRequestBody = DriverAssignmentRequest
+
+// PutDriverAssignmentEndJSONRequestBody defines body for PutDriverAssignmentEnd for application/json ContentType.
+type PutDriverAssignmentEndJSONRequestBody = DriverUnassignRequest
+
+// PutDriverDeactivateJSONRequestBody defines body for PutDriverDeactivate for application/json ContentType.
+type PutDriverDeactivateJSONRequestBody = DriverDeactivateRequest
+
+// PostDriverWorkSessionJSONRequestBody defines body for PostDriverWorkSession for application/json ContentType.
+type PostDriverWorkSessionJSONRequestBody = WorkSessionRequest
+
+// PutDriverWorkSessionEndJSONRequestBody defines body for PutDriverWorkSessionEnd for application/json ContentType.
+type PutDriverWorkSessionEndJSONRequestBody = WorkSessionEndRequest
+
 // PutDriverWorktimeJSONRequestBody defines body for PutDriverWorktime for application/json ContentType.
 type PutDriverWorktimeJSONRequestBody = WorkTimeUpdateRequest
 
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody = LoginRequest
 
+// PostLoginTotpJSONRequestBody defines body for PostLoginTotp for application/json ContentType.
+type PostLoginTotpJSONRequestBody = TotpLoginRequest
+
+// PostLoginTotpEnrollJSONRequestBody defines body for PostLoginTotpEnroll for application/json ContentType.
+type PostLoginTotpEnrollJSONRequestBody = TotpChallengeRequest
+
+// PostMaintenancePlanJSONRequestBody defines body for PostMaintenancePlan for application/json ContentType.
+type PostMaintenancePlanJSONRequestBody = MaintenancePlanRequest
+
+// PutMaintenancePlanJSONRequestBody defines body for PutMaintenancePlan for application/json ContentType.
+type PutMaintenancePlanJSONRequestBody = MaintenancePlanUpdateRequest
+
 // PutMileageJSONRequestBody defines body for PutMileage for application/json ContentType.
 type PutMileageJSONRequestBody = UpdateMileageRequest
 
 // PutNotificationAllstatusJSONRequestBody defines body for PutNotificationAllstatus for application/json ContentType.
 type PutNotificationAllstatusJSONRequestBody = ChangeAllNotificationsStatusRequest
 
+// PostNotificationRuleJSONRequestBody defines body for PostNotificationRule for application/json ContentType.
+type PostNotificationRuleJSONRequestBody = NotificationRuleRequest
+
+// PutNotificationRuleJSONRequestBody defines body for PutNotificationRule for application/json ContentType.
+type PutNotificationRuleJSONRequestBody = NotificationRuleUpdateRequest
+
 // PutNotificationStatusJSONRequestBody defines body for PutNotificationStatus for application/json ContentType.
 type PutNotificationStatusJSONRequestBody = ChangeNotificationStatusRequest
 
 // PostPositionJSONRequestBody defines body for PostPosition for application/json ContentType.
 type PostPositionJSONRequestBody = PositionRequest
 
+// PostPositionGeofenceJSONRequestBody defines body for PostPositionGeofence for application/json ContentType.
+type PostPositionGeofenceJSONRequestBody = GeofenceRequest
+
+// PutPositionGeofenceJSONRequestBody defines body for PutPositionGeofence for application/json ContentType.
+type PutPositionGeofenceJSONRequestBody = GeofenceUpdateRequest
+
 // PostSensordataJSONRequestBody defines body for PostSensordata for application/json ContentType.
 type PostSensordataJSONRequestBody = NewSensorData
 
+// PostTotpConfirmJSONRequestBody defines body for PostTotpConfirm for application/json ContentType.
+type PostTotpConfirmJSONRequestBody = TotpCodeRequest
+
+// PostTotpDisableJSONRequestBody defines body for PostTotpDisable for application/json ContentType.
+type PostTotpDisableJSONRequestBody = TotpCodeRequest
+
+// PutTotpPolicyJSONRequestBody defines body for PutTotpPolicy for application/json ContentType.
+type PutTotpPolicyJSONRequestBody = TotpPolicyRequest
+
+// PostTotpRecoverycodesJSONRequestBody defines body for PostTotpRecoverycodes for application/json ContentType.
+type PostTotpRecoverycodesJSONRequestBody = TotpCodeRequest
+
 // PostUserJSONRequestBody defines body for PostUser for application/json ContentType.
 type PostUserJSONRequestBody = UserRegistration
 
@@ -526,14 +2111,38 @@ type PutUserJSONRequestBody = UpdatePassword
 // PutUserinfoJSONRequestBody defines body for PutUserinfo for application/json ContentType.
 type PutUserinfoJSONRequestBody = UserDetails
 
+// PostWebhookJSONRequestBody defines body for PostWebhook for application/json ContentType.
+type PostWebhookJSONRequestBody = WebhookRequest
+
+// PutWebhookJSONRequestBody defines body for PutWebhook for application/json ContentType.
+type PutWebhookJSONRequestBody = WebhookUpdateRequest
+
+// PutWebhookDeliveryReplayJSONRequestBody defines body for PutWebhookDeliveryReplay for application/json ContentType.
+type PutWebhookDeliveryReplayJSONRequestBody = WebhookReplayRequest
+
 // PostWheelsJSONRequestBody defines body for PostWheels for application/json ContentType.
 type PostWheelsJSONRequestBody = WheelRegistration
 
 // PutWheelsJSONRequestBody defines body for PutWheels for application/json ContentType.
 type PutWheelsJSONRequestBody = WheelChange
 
+// PostWorkOrderJSONRequestBody defines body for PostWorkOrder for application/json ContentType.
+type PostWorkOrderJSONRequestBody = WorkOrderRequest
+
+// PutWorkOrderCancelJSONRequestBody defines body for PutWorkOrderCancel for application/json ContentType.
+type PutWorkOrderCancelJSONRequestBody = WorkOrderCancelRequest
+
+// PutWorkOrderCompleteJSONRequestBody defines body for PutWorkOrderComplete for application/json ContentType.
+type PutWorkOrderCompleteJSONRequestBody = WorkOrderCompleteRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Export the audit log as CSV
+	// (GET /audit/export)
+	GetAuditExport(w http.ResponseWriter, r *http.Request, params GetAuditExportParams)
+	// Get the audit log of the company
+	// (GET /audit/list)
+	GetAuditList(w http.ResponseWriter, r *http.Request, params GetAuditListParams)
 	// Get a single Auto by ID
 	// (GET /auto)
 	GetAuto(w http.ResponseWriter, r *http.Request, params GetAutoParams)
@@ -546,48 +2155,180 @@ type ServerInterface interface {
 	// Get list of Autos
 	// (GET /auto/list)
 	GetAutoList(w http.ResponseWriter, r *http.Request, params GetAutoListParams)
+	// Get a breakage with the state of its repair
+	// (GET /breakage)
+	GetBreakage(w http.ResponseWriter, r *http.Request, params GetBreakageParams)
 	// Add a new breakage from MQTT data
 	// (POST /breakage)
 	PostBreakage(w http.ResponseWriter, r *http.Request)
+	// Update the repair details of a breakage
+	// (PUT /breakage)
+	PutBreakage(w http.ResponseWriter, r *http.Request)
+	// Status changes of a breakage
+	// (GET /breakage/history)
+	GetBreakageHistory(w http.ResponseWriter, r *http.Request, params GetBreakageHistoryParams)
 	// Get a list of breakages for a specific car
 	// (GET /breakage/list)
 	GetBreakageList(w http.ResponseWriter, r *http.Request, params GetBreakageListParams)
+	// Breakage frequency by type, car model or driver
+	// (GET /breakage/stats)
+	GetBreakageStats(w http.ResponseWriter, r *http.Request, params GetBreakageStatsParams)
+	// Change the status of a breakage
+	// (PUT /breakage/status)
+	PutBreakageStatus(w http.ResponseWriter, r *http.Request)
+	// Remove a type from the breakage catalogue
+	// (DELETE /breakage/type)
+	DeleteBreakageType(w http.ResponseWriter, r *http.Request, params DeleteBreakageTypeParams)
+	// Add a type to the breakage catalogue
+	// (POST /breakage/type)
+	PostBreakageType(w http.ResponseWriter, r *http.Request)
+	// Replace a type of the breakage catalogue
+	// (PUT /breakage/type)
+	PutBreakageType(w http.ResponseWriter, r *http.Request)
+	// The breakage catalogue of the company
+	// (GET /breakage/type/list)
+	GetBreakageTypeList(w http.ResponseWriter, r *http.Request)
 	// Add a driver
 	// (POST /driver)
 	PostDriver(w http.ResponseWriter, r *http.Request)
+	// Update a driver
+	// (PUT /driver)
+	PutDriver(w http.ResponseWriter, r *http.Request)
+	// Assign a driver to a car
+	// (POST /driver/assignment)
+	PostDriverAssignment(w http.ResponseWriter, r *http.Request)
+	// Unassign a driver from their car
+	// (PUT /driver/assignment/end)
+	PutDriverAssignmentEnd(w http.ResponseWriter, r *http.Request)
+	// History of driver assignments
+	// (GET /driver/assignment/list)
+	GetDriverAssignmentList(w http.ResponseWriter, r *http.Request, params GetDriverAssignmentListParams)
+	// Deactivate a driver
+	// (PUT /driver/deactivate)
+	PutDriverDeactivate(w http.ResponseWriter, r *http.Request)
+	// Delete a document of a driver
+	// (DELETE /driver/document)
+	DeleteDriverDocument(w http.ResponseWriter, r *http.Request, params DeleteDriverDocumentParams)
+	// Download a document of a driver
+	// (GET /driver/document)
+	GetDriverDocument(w http.ResponseWriter, r *http.Request, params GetDriverDocumentParams)
+	// Attach a document to a driver
+	// (POST /driver/document)
+	PostDriverDocument(w http.ResponseWriter, r *http.Request, params PostDriverDocumentParams)
+	// Documents of a driver
+	// (GET /driver/document/list)
+	GetDriverDocumentList(w http.ResponseWriter, r *http.Request, params GetDriverDocumentListParams)
 	// Driver information
 	// (GET /driver/info)
 	GetDriverInfo(w http.ResponseWriter, r *http.Request, params GetDriverInfoParams)
 	// Drivers info
 	// (GET /driver/list)
 	GetDriverList(w http.ResponseWriter, r *http.Request, params GetDriverListParams)
+	// Monthly timesheet of a driver
+	// (GET /driver/timesheet)
+	GetDriverTimesheet(w http.ResponseWriter, r *http.Request, params GetDriverTimesheetParams)
+	// Record a work session of a driver
+	// (POST /driver/work-session)
+	PostDriverWorkSession(w http.ResponseWriter, r *http.Request)
+	// End the open work session of a driver
+	// (PUT /driver/work-session/end)
+	PutDriverWorkSessionEnd(w http.ResponseWriter, r *http.Request)
+	// Log of work sessions
+	// (GET /driver/work-session/list)
+	GetDriverWorkSessionList(w http.ResponseWriter, r *http.Request, params GetDriverWorkSessionListParams)
+	// Daily and weekly work totals of a driver
+	// (GET /driver/work-time)
+	GetDriverWorkTime(w http.ResponseWriter, r *http.Request, params GetDriverWorkTimeParams)
+	// Violations of the rest-time rules
+	// (GET /driver/work-violation/list)
+	GetDriverWorkViolationList(w http.ResponseWriter, r *http.Request, params GetDriverWorkViolationListParams)
 	// Update the driver's worked hours
 	// (PUT /driver/worktime)
 	PutDriverWorktime(w http.ResponseWriter, r *http.Request)
+	// Import cars, wheels or drivers from a CSV or XLSX file
+	// (POST /import/{kind})
+	PostImportKind(w http.ResponseWriter, r *http.Request, kind string, params PostImportKindParams)
+	// Download an empty import file with the columns of a kind
+	// (GET /import/{kind}/template)
+	GetImportKindTemplate(w http.ResponseWriter, r *http.Request, kind string, params GetImportKindTemplateParams)
 	// User login
 	// (POST /login)
 	PostLogin(w http.ResponseWriter, r *http.Request)
+	// Complete login with a TOTP or recovery code
+	// (POST /login/totp)
+	PostLoginTotp(w http.ResponseWriter, r *http.Request)
+	// Start TOTP enrollment during login when company policy requires it
+	// (POST /login/totp/enroll)
+	PostLoginTotpEnroll(w http.ResponseWriter, r *http.Request)
+	// Add a maintenance plan
+	// (POST /maintenance/plan)
+	PostMaintenancePlan(w http.ResponseWriter, r *http.Request)
+	// Replace a maintenance plan
+	// (PUT /maintenance/plan)
+	PutMaintenancePlan(w http.ResponseWriter, r *http.Request)
+	// The maintenance plans of the company
+	// (GET /maintenance/plan/list)
+	GetMaintenancePlanList(w http.ResponseWriter, r *http.Request)
+	// Upcoming maintenance
+	// (GET /maintenance/upcoming)
+	GetMaintenanceUpcoming(w http.ResponseWriter, r *http.Request, params GetMaintenanceUpcomingParams)
 	// Update car mileage
 	// (PUT /mileage)
 	PutMileage(w http.ResponseWriter, r *http.Request)
 	// Change the status of all notifications for a specific user
 	// (PUT /notification/allstatus)
 	PutNotificationAllstatus(w http.ResponseWriter, r *http.Request)
+	// Delivery status of a notification on each channel it was routed to
+	// (GET /notification/deliveries)
+	GetNotificationDeliveries(w http.ResponseWriter, r *http.Request, params GetNotificationDeliveriesParams)
 	// Get detailed information about a specific notification
 	// (GET /notification/info)
 	GetNotificationInfo(w http.ResponseWriter, r *http.Request, params GetNotificationInfoParams)
 	// Get list of notifications based on status
 	// (GET /notification/list)
 	GetNotificationList(w http.ResponseWriter, r *http.Request, params GetNotificationListParams)
+	// Remove a notification routing rule
+	// (DELETE /notification/rule)
+	DeleteNotificationRule(w http.ResponseWriter, r *http.Request, params DeleteNotificationRuleParams)
+	// Add a notification routing rule
+	// (POST /notification/rule)
+	PostNotificationRule(w http.ResponseWriter, r *http.Request)
+	// Replace a notification routing rule
+	// (PUT /notification/rule)
+	PutNotificationRule(w http.ResponseWriter, r *http.Request)
+	// The notification routing rules of the company
+	// (GET /notification/rule/list)
+	GetNotificationRuleList(w http.ResponseWriter, r *http.Request)
 	// Change the status of a specific notification
 	// (PUT /notification/status)
 	PutNotificationStatus(w http.ResponseWriter, r *http.Request)
+	// Export all of the company's data as a zip archive
+	// (GET /portability/export)
+	GetPortabilityExport(w http.ResponseWriter, r *http.Request, params GetPortabilityExportParams)
+	// Import an export archive into the company
+	// (POST /portability/import)
+	PostPortabilityImport(w http.ResponseWriter, r *http.Request)
 	// Add car position from MQTT
 	// (POST /position)
 	PostPosition(w http.ResponseWriter, r *http.Request)
 	// Get the route of a car
 	// (GET /position/carroute)
 	GetPositionCarroute(w http.ResponseWriter, r *http.Request, params GetPositionCarrouteParams)
+	// Get the trips of a driver
+	// (GET /position/drivertrips)
+	GetPositionDrivertrips(w http.ResponseWriter, r *http.Request, params GetPositionDrivertripsParams)
+	// Remove a geofence
+	// (DELETE /position/geofence)
+	DeletePositionGeofence(w http.ResponseWriter, r *http.Request, params DeletePositionGeofenceParams)
+	// Add a geofence
+	// (POST /position/geofence)
+	PostPositionGeofence(w http.ResponseWriter, r *http.Request)
+	// Replace a geofence
+	// (PUT /position/geofence)
+	PutPositionGeofence(w http.ResponseWriter, r *http.Request)
+	// The geofences of the company
+	// (GET /position/geofence/list)
+	GetPositionGeofenceList(w http.ResponseWriter, r *http.Request)
 	// Get current car positions
 	// (GET /position/listcurrent)
 	GetPositionListcurrent(w http.ResponseWriter, r *http.Request)
@@ -603,6 +2344,9 @@ type ServerInterface interface {
 	// Generate report
 	// (GET /report)
 	GetReport(w http.ResponseWriter, r *http.Request)
+	// Search cars, drivers, wheels and breakages of the company
+	// (GET /search)
+	GetSearch(w http.ResponseWriter, r *http.Request, params GetSearchParams)
 	// Update an existing sensor
 	// (POST /sensordata)
 	PostSensordata(w http.ResponseWriter, r *http.Request)
@@ -612,6 +2356,24 @@ type ServerInterface interface {
 	// Get data by wheel ID
 	// (GET /temperaturedata)
 	GetTemperaturedata(w http.ResponseWriter, r *http.Request, params GetTemperaturedataParams)
+	// Get two-factor authentication status
+	// (GET /totp)
+	GetTotp(w http.ResponseWriter, r *http.Request)
+	// Confirm TOTP enrollment and enable two-factor authentication
+	// (POST /totp/confirm)
+	PostTotpConfirm(w http.ResponseWriter, r *http.Request)
+	// Disable two-factor authentication
+	// (POST /totp/disable)
+	PostTotpDisable(w http.ResponseWriter, r *http.Request)
+	// Start TOTP enrollment
+	// (POST /totp/enroll)
+	PostTotpEnroll(w http.ResponseWriter, r *http.Request)
+	// Set the company two-factor authentication policy
+	// (PUT /totp/policy)
+	PutTotpPolicy(w http.ResponseWriter, r *http.Request)
+	// Regenerate recovery codes
+	// (POST /totp/recoverycodes)
+	PostTotpRecoverycodes(w http.ResponseWriter, r *http.Request)
 	// Get user details
 	// (GET /user)
 	GetUser(w http.ResponseWriter, r *http.Request)
@@ -624,6 +2386,27 @@ type ServerInterface interface {
 	// Update user details
 	// (PUT /userinfo)
 	PutUserinfo(w http.ResponseWriter, r *http.Request)
+	// Remove a webhook endpoint with its deliveries
+	// (DELETE /webhook)
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, params DeleteWebhookParams)
+	// Register a webhook endpoint
+	// (POST /webhook)
+	PostWebhook(w http.ResponseWriter, r *http.Request)
+	// Replace a webhook endpoint
+	// (PUT /webhook)
+	PutWebhook(w http.ResponseWriter, r *http.Request)
+	// Attempts of a webhook delivery
+	// (GET /webhook/delivery/attempts)
+	GetWebhookDeliveryAttempts(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveryAttemptsParams)
+	// Get the webhook delivery log of the company
+	// (GET /webhook/delivery/list)
+	GetWebhookDeliveryList(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveryListParams)
+	// Deliver a webhook delivery again
+	// (PUT /webhook/delivery/replay)
+	PutWebhookDeliveryReplay(w http.ResponseWriter, r *http.Request)
+	// The webhook endpoints of the company
+	// (GET /webhook/list)
+	GetWebhookList(w http.ResponseWriter, r *http.Request)
 	// Get wheel data
 	// (GET /wheels)
 	GetWheels(w http.ResponseWriter, r *http.Request, params GetWheelsParams)
@@ -636,12 +2419,39 @@ type ServerInterface interface {
 	// Get wheels by state number
 	// (GET /wheels/{state_number})
 	GetWheelsStateNumber(w http.ResponseWriter, r *http.Request, stateNumber string)
+	// Get a work order
+	// (GET /work-order)
+	GetWorkOrder(w http.ResponseWriter, r *http.Request, params GetWorkOrderParams)
+	// Open a work order from a breakage or a maintenance task
+	// (POST /work-order)
+	PostWorkOrder(w http.ResponseWriter, r *http.Request)
+	// Cancel an open work order
+	// (PUT /work-order/cancel)
+	PutWorkOrderCancel(w http.ResponseWriter, r *http.Request)
+	// Complete an open work order
+	// (PUT /work-order/complete)
+	PutWorkOrderComplete(w http.ResponseWriter, r *http.Request)
+	// Get a list of work orders
+	// (GET /work-order/list)
+	GetWorkOrderList(w http.ResponseWriter, r *http.Request, params GetWorkOrderListParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Export the audit log as CSV
+// (GET /audit/export)
+func (_ Unimplemented) GetAuditExport(w http.ResponseWriter, r *http.Request, params GetAuditExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the audit log of the company
+// (GET /audit/list)
+func (_ Unimplemented) GetAuditList(w http.ResponseWriter, r *http.Request, params GetAuditListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a single Auto by ID
 // (GET /auto)
 func (_ Unimplemented) GetAuto(w http.ResponseWriter, r *http.Request, params GetAutoParams) {
@@ -666,24 +2476,132 @@ func (_ Unimplemented) GetAutoList(w http.ResponseWriter, r *http.Request, param
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a breakage with the state of its repair
+// (GET /breakage)
+func (_ Unimplemented) GetBreakage(w http.ResponseWriter, r *http.Request, params GetBreakageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add a new breakage from MQTT data
 // (POST /breakage)
 func (_ Unimplemented) PostBreakage(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update the repair details of a breakage
+// (PUT /breakage)
+func (_ Unimplemented) PutBreakage(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Status changes of a breakage
+// (GET /breakage/history)
+func (_ Unimplemented) GetBreakageHistory(w http.ResponseWriter, r *http.Request, params GetBreakageHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a list of breakages for a specific car
 // (GET /breakage/list)
 func (_ Unimplemented) GetBreakageList(w http.ResponseWriter, r *http.Request, params GetBreakageListParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Breakage frequency by type, car model or driver
+// (GET /breakage/stats)
+func (_ Unimplemented) GetBreakageStats(w http.ResponseWriter, r *http.Request, params GetBreakageStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change the status of a breakage
+// (PUT /breakage/status)
+func (_ Unimplemented) PutBreakageStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a type from the breakage catalogue
+// (DELETE /breakage/type)
+func (_ Unimplemented) DeleteBreakageType(w http.ResponseWriter, r *http.Request, params DeleteBreakageTypeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a type to the breakage catalogue
+// (POST /breakage/type)
+func (_ Unimplemented) PostBreakageType(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a type of the breakage catalogue
+// (PUT /breakage/type)
+func (_ Unimplemented) PutBreakageType(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// The breakage catalogue of the company
+// (GET /breakage/type/list)
+func (_ Unimplemented) GetBreakageTypeList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add a driver
 // (POST /driver)
 func (_ Unimplemented) PostDriver(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update a driver
+// (PUT /driver)
+func (_ Unimplemented) PutDriver(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Assign a driver to a car
+// (POST /driver/assignment)
+func (_ Unimplemented) PostDriverAssignment(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unassign a driver from their car
+// (PUT /driver/assignment/end)
+func (_ Unimplemented) PutDriverAssignmentEnd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// History of driver assignments
+// (GET /driver/assignment/list)
+func (_ Unimplemented) GetDriverAssignmentList(w http.ResponseWriter, r *http.Request, params GetDriverAssignmentListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Deactivate a driver
+// (PUT /driver/deactivate)
+func (_ Unimplemented) PutDriverDeactivate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a document of a driver
+// (DELETE /driver/document)
+func (_ Unimplemented) DeleteDriverDocument(w http.ResponseWriter, r *http.Request, params DeleteDriverDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a document of a driver
+// (GET /driver/document)
+func (_ Unimplemented) GetDriverDocument(w http.ResponseWriter, r *http.Request, params GetDriverDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Attach a document to a driver
+// (POST /driver/document)
+func (_ Unimplemented) PostDriverDocument(w http.ResponseWriter, r *http.Request, params PostDriverDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Documents of a driver
+// (GET /driver/document/list)
+func (_ Unimplemented) GetDriverDocumentList(w http.ResponseWriter, r *http.Request, params GetDriverDocumentListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Driver information
 // (GET /driver/info)
 func (_ Unimplemented) GetDriverInfo(w http.ResponseWriter, r *http.Request, params GetDriverInfoParams) {
@@ -696,18 +2614,102 @@ func (_ Unimplemented) GetDriverList(w http.ResponseWriter, r *http.Request, par
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Monthly timesheet of a driver
+// (GET /driver/timesheet)
+func (_ Unimplemented) GetDriverTimesheet(w http.ResponseWriter, r *http.Request, params GetDriverTimesheetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Record a work session of a driver
+// (POST /driver/work-session)
+func (_ Unimplemented) PostDriverWorkSession(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// End the open work session of a driver
+// (PUT /driver/work-session/end)
+func (_ Unimplemented) PutDriverWorkSessionEnd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log of work sessions
+// (GET /driver/work-session/list)
+func (_ Unimplemented) GetDriverWorkSessionList(w http.ResponseWriter, r *http.Request, params GetDriverWorkSessionListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Daily and weekly work totals of a driver
+// (GET /driver/work-time)
+func (_ Unimplemented) GetDriverWorkTime(w http.ResponseWriter, r *http.Request, params GetDriverWorkTimeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Violations of the rest-time rules
+// (GET /driver/work-violation/list)
+func (_ Unimplemented) GetDriverWorkViolationList(w http.ResponseWriter, r *http.Request, params GetDriverWorkViolationListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Update the driver's worked hours
 // (PUT /driver/worktime)
 func (_ Unimplemented) PutDriverWorktime(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Import cars, wheels or drivers from a CSV or XLSX file
+// (POST /import/{kind})
+func (_ Unimplemented) PostImportKind(w http.ResponseWriter, r *http.Request, kind string, params PostImportKindParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download an empty import file with the columns of a kind
+// (GET /import/{kind}/template)
+func (_ Unimplemented) GetImportKindTemplate(w http.ResponseWriter, r *http.Request, kind string, params GetImportKindTemplateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // User login
 // (POST /login)
 func (_ Unimplemented) PostLogin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Complete login with a TOTP or recovery code
+// (POST /login/totp)
+func (_ Unimplemented) PostLoginTotp(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start TOTP enrollment during login when company policy requires it
+// (POST /login/totp/enroll)
+func (_ Unimplemented) PostLoginTotpEnroll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a maintenance plan
+// (POST /maintenance/plan)
+func (_ Unimplemented) PostMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a maintenance plan
+// (PUT /maintenance/plan)
+func (_ Unimplemented) PutMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// The maintenance plans of the company
+// (GET /maintenance/plan/list)
+func (_ Unimplemented) GetMaintenancePlanList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upcoming maintenance
+// (GET /maintenance/upcoming)
+func (_ Unimplemented) GetMaintenanceUpcoming(w http.ResponseWriter, r *http.Request, params GetMaintenanceUpcomingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Update car mileage
 // (PUT /mileage)
 func (_ Unimplemented) PutMileage(w http.ResponseWriter, r *http.Request) {
@@ -720,6 +2722,12 @@ func (_ Unimplemented) PutNotificationAllstatus(w http.ResponseWriter, r *http.R
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delivery status of a notification on each channel it was routed to
+// (GET /notification/deliveries)
+func (_ Unimplemented) GetNotificationDeliveries(w http.ResponseWriter, r *http.Request, params GetNotificationDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get detailed information about a specific notification
 // (GET /notification/info)
 func (_ Unimplemented) GetNotificationInfo(w http.ResponseWriter, r *http.Request, params GetNotificationInfoParams) {
@@ -732,12 +2740,48 @@ func (_ Unimplemented) GetNotificationList(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Remove a notification routing rule
+// (DELETE /notification/rule)
+func (_ Unimplemented) DeleteNotificationRule(w http.ResponseWriter, r *http.Request, params DeleteNotificationRuleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a notification routing rule
+// (POST /notification/rule)
+func (_ Unimplemented) PostNotificationRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a notification routing rule
+// (PUT /notification/rule)
+func (_ Unimplemented) PutNotificationRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// The notification routing rules of the company
+// (GET /notification/rule/list)
+func (_ Unimplemented) GetNotificationRuleList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Change the status of a specific notification
 // (PUT /notification/status)
 func (_ Unimplemented) PutNotificationStatus(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export all of the company's data as a zip archive
+// (GET /portability/export)
+func (_ Unimplemented) GetPortabilityExport(w http.ResponseWriter, r *http.Request, params GetPortabilityExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import an export archive into the company
+// (POST /portability/import)
+func (_ Unimplemented) PostPortabilityImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Add car position from MQTT
 // (POST /position)
 func (_ Unimplemented) PostPosition(w http.ResponseWriter, r *http.Request) {
@@ -750,6 +2794,36 @@ func (_ Unimplemented) GetPositionCarroute(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the trips of a driver
+// (GET /position/drivertrips)
+func (_ Unimplemented) GetPositionDrivertrips(w http.ResponseWriter, r *http.Request, params GetPositionDrivertripsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a geofence
+// (DELETE /position/geofence)
+func (_ Unimplemented) DeletePositionGeofence(w http.ResponseWriter, r *http.Request, params DeletePositionGeofenceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a geofence
+// (POST /position/geofence)
+func (_ Unimplemented) PostPositionGeofence(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a geofence
+// (PUT /position/geofence)
+func (_ Unimplemented) PutPositionGeofence(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// The geofences of the company
+// (GET /position/geofence/list)
+func (_ Unimplemented) GetPositionGeofenceList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get current car positions
 // (GET /position/listcurrent)
 func (_ Unimplemented) GetPositionListcurrent(w http.ResponseWriter, r *http.Request) {
@@ -780,6 +2854,12 @@ func (_ Unimplemented) GetReport(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Search cars, drivers, wheels and breakages of the company
+// (GET /search)
+func (_ Unimplemented) GetSearch(w http.ResponseWriter, r *http.Request, params GetSearchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Update an existing sensor
 // (POST /sensordata)
 func (_ Unimplemented) PostSensordata(w http.ResponseWriter, r *http.Request) {
@@ -798,6 +2878,42 @@ func (_ Unimplemented) GetTemperaturedata(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get two-factor authentication status
+// (GET /totp)
+func (_ Unimplemented) GetTotp(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm TOTP enrollment and enable two-factor authentication
+// (POST /totp/confirm)
+func (_ Unimplemented) PostTotpConfirm(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Disable two-factor authentication
+// (POST /totp/disable)
+func (_ Unimplemented) PostTotpDisable(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start TOTP enrollment
+// (POST /totp/enroll)
+func (_ Unimplemented) PostTotpEnroll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the company two-factor authentication policy
+// (PUT /totp/policy)
+func (_ Unimplemented) PutTotpPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Regenerate recovery codes
+// (POST /totp/recoverycodes)
+func (_ Unimplemented) PostTotpRecoverycodes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get user details
 // (GET /user)
 func (_ Unimplemented) GetUser(w http.ResponseWriter, r *http.Request) {
@@ -822,6 +2938,48 @@ func (_ Unimplemented) PutUserinfo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Remove a webhook endpoint with its deliveries
+// (DELETE /webhook)
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, params DeleteWebhookParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a webhook endpoint
+// (POST /webhook)
+func (_ Unimplemented) PostWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace a webhook endpoint
+// (PUT /webhook)
+func (_ Unimplemented) PutWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Attempts of a webhook delivery
+// (GET /webhook/delivery/attempts)
+func (_ Unimplemented) GetWebhookDeliveryAttempts(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveryAttemptsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the webhook delivery log of the company
+// (GET /webhook/delivery/list)
+func (_ Unimplemented) GetWebhookDeliveryList(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveryListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Deliver a webhook delivery again
+// (PUT /webhook/delivery/replay)
+func (_ Unimplemented) PutWebhookDeliveryReplay(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// The webhook endpoints of the company
+// (GET /webhook/list)
+func (_ Unimplemented) GetWebhookList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get wheel data
 // (GET /wheels)
 func (_ Unimplemented) GetWheels(w http.ResponseWriter, r *http.Request, params GetWheelsParams) {
@@ -846,6 +3004,36 @@ func (_ Unimplemented) GetWheelsStateNumber(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a work order
+// (GET /work-order)
+func (_ Unimplemented) GetWorkOrder(w http.ResponseWriter, r *http.Request, params GetWorkOrderParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Open a work order from a breakage or a maintenance task
+// (POST /work-order)
+func (_ Unimplemented) PostWorkOrder(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel an open work order
+// (PUT /work-order/cancel)
+func (_ Unimplemented) PutWorkOrderCancel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete an open work order
+// (PUT /work-order/complete)
+func (_ Unimplemented) PutWorkOrderComplete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a list of work orders
+// (GET /work-order/list)
+func (_ Unimplemented) GetWorkOrderList(w http.ResponseWriter, r *http.Request, params GetWorkOrderListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -855,8 +3043,8 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// GetAuto operation middleware
-func (siw *ServerInterfaceWrapper) GetAuto(w http.ResponseWriter, r *http.Request) {
+// GetAuditExport operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditExport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -867,45 +3055,58 @@ func (siw *ServerInterfaceWrapper) GetAuto(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAutoParams
-
-	// ------------- Required query parameter "car_id" -------------
+	var params GetAuditExportParams
 
-	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
+	// ------------- Optional query parameter "actor_id" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuto(w, r, params)
-	}))
+	// ------------- Optional query parameter "resource_type" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", r.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "resource_id" -------------
 
-// PostAuto operation middleware
-func (siw *ServerInterfaceWrapper) PostAuto(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "resource_id", r.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_id", Err: err})
+		return
+	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "from" -------------
 
-	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuto(w, r)
+		siw.Handler.GetAuditExport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -915,8 +3116,8 @@ func (siw *ServerInterfaceWrapper) PostAuto(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// GetAutoInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetAutoInfo(w http.ResponseWriter, r *http.Request) {
+// GetAuditList operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -927,100 +3128,90 @@ func (siw *ServerInterfaceWrapper) GetAutoInfo(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAutoInfoParams
+	var params GetAuditListParams
 
-	// ------------- Required query parameter "car_id" -------------
-
-	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
+	// ------------- Optional query parameter "actor_id" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAutoInfo(w, r, params)
-	}))
+	// ------------- Optional query parameter "resource_type" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", r.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetAutoList operation middleware
-func (siw *ServerInterfaceWrapper) GetAutoList(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAutoListParams
+	// ------------- Optional query parameter "resource_id" -------------
 
-	// ------------- Required query parameter "offset" -------------
-
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+	err = runtime.BindQueryParameter("form", true, false, "resource_id", r.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "limit" -------------
-
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+	// ------------- Optional query parameter "to" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAutoList(w, r, params)
-	}))
+	// ------------- Optional query parameter "offset" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// PostBreakage operation middleware
-func (siw *ServerInterfaceWrapper) PostBreakage(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "cursor" -------------
 
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+	// ------------- Optional query parameter "sort" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostBreakage(w, r)
+		siw.Handler.GetAuditList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1030,8 +3221,8 @@ func (siw *ServerInterfaceWrapper) PostBreakage(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetBreakageList operation middleware
-func (siw *ServerInterfaceWrapper) GetBreakageList(w http.ResponseWriter, r *http.Request) {
+// GetAuto operation middleware
+func (siw *ServerInterfaceWrapper) GetAuto(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1042,7 +3233,7 @@ func (siw *ServerInterfaceWrapper) GetBreakageList(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBreakageListParams
+	var params GetAutoParams
 
 	// ------------- Required query parameter "car_id" -------------
 
@@ -1060,7 +3251,7 @@ func (siw *ServerInterfaceWrapper) GetBreakageList(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBreakageList(w, r, params)
+		siw.Handler.GetAuto(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1070,8 +3261,8 @@ func (siw *ServerInterfaceWrapper) GetBreakageList(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// PostDriver operation middleware
-func (siw *ServerInterfaceWrapper) PostDriver(w http.ResponseWriter, r *http.Request) {
+// PostAuto operation middleware
+func (siw *ServerInterfaceWrapper) PostAuto(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1080,7 +3271,7 @@ func (siw *ServerInterfaceWrapper) PostDriver(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostDriver(w, r)
+		siw.Handler.PostAuto(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1090,8 +3281,8 @@ func (siw *ServerInterfaceWrapper) PostDriver(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetDriverInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetDriverInfo(w http.ResponseWriter, r *http.Request) {
+// GetAutoInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetAutoInfo(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1102,25 +3293,25 @@ func (siw *ServerInterfaceWrapper) GetDriverInfo(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDriverInfoParams
+	var params GetAutoInfoParams
 
-	// ------------- Required query parameter "driver_id" -------------
+	// ------------- Required query parameter "car_id" -------------
 
-	if paramValue := r.URL.Query().Get("driver_id"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "driver_id"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "driver_id", r.URL.Query(), &params.DriverId)
+	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDriverInfo(w, r, params)
+		siw.Handler.GetAutoInfo(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1130,8 +3321,8 @@ func (siw *ServerInterfaceWrapper) GetDriverInfo(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetDriverList operation middleware
-func (siw *ServerInterfaceWrapper) GetDriverList(w http.ResponseWriter, r *http.Request) {
+// GetAutoList operation middleware
+func (siw *ServerInterfaceWrapper) GetAutoList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1142,18 +3333,11 @@ func (siw *ServerInterfaceWrapper) GetDriverList(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDriverListParams
-
-	// ------------- Required query parameter "offset" -------------
-
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	var params GetAutoListParams
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
-		return
-	}
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
@@ -1174,8 +3358,40 @@ func (siw *ServerInterfaceWrapper) GetDriverList(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "brand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "brand", r.URL.Query(), &params.Brand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "brand", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "car_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "car_type", r.URL.Query(), &params.CarType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_type", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDriverList(w, r, params)
+		siw.Handler.GetAutoList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1185,8 +3401,10 @@ func (siw *ServerInterfaceWrapper) GetDriverList(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PutDriverWorktime operation middleware
-func (siw *ServerInterfaceWrapper) PutDriverWorktime(w http.ResponseWriter, r *http.Request) {
+// GetBreakage operation middleware
+func (siw *ServerInterfaceWrapper) GetBreakage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -1194,22 +3412,26 @@ func (siw *ServerInterfaceWrapper) PutDriverWorktime(w http.ResponseWriter, r *h
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutDriverWorktime(w, r)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBreakageParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Required query parameter "breakage_id" -------------
 
-	handler.ServeHTTP(w, r)
-}
+	if paramValue := r.URL.Query().Get("breakage_id"); paramValue != "" {
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "breakage_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "breakage_id", r.URL.Query(), &params.BreakageId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "breakage_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLogin(w, r)
+		siw.Handler.GetBreakage(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1219,8 +3441,8 @@ func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PutMileage operation middleware
-func (siw *ServerInterfaceWrapper) PutMileage(w http.ResponseWriter, r *http.Request) {
+// PostBreakage operation middleware
+func (siw *ServerInterfaceWrapper) PostBreakage(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1229,7 +3451,7 @@ func (siw *ServerInterfaceWrapper) PutMileage(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutMileage(w, r)
+		siw.Handler.PostBreakage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1239,8 +3461,8 @@ func (siw *ServerInterfaceWrapper) PutMileage(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PutNotificationAllstatus operation middleware
-func (siw *ServerInterfaceWrapper) PutNotificationAllstatus(w http.ResponseWriter, r *http.Request) {
+// PutBreakage operation middleware
+func (siw *ServerInterfaceWrapper) PutBreakage(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1249,7 +3471,7 @@ func (siw *ServerInterfaceWrapper) PutNotificationAllstatus(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutNotificationAllstatus(w, r)
+		siw.Handler.PutBreakage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1259,8 +3481,8 @@ func (siw *ServerInterfaceWrapper) PutNotificationAllstatus(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetNotificationInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetNotificationInfo(w http.ResponseWriter, r *http.Request) {
+// GetBreakageHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetBreakageHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1271,25 +3493,25 @@ func (siw *ServerInterfaceWrapper) GetNotificationInfo(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNotificationInfoParams
+	var params GetBreakageHistoryParams
 
-	// ------------- Required query parameter "id" -------------
+	// ------------- Required query parameter "breakage_id" -------------
 
-	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("breakage_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "breakage_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindQueryParameter("form", true, true, "breakage_id", r.URL.Query(), &params.BreakageId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "breakage_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNotificationInfo(w, r, params)
+		siw.Handler.GetBreakageHistory(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1299,8 +3521,8 @@ func (siw *ServerInterfaceWrapper) GetNotificationInfo(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetNotificationList operation middleware
-func (siw *ServerInterfaceWrapper) GetNotificationList(w http.ResponseWriter, r *http.Request) {
+// GetBreakageList operation middleware
+func (siw *ServerInterfaceWrapper) GetBreakageList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1311,88 +3533,97 @@ func (siw *ServerInterfaceWrapper) GetNotificationList(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNotificationListParams
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
+	var params GetBreakageListParams
 
-	// ------------- Required query parameter "limit" -------------
+	// ------------- Required query parameter "car_id" -------------
 
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "offset" -------------
-
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNotificationList(w, r, params)
-	}))
+	// ------------- Optional query parameter "cursor" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "sort" -------------
 
-// PutNotificationStatus operation middleware
-func (siw *ServerInterfaceWrapper) PutNotificationStatus(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+	// ------------- Optional query parameter "type" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutNotificationStatus(w, r)
-	}))
+	// ------------- Optional query parameter "status" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "severity" -------------
 
-// PostPosition operation middleware
-func (siw *ServerInterfaceWrapper) PostPosition(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "severity", r.URL.Query(), &params.Severity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "severity", Err: err})
+		return
+	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "from" -------------
 
-	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPosition(w, r)
+		siw.Handler.GetBreakageList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1402,8 +3633,8 @@ func (siw *ServerInterfaceWrapper) PostPosition(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetPositionCarroute operation middleware
-func (siw *ServerInterfaceWrapper) GetPositionCarroute(w http.ResponseWriter, r *http.Request) {
+// GetBreakageStats operation middleware
+func (siw *ServerInterfaceWrapper) GetBreakageStats(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1414,55 +3645,41 @@ func (siw *ServerInterfaceWrapper) GetPositionCarroute(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPositionCarrouteParams
+	var params GetBreakageStatsParams
 
-	// ------------- Required query parameter "car_id" -------------
+	// ------------- Required query parameter "group_by" -------------
 
-	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("group_by"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_by"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
+	err = runtime.BindQueryParameter("form", true, true, "group_by", r.URL.Query(), &params.GroupBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "time_from" -------------
-
-	if paramValue := r.URL.Query().Get("time_from"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "time_from"})
-		return
-	}
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "time_from", r.URL.Query(), &params.TimeFrom)
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time_from", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "time_to" -------------
-
-	if paramValue := r.URL.Query().Get("time_to"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "time_to"})
-		return
-	}
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "time_to", r.URL.Query(), &params.TimeTo)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time_to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPositionCarroute(w, r, params)
+		siw.Handler.GetBreakageStats(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1472,8 +3689,8 @@ func (siw *ServerInterfaceWrapper) GetPositionCarroute(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPositionListcurrent operation middleware
-func (siw *ServerInterfaceWrapper) GetPositionListcurrent(w http.ResponseWriter, r *http.Request) {
+// PutBreakageStatus operation middleware
+func (siw *ServerInterfaceWrapper) PutBreakageStatus(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1482,7 +3699,7 @@ func (siw *ServerInterfaceWrapper) GetPositionListcurrent(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPositionListcurrent(w, r)
+		siw.Handler.PutBreakageStatus(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1492,8 +3709,8 @@ func (siw *ServerInterfaceWrapper) GetPositionListcurrent(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetPositionsListcars operation middleware
-func (siw *ServerInterfaceWrapper) GetPositionsListcars(w http.ResponseWriter, r *http.Request) {
+// DeleteBreakageType operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBreakageType(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1504,40 +3721,25 @@ func (siw *ServerInterfaceWrapper) GetPositionsListcars(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPositionsListcarsParams
-
-	// ------------- Required query parameter "limit" -------------
-
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
-		return
-	}
+	var params DeleteBreakageTypeParams
 
-	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Required query parameter "type_id" -------------
 
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("type_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, true, "type_id", r.URL.Query(), &params.TypeId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPositionsListcars(w, r, params)
+		siw.Handler.DeleteBreakageType(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1547,10 +3749,8 @@ func (siw *ServerInterfaceWrapper) GetPositionsListcars(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPressuredata operation middleware
-func (siw *ServerInterfaceWrapper) GetPressuredata(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PostBreakageType operation middleware
+func (siw *ServerInterfaceWrapper) PostBreakageType(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1558,56 +3758,48 @@ func (siw *ServerInterfaceWrapper) GetPressuredata(w http.ResponseWriter, r *htt
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPressuredataParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBreakageType(w, r)
+	}))
 
-	// ------------- Required query parameter "wheel_id" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	if paramValue := r.URL.Query().Get("wheel_id"); paramValue != "" {
+	handler.ServeHTTP(w, r)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "wheel_id"})
-		return
-	}
+// PutBreakageType operation middleware
+func (siw *ServerInterfaceWrapper) PutBreakageType(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, true, "wheel_id", r.URL.Query(), &params.WheelId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wheel_id", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Required query parameter "from" -------------
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+	r = r.WithContext(ctx)
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
-		return
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBreakageType(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Required query parameter "to" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+// GetBreakageTypeList operation middleware
+func (siw *ServerInterfaceWrapper) GetBreakageTypeList(w http.ResponseWriter, r *http.Request) {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
-		return
-	}
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPressuredata(w, r, params)
+		siw.Handler.GetBreakageTypeList(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1617,8 +3809,8 @@ func (siw *ServerInterfaceWrapper) GetPressuredata(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// PostRefresh operation middleware
-func (siw *ServerInterfaceWrapper) PostRefresh(w http.ResponseWriter, r *http.Request) {
+// PostDriver operation middleware
+func (siw *ServerInterfaceWrapper) PostDriver(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1627,7 +3819,7 @@ func (siw *ServerInterfaceWrapper) PostRefresh(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostRefresh(w, r)
+		siw.Handler.PostDriver(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1637,8 +3829,8 @@ func (siw *ServerInterfaceWrapper) PostRefresh(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetReport operation middleware
-func (siw *ServerInterfaceWrapper) GetReport(w http.ResponseWriter, r *http.Request) {
+// PutDriver operation middleware
+func (siw *ServerInterfaceWrapper) PutDriver(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1647,7 +3839,7 @@ func (siw *ServerInterfaceWrapper) GetReport(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetReport(w, r)
+		siw.Handler.PutDriver(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1657,8 +3849,8 @@ func (siw *ServerInterfaceWrapper) GetReport(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostSensordata operation middleware
-func (siw *ServerInterfaceWrapper) PostSensordata(w http.ResponseWriter, r *http.Request) {
+// PostDriverAssignment operation middleware
+func (siw *ServerInterfaceWrapper) PostDriverAssignment(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1667,7 +3859,7 @@ func (siw *ServerInterfaceWrapper) PostSensordata(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostSensordata(w, r)
+		siw.Handler.PostDriverAssignment(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1677,10 +3869,8 @@ func (siw *ServerInterfaceWrapper) PostSensordata(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetSensors operation middleware
-func (siw *ServerInterfaceWrapper) GetSensors(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PutDriverAssignmentEnd operation middleware
+func (siw *ServerInterfaceWrapper) PutDriverAssignmentEnd(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1688,26 +3878,8 @@ func (siw *ServerInterfaceWrapper) GetSensors(w http.ResponseWriter, r *http.Req
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSensorsParams
-
-	// ------------- Required query parameter "car_id" -------------
-
-	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSensors(w, r, params)
+		siw.Handler.PutDriverAssignmentEnd(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1717,8 +3889,8 @@ func (siw *ServerInterfaceWrapper) GetSensors(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetTemperaturedata operation middleware
-func (siw *ServerInterfaceWrapper) GetTemperaturedata(w http.ResponseWriter, r *http.Request) {
+// GetDriverAssignmentList operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverAssignmentList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1729,55 +3901,81 @@ func (siw *ServerInterfaceWrapper) GetTemperaturedata(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTemperaturedataParams
-
-	// ------------- Required query parameter "wheel_id" -------------
+	var params GetDriverAssignmentListParams
 
-	if paramValue := r.URL.Query().Get("wheel_id"); paramValue != "" {
+	// ------------- Optional query parameter "driver_id" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "wheel_id"})
+	err = runtime.BindQueryParameter("form", true, false, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "wheel_id", r.URL.Query(), &params.WheelId)
+	// ------------- Optional query parameter "car_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "car_id", r.URL.Query(), &params.CarId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wheel_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "from" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "to" -------------
+	// ------------- Required query parameter "limit" -------------
 
-	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTemperaturedata(w, r, params)
+		siw.Handler.GetDriverAssignmentList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1787,8 +3985,8 @@ func (siw *ServerInterfaceWrapper) GetTemperaturedata(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetUser operation middleware
-func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+// PutDriverDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PutDriverDeactivate(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1797,7 +3995,7 @@ func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUser(w, r)
+		siw.Handler.PutDriverDeactivate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1807,31 +4005,37 @@ func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PostUser operation middleware
-func (siw *ServerInterfaceWrapper) PostUser(w http.ResponseWriter, r *http.Request) {
+// DeleteDriverDocument operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDriverDocument(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUser(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	ctx := r.Context()
 
-	handler.ServeHTTP(w, r)
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-// PutUser operation middleware
-func (siw *ServerInterfaceWrapper) PutUser(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(ctx)
 
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDriverDocumentParams
 
-	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+	// ------------- Required query parameter "document_id" -------------
 
-	r = r.WithContext(ctx)
+	if paramValue := r.URL.Query().Get("document_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "document_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "document_id", r.URL.Query(), &params.DocumentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "document_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutUser(w, r)
+		siw.Handler.DeleteDriverDocument(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1841,8 +4045,10 @@ func (siw *ServerInterfaceWrapper) PutUser(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PutUserinfo operation middleware
-func (siw *ServerInterfaceWrapper) PutUserinfo(w http.ResponseWriter, r *http.Request) {
+// GetDriverDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -1850,8 +4056,26 @@ func (siw *ServerInterfaceWrapper) PutUserinfo(w http.ResponseWriter, r *http.Re
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverDocumentParams
+
+	// ------------- Required query parameter "document_id" -------------
+
+	if paramValue := r.URL.Query().Get("document_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "document_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "document_id", r.URL.Query(), &params.DocumentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "document_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutUserinfo(w, r)
+		siw.Handler.GetDriverDocument(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1861,8 +4085,8 @@ func (siw *ServerInterfaceWrapper) PutUserinfo(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetWheels operation middleware
-func (siw *ServerInterfaceWrapper) GetWheels(w http.ResponseWriter, r *http.Request) {
+// PostDriverDocument operation middleware
+func (siw *ServerInterfaceWrapper) PostDriverDocument(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1873,25 +4097,55 @@ func (siw *ServerInterfaceWrapper) GetWheels(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetWheelsParams
+	var params PostDriverDocumentParams
 
-	// ------------- Required query parameter "id" -------------
+	// ------------- Required query parameter "driver_id" -------------
 
-	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("driver_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "driver_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindQueryParameter("form", true, true, "driver_id", r.URL.Query(), &params.DriverId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "type" -------------
+
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "file_name" -------------
+
+	if paramValue := r.URL.Query().Get("file_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "file_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "file_name", r.URL.Query(), &params.FileName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWheels(w, r, params)
+		siw.Handler.PostDriverDocument(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1901,8 +4155,10 @@ func (siw *ServerInterfaceWrapper) GetWheels(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostWheels operation middleware
-func (siw *ServerInterfaceWrapper) PostWheels(w http.ResponseWriter, r *http.Request) {
+// GetDriverDocumentList operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverDocumentList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -1910,8 +4166,26 @@ func (siw *ServerInterfaceWrapper) PostWheels(w http.ResponseWriter, r *http.Req
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverDocumentListParams
+
+	// ------------- Required query parameter "driver_id" -------------
+
+	if paramValue := r.URL.Query().Get("driver_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "driver_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostWheels(w, r)
+		siw.Handler.GetDriverDocumentList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1921,8 +4195,10 @@ func (siw *ServerInterfaceWrapper) PostWheels(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PutWheels operation middleware
-func (siw *ServerInterfaceWrapper) PutWheels(w http.ResponseWriter, r *http.Request) {
+// GetDriverInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverInfo(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -1930,8 +4206,26 @@ func (siw *ServerInterfaceWrapper) PutWheels(w http.ResponseWriter, r *http.Requ
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverInfoParams
+
+	// ------------- Required query parameter "driver_id" -------------
+
+	if paramValue := r.URL.Query().Get("driver_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "driver_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutWheels(w, r)
+		siw.Handler.GetDriverInfo(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1941,28 +4235,69 @@ func (siw *ServerInterfaceWrapper) PutWheels(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetWheelsStateNumber operation middleware
-func (siw *ServerInterfaceWrapper) GetWheelsStateNumber(w http.ResponseWriter, r *http.Request) {
+// GetDriverList operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "state_number" -------------
-	var stateNumber string
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "state_number", chi.URLParam(r, "state_number"), &stateNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverListParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state_number", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Required query parameter "limit" -------------
 
-	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-	r = r.WithContext(ctx)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_inactive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_inactive", r.URL.Query(), &params.IncludeInactive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_inactive", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWheelsStateNumber(w, r, stateNumber)
+		siw.Handler.GetDriverList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1972,979 +4307,7086 @@ func (siw *ServerInterfaceWrapper) GetWheelsStateNumber(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetDriverTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverTimesheet(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverTimesheetParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Required query parameter "driver_id" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	if paramValue := r.URL.Query().Get("driver_id"); paramValue != "" {
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "driver_id"})
+		return
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, true, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Required query parameter "month" -------------
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	if paramValue := r.URL.Query().Get("month"); paramValue != "" {
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "month"})
+		return
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, true, "month", r.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDriverTimesheet(w, r, params)
+	}))
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+	handler.ServeHTTP(w, r)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+// PostDriverWorkSession operation middleware
+func (siw *ServerInterfaceWrapper) PostDriverWorkSession(w http.ResponseWriter, r *http.Request) {
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	ctx := r.Context()
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	r = r.WithContext(ctx)
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostDriverWorkSession(w, r)
+	}))
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/auto", wrapper.GetAuto)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auto", wrapper.PostAuto)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/auto/info", wrapper.GetAutoInfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/auto/list", wrapper.GetAutoList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/breakage", wrapper.PostBreakage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/breakage/list", wrapper.GetBreakageList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/driver", wrapper.PostDriver)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/driver/info", wrapper.GetDriverInfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/driver/list", wrapper.GetDriverList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/driver/worktime", wrapper.PutDriverWorktime)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/login", wrapper.PostLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/mileage", wrapper.PutMileage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/notification/allstatus", wrapper.PutNotificationAllstatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/notification/info", wrapper.GetNotificationInfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/notification/list", wrapper.GetNotificationList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/notification/status", wrapper.PutNotificationStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/position", wrapper.PostPosition)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/position/carroute", wrapper.GetPositionCarroute)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/position/listcurrent", wrapper.GetPositionListcurrent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/positions/listcars", wrapper.GetPositionsListcars)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pressuredata", wrapper.GetPressuredata)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/refresh", wrapper.PostRefresh)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/report", wrapper.GetReport)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/sensordata", wrapper.PostSensordata)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/sensors", wrapper.GetSensors)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/temperaturedata", wrapper.GetTemperaturedata)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/user", wrapper.GetUser)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/user", wrapper.PostUser)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/user", wrapper.PutUser)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/userinfo", wrapper.PutUserinfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/wheels", wrapper.GetWheels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/wheels", wrapper.PostWheels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/wheels", wrapper.PutWheels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/wheels/{state_number}", wrapper.GetWheelsStateNumber)
-	})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return r
+	handler.ServeHTTP(w, r)
 }
 
-type GetAutoRequestObject struct {
-	Params GetAutoParams
-}
+// PutDriverWorkSessionEnd operation middleware
+func (siw *ServerInterfaceWrapper) PutDriverWorkSessionEnd(w http.ResponseWriter, r *http.Request) {
 
-type GetAutoResponseObject interface {
-	VisitGetAutoResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetAuto200JSONResponse AutoResponse
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-func (response GetAuto200JSONResponse) VisitGetAutoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutDriverWorkSessionEnd(w, r)
+	}))
 
-type PostAutoRequestObject struct {
-	Body *PostAutoJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostAutoResponseObject interface {
-	VisitPostAutoResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostAuto201JSONResponse AutoResponse
+// GetDriverWorkSessionList operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverWorkSessionList(w http.ResponseWriter, r *http.Request) {
 
-func (response PostAuto201JSONResponse) VisitPostAutoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetAutoInfoRequestObject struct {
-	Params GetAutoInfoParams
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-type GetAutoInfoResponseObject interface {
-	VisitGetAutoInfoResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetAutoInfo200JSONResponse struct {
-	Auto   *AutoResponse    `json:"auto,omitempty"`
-	Wheels *[]WheelResponse `json:"wheels,omitempty"`
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverWorkSessionListParams
 
-func (response GetAutoInfo200JSONResponse) VisitGetAutoInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "driver_id" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
 
-type GetAutoListRequestObject struct {
-	Params GetAutoListParams
-}
+	// ------------- Optional query parameter "from" -------------
 
-type GetAutoListResponseObject interface {
-	VisitGetAutoListResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-type GetAutoList200JSONResponse []AutoResponse
+	// ------------- Optional query parameter "to" -------------
 
-func (response GetAutoList200JSONResponse) VisitGetAutoListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type PostBreakageRequestObject struct {
-	Body *PostBreakageJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type PostBreakageResponseObject interface {
-	VisitPostBreakageResponse(w http.ResponseWriter) error
-}
+	// ------------- Required query parameter "limit" -------------
 
-type PostBreakage201Response struct {
-}
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-func (response PostBreakage201Response) VisitPostBreakageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
 
-type GetBreakageListRequestObject struct {
-	Params GetBreakageListParams
-}
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type GetBreakageListResponseObject interface {
-	VisitGetBreakageListResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type GetBreakageList200JSONResponse []BreakageListResponse
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-func (response GetBreakageList200JSONResponse) VisitGetBreakageListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "sort" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-type PostDriverRequestObject struct {
-	Body *PostDriverJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDriverWorkSessionList(w, r, params)
+	}))
 
-type PostDriverResponseObject interface {
-	VisitPostDriverResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostDriver201Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostDriver201Response) VisitPostDriverResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+// GetDriverWorkTime operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverWorkTime(w http.ResponseWriter, r *http.Request) {
 
-type GetDriverInfoRequestObject struct {
-	Params GetDriverInfoParams
-}
+	var err error
 
-type GetDriverInfoResponseObject interface {
-	VisitGetDriverInfoResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetDriverInfo200JSONResponse []DriverInfoResponse
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-func (response GetDriverInfo200JSONResponse) VisitGetDriverInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverWorkTimeParams
 
-type GetDriverListRequestObject struct {
-	Params GetDriverListParams
-}
+	// ------------- Required query parameter "driver_id" -------------
 
-type GetDriverListResponseObject interface {
-	VisitGetDriverListResponse(w http.ResponseWriter) error
-}
+	if paramValue := r.URL.Query().Get("driver_id"); paramValue != "" {
 
-type GetDriverList200JSONResponse []DriverStatisticsResponse
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "driver_id"})
+		return
+	}
 
-func (response GetDriverList200JSONResponse) VisitGetDriverListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, true, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "from" -------------
 
-type PutDriverWorktimeRequestObject struct {
-	Body *PutDriverWorktimeJSONRequestBody
-}
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
 
-type PutDriverWorktimeResponseObject interface {
-	VisitPutDriverWorktimeResponse(w http.ResponseWriter) error
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
 
-type PutDriverWorktime200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-func (response PutDriverWorktime200Response) VisitPutDriverWorktimeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Required query parameter "to" -------------
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
 
-type PostLogin201JSONResponse TokenResponse
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-func (response PostLogin201JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDriverWorkTime(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PutMileageRequestObject struct {
-	Body *PutMileageJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PutMileageResponseObject interface {
-	VisitPutMileageResponse(w http.ResponseWriter) error
-}
+// GetDriverWorkViolationList operation middleware
+func (siw *ServerInterfaceWrapper) GetDriverWorkViolationList(w http.ResponseWriter, r *http.Request) {
 
-type PutMileage200Response struct {
-}
+	var err error
 
-func (response PutMileage200Response) VisitPutMileageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx := r.Context()
 
-type PutNotificationAllstatusRequestObject struct {
-	Body *PutNotificationAllstatusJSONRequestBody
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-type PutNotificationAllstatusResponseObject interface {
-	VisitPutNotificationAllstatusResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type PutNotificationAllstatus200Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDriverWorkViolationListParams
 
-func (response PutNotificationAllstatus200Response) VisitPutNotificationAllstatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "driver_id" -------------
 
-type GetNotificationInfoRequestObject struct {
-	Params GetNotificationInfoParams
-}
+	err = runtime.BindQueryParameter("form", true, false, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
 
-type GetNotificationInfoResponseObject interface {
-	VisitGetNotificationInfoResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "type" -------------
 
-type GetNotificationInfo200JSONResponse NotificationInfoResponse
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-func (response GetNotificationInfo200JSONResponse) VisitGetNotificationInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "from" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-type GetNotificationListRequestObject struct {
-	Params GetNotificationListParams
-}
+	// ------------- Optional query parameter "to" -------------
 
-type GetNotificationListResponseObject interface {
-	VisitGetNotificationListResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-type GetNotificationList200JSONResponse []NotificationListResponse
+	// ------------- Optional query parameter "offset" -------------
 
-func (response GetNotificationList200JSONResponse) VisitGetNotificationListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "limit" -------------
 
-type PutNotificationStatusRequestObject struct {
-	Body *PutNotificationStatusJSONRequestBody
-}
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-type PutNotificationStatusResponseObject interface {
-	VisitPutNotificationStatusResponse(w http.ResponseWriter) error
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
 
-type PutNotificationStatus200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response PutNotificationStatus200Response) VisitPutNotificationStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type PostPositionRequestObject struct {
-	Body *PostPositionJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-type PostPositionResponseObject interface {
-	VisitPostPositionResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "sort" -------------
 
-type PostPosition201Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-func (response PostPosition201Response) VisitPostPositionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDriverWorkViolationList(w, r, params)
+	}))
 
-type GetPositionCarrouteRequestObject struct {
-	Params GetPositionCarrouteParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetPositionCarrouteResponseObject interface {
-	VisitGetPositionCarrouteResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetPositionCarroute200JSONResponse RouteCarResponse
-
-func (response GetPositionCarroute200JSONResponse) VisitGetPositionCarrouteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PutDriverWorktime operation middleware
+func (siw *ServerInterfaceWrapper) PutDriverWorktime(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetPositionListcurrentRequestObject struct {
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-type GetPositionListcurrentResponseObject interface {
-	VisitGetPositionListcurrentResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetPositionListcurrent200JSONResponse []PositionCurrentListResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutDriverWorktime(w, r)
+	}))
 
-func (response GetPositionListcurrent200JSONResponse) VisitGetPositionListcurrentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetPositionsListcarsRequestObject struct {
-	Params GetPositionsListcarsParams
-}
+// PostImportKind operation middleware
+func (siw *ServerInterfaceWrapper) PostImportKind(w http.ResponseWriter, r *http.Request) {
 
-type GetPositionsListcarsResponseObject interface {
-	VisitGetPositionsListcarsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetPositionsListcars200JSONResponse []PositionCarListResponse
+	// ------------- Path parameter "kind" -------------
+	var kind string
 
-func (response GetPositionsListcars200JSONResponse) VisitGetPositionsListcarsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", chi.URLParam(r, "kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetPressuredataRequestObject struct {
-	Params GetPressuredataParams
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-type GetPressuredataResponseObject interface {
-	VisitGetPressuredataResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetPressuredata200JSONResponse []PressureData
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostImportKindParams
 
-func (response GetPressuredata200JSONResponse) VisitGetPressuredataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "dry_run" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
 
-type PostRefreshRequestObject struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostImportKind(w, r, kind, params)
+	}))
 
-type PostRefreshResponseObject interface {
-	VisitPostRefreshResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostRefresh201JSONResponse TokenResponse
+// GetImportKindTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetImportKindTemplate(w http.ResponseWriter, r *http.Request) {
 
-func (response PostRefresh201JSONResponse) VisitPostRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "kind" -------------
+	var kind string
 
-type GetReportRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", chi.URLParam(r, "kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
 
-type GetReportResponseObject interface {
-	VisitGetReportResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetReport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-func (response GetReport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetReportResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetImportKindTemplateParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
 
-type PostSensordataRequestObject struct {
-	Body *PostSensordataJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImportKindTemplate(w, r, kind, params)
+	}))
 
-type PostSensordataResponseObject interface {
-	VisitPostSensordataResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostSensordata201Response struct {
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostSensordata201Response) VisitPostSensordataResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
 
-type GetSensorsRequestObject struct {
-	Params GetSensorsParams
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogin(w, r)
+	}))
 
-type GetSensorsResponseObject interface {
-	VisitGetSensorsResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetSensors200JSONResponse []SensorsData
+// PostLoginTotp operation middleware
+func (siw *ServerInterfaceWrapper) PostLoginTotp(w http.ResponseWriter, r *http.Request) {
 
-func (response GetSensors200JSONResponse) VisitGetSensorsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLoginTotp(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetTemperaturedataRequestObject struct {
-	Params GetTemperaturedataParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetTemperaturedataResponseObject interface {
-	VisitGetTemperaturedataResponse(w http.ResponseWriter) error
-}
+// PostLoginTotpEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostLoginTotpEnroll(w http.ResponseWriter, r *http.Request) {
 
-type GetTemperaturedata200JSONResponse []TemperatureData
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLoginTotpEnroll(w, r)
+	}))
 
-func (response GetTemperaturedata200JSONResponse) VisitGetTemperaturedataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetUserRequestObject struct {
-}
+// PostMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) PostMaintenancePlan(w http.ResponseWriter, r *http.Request) {
 
-type GetUserResponseObject interface {
-	VisitGetUserResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetUser200JSONResponse UserDetails
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostMaintenancePlan(w, r)
+	}))
 
-type PostUserRequestObject struct {
-	Body *PostUserJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostUserResponseObject interface {
-	VisitPostUserResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type PostUser201Response struct {
-}
+// PutMaintenancePlan operation middleware
+func (siw *ServerInterfaceWrapper) PutMaintenancePlan(w http.ResponseWriter, r *http.Request) {
 
-func (response PostUser201Response) VisitPostUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	ctx := r.Context()
 
-type PutUserRequestObject struct {
-	Body *PutUserJSONRequestBody
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-type PutUserResponseObject interface {
-	VisitPutUserResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type PutUser200Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutMaintenancePlan(w, r)
+	}))
 
-func (response PutUser200Response) VisitPutUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PutUserinfoRequestObject struct {
-	Body *PutUserinfoJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PutUserinfoResponseObject interface {
-	VisitPutUserinfoResponse(w http.ResponseWriter) error
-}
+// GetMaintenancePlanList operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenancePlanList(w http.ResponseWriter, r *http.Request) {
 
-type PutUserinfo200Response struct {
-}
+	ctx := r.Context()
 
-func (response PutUserinfo200Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-type PutUserinfo400Response struct {
-}
+	r = r.WithContext(ctx)
 
-func (response PutUserinfo400Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaintenancePlanList(w, r)
+	}))
 
-type PutUserinfo404Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response PutUserinfo404Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type PutUserinfo500Response struct {
-}
+// GetMaintenanceUpcoming operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenanceUpcoming(w http.ResponseWriter, r *http.Request) {
 
-func (response PutUserinfo500Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	var err error
 
-type GetWheelsRequestObject struct {
-	Params GetWheelsParams
-}
+	ctx := r.Context()
 
-type GetWheelsResponseObject interface {
-	VisitGetWheelsResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
 
-type GetWheels200JSONResponse WheelResponse
+	r = r.WithContext(ctx)
 
-func (response GetWheels200JSONResponse) VisitGetWheelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMaintenanceUpcomingParams
+
+	// ------------- Optional query parameter "car_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "car_id", r.URL.Query(), &params.CarId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaintenanceUpcoming(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutMileage operation middleware
+func (siw *ServerInterfaceWrapper) PutMileage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutMileage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutNotificationAllstatus operation middleware
+func (siw *ServerInterfaceWrapper) PutNotificationAllstatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutNotificationAllstatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationDeliveriesParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationDeliveries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationInfo(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationInfoParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationInfo(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationList operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationListParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_severity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_severity", r.URL.Query(), &params.MinSeverity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_severity", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "breakage_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "breakage_type", r.URL.Query(), &params.BreakageType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "breakage_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "breakage_status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "breakage_status", r.URL.Query(), &params.BreakageStatus)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "breakage_status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteNotificationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNotificationRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteNotificationRuleParams
+
+	// ------------- Required query parameter "rule_id" -------------
+
+	if paramValue := r.URL.Query().Get("rule_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rule_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "rule_id", r.URL.Query(), &params.RuleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNotificationRule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostNotificationRule operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostNotificationRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutNotificationRule operation middleware
+func (siw *ServerInterfaceWrapper) PutNotificationRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutNotificationRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationRuleList operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationRuleList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationRuleList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutNotificationStatus operation middleware
+func (siw *ServerInterfaceWrapper) PutNotificationStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutNotificationStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPortabilityExport operation middleware
+func (siw *ServerInterfaceWrapper) GetPortabilityExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPortabilityExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPortabilityExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPortabilityImport operation middleware
+func (siw *ServerInterfaceWrapper) PostPortabilityImport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPortabilityImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPosition operation middleware
+func (siw *ServerInterfaceWrapper) PostPosition(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPosition(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPositionCarroute operation middleware
+func (siw *ServerInterfaceWrapper) GetPositionCarroute(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPositionCarrouteParams
+
+	// ------------- Required query parameter "car_id" -------------
+
+	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "time_from" -------------
+
+	if paramValue := r.URL.Query().Get("time_from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "time_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "time_from", r.URL.Query(), &params.TimeFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "time_to" -------------
+
+	if paramValue := r.URL.Query().Get("time_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "time_to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "time_to", r.URL.Query(), &params.TimeTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time_to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPositionCarroute(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPositionDrivertrips operation middleware
+func (siw *ServerInterfaceWrapper) GetPositionDrivertrips(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPositionDrivertripsParams
+
+	// ------------- Required query parameter "driver_id" -------------
+
+	if paramValue := r.URL.Query().Get("driver_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "driver_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "driver_id", r.URL.Query(), &params.DriverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "driver_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "time_from" -------------
+
+	if paramValue := r.URL.Query().Get("time_from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "time_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "time_from", r.URL.Query(), &params.TimeFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "time_to" -------------
+
+	if paramValue := r.URL.Query().Get("time_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "time_to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "time_to", r.URL.Query(), &params.TimeTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time_to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPositionDrivertrips(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePositionGeofence operation middleware
+func (siw *ServerInterfaceWrapper) DeletePositionGeofence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePositionGeofenceParams
+
+	// ------------- Required query parameter "geofence_id" -------------
+
+	if paramValue := r.URL.Query().Get("geofence_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "geofence_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "geofence_id", r.URL.Query(), &params.GeofenceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "geofence_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePositionGeofence(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPositionGeofence operation middleware
+func (siw *ServerInterfaceWrapper) PostPositionGeofence(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPositionGeofence(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutPositionGeofence operation middleware
+func (siw *ServerInterfaceWrapper) PutPositionGeofence(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutPositionGeofence(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPositionGeofenceList operation middleware
+func (siw *ServerInterfaceWrapper) GetPositionGeofenceList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPositionGeofenceList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPositionListcurrent operation middleware
+func (siw *ServerInterfaceWrapper) GetPositionListcurrent(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPositionListcurrent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPositionsListcars operation middleware
+func (siw *ServerInterfaceWrapper) GetPositionsListcars(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPositionsListcarsParams
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "brand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "brand", r.URL.Query(), &params.Brand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "brand", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "car_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "car_type", r.URL.Query(), &params.CarType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPositionsListcars(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPressuredata operation middleware
+func (siw *ServerInterfaceWrapper) GetPressuredata(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPressuredataParams
+
+	// ------------- Required query parameter "wheel_id" -------------
+
+	if paramValue := r.URL.Query().Get("wheel_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "wheel_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "wheel_id", r.URL.Query(), &params.WheelId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wheel_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPressuredata(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostRefresh(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReport operation middleware
+func (siw *ServerInterfaceWrapper) GetReport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "types", r.URL.Query(), &params.Types)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "types", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSearch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSensordata operation middleware
+func (siw *ServerInterfaceWrapper) PostSensordata(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSensordata(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSensors operation middleware
+func (siw *ServerInterfaceWrapper) GetSensors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSensorsParams
+
+	// ------------- Required query parameter "car_id" -------------
+
+	if paramValue := r.URL.Query().Get("car_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "car_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "car_id", r.URL.Query(), &params.CarId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSensors(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTemperaturedata operation middleware
+func (siw *ServerInterfaceWrapper) GetTemperaturedata(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemperaturedataParams
+
+	// ------------- Required query parameter "wheel_id" -------------
+
+	if paramValue := r.URL.Query().Get("wheel_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "wheel_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "wheel_id", r.URL.Query(), &params.WheelId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wheel_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTemperaturedata(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTotp operation middleware
+func (siw *ServerInterfaceWrapper) GetTotp(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTotp(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTotpConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostTotpConfirm(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTotpConfirm(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTotpDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostTotpDisable(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTotpDisable(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTotpEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostTotpEnroll(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTotpEnroll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutTotpPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutTotpPolicy(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTotpPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTotpRecoverycodes operation middleware
+func (siw *ServerInterfaceWrapper) PostTotpRecoverycodes(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTotpRecoverycodes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUser operation middleware
+func (siw *ServerInterfaceWrapper) PostUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutUser operation middleware
+func (siw *ServerInterfaceWrapper) PutUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutUserinfo operation middleware
+func (siw *ServerInterfaceWrapper) PutUserinfo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUserinfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWebhookParams
+
+	// ------------- Required query parameter "webhook_id" -------------
+
+	if paramValue := r.URL.Query().Get("webhook_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "webhook_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "webhook_id", r.URL.Query(), &params.WebhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PutWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDeliveryAttempts operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveryAttempts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveryAttemptsParams
+
+	// ------------- Required query parameter "delivery_id" -------------
+
+	if paramValue := r.URL.Query().Get("delivery_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "delivery_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "delivery_id", r.URL.Query(), &params.DeliveryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delivery_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveryAttempts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDeliveryList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveryList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveryListParams
+
+	// ------------- Optional query parameter "webhook_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "webhook_id", r.URL.Query(), &params.WebhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "event_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event_type", r.URL.Query(), &params.EventType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "event_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveryList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutWebhookDeliveryReplay operation middleware
+func (siw *ServerInterfaceWrapper) PutWebhookDeliveryReplay(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWebhookDeliveryReplay(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookList(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWheels operation middleware
+func (siw *ServerInterfaceWrapper) GetWheels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWheelsParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWheels(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWheels operation middleware
+func (siw *ServerInterfaceWrapper) PostWheels(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWheels(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutWheels operation middleware
+func (siw *ServerInterfaceWrapper) PutWheels(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWheels(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWheelsStateNumber operation middleware
+func (siw *ServerInterfaceWrapper) GetWheelsStateNumber(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "state_number" -------------
+	var stateNumber string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "state_number", chi.URLParam(r, "state_number"), &stateNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state_number", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWheelsStateNumber(w, r, stateNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkOrder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkOrderParams
+
+	// ------------- Required query parameter "work_order_id" -------------
+
+	if paramValue := r.URL.Query().Get("work_order_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "work_order_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "work_order_id", r.URL.Query(), &params.WorkOrderId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "work_order_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkOrder(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWorkOrder operation middleware
+func (siw *ServerInterfaceWrapper) PostWorkOrder(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWorkOrder(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutWorkOrderCancel operation middleware
+func (siw *ServerInterfaceWrapper) PutWorkOrderCancel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWorkOrderCancel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutWorkOrderComplete operation middleware
+func (siw *ServerInterfaceWrapper) PutWorkOrderComplete(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWorkOrderComplete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkOrderList operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkOrderList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthorizationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkOrderListParams
+
+	// ------------- Optional query parameter "car_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "car_id", r.URL.Query(), &params.CarId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "car_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkOrderList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit/export", wrapper.GetAuditExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit/list", wrapper.GetAuditList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auto", wrapper.GetAuto)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auto", wrapper.PostAuto)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auto/info", wrapper.GetAutoInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auto/list", wrapper.GetAutoList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/breakage", wrapper.GetBreakage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/breakage", wrapper.PostBreakage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/breakage", wrapper.PutBreakage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/breakage/history", wrapper.GetBreakageHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/breakage/list", wrapper.GetBreakageList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/breakage/stats", wrapper.GetBreakageStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/breakage/status", wrapper.PutBreakageStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/breakage/type", wrapper.DeleteBreakageType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/breakage/type", wrapper.PostBreakageType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/breakage/type", wrapper.PutBreakageType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/breakage/type/list", wrapper.GetBreakageTypeList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/driver", wrapper.PostDriver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/driver", wrapper.PutDriver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/driver/assignment", wrapper.PostDriverAssignment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/driver/assignment/end", wrapper.PutDriverAssignmentEnd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/assignment/list", wrapper.GetDriverAssignmentList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/driver/deactivate", wrapper.PutDriverDeactivate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/driver/document", wrapper.DeleteDriverDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/document", wrapper.GetDriverDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/driver/document", wrapper.PostDriverDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/document/list", wrapper.GetDriverDocumentList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/info", wrapper.GetDriverInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/list", wrapper.GetDriverList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/timesheet", wrapper.GetDriverTimesheet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/driver/work-session", wrapper.PostDriverWorkSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/driver/work-session/end", wrapper.PutDriverWorkSessionEnd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/work-session/list", wrapper.GetDriverWorkSessionList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/work-time", wrapper.GetDriverWorkTime)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/driver/work-violation/list", wrapper.GetDriverWorkViolationList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/driver/worktime", wrapper.PutDriverWorktime)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/import/{kind}", wrapper.PostImportKind)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/import/{kind}/template", wrapper.GetImportKindTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/login", wrapper.PostLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/login/totp", wrapper.PostLoginTotp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/login/totp/enroll", wrapper.PostLoginTotpEnroll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/maintenance/plan", wrapper.PostMaintenancePlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/maintenance/plan", wrapper.PutMaintenancePlan)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/maintenance/plan/list", wrapper.GetMaintenancePlanList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/maintenance/upcoming", wrapper.GetMaintenanceUpcoming)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/mileage", wrapper.PutMileage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/notification/allstatus", wrapper.PutNotificationAllstatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notification/deliveries", wrapper.GetNotificationDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notification/info", wrapper.GetNotificationInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notification/list", wrapper.GetNotificationList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/notification/rule", wrapper.DeleteNotificationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notification/rule", wrapper.PostNotificationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/notification/rule", wrapper.PutNotificationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notification/rule/list", wrapper.GetNotificationRuleList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/notification/status", wrapper.PutNotificationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/portability/export", wrapper.GetPortabilityExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/portability/import", wrapper.PostPortabilityImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/position", wrapper.PostPosition)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/position/carroute", wrapper.GetPositionCarroute)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/position/drivertrips", wrapper.GetPositionDrivertrips)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/position/geofence", wrapper.DeletePositionGeofence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/position/geofence", wrapper.PostPositionGeofence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/position/geofence", wrapper.PutPositionGeofence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/position/geofence/list", wrapper.GetPositionGeofenceList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/position/listcurrent", wrapper.GetPositionListcurrent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/positions/listcars", wrapper.GetPositionsListcars)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pressuredata", wrapper.GetPressuredata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/refresh", wrapper.PostRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/report", wrapper.GetReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/search", wrapper.GetSearch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/sensordata", wrapper.PostSensordata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/sensors", wrapper.GetSensors)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/temperaturedata", wrapper.GetTemperaturedata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/totp", wrapper.GetTotp)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/totp/confirm", wrapper.PostTotpConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/totp/disable", wrapper.PostTotpDisable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/totp/enroll", wrapper.PostTotpEnroll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/totp/policy", wrapper.PutTotpPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/totp/recoverycodes", wrapper.PostTotpRecoverycodes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user", wrapper.GetUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/user", wrapper.PostUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/user", wrapper.PutUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/userinfo", wrapper.PutUserinfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhook", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook", wrapper.PostWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhook", wrapper.PutWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/delivery/attempts", wrapper.GetWebhookDeliveryAttempts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/delivery/list", wrapper.GetWebhookDeliveryList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhook/delivery/replay", wrapper.PutWebhookDeliveryReplay)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook/list", wrapper.GetWebhookList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wheels", wrapper.GetWheels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wheels", wrapper.PostWheels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/wheels", wrapper.PutWheels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wheels/{state_number}", wrapper.GetWheelsStateNumber)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/work-order", wrapper.GetWorkOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/work-order", wrapper.PostWorkOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/work-order/cancel", wrapper.PutWorkOrderCancel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/work-order/complete", wrapper.PutWorkOrderComplete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/work-order/list", wrapper.GetWorkOrderList)
+	})
+
+	return r
+}
+
+type GetAuditExportRequestObject struct {
+	Params GetAuditExportParams
+}
+
+type GetAuditExportResponseObject interface {
+	VisitGetAuditExportResponse(w http.ResponseWriter) error
+}
+
+type GetAuditExport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAuditExport200TextcsvResponse) VisitGetAuditExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAuditListRequestObject struct {
+	Params GetAuditListParams
+}
+
+type GetAuditListResponseObject interface {
+	VisitGetAuditListResponse(w http.ResponseWriter) error
+}
+
+type GetAuditList200JSONResponse []AuditEntryResponse
+
+func (response GetAuditList200JSONResponse) VisitGetAuditListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoRequestObject struct {
+	Params GetAutoParams
+}
+
+type GetAutoResponseObject interface {
+	VisitGetAutoResponse(w http.ResponseWriter) error
+}
+
+type GetAuto200JSONResponse AutoResponse
+
+func (response GetAuto200JSONResponse) VisitGetAutoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAutoRequestObject struct {
+	Body *PostAutoJSONRequestBody
+}
+
+type PostAutoResponseObject interface {
+	VisitPostAutoResponse(w http.ResponseWriter) error
+}
+
+type PostAuto201JSONResponse AutoResponse
+
+func (response PostAuto201JSONResponse) VisitPostAutoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInfoRequestObject struct {
+	Params GetAutoInfoParams
+}
+
+type GetAutoInfoResponseObject interface {
+	VisitGetAutoInfoResponse(w http.ResponseWriter) error
+}
+
+type GetAutoInfo200JSONResponse struct {
+	Auto   *AutoResponse    `json:"auto,omitempty"`
+	Wheels *[]WheelResponse `json:"wheels,omitempty"`
+}
+
+func (response GetAutoInfo200JSONResponse) VisitGetAutoInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoListRequestObject struct {
+	Params GetAutoListParams
+}
+
+type GetAutoListResponseObject interface {
+	VisitGetAutoListResponse(w http.ResponseWriter) error
+}
+
+type GetAutoList200JSONResponse []AutoResponse
+
+func (response GetAutoList200JSONResponse) VisitGetAutoListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBreakageRequestObject struct {
+	Params GetBreakageParams
+}
+
+type GetBreakageResponseObject interface {
+	VisitGetBreakageResponse(w http.ResponseWriter) error
+}
+
+type GetBreakage200JSONResponse BreakageResponse
+
+func (response GetBreakage200JSONResponse) VisitGetBreakageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBreakage404Response struct {
+}
+
+func (response GetBreakage404Response) VisitGetBreakageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostBreakageRequestObject struct {
+	Body *PostBreakageJSONRequestBody
+}
+
+type PostBreakageResponseObject interface {
+	VisitPostBreakageResponse(w http.ResponseWriter) error
+}
+
+type PostBreakage201Response struct {
+}
+
+func (response PostBreakage201Response) VisitPostBreakageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostBreakage409Response struct {
+}
+
+func (response PostBreakage409Response) VisitPostBreakageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostBreakage422Response struct {
+}
+
+func (response PostBreakage422Response) VisitPostBreakageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(422)
+	return nil
+}
+
+type PutBreakageRequestObject struct {
+	Body *PutBreakageJSONRequestBody
+}
+
+type PutBreakageResponseObject interface {
+	VisitPutBreakageResponse(w http.ResponseWriter) error
+}
+
+type PutBreakage200JSONResponse BreakageResponse
+
+func (response PutBreakage200JSONResponse) VisitPutBreakageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBreakage404Response struct {
+}
+
+func (response PutBreakage404Response) VisitPutBreakageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetBreakageHistoryRequestObject struct {
+	Params GetBreakageHistoryParams
+}
+
+type GetBreakageHistoryResponseObject interface {
+	VisitGetBreakageHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetBreakageHistory200JSONResponse []BreakageStatusChangeResponse
+
+func (response GetBreakageHistory200JSONResponse) VisitGetBreakageHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBreakageHistory404Response struct {
+}
+
+func (response GetBreakageHistory404Response) VisitGetBreakageHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetBreakageListRequestObject struct {
+	Params GetBreakageListParams
+}
+
+type GetBreakageListResponseObject interface {
+	VisitGetBreakageListResponse(w http.ResponseWriter) error
+}
+
+type GetBreakageList200JSONResponse []BreakageListResponse
+
+func (response GetBreakageList200JSONResponse) VisitGetBreakageListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBreakageStatsRequestObject struct {
+	Params GetBreakageStatsParams
+}
+
+type GetBreakageStatsResponseObject interface {
+	VisitGetBreakageStatsResponse(w http.ResponseWriter) error
+}
+
+type GetBreakageStats200JSONResponse []BreakageStatsResponse
+
+func (response GetBreakageStats200JSONResponse) VisitGetBreakageStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBreakageStatusRequestObject struct {
+	Body *PutBreakageStatusJSONRequestBody
+}
+
+type PutBreakageStatusResponseObject interface {
+	VisitPutBreakageStatusResponse(w http.ResponseWriter) error
+}
+
+type PutBreakageStatus200JSONResponse BreakageResponse
+
+func (response PutBreakageStatus200JSONResponse) VisitPutBreakageStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBreakageStatus404Response struct {
+}
+
+func (response PutBreakageStatus404Response) VisitPutBreakageStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutBreakageStatus409Response struct {
+}
+
+func (response PutBreakageStatus409Response) VisitPutBreakageStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type DeleteBreakageTypeRequestObject struct {
+	Params DeleteBreakageTypeParams
+}
+
+type DeleteBreakageTypeResponseObject interface {
+	VisitDeleteBreakageTypeResponse(w http.ResponseWriter) error
+}
+
+type DeleteBreakageType204Response struct {
+}
+
+func (response DeleteBreakageType204Response) VisitDeleteBreakageTypeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBreakageType404Response struct {
+}
+
+func (response DeleteBreakageType404Response) VisitDeleteBreakageTypeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostBreakageTypeRequestObject struct {
+	Body *PostBreakageTypeJSONRequestBody
+}
+
+type PostBreakageTypeResponseObject interface {
+	VisitPostBreakageTypeResponse(w http.ResponseWriter) error
+}
+
+type PostBreakageType201JSONResponse BreakageTypeResponse
+
+func (response PostBreakageType201JSONResponse) VisitPostBreakageTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBreakageType409Response struct {
+}
+
+func (response PostBreakageType409Response) VisitPostBreakageTypeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PutBreakageTypeRequestObject struct {
+	Body *PutBreakageTypeJSONRequestBody
+}
+
+type PutBreakageTypeResponseObject interface {
+	VisitPutBreakageTypeResponse(w http.ResponseWriter) error
+}
+
+type PutBreakageType200JSONResponse BreakageTypeResponse
+
+func (response PutBreakageType200JSONResponse) VisitPutBreakageTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutBreakageType404Response struct {
+}
+
+func (response PutBreakageType404Response) VisitPutBreakageTypeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutBreakageType409Response struct {
+}
+
+func (response PutBreakageType409Response) VisitPutBreakageTypeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type GetBreakageTypeListRequestObject struct {
+}
+
+type GetBreakageTypeListResponseObject interface {
+	VisitGetBreakageTypeListResponse(w http.ResponseWriter) error
+}
+
+type GetBreakageTypeList200JSONResponse []BreakageTypeResponse
+
+func (response GetBreakageTypeList200JSONResponse) VisitGetBreakageTypeListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDriverRequestObject struct {
+	Body *PostDriverJSONRequestBody
+}
+
+type PostDriverResponseObject interface {
+	VisitPostDriverResponse(w http.ResponseWriter) error
+}
+
+type PostDriver201Response struct {
+}
+
+func (response PostDriver201Response) VisitPostDriverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type PutDriverRequestObject struct {
+	Body *PutDriverJSONRequestBody
+}
+
+type PutDriverResponseObject interface {
+	VisitPutDriverResponse(w http.ResponseWriter) error
+}
+
+type PutDriver200JSONResponse DriverInfoResponse
+
+func (response PutDriver200JSONResponse) VisitPutDriverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDriver404Response struct {
+}
+
+func (response PutDriver404Response) VisitPutDriverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostDriverAssignmentRequestObject struct {
+	Body *PostDriverAssignmentJSONRequestBody
+}
+
+type PostDriverAssignmentResponseObject interface {
+	VisitPostDriverAssignmentResponse(w http.ResponseWriter) error
+}
+
+type PostDriverAssignment201JSONResponse DriverAssignmentResponse
+
+func (response PostDriverAssignment201JSONResponse) VisitPostDriverAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDriverAssignment404Response struct {
+}
+
+func (response PostDriverAssignment404Response) VisitPostDriverAssignmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostDriverAssignment409Response struct {
+}
+
+func (response PostDriverAssignment409Response) VisitPostDriverAssignmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PutDriverAssignmentEndRequestObject struct {
+	Body *PutDriverAssignmentEndJSONRequestBody
+}
+
+type PutDriverAssignmentEndResponseObject interface {
+	VisitPutDriverAssignmentEndResponse(w http.ResponseWriter) error
+}
+
+type PutDriverAssignmentEnd200JSONResponse DriverAssignmentResponse
+
+func (response PutDriverAssignmentEnd200JSONResponse) VisitPutDriverAssignmentEndResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDriverAssignmentEnd409Response struct {
+}
+
+func (response PutDriverAssignmentEnd409Response) VisitPutDriverAssignmentEndResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type GetDriverAssignmentListRequestObject struct {
+	Params GetDriverAssignmentListParams
+}
+
+type GetDriverAssignmentListResponseObject interface {
+	VisitGetDriverAssignmentListResponse(w http.ResponseWriter) error
+}
+
+type GetDriverAssignmentList200JSONResponse []DriverAssignmentResponse
+
+func (response GetDriverAssignmentList200JSONResponse) VisitGetDriverAssignmentListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDriverDeactivateRequestObject struct {
+	Body *PutDriverDeactivateJSONRequestBody
+}
+
+type PutDriverDeactivateResponseObject interface {
+	VisitPutDriverDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PutDriverDeactivate200JSONResponse DriverInfoResponse
+
+func (response PutDriverDeactivate200JSONResponse) VisitPutDriverDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDriverDeactivate404Response struct {
+}
+
+func (response PutDriverDeactivate404Response) VisitPutDriverDeactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutDriverDeactivate409Response struct {
+}
+
+func (response PutDriverDeactivate409Response) VisitPutDriverDeactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type DeleteDriverDocumentRequestObject struct {
+	Params DeleteDriverDocumentParams
+}
+
+type DeleteDriverDocumentResponseObject interface {
+	VisitDeleteDriverDocumentResponse(w http.ResponseWriter) error
+}
+
+type DeleteDriverDocument204Response struct {
+}
+
+func (response DeleteDriverDocument204Response) VisitDeleteDriverDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDriverDocument404Response struct {
+}
+
+func (response DeleteDriverDocument404Response) VisitDeleteDriverDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetDriverDocumentRequestObject struct {
+	Params GetDriverDocumentParams
+}
+
+type GetDriverDocumentResponseObject interface {
+	VisitGetDriverDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetDriverDocument200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetDriverDocument200ApplicationoctetStreamResponse) VisitGetDriverDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetDriverDocument404Response struct {
+}
+
+func (response GetDriverDocument404Response) VisitGetDriverDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostDriverDocumentRequestObject struct {
+	Params PostDriverDocumentParams
+	Body   io.Reader
+}
+
+type PostDriverDocumentResponseObject interface {
+	VisitPostDriverDocumentResponse(w http.ResponseWriter) error
+}
+
+type PostDriverDocument201JSONResponse DriverDocumentResponse
+
+func (response PostDriverDocument201JSONResponse) VisitPostDriverDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDriverDocument404Response struct {
+}
+
+func (response PostDriverDocument404Response) VisitPostDriverDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetDriverDocumentListRequestObject struct {
+	Params GetDriverDocumentListParams
+}
+
+type GetDriverDocumentListResponseObject interface {
+	VisitGetDriverDocumentListResponse(w http.ResponseWriter) error
+}
+
+type GetDriverDocumentList200JSONResponse []DriverDocumentResponse
+
+func (response GetDriverDocumentList200JSONResponse) VisitGetDriverDocumentListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDriverInfoRequestObject struct {
+	Params GetDriverInfoParams
+}
+
+type GetDriverInfoResponseObject interface {
+	VisitGetDriverInfoResponse(w http.ResponseWriter) error
+}
+
+type GetDriverInfo200JSONResponse []DriverInfoResponse
+
+func (response GetDriverInfo200JSONResponse) VisitGetDriverInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDriverListRequestObject struct {
+	Params GetDriverListParams
+}
+
+type GetDriverListResponseObject interface {
+	VisitGetDriverListResponse(w http.ResponseWriter) error
+}
+
+type GetDriverList200JSONResponse []DriverStatisticsResponse
+
+func (response GetDriverList200JSONResponse) VisitGetDriverListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDriverTimesheetRequestObject struct {
+	Params GetDriverTimesheetParams
+}
+
+type GetDriverTimesheetResponseObject interface {
+	VisitGetDriverTimesheetResponse(w http.ResponseWriter) error
+}
+
+type GetDriverTimesheet200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetDriverTimesheet200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetDriverTimesheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetDriverTimesheet404Response struct {
+}
+
+func (response GetDriverTimesheet404Response) VisitGetDriverTimesheetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostDriverWorkSessionRequestObject struct {
+	Body *PostDriverWorkSessionJSONRequestBody
+}
+
+type PostDriverWorkSessionResponseObject interface {
+	VisitPostDriverWorkSessionResponse(w http.ResponseWriter) error
+}
+
+type PostDriverWorkSession201JSONResponse WorkSessionResponse
+
+func (response PostDriverWorkSession201JSONResponse) VisitPostDriverWorkSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDriverWorkSession404Response struct {
+}
+
+func (response PostDriverWorkSession404Response) VisitPostDriverWorkSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostDriverWorkSession409Response struct {
+}
+
+func (response PostDriverWorkSession409Response) VisitPostDriverWorkSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PutDriverWorkSessionEndRequestObject struct {
+	Body *PutDriverWorkSessionEndJSONRequestBody
+}
+
+type PutDriverWorkSessionEndResponseObject interface {
+	VisitPutDriverWorkSessionEndResponse(w http.ResponseWriter) error
+}
+
+type PutDriverWorkSessionEnd200JSONResponse WorkSessionResponse
+
+func (response PutDriverWorkSessionEnd200JSONResponse) VisitPutDriverWorkSessionEndResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDriverWorkSessionEnd409Response struct {
+}
+
+func (response PutDriverWorkSessionEnd409Response) VisitPutDriverWorkSessionEndResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type GetDriverWorkSessionListRequestObject struct {
+	Params GetDriverWorkSessionListParams
+}
+
+type GetDriverWorkSessionListResponseObject interface {
+	VisitGetDriverWorkSessionListResponse(w http.ResponseWriter) error
+}
+
+type GetDriverWorkSessionList200JSONResponse []WorkSessionResponse
+
+func (response GetDriverWorkSessionList200JSONResponse) VisitGetDriverWorkSessionListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDriverWorkTimeRequestObject struct {
+	Params GetDriverWorkTimeParams
+}
+
+type GetDriverWorkTimeResponseObject interface {
+	VisitGetDriverWorkTimeResponse(w http.ResponseWriter) error
+}
+
+type GetDriverWorkTime200JSONResponse WorkTimeSummaryResponse
+
+func (response GetDriverWorkTime200JSONResponse) VisitGetDriverWorkTimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDriverWorkViolationListRequestObject struct {
+	Params GetDriverWorkViolationListParams
+}
+
+type GetDriverWorkViolationListResponseObject interface {
+	VisitGetDriverWorkViolationListResponse(w http.ResponseWriter) error
+}
+
+type GetDriverWorkViolationList200JSONResponse []WorkViolationResponse
+
+func (response GetDriverWorkViolationList200JSONResponse) VisitGetDriverWorkViolationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutDriverWorktimeRequestObject struct {
+	Body *PutDriverWorktimeJSONRequestBody
+}
+
+type PutDriverWorktimeResponseObject interface {
+	VisitPutDriverWorktimeResponse(w http.ResponseWriter) error
+}
+
+type PutDriverWorktime200Response struct {
+}
+
+func (response PutDriverWorktime200Response) VisitPutDriverWorktimeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostImportKindRequestObject struct {
+	Kind   string `json:"kind"`
+	Params PostImportKindParams
+	Body   io.Reader
+}
+
+type PostImportKindResponseObject interface {
+	VisitPostImportKindResponse(w http.ResponseWriter) error
+}
+
+type PostImportKind200JSONResponse ImportResultResponse
+
+func (response PostImportKind200JSONResponse) VisitPostImportKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportKind400Response struct {
+}
+
+func (response PostImportKind400Response) VisitPostImportKindResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostImportKind422Response struct {
+}
+
+func (response PostImportKind422Response) VisitPostImportKindResponse(w http.ResponseWriter) error {
+	w.WriteHeader(422)
+	return nil
+}
+
+type GetImportKindTemplateRequestObject struct {
+	Kind   string `json:"kind"`
+	Params GetImportKindTemplateParams
+}
+
+type GetImportKindTemplateResponseObject interface {
+	VisitGetImportKindTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetImportKindTemplate200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetImportKindTemplate200TextcsvResponse) VisitGetImportKindTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin201JSONResponse LoginResponse
+
+func (response PostLogin201JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginTotpRequestObject struct {
+	Body *PostLoginTotpJSONRequestBody
+}
+
+type PostLoginTotpResponseObject interface {
+	VisitPostLoginTotpResponse(w http.ResponseWriter) error
+}
+
+type PostLoginTotp201JSONResponse TotpLoginResponse
+
+func (response PostLoginTotp201JSONResponse) VisitPostLoginTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginTotp429Response struct {
+}
+
+func (response PostLoginTotp429Response) VisitPostLoginTotpResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type PostLoginTotpEnrollRequestObject struct {
+	Body *PostLoginTotpEnrollJSONRequestBody
+}
+
+type PostLoginTotpEnrollResponseObject interface {
+	VisitPostLoginTotpEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostLoginTotpEnroll200JSONResponse TotpEnrollResponse
+
+func (response PostLoginTotpEnroll200JSONResponse) VisitPostLoginTotpEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMaintenancePlanRequestObject struct {
+	Body *PostMaintenancePlanJSONRequestBody
+}
+
+type PostMaintenancePlanResponseObject interface {
+	VisitPostMaintenancePlanResponse(w http.ResponseWriter) error
+}
+
+type PostMaintenancePlan201JSONResponse MaintenancePlanResponse
+
+func (response PostMaintenancePlan201JSONResponse) VisitPostMaintenancePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMaintenancePlan404Response struct {
+}
+
+func (response PostMaintenancePlan404Response) VisitPostMaintenancePlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutMaintenancePlanRequestObject struct {
+	Body *PutMaintenancePlanJSONRequestBody
+}
+
+type PutMaintenancePlanResponseObject interface {
+	VisitPutMaintenancePlanResponse(w http.ResponseWriter) error
+}
+
+type PutMaintenancePlan200JSONResponse MaintenancePlanResponse
+
+func (response PutMaintenancePlan200JSONResponse) VisitPutMaintenancePlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMaintenancePlan404Response struct {
+}
+
+func (response PutMaintenancePlan404Response) VisitPutMaintenancePlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetMaintenancePlanListRequestObject struct {
+}
+
+type GetMaintenancePlanListResponseObject interface {
+	VisitGetMaintenancePlanListResponse(w http.ResponseWriter) error
+}
+
+type GetMaintenancePlanList200JSONResponse []MaintenancePlanResponse
+
+func (response GetMaintenancePlanList200JSONResponse) VisitGetMaintenancePlanListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceUpcomingRequestObject struct {
+	Params GetMaintenanceUpcomingParams
+}
+
+type GetMaintenanceUpcomingResponseObject interface {
+	VisitGetMaintenanceUpcomingResponse(w http.ResponseWriter) error
+}
+
+type GetMaintenanceUpcoming200JSONResponse []MaintenanceTaskResponse
+
+func (response GetMaintenanceUpcoming200JSONResponse) VisitGetMaintenanceUpcomingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMileageRequestObject struct {
+	Body *PutMileageJSONRequestBody
+}
+
+type PutMileageResponseObject interface {
+	VisitPutMileageResponse(w http.ResponseWriter) error
+}
+
+type PutMileage200Response struct {
+}
+
+func (response PutMileage200Response) VisitPutMileageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutNotificationAllstatusRequestObject struct {
+	Body *PutNotificationAllstatusJSONRequestBody
+}
+
+type PutNotificationAllstatusResponseObject interface {
+	VisitPutNotificationAllstatusResponse(w http.ResponseWriter) error
+}
+
+type PutNotificationAllstatus200Response struct {
+}
+
+func (response PutNotificationAllstatus200Response) VisitPutNotificationAllstatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetNotificationDeliveriesRequestObject struct {
+	Params GetNotificationDeliveriesParams
+}
+
+type GetNotificationDeliveriesResponseObject interface {
+	VisitGetNotificationDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationDeliveries200JSONResponse []NotificationDeliveryResponse
+
+func (response GetNotificationDeliveries200JSONResponse) VisitGetNotificationDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationDeliveries404Response struct {
+}
+
+func (response GetNotificationDeliveries404Response) VisitGetNotificationDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetNotificationInfoRequestObject struct {
+	Params GetNotificationInfoParams
+}
+
+type GetNotificationInfoResponseObject interface {
+	VisitGetNotificationInfoResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationInfo200JSONResponse NotificationInfoResponse
+
+func (response GetNotificationInfo200JSONResponse) VisitGetNotificationInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationListRequestObject struct {
+	Params GetNotificationListParams
+}
+
+type GetNotificationListResponseObject interface {
+	VisitGetNotificationListResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationList200JSONResponse []NotificationListResponse
+
+func (response GetNotificationList200JSONResponse) VisitGetNotificationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNotificationRuleRequestObject struct {
+	Params DeleteNotificationRuleParams
+}
+
+type DeleteNotificationRuleResponseObject interface {
+	VisitDeleteNotificationRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteNotificationRule204Response struct {
+}
+
+func (response DeleteNotificationRule204Response) VisitDeleteNotificationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteNotificationRule404Response struct {
+}
+
+func (response DeleteNotificationRule404Response) VisitDeleteNotificationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostNotificationRuleRequestObject struct {
+	Body *PostNotificationRuleJSONRequestBody
+}
+
+type PostNotificationRuleResponseObject interface {
+	VisitPostNotificationRuleResponse(w http.ResponseWriter) error
+}
+
+type PostNotificationRule201JSONResponse NotificationRuleResponse
+
+func (response PostNotificationRule201JSONResponse) VisitPostNotificationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationRule400Response struct {
+}
+
+func (response PostNotificationRule400Response) VisitPostNotificationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PutNotificationRuleRequestObject struct {
+	Body *PutNotificationRuleJSONRequestBody
+}
+
+type PutNotificationRuleResponseObject interface {
+	VisitPutNotificationRuleResponse(w http.ResponseWriter) error
+}
+
+type PutNotificationRule200JSONResponse NotificationRuleResponse
+
+func (response PutNotificationRule200JSONResponse) VisitPutNotificationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNotificationRule404Response struct {
+}
+
+func (response PutNotificationRule404Response) VisitPutNotificationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetNotificationRuleListRequestObject struct {
+}
+
+type GetNotificationRuleListResponseObject interface {
+	VisitGetNotificationRuleListResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationRuleList200JSONResponse []NotificationRuleResponse
+
+func (response GetNotificationRuleList200JSONResponse) VisitGetNotificationRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNotificationStatusRequestObject struct {
+	Body *PutNotificationStatusJSONRequestBody
+}
+
+type PutNotificationStatusResponseObject interface {
+	VisitPutNotificationStatusResponse(w http.ResponseWriter) error
+}
+
+type PutNotificationStatus200Response struct {
+}
+
+func (response PutNotificationStatus200Response) VisitPutNotificationStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetPortabilityExportRequestObject struct {
+	Params GetPortabilityExportParams
+}
+
+type GetPortabilityExportResponseObject interface {
+	VisitGetPortabilityExportResponse(w http.ResponseWriter) error
+}
+
+type GetPortabilityExport200ApplicationzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPortabilityExport200ApplicationzipResponse) VisitGetPortabilityExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPortabilityExport400Response struct {
+}
+
+func (response GetPortabilityExport400Response) VisitGetPortabilityExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostPortabilityImportRequestObject struct {
+	Body io.Reader
+}
+
+type PostPortabilityImportResponseObject interface {
+	VisitPostPortabilityImportResponse(w http.ResponseWriter) error
+}
+
+type PostPortabilityImport200JSONResponse []RestoreResultResponse
+
+func (response PostPortabilityImport200JSONResponse) VisitPostPortabilityImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPortabilityImport400Response struct {
+}
+
+func (response PostPortabilityImport400Response) VisitPostPortabilityImportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostPositionRequestObject struct {
+	Body *PostPositionJSONRequestBody
+}
+
+type PostPositionResponseObject interface {
+	VisitPostPositionResponse(w http.ResponseWriter) error
+}
+
+type PostPosition201Response struct {
+}
+
+func (response PostPosition201Response) VisitPostPositionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostPosition409Response struct {
+}
+
+func (response PostPosition409Response) VisitPostPositionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostPosition422Response struct {
+}
+
+func (response PostPosition422Response) VisitPostPositionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(422)
+	return nil
+}
+
+type GetPositionCarrouteRequestObject struct {
+	Params GetPositionCarrouteParams
+}
+
+type GetPositionCarrouteResponseObject interface {
+	VisitGetPositionCarrouteResponse(w http.ResponseWriter) error
+}
+
+type GetPositionCarroute200JSONResponse RouteCarResponse
+
+func (response GetPositionCarroute200JSONResponse) VisitGetPositionCarrouteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPositionDrivertripsRequestObject struct {
+	Params GetPositionDrivertripsParams
+}
+
+type GetPositionDrivertripsResponseObject interface {
+	VisitGetPositionDrivertripsResponse(w http.ResponseWriter) error
+}
+
+type GetPositionDrivertrips200JSONResponse []DriverTripResponse
+
+func (response GetPositionDrivertrips200JSONResponse) VisitGetPositionDrivertripsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPositionDrivertrips204Response struct {
+}
+
+func (response GetPositionDrivertrips204Response) VisitGetPositionDrivertripsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePositionGeofenceRequestObject struct {
+	Params DeletePositionGeofenceParams
+}
+
+type DeletePositionGeofenceResponseObject interface {
+	VisitDeletePositionGeofenceResponse(w http.ResponseWriter) error
+}
+
+type DeletePositionGeofence204Response struct {
+}
+
+func (response DeletePositionGeofence204Response) VisitDeletePositionGeofenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePositionGeofence404Response struct {
+}
+
+func (response DeletePositionGeofence404Response) VisitDeletePositionGeofenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostPositionGeofenceRequestObject struct {
+	Body *PostPositionGeofenceJSONRequestBody
+}
+
+type PostPositionGeofenceResponseObject interface {
+	VisitPostPositionGeofenceResponse(w http.ResponseWriter) error
+}
+
+type PostPositionGeofence201JSONResponse GeofenceResponse
+
+func (response PostPositionGeofence201JSONResponse) VisitPostPositionGeofenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPositionGeofence400Response struct {
+}
+
+func (response PostPositionGeofence400Response) VisitPostPositionGeofenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PutPositionGeofenceRequestObject struct {
+	Body *PutPositionGeofenceJSONRequestBody
+}
+
+type PutPositionGeofenceResponseObject interface {
+	VisitPutPositionGeofenceResponse(w http.ResponseWriter) error
+}
+
+type PutPositionGeofence200JSONResponse GeofenceResponse
+
+func (response PutPositionGeofence200JSONResponse) VisitPutPositionGeofenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPositionGeofence404Response struct {
+}
+
+func (response PutPositionGeofence404Response) VisitPutPositionGeofenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetPositionGeofenceListRequestObject struct {
+}
+
+type GetPositionGeofenceListResponseObject interface {
+	VisitGetPositionGeofenceListResponse(w http.ResponseWriter) error
+}
+
+type GetPositionGeofenceList200JSONResponse []GeofenceResponse
+
+func (response GetPositionGeofenceList200JSONResponse) VisitGetPositionGeofenceListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPositionListcurrentRequestObject struct {
+}
+
+type GetPositionListcurrentResponseObject interface {
+	VisitGetPositionListcurrentResponse(w http.ResponseWriter) error
+}
+
+type GetPositionListcurrent200JSONResponse []PositionCurrentListResponse
+
+func (response GetPositionListcurrent200JSONResponse) VisitGetPositionListcurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPositionsListcarsRequestObject struct {
+	Params GetPositionsListcarsParams
+}
+
+type GetPositionsListcarsResponseObject interface {
+	VisitGetPositionsListcarsResponse(w http.ResponseWriter) error
+}
+
+type GetPositionsListcars200JSONResponse []PositionCarListResponse
+
+func (response GetPositionsListcars200JSONResponse) VisitGetPositionsListcarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPressuredataRequestObject struct {
+	Params GetPressuredataParams
+}
+
+type GetPressuredataResponseObject interface {
+	VisitGetPressuredataResponse(w http.ResponseWriter) error
+}
+
+type GetPressuredata200JSONResponse []PressureData
+
+func (response GetPressuredata200JSONResponse) VisitGetPressuredataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRefreshRequestObject struct {
+}
+
+type PostRefreshResponseObject interface {
+	VisitPostRefreshResponse(w http.ResponseWriter) error
+}
+
+type PostRefresh201JSONResponse TokenResponse
+
+func (response PostRefresh201JSONResponse) VisitPostRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportRequestObject struct {
+}
+
+type GetReportResponseObject interface {
+	VisitGetReportResponse(w http.ResponseWriter) error
+}
+
+type GetReport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetReport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetSearchRequestObject struct {
+	Params GetSearchParams
+}
+
+type GetSearchResponseObject interface {
+	VisitGetSearchResponse(w http.ResponseWriter) error
+}
+
+type GetSearch200JSONResponse []SearchResultResponse
+
+func (response GetSearch200JSONResponse) VisitGetSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSensordataRequestObject struct {
+	Body *PostSensordataJSONRequestBody
+}
+
+type PostSensordataResponseObject interface {
+	VisitPostSensordataResponse(w http.ResponseWriter) error
+}
+
+type PostSensordata201Response struct {
+}
+
+func (response PostSensordata201Response) VisitPostSensordataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostSensordata409Response struct {
+}
+
+func (response PostSensordata409Response) VisitPostSensordataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostSensordata422Response struct {
+}
+
+func (response PostSensordata422Response) VisitPostSensordataResponse(w http.ResponseWriter) error {
+	w.WriteHeader(422)
+	return nil
+}
+
+type GetSensorsRequestObject struct {
+	Params GetSensorsParams
+}
+
+type GetSensorsResponseObject interface {
+	VisitGetSensorsResponse(w http.ResponseWriter) error
+}
+
+type GetSensors200JSONResponse []SensorsData
+
+func (response GetSensors200JSONResponse) VisitGetSensorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTemperaturedataRequestObject struct {
+	Params GetTemperaturedataParams
+}
+
+type GetTemperaturedataResponseObject interface {
+	VisitGetTemperaturedataResponse(w http.ResponseWriter) error
+}
+
+type GetTemperaturedata200JSONResponse []TemperatureData
+
+func (response GetTemperaturedata200JSONResponse) VisitGetTemperaturedataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTotpRequestObject struct {
+}
+
+type GetTotpResponseObject interface {
+	VisitGetTotpResponse(w http.ResponseWriter) error
+}
+
+type GetTotp200JSONResponse TotpStatusResponse
+
+func (response GetTotp200JSONResponse) VisitGetTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTotpConfirmRequestObject struct {
+	Body *PostTotpConfirmJSONRequestBody
+}
+
+type PostTotpConfirmResponseObject interface {
+	VisitPostTotpConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostTotpConfirm200JSONResponse RecoveryCodesResponse
+
+func (response PostTotpConfirm200JSONResponse) VisitPostTotpConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTotpDisableRequestObject struct {
+	Body *PostTotpDisableJSONRequestBody
+}
+
+type PostTotpDisableResponseObject interface {
+	VisitPostTotpDisableResponse(w http.ResponseWriter) error
+}
+
+type PostTotpDisable200Response struct {
+}
+
+func (response PostTotpDisable200Response) VisitPostTotpDisableResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostTotpDisable429Response struct {
+}
+
+func (response PostTotpDisable429Response) VisitPostTotpDisableResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type PostTotpEnrollRequestObject struct {
+}
+
+type PostTotpEnrollResponseObject interface {
+	VisitPostTotpEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostTotpEnroll200JSONResponse TotpEnrollResponse
+
+func (response PostTotpEnroll200JSONResponse) VisitPostTotpEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTotpPolicyRequestObject struct {
+	Body *PutTotpPolicyJSONRequestBody
+}
+
+type PutTotpPolicyResponseObject interface {
+	VisitPutTotpPolicyResponse(w http.ResponseWriter) error
+}
+
+type PutTotpPolicy200Response struct {
+}
+
+func (response PutTotpPolicy200Response) VisitPutTotpPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostTotpRecoverycodesRequestObject struct {
+	Body *PostTotpRecoverycodesJSONRequestBody
+}
+
+type PostTotpRecoverycodesResponseObject interface {
+	VisitPostTotpRecoverycodesResponse(w http.ResponseWriter) error
+}
+
+type PostTotpRecoverycodes200JSONResponse RecoveryCodesResponse
+
+func (response PostTotpRecoverycodes200JSONResponse) VisitPostTotpRecoverycodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTotpRecoverycodes429Response struct {
+}
+
+func (response PostTotpRecoverycodes429Response) VisitPostTotpRecoverycodesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetUserRequestObject struct {
+}
+
+type GetUserResponseObject interface {
+	VisitGetUserResponse(w http.ResponseWriter) error
+}
+
+type GetUser200JSONResponse UserDetails
+
+func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUserRequestObject struct {
+	Body *PostUserJSONRequestBody
+}
+
+type PostUserResponseObject interface {
+	VisitPostUserResponse(w http.ResponseWriter) error
+}
+
+type PostUser201Response struct {
+}
+
+func (response PostUser201Response) VisitPostUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type PutUserRequestObject struct {
+	Body *PutUserJSONRequestBody
+}
+
+type PutUserResponseObject interface {
+	VisitPutUserResponse(w http.ResponseWriter) error
+}
+
+type PutUser200Response struct {
+}
+
+func (response PutUser200Response) VisitPutUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutUserinfoRequestObject struct {
+	Body *PutUserinfoJSONRequestBody
+}
+
+type PutUserinfoResponseObject interface {
+	VisitPutUserinfoResponse(w http.ResponseWriter) error
+}
+
+type PutUserinfo200Response struct {
+}
+
+func (response PutUserinfo200Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutUserinfo400Response struct {
+}
+
+func (response PutUserinfo400Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PutUserinfo404Response struct {
+}
+
+func (response PutUserinfo404Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutUserinfo500Response struct {
+}
+
+func (response PutUserinfo500Response) VisitPutUserinfoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type DeleteWebhookRequestObject struct {
+	Params DeleteWebhookParams
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook404Response struct {
+}
+
+func (response DeleteWebhook404Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostWebhookRequestObject struct {
+	Body *PostWebhookJSONRequestBody
+}
+
+type PostWebhookResponseObject interface {
+	VisitPostWebhookResponse(w http.ResponseWriter) error
+}
+
+type PostWebhook201JSONResponse WebhookResponse
+
+func (response PostWebhook201JSONResponse) VisitPostWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhook400Response struct {
+}
+
+func (response PostWebhook400Response) VisitPostWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PutWebhookRequestObject struct {
+	Body *PutWebhookJSONRequestBody
+}
+
+type PutWebhookResponseObject interface {
+	VisitPutWebhookResponse(w http.ResponseWriter) error
+}
+
+type PutWebhook200JSONResponse WebhookResponse
+
+func (response PutWebhook200JSONResponse) VisitPutWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutWebhook404Response struct {
+}
+
+func (response PutWebhook404Response) VisitPutWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetWebhookDeliveryAttemptsRequestObject struct {
+	Params GetWebhookDeliveryAttemptsParams
+}
+
+type GetWebhookDeliveryAttemptsResponseObject interface {
+	VisitGetWebhookDeliveryAttemptsResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookDeliveryAttempts200JSONResponse []WebhookAttemptResponse
+
+func (response GetWebhookDeliveryAttempts200JSONResponse) VisitGetWebhookDeliveryAttemptsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookDeliveryAttempts404Response struct {
+}
+
+func (response GetWebhookDeliveryAttempts404Response) VisitGetWebhookDeliveryAttemptsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetWebhookDeliveryListRequestObject struct {
+	Params GetWebhookDeliveryListParams
+}
+
+type GetWebhookDeliveryListResponseObject interface {
+	VisitGetWebhookDeliveryListResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookDeliveryList200JSONResponse []WebhookDeliveryResponse
+
+func (response GetWebhookDeliveryList200JSONResponse) VisitGetWebhookDeliveryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutWebhookDeliveryReplayRequestObject struct {
+	Body *PutWebhookDeliveryReplayJSONRequestBody
+}
+
+type PutWebhookDeliveryReplayResponseObject interface {
+	VisitPutWebhookDeliveryReplayResponse(w http.ResponseWriter) error
+}
+
+type PutWebhookDeliveryReplay200JSONResponse WebhookDeliveryResponse
+
+func (response PutWebhookDeliveryReplay200JSONResponse) VisitPutWebhookDeliveryReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutWebhookDeliveryReplay404Response struct {
+}
+
+func (response PutWebhookDeliveryReplay404Response) VisitPutWebhookDeliveryReplayResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetWebhookListRequestObject struct {
+}
+
+type GetWebhookListResponseObject interface {
+	VisitGetWebhookListResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookList200JSONResponse []WebhookResponse
+
+func (response GetWebhookList200JSONResponse) VisitGetWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWheelsRequestObject struct {
+	Params GetWheelsParams
+}
+
+type GetWheelsResponseObject interface {
+	VisitGetWheelsResponse(w http.ResponseWriter) error
+}
+
+type GetWheels200JSONResponse WheelResponse
+
+func (response GetWheels200JSONResponse) VisitGetWheelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWheelsRequestObject struct {
+	Body *PostWheelsJSONRequestBody
+}
+
+type PostWheelsResponseObject interface {
+	VisitPostWheelsResponse(w http.ResponseWriter) error
+}
+
+type PostWheels201JSONResponse WheelResponse
+
+func (response PostWheels201JSONResponse) VisitPostWheelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutWheelsRequestObject struct {
+	Body *PutWheelsJSONRequestBody
+}
+
+type PutWheelsResponseObject interface {
+	VisitPutWheelsResponse(w http.ResponseWriter) error
+}
+
+type PutWheels200JSONResponse WheelResponse
+
+func (response PutWheels200JSONResponse) VisitPutWheelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWheelsStateNumberRequestObject struct {
+	StateNumber string `json:"state_number"`
+}
+
+type GetWheelsStateNumberResponseObject interface {
+	VisitGetWheelsStateNumberResponse(w http.ResponseWriter) error
+}
+
+type GetWheelsStateNumber200JSONResponse []WheelsDataForDevice
+
+func (response GetWheelsStateNumber200JSONResponse) VisitGetWheelsStateNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkOrderRequestObject struct {
+	Params GetWorkOrderParams
+}
+
+type GetWorkOrderResponseObject interface {
+	VisitGetWorkOrderResponse(w http.ResponseWriter) error
+}
+
+type GetWorkOrder200JSONResponse WorkOrderResponse
+
+func (response GetWorkOrder200JSONResponse) VisitGetWorkOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkOrder404Response struct {
+}
+
+func (response GetWorkOrder404Response) VisitGetWorkOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostWorkOrderRequestObject struct {
+	Body *PostWorkOrderJSONRequestBody
+}
+
+type PostWorkOrderResponseObject interface {
+	VisitPostWorkOrderResponse(w http.ResponseWriter) error
+}
+
+type PostWorkOrder201JSONResponse WorkOrderResponse
+
+func (response PostWorkOrder201JSONResponse) VisitPostWorkOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWorkOrder404Response struct {
+}
+
+func (response PostWorkOrder404Response) VisitPostWorkOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostWorkOrder409Response struct {
+}
+
+func (response PostWorkOrder409Response) VisitPostWorkOrderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PutWorkOrderCancelRequestObject struct {
+	Body *PutWorkOrderCancelJSONRequestBody
+}
+
+type PutWorkOrderCancelResponseObject interface {
+	VisitPutWorkOrderCancelResponse(w http.ResponseWriter) error
+}
+
+type PutWorkOrderCancel200JSONResponse WorkOrderResponse
+
+func (response PutWorkOrderCancel200JSONResponse) VisitPutWorkOrderCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutWorkOrderCancel404Response struct {
+}
+
+func (response PutWorkOrderCancel404Response) VisitPutWorkOrderCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutWorkOrderCancel409Response struct {
+}
+
+func (response PutWorkOrderCancel409Response) VisitPutWorkOrderCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PutWorkOrderCompleteRequestObject struct {
+	Body *PutWorkOrderCompleteJSONRequestBody
+}
+
+type PutWorkOrderCompleteResponseObject interface {
+	VisitPutWorkOrderCompleteResponse(w http.ResponseWriter) error
+}
+
+type PutWorkOrderComplete200JSONResponse WorkOrderResponse
+
+func (response PutWorkOrderComplete200JSONResponse) VisitPutWorkOrderCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutWorkOrderComplete400Response struct {
+}
+
+func (response PutWorkOrderComplete400Response) VisitPutWorkOrderCompleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PutWorkOrderComplete404Response struct {
+}
+
+func (response PutWorkOrderComplete404Response) VisitPutWorkOrderCompleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutWorkOrderComplete409Response struct {
+}
+
+func (response PutWorkOrderComplete409Response) VisitPutWorkOrderCompleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type GetWorkOrderListRequestObject struct {
+	Params GetWorkOrderListParams
+}
+
+type GetWorkOrderListResponseObject interface {
+	VisitGetWorkOrderListResponse(w http.ResponseWriter) error
+}
+
+type GetWorkOrderList200JSONResponse []WorkOrderResponse
+
+func (response GetWorkOrderList200JSONResponse) VisitGetWorkOrderListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostWheelsRequestObject struct {
-	Body *PostWheelsJSONRequestBody
-}
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Export the audit log as CSV
+	// (GET /audit/export)
+	GetAuditExport(ctx context.Context, request GetAuditExportRequestObject) (GetAuditExportResponseObject, error)
+	// Get the audit log of the company
+	// (GET /audit/list)
+	GetAuditList(ctx context.Context, request GetAuditListRequestObject) (GetAuditListResponseObject, error)
+	// Get a single Auto by ID
+	// (GET /auto)
+	GetAuto(ctx context.Context, request GetAutoRequestObject) (GetAutoResponseObject, error)
+	// Register a Auto
+	// (POST /auto)
+	PostAuto(ctx context.Context, request PostAutoRequestObject) (PostAutoResponseObject, error)
+	// Get Auto and its wheels by car ID
+	// (GET /auto/info)
+	GetAutoInfo(ctx context.Context, request GetAutoInfoRequestObject) (GetAutoInfoResponseObject, error)
+	// Get list of Autos
+	// (GET /auto/list)
+	GetAutoList(ctx context.Context, request GetAutoListRequestObject) (GetAutoListResponseObject, error)
+	// Get a breakage with the state of its repair
+	// (GET /breakage)
+	GetBreakage(ctx context.Context, request GetBreakageRequestObject) (GetBreakageResponseObject, error)
+	// Add a new breakage from MQTT data
+	// (POST /breakage)
+	PostBreakage(ctx context.Context, request PostBreakageRequestObject) (PostBreakageResponseObject, error)
+	// Update the repair details of a breakage
+	// (PUT /breakage)
+	PutBreakage(ctx context.Context, request PutBreakageRequestObject) (PutBreakageResponseObject, error)
+	// Status changes of a breakage
+	// (GET /breakage/history)
+	GetBreakageHistory(ctx context.Context, request GetBreakageHistoryRequestObject) (GetBreakageHistoryResponseObject, error)
+	// Get a list of breakages for a specific car
+	// (GET /breakage/list)
+	GetBreakageList(ctx context.Context, request GetBreakageListRequestObject) (GetBreakageListResponseObject, error)
+	// Breakage frequency by type, car model or driver
+	// (GET /breakage/stats)
+	GetBreakageStats(ctx context.Context, request GetBreakageStatsRequestObject) (GetBreakageStatsResponseObject, error)
+	// Change the status of a breakage
+	// (PUT /breakage/status)
+	PutBreakageStatus(ctx context.Context, request PutBreakageStatusRequestObject) (PutBreakageStatusResponseObject, error)
+	// Remove a type from the breakage catalogue
+	// (DELETE /breakage/type)
+	DeleteBreakageType(ctx context.Context, request DeleteBreakageTypeRequestObject) (DeleteBreakageTypeResponseObject, error)
+	// Add a type to the breakage catalogue
+	// (POST /breakage/type)
+	PostBreakageType(ctx context.Context, request PostBreakageTypeRequestObject) (PostBreakageTypeResponseObject, error)
+	// Replace a type of the breakage catalogue
+	// (PUT /breakage/type)
+	PutBreakageType(ctx context.Context, request PutBreakageTypeRequestObject) (PutBreakageTypeResponseObject, error)
+	// The breakage catalogue of the company
+	// (GET /breakage/type/list)
+	GetBreakageTypeList(ctx context.Context, request GetBreakageTypeListRequestObject) (GetBreakageTypeListResponseObject, error)
+	// Add a driver
+	// (POST /driver)
+	PostDriver(ctx context.Context, request PostDriverRequestObject) (PostDriverResponseObject, error)
+	// Update a driver
+	// (PUT /driver)
+	PutDriver(ctx context.Context, request PutDriverRequestObject) (PutDriverResponseObject, error)
+	// Assign a driver to a car
+	// (POST /driver/assignment)
+	PostDriverAssignment(ctx context.Context, request PostDriverAssignmentRequestObject) (PostDriverAssignmentResponseObject, error)
+	// Unassign a driver from their car
+	// (PUT /driver/assignment/end)
+	PutDriverAssignmentEnd(ctx context.Context, request PutDriverAssignmentEndRequestObject) (PutDriverAssignmentEndResponseObject, error)
+	// History of driver assignments
+	// (GET /driver/assignment/list)
+	GetDriverAssignmentList(ctx context.Context, request GetDriverAssignmentListRequestObject) (GetDriverAssignmentListResponseObject, error)
+	// Deactivate a driver
+	// (PUT /driver/deactivate)
+	PutDriverDeactivate(ctx context.Context, request PutDriverDeactivateRequestObject) (PutDriverDeactivateResponseObject, error)
+	// Delete a document of a driver
+	// (DELETE /driver/document)
+	DeleteDriverDocument(ctx context.Context, request DeleteDriverDocumentRequestObject) (DeleteDriverDocumentResponseObject, error)
+	// Download a document of a driver
+	// (GET /driver/document)
+	GetDriverDocument(ctx context.Context, request GetDriverDocumentRequestObject) (GetDriverDocumentResponseObject, error)
+	// Attach a document to a driver
+	// (POST /driver/document)
+	PostDriverDocument(ctx context.Context, request PostDriverDocumentRequestObject) (PostDriverDocumentResponseObject, error)
+	// Documents of a driver
+	// (GET /driver/document/list)
+	GetDriverDocumentList(ctx context.Context, request GetDriverDocumentListRequestObject) (GetDriverDocumentListResponseObject, error)
+	// Driver information
+	// (GET /driver/info)
+	GetDriverInfo(ctx context.Context, request GetDriverInfoRequestObject) (GetDriverInfoResponseObject, error)
+	// Drivers info
+	// (GET /driver/list)
+	GetDriverList(ctx context.Context, request GetDriverListRequestObject) (GetDriverListResponseObject, error)
+	// Monthly timesheet of a driver
+	// (GET /driver/timesheet)
+	GetDriverTimesheet(ctx context.Context, request GetDriverTimesheetRequestObject) (GetDriverTimesheetResponseObject, error)
+	// Record a work session of a driver
+	// (POST /driver/work-session)
+	PostDriverWorkSession(ctx context.Context, request PostDriverWorkSessionRequestObject) (PostDriverWorkSessionResponseObject, error)
+	// End the open work session of a driver
+	// (PUT /driver/work-session/end)
+	PutDriverWorkSessionEnd(ctx context.Context, request PutDriverWorkSessionEndRequestObject) (PutDriverWorkSessionEndResponseObject, error)
+	// Log of work sessions
+	// (GET /driver/work-session/list)
+	GetDriverWorkSessionList(ctx context.Context, request GetDriverWorkSessionListRequestObject) (GetDriverWorkSessionListResponseObject, error)
+	// Daily and weekly work totals of a driver
+	// (GET /driver/work-time)
+	GetDriverWorkTime(ctx context.Context, request GetDriverWorkTimeRequestObject) (GetDriverWorkTimeResponseObject, error)
+	// Violations of the rest-time rules
+	// (GET /driver/work-violation/list)
+	GetDriverWorkViolationList(ctx context.Context, request GetDriverWorkViolationListRequestObject) (GetDriverWorkViolationListResponseObject, error)
+	// Update the driver's worked hours
+	// (PUT /driver/worktime)
+	PutDriverWorktime(ctx context.Context, request PutDriverWorktimeRequestObject) (PutDriverWorktimeResponseObject, error)
+	// Import cars, wheels or drivers from a CSV or XLSX file
+	// (POST /import/{kind})
+	PostImportKind(ctx context.Context, request PostImportKindRequestObject) (PostImportKindResponseObject, error)
+	// Download an empty import file with the columns of a kind
+	// (GET /import/{kind}/template)
+	GetImportKindTemplate(ctx context.Context, request GetImportKindTemplateRequestObject) (GetImportKindTemplateResponseObject, error)
+	// User login
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Complete login with a TOTP or recovery code
+	// (POST /login/totp)
+	PostLoginTotp(ctx context.Context, request PostLoginTotpRequestObject) (PostLoginTotpResponseObject, error)
+	// Start TOTP enrollment during login when company policy requires it
+	// (POST /login/totp/enroll)
+	PostLoginTotpEnroll(ctx context.Context, request PostLoginTotpEnrollRequestObject) (PostLoginTotpEnrollResponseObject, error)
+	// Add a maintenance plan
+	// (POST /maintenance/plan)
+	PostMaintenancePlan(ctx context.Context, request PostMaintenancePlanRequestObject) (PostMaintenancePlanResponseObject, error)
+	// Replace a maintenance plan
+	// (PUT /maintenance/plan)
+	PutMaintenancePlan(ctx context.Context, request PutMaintenancePlanRequestObject) (PutMaintenancePlanResponseObject, error)
+	// The maintenance plans of the company
+	// (GET /maintenance/plan/list)
+	GetMaintenancePlanList(ctx context.Context, request GetMaintenancePlanListRequestObject) (GetMaintenancePlanListResponseObject, error)
+	// Upcoming maintenance
+	// (GET /maintenance/upcoming)
+	GetMaintenanceUpcoming(ctx context.Context, request GetMaintenanceUpcomingRequestObject) (GetMaintenanceUpcomingResponseObject, error)
+	// Update car mileage
+	// (PUT /mileage)
+	PutMileage(ctx context.Context, request PutMileageRequestObject) (PutMileageResponseObject, error)
+	// Change the status of all notifications for a specific user
+	// (PUT /notification/allstatus)
+	PutNotificationAllstatus(ctx context.Context, request PutNotificationAllstatusRequestObject) (PutNotificationAllstatusResponseObject, error)
+	// Delivery status of a notification on each channel it was routed to
+	// (GET /notification/deliveries)
+	GetNotificationDeliveries(ctx context.Context, request GetNotificationDeliveriesRequestObject) (GetNotificationDeliveriesResponseObject, error)
+	// Get detailed information about a specific notification
+	// (GET /notification/info)
+	GetNotificationInfo(ctx context.Context, request GetNotificationInfoRequestObject) (GetNotificationInfoResponseObject, error)
+	// Get list of notifications based on status
+	// (GET /notification/list)
+	GetNotificationList(ctx context.Context, request GetNotificationListRequestObject) (GetNotificationListResponseObject, error)
+	// Remove a notification routing rule
+	// (DELETE /notification/rule)
+	DeleteNotificationRule(ctx context.Context, request DeleteNotificationRuleRequestObject) (DeleteNotificationRuleResponseObject, error)
+	// Add a notification routing rule
+	// (POST /notification/rule)
+	PostNotificationRule(ctx context.Context, request PostNotificationRuleRequestObject) (PostNotificationRuleResponseObject, error)
+	// Replace a notification routing rule
+	// (PUT /notification/rule)
+	PutNotificationRule(ctx context.Context, request PutNotificationRuleRequestObject) (PutNotificationRuleResponseObject, error)
+	// The notification routing rules of the company
+	// (GET /notification/rule/list)
+	GetNotificationRuleList(ctx context.Context, request GetNotificationRuleListRequestObject) (GetNotificationRuleListResponseObject, error)
+	// Change the status of a specific notification
+	// (PUT /notification/status)
+	PutNotificationStatus(ctx context.Context, request PutNotificationStatusRequestObject) (PutNotificationStatusResponseObject, error)
+	// Export all of the company's data as a zip archive
+	// (GET /portability/export)
+	GetPortabilityExport(ctx context.Context, request GetPortabilityExportRequestObject) (GetPortabilityExportResponseObject, error)
+	// Import an export archive into the company
+	// (POST /portability/import)
+	PostPortabilityImport(ctx context.Context, request PostPortabilityImportRequestObject) (PostPortabilityImportResponseObject, error)
+	// Add car position from MQTT
+	// (POST /position)
+	PostPosition(ctx context.Context, request PostPositionRequestObject) (PostPositionResponseObject, error)
+	// Get the route of a car
+	// (GET /position/carroute)
+	GetPositionCarroute(ctx context.Context, request GetPositionCarrouteRequestObject) (GetPositionCarrouteResponseObject, error)
+	// Get the trips of a driver
+	// (GET /position/drivertrips)
+	GetPositionDrivertrips(ctx context.Context, request GetPositionDrivertripsRequestObject) (GetPositionDrivertripsResponseObject, error)
+	// Remove a geofence
+	// (DELETE /position/geofence)
+	DeletePositionGeofence(ctx context.Context, request DeletePositionGeofenceRequestObject) (DeletePositionGeofenceResponseObject, error)
+	// Add a geofence
+	// (POST /position/geofence)
+	PostPositionGeofence(ctx context.Context, request PostPositionGeofenceRequestObject) (PostPositionGeofenceResponseObject, error)
+	// Replace a geofence
+	// (PUT /position/geofence)
+	PutPositionGeofence(ctx context.Context, request PutPositionGeofenceRequestObject) (PutPositionGeofenceResponseObject, error)
+	// The geofences of the company
+	// (GET /position/geofence/list)
+	GetPositionGeofenceList(ctx context.Context, request GetPositionGeofenceListRequestObject) (GetPositionGeofenceListResponseObject, error)
+	// Get current car positions
+	// (GET /position/listcurrent)
+	GetPositionListcurrent(ctx context.Context, request GetPositionListcurrentRequestObject) (GetPositionListcurrentResponseObject, error)
+	// Get list of cars
+	// (GET /positions/listcars)
+	GetPositionsListcars(ctx context.Context, request GetPositionsListcarsRequestObject) (GetPositionsListcarsResponseObject, error)
+	// Get data by wheel ID
+	// (GET /pressuredata)
+	GetPressuredata(ctx context.Context, request GetPressuredataRequestObject) (GetPressuredataResponseObject, error)
+	// Refresh access token
+	// (POST /refresh)
+	PostRefresh(ctx context.Context, request PostRefreshRequestObject) (PostRefreshResponseObject, error)
+	// Generate report
+	// (GET /report)
+	GetReport(ctx context.Context, request GetReportRequestObject) (GetReportResponseObject, error)
+	// Search cars, drivers, wheels and breakages of the company
+	// (GET /search)
+	GetSearch(ctx context.Context, request GetSearchRequestObject) (GetSearchResponseObject, error)
+	// Update an existing sensor
+	// (POST /sensordata)
+	PostSensordata(ctx context.Context, request PostSensordataRequestObject) (PostSensordataResponseObject, error)
+	// Provides actual data by car ID
+	// (GET /sensors)
+	GetSensors(ctx context.Context, request GetSensorsRequestObject) (GetSensorsResponseObject, error)
+	// Get data by wheel ID
+	// (GET /temperaturedata)
+	GetTemperaturedata(ctx context.Context, request GetTemperaturedataRequestObject) (GetTemperaturedataResponseObject, error)
+	// Get two-factor authentication status
+	// (GET /totp)
+	GetTotp(ctx context.Context, request GetTotpRequestObject) (GetTotpResponseObject, error)
+	// Confirm TOTP enrollment and enable two-factor authentication
+	// (POST /totp/confirm)
+	PostTotpConfirm(ctx context.Context, request PostTotpConfirmRequestObject) (PostTotpConfirmResponseObject, error)
+	// Disable two-factor authentication
+	// (POST /totp/disable)
+	PostTotpDisable(ctx context.Context, request PostTotpDisableRequestObject) (PostTotpDisableResponseObject, error)
+	// Start TOTP enrollment
+	// (POST /totp/enroll)
+	PostTotpEnroll(ctx context.Context, request PostTotpEnrollRequestObject) (PostTotpEnrollResponseObject, error)
+	// Set the company two-factor authentication policy
+	// (PUT /totp/policy)
+	PutTotpPolicy(ctx context.Context, request PutTotpPolicyRequestObject) (PutTotpPolicyResponseObject, error)
+	// Regenerate recovery codes
+	// (POST /totp/recoverycodes)
+	PostTotpRecoverycodes(ctx context.Context, request PostTotpRecoverycodesRequestObject) (PostTotpRecoverycodesResponseObject, error)
+	// Get user details
+	// (GET /user)
+	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
+	// User registration
+	// (POST /user)
+	PostUser(ctx context.Context, request PostUserRequestObject) (PostUserResponseObject, error)
+	// Update user password
+	// (PUT /user)
+	PutUser(ctx context.Context, request PutUserRequestObject) (PutUserResponseObject, error)
+	// Update user details
+	// (PUT /userinfo)
+	PutUserinfo(ctx context.Context, request PutUserinfoRequestObject) (PutUserinfoResponseObject, error)
+	// Remove a webhook endpoint with its deliveries
+	// (DELETE /webhook)
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Register a webhook endpoint
+	// (POST /webhook)
+	PostWebhook(ctx context.Context, request PostWebhookRequestObject) (PostWebhookResponseObject, error)
+	// Replace a webhook endpoint
+	// (PUT /webhook)
+	PutWebhook(ctx context.Context, request PutWebhookRequestObject) (PutWebhookResponseObject, error)
+	// Attempts of a webhook delivery
+	// (GET /webhook/delivery/attempts)
+	GetWebhookDeliveryAttempts(ctx context.Context, request GetWebhookDeliveryAttemptsRequestObject) (GetWebhookDeliveryAttemptsResponseObject, error)
+	// Get the webhook delivery log of the company
+	// (GET /webhook/delivery/list)
+	GetWebhookDeliveryList(ctx context.Context, request GetWebhookDeliveryListRequestObject) (GetWebhookDeliveryListResponseObject, error)
+	// Deliver a webhook delivery again
+	// (PUT /webhook/delivery/replay)
+	PutWebhookDeliveryReplay(ctx context.Context, request PutWebhookDeliveryReplayRequestObject) (PutWebhookDeliveryReplayResponseObject, error)
+	// The webhook endpoints of the company
+	// (GET /webhook/list)
+	GetWebhookList(ctx context.Context, request GetWebhookListRequestObject) (GetWebhookListResponseObject, error)
+	// Get wheel data
+	// (GET /wheels)
+	GetWheels(ctx context.Context, request GetWheelsRequestObject) (GetWheelsResponseObject, error)
+	// Register a wheel
+	// (POST /wheels)
+	PostWheels(ctx context.Context, request PostWheelsRequestObject) (PostWheelsResponseObject, error)
+	// Update wheel data
+	// (PUT /wheels)
+	PutWheels(ctx context.Context, request PutWheelsRequestObject) (PutWheelsResponseObject, error)
+	// Get wheels by state number
+	// (GET /wheels/{state_number})
+	GetWheelsStateNumber(ctx context.Context, request GetWheelsStateNumberRequestObject) (GetWheelsStateNumberResponseObject, error)
+	// Get a work order
+	// (GET /work-order)
+	GetWorkOrder(ctx context.Context, request GetWorkOrderRequestObject) (GetWorkOrderResponseObject, error)
+	// Open a work order from a breakage or a maintenance task
+	// (POST /work-order)
+	PostWorkOrder(ctx context.Context, request PostWorkOrderRequestObject) (PostWorkOrderResponseObject, error)
+	// Cancel an open work order
+	// (PUT /work-order/cancel)
+	PutWorkOrderCancel(ctx context.Context, request PutWorkOrderCancelRequestObject) (PutWorkOrderCancelResponseObject, error)
+	// Complete an open work order
+	// (PUT /work-order/complete)
+	PutWorkOrderComplete(ctx context.Context, request PutWorkOrderCompleteRequestObject) (PutWorkOrderCompleteResponseObject, error)
+	// Get a list of work orders
+	// (GET /work-order/list)
+	GetWorkOrderList(ctx context.Context, request GetWorkOrderListRequestObject) (GetWorkOrderListResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAuditExport operation middleware
+func (sh *strictHandler) GetAuditExport(w http.ResponseWriter, r *http.Request, params GetAuditExportParams) {
+	var request GetAuditExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditExport(ctx, request.(GetAuditExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditExportResponseObject); ok {
+		if err := validResponse.VisitGetAuditExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuditList operation middleware
+func (sh *strictHandler) GetAuditList(w http.ResponseWriter, r *http.Request, params GetAuditListParams) {
+	var request GetAuditListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditList(ctx, request.(GetAuditListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditListResponseObject); ok {
+		if err := validResponse.VisitGetAuditListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuto operation middleware
+func (sh *strictHandler) GetAuto(w http.ResponseWriter, r *http.Request, params GetAutoParams) {
+	var request GetAutoRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuto(ctx, request.(GetAutoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuto")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAutoResponseObject); ok {
+		if err := validResponse.VisitGetAutoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuto operation middleware
+func (sh *strictHandler) PostAuto(w http.ResponseWriter, r *http.Request) {
+	var request PostAutoRequestObject
+
+	var body PostAutoJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuto(ctx, request.(PostAutoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuto")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAutoResponseObject); ok {
+		if err := validResponse.VisitPostAutoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAutoInfo operation middleware
+func (sh *strictHandler) GetAutoInfo(w http.ResponseWriter, r *http.Request, params GetAutoInfoParams) {
+	var request GetAutoInfoRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAutoInfo(ctx, request.(GetAutoInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAutoInfo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAutoInfoResponseObject); ok {
+		if err := validResponse.VisitGetAutoInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAutoList operation middleware
+func (sh *strictHandler) GetAutoList(w http.ResponseWriter, r *http.Request, params GetAutoListParams) {
+	var request GetAutoListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAutoList(ctx, request.(GetAutoListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAutoList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAutoListResponseObject); ok {
+		if err := validResponse.VisitGetAutoListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBreakage operation middleware
+func (sh *strictHandler) GetBreakage(w http.ResponseWriter, r *http.Request, params GetBreakageParams) {
+	var request GetBreakageRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBreakage(ctx, request.(GetBreakageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBreakage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBreakageResponseObject); ok {
+		if err := validResponse.VisitGetBreakageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostBreakage operation middleware
+func (sh *strictHandler) PostBreakage(w http.ResponseWriter, r *http.Request) {
+	var request PostBreakageRequestObject
+
+	var body PostBreakageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBreakage(ctx, request.(PostBreakageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBreakage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostBreakageResponseObject); ok {
+		if err := validResponse.VisitPostBreakageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutBreakage operation middleware
+func (sh *strictHandler) PutBreakage(w http.ResponseWriter, r *http.Request) {
+	var request PutBreakageRequestObject
+
+	var body PutBreakageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBreakage(ctx, request.(PutBreakageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBreakage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutBreakageResponseObject); ok {
+		if err := validResponse.VisitPutBreakageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBreakageHistory operation middleware
+func (sh *strictHandler) GetBreakageHistory(w http.ResponseWriter, r *http.Request, params GetBreakageHistoryParams) {
+	var request GetBreakageHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBreakageHistory(ctx, request.(GetBreakageHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBreakageHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBreakageHistoryResponseObject); ok {
+		if err := validResponse.VisitGetBreakageHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBreakageList operation middleware
+func (sh *strictHandler) GetBreakageList(w http.ResponseWriter, r *http.Request, params GetBreakageListParams) {
+	var request GetBreakageListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBreakageList(ctx, request.(GetBreakageListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBreakageList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBreakageListResponseObject); ok {
+		if err := validResponse.VisitGetBreakageListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBreakageStats operation middleware
+func (sh *strictHandler) GetBreakageStats(w http.ResponseWriter, r *http.Request, params GetBreakageStatsParams) {
+	var request GetBreakageStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBreakageStats(ctx, request.(GetBreakageStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBreakageStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBreakageStatsResponseObject); ok {
+		if err := validResponse.VisitGetBreakageStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutBreakageStatus operation middleware
+func (sh *strictHandler) PutBreakageStatus(w http.ResponseWriter, r *http.Request) {
+	var request PutBreakageStatusRequestObject
+
+	var body PutBreakageStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBreakageStatus(ctx, request.(PutBreakageStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBreakageStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutBreakageStatusResponseObject); ok {
+		if err := validResponse.VisitPutBreakageStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteBreakageType operation middleware
+func (sh *strictHandler) DeleteBreakageType(w http.ResponseWriter, r *http.Request, params DeleteBreakageTypeParams) {
+	var request DeleteBreakageTypeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBreakageType(ctx, request.(DeleteBreakageTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBreakageType")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteBreakageTypeResponseObject); ok {
+		if err := validResponse.VisitDeleteBreakageTypeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostBreakageType operation middleware
+func (sh *strictHandler) PostBreakageType(w http.ResponseWriter, r *http.Request) {
+	var request PostBreakageTypeRequestObject
+
+	var body PostBreakageTypeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBreakageType(ctx, request.(PostBreakageTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBreakageType")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostBreakageTypeResponseObject); ok {
+		if err := validResponse.VisitPostBreakageTypeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutBreakageType operation middleware
+func (sh *strictHandler) PutBreakageType(w http.ResponseWriter, r *http.Request) {
+	var request PutBreakageTypeRequestObject
+
+	var body PutBreakageTypeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutBreakageType(ctx, request.(PutBreakageTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutBreakageType")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutBreakageTypeResponseObject); ok {
+		if err := validResponse.VisitPutBreakageTypeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBreakageTypeList operation middleware
+func (sh *strictHandler) GetBreakageTypeList(w http.ResponseWriter, r *http.Request) {
+	var request GetBreakageTypeListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBreakageTypeList(ctx, request.(GetBreakageTypeListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBreakageTypeList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBreakageTypeListResponseObject); ok {
+		if err := validResponse.VisitGetBreakageTypeListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDriver operation middleware
+func (sh *strictHandler) PostDriver(w http.ResponseWriter, r *http.Request) {
+	var request PostDriverRequestObject
+
+	var body PostDriverJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDriver(ctx, request.(PostDriverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDriver")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDriverResponseObject); ok {
+		if err := validResponse.VisitPostDriverResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutDriver operation middleware
+func (sh *strictHandler) PutDriver(w http.ResponseWriter, r *http.Request) {
+	var request PutDriverRequestObject
+
+	var body PutDriverJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutDriver(ctx, request.(PutDriverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutDriver")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutDriverResponseObject); ok {
+		if err := validResponse.VisitPutDriverResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDriverAssignment operation middleware
+func (sh *strictHandler) PostDriverAssignment(w http.ResponseWriter, r *http.Request) {
+	var request PostDriverAssignmentRequestObject
+
+	var body PostDriverAssignmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDriverAssignment(ctx, request.(PostDriverAssignmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDriverAssignment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDriverAssignmentResponseObject); ok {
+		if err := validResponse.VisitPostDriverAssignmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutDriverAssignmentEnd operation middleware
+func (sh *strictHandler) PutDriverAssignmentEnd(w http.ResponseWriter, r *http.Request) {
+	var request PutDriverAssignmentEndRequestObject
+
+	var body PutDriverAssignmentEndJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutDriverAssignmentEnd(ctx, request.(PutDriverAssignmentEndRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutDriverAssignmentEnd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutDriverAssignmentEndResponseObject); ok {
+		if err := validResponse.VisitPutDriverAssignmentEndResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverAssignmentList operation middleware
+func (sh *strictHandler) GetDriverAssignmentList(w http.ResponseWriter, r *http.Request, params GetDriverAssignmentListParams) {
+	var request GetDriverAssignmentListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverAssignmentList(ctx, request.(GetDriverAssignmentListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverAssignmentList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverAssignmentListResponseObject); ok {
+		if err := validResponse.VisitGetDriverAssignmentListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutDriverDeactivate operation middleware
+func (sh *strictHandler) PutDriverDeactivate(w http.ResponseWriter, r *http.Request) {
+	var request PutDriverDeactivateRequestObject
+
+	var body PutDriverDeactivateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutDriverDeactivate(ctx, request.(PutDriverDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutDriverDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutDriverDeactivateResponseObject); ok {
+		if err := validResponse.VisitPutDriverDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteDriverDocument operation middleware
+func (sh *strictHandler) DeleteDriverDocument(w http.ResponseWriter, r *http.Request, params DeleteDriverDocumentParams) {
+	var request DeleteDriverDocumentRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDriverDocument(ctx, request.(DeleteDriverDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDriverDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDriverDocumentResponseObject); ok {
+		if err := validResponse.VisitDeleteDriverDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverDocument operation middleware
+func (sh *strictHandler) GetDriverDocument(w http.ResponseWriter, r *http.Request, params GetDriverDocumentParams) {
+	var request GetDriverDocumentRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverDocument(ctx, request.(GetDriverDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverDocumentResponseObject); ok {
+		if err := validResponse.VisitGetDriverDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDriverDocument operation middleware
+func (sh *strictHandler) PostDriverDocument(w http.ResponseWriter, r *http.Request, params PostDriverDocumentParams) {
+	var request PostDriverDocumentRequestObject
+
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDriverDocument(ctx, request.(PostDriverDocumentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDriverDocument")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDriverDocumentResponseObject); ok {
+		if err := validResponse.VisitPostDriverDocumentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverDocumentList operation middleware
+func (sh *strictHandler) GetDriverDocumentList(w http.ResponseWriter, r *http.Request, params GetDriverDocumentListParams) {
+	var request GetDriverDocumentListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverDocumentList(ctx, request.(GetDriverDocumentListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverDocumentList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverDocumentListResponseObject); ok {
+		if err := validResponse.VisitGetDriverDocumentListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverInfo operation middleware
+func (sh *strictHandler) GetDriverInfo(w http.ResponseWriter, r *http.Request, params GetDriverInfoParams) {
+	var request GetDriverInfoRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverInfo(ctx, request.(GetDriverInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverInfo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverInfoResponseObject); ok {
+		if err := validResponse.VisitGetDriverInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverList operation middleware
+func (sh *strictHandler) GetDriverList(w http.ResponseWriter, r *http.Request, params GetDriverListParams) {
+	var request GetDriverListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverList(ctx, request.(GetDriverListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverListResponseObject); ok {
+		if err := validResponse.VisitGetDriverListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverTimesheet operation middleware
+func (sh *strictHandler) GetDriverTimesheet(w http.ResponseWriter, r *http.Request, params GetDriverTimesheetParams) {
+	var request GetDriverTimesheetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverTimesheet(ctx, request.(GetDriverTimesheetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverTimesheet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverTimesheetResponseObject); ok {
+		if err := validResponse.VisitGetDriverTimesheetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDriverWorkSession operation middleware
+func (sh *strictHandler) PostDriverWorkSession(w http.ResponseWriter, r *http.Request) {
+	var request PostDriverWorkSessionRequestObject
+
+	var body PostDriverWorkSessionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDriverWorkSession(ctx, request.(PostDriverWorkSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDriverWorkSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDriverWorkSessionResponseObject); ok {
+		if err := validResponse.VisitPostDriverWorkSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutDriverWorkSessionEnd operation middleware
+func (sh *strictHandler) PutDriverWorkSessionEnd(w http.ResponseWriter, r *http.Request) {
+	var request PutDriverWorkSessionEndRequestObject
+
+	var body PutDriverWorkSessionEndJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutDriverWorkSessionEnd(ctx, request.(PutDriverWorkSessionEndRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutDriverWorkSessionEnd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutDriverWorkSessionEndResponseObject); ok {
+		if err := validResponse.VisitPutDriverWorkSessionEndResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverWorkSessionList operation middleware
+func (sh *strictHandler) GetDriverWorkSessionList(w http.ResponseWriter, r *http.Request, params GetDriverWorkSessionListParams) {
+	var request GetDriverWorkSessionListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverWorkSessionList(ctx, request.(GetDriverWorkSessionListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverWorkSessionList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverWorkSessionListResponseObject); ok {
+		if err := validResponse.VisitGetDriverWorkSessionListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverWorkTime operation middleware
+func (sh *strictHandler) GetDriverWorkTime(w http.ResponseWriter, r *http.Request, params GetDriverWorkTimeParams) {
+	var request GetDriverWorkTimeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverWorkTime(ctx, request.(GetDriverWorkTimeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverWorkTime")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverWorkTimeResponseObject); ok {
+		if err := validResponse.VisitGetDriverWorkTimeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDriverWorkViolationList operation middleware
+func (sh *strictHandler) GetDriverWorkViolationList(w http.ResponseWriter, r *http.Request, params GetDriverWorkViolationListParams) {
+	var request GetDriverWorkViolationListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDriverWorkViolationList(ctx, request.(GetDriverWorkViolationListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDriverWorkViolationList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDriverWorkViolationListResponseObject); ok {
+		if err := validResponse.VisitGetDriverWorkViolationListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutDriverWorktime operation middleware
+func (sh *strictHandler) PutDriverWorktime(w http.ResponseWriter, r *http.Request) {
+	var request PutDriverWorktimeRequestObject
+
+	var body PutDriverWorktimeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutDriverWorktime(ctx, request.(PutDriverWorktimeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutDriverWorktime")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutDriverWorktimeResponseObject); ok {
+		if err := validResponse.VisitPutDriverWorktimeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostImportKind operation middleware
+func (sh *strictHandler) PostImportKind(w http.ResponseWriter, r *http.Request, kind string, params PostImportKindParams) {
+	var request PostImportKindRequestObject
+
+	request.Kind = kind
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImportKind(ctx, request.(PostImportKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImportKind")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostImportKindResponseObject); ok {
+		if err := validResponse.VisitPostImportKindResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetImportKindTemplate operation middleware
+func (sh *strictHandler) GetImportKindTemplate(w http.ResponseWriter, r *http.Request, kind string, params GetImportKindTemplateParams) {
+	var request GetImportKindTemplateRequestObject
+
+	request.Kind = kind
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImportKindTemplate(ctx, request.(GetImportKindTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImportKindTemplate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetImportKindTemplateResponseObject); ok {
+		if err := validResponse.VisitGetImportKindTemplateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLoginTotp operation middleware
+func (sh *strictHandler) PostLoginTotp(w http.ResponseWriter, r *http.Request) {
+	var request PostLoginTotpRequestObject
+
+	var body PostLoginTotpJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLoginTotp(ctx, request.(PostLoginTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLoginTotp")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLoginTotpResponseObject); ok {
+		if err := validResponse.VisitPostLoginTotpResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLoginTotpEnroll operation middleware
+func (sh *strictHandler) PostLoginTotpEnroll(w http.ResponseWriter, r *http.Request) {
+	var request PostLoginTotpEnrollRequestObject
+
+	var body PostLoginTotpEnrollJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLoginTotpEnroll(ctx, request.(PostLoginTotpEnrollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLoginTotpEnroll")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLoginTotpEnrollResponseObject); ok {
+		if err := validResponse.VisitPostLoginTotpEnrollResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostMaintenancePlan operation middleware
+func (sh *strictHandler) PostMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	var request PostMaintenancePlanRequestObject
+
+	var body PostMaintenancePlanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMaintenancePlan(ctx, request.(PostMaintenancePlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMaintenancePlan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostMaintenancePlanResponseObject); ok {
+		if err := validResponse.VisitPostMaintenancePlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutMaintenancePlan operation middleware
+func (sh *strictHandler) PutMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	var request PutMaintenancePlanRequestObject
+
+	var body PutMaintenancePlanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMaintenancePlan(ctx, request.(PutMaintenancePlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMaintenancePlan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutMaintenancePlanResponseObject); ok {
+		if err := validResponse.VisitPutMaintenancePlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMaintenancePlanList operation middleware
+func (sh *strictHandler) GetMaintenancePlanList(w http.ResponseWriter, r *http.Request) {
+	var request GetMaintenancePlanListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMaintenancePlanList(ctx, request.(GetMaintenancePlanListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMaintenancePlanList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMaintenancePlanListResponseObject); ok {
+		if err := validResponse.VisitGetMaintenancePlanListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMaintenanceUpcoming operation middleware
+func (sh *strictHandler) GetMaintenanceUpcoming(w http.ResponseWriter, r *http.Request, params GetMaintenanceUpcomingParams) {
+	var request GetMaintenanceUpcomingRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMaintenanceUpcoming(ctx, request.(GetMaintenanceUpcomingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMaintenanceUpcoming")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMaintenanceUpcomingResponseObject); ok {
+		if err := validResponse.VisitGetMaintenanceUpcomingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutMileage operation middleware
+func (sh *strictHandler) PutMileage(w http.ResponseWriter, r *http.Request) {
+	var request PutMileageRequestObject
+
+	var body PutMileageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMileage(ctx, request.(PutMileageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMileage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutMileageResponseObject); ok {
+		if err := validResponse.VisitPutMileageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutNotificationAllstatus operation middleware
+func (sh *strictHandler) PutNotificationAllstatus(w http.ResponseWriter, r *http.Request) {
+	var request PutNotificationAllstatusRequestObject
+
+	var body PutNotificationAllstatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutNotificationAllstatus(ctx, request.(PutNotificationAllstatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutNotificationAllstatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutNotificationAllstatusResponseObject); ok {
+		if err := validResponse.VisitPutNotificationAllstatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNotificationDeliveries operation middleware
+func (sh *strictHandler) GetNotificationDeliveries(w http.ResponseWriter, r *http.Request, params GetNotificationDeliveriesParams) {
+	var request GetNotificationDeliveriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationDeliveries(ctx, request.(GetNotificationDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNotificationDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetNotificationDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNotificationInfo operation middleware
+func (sh *strictHandler) GetNotificationInfo(w http.ResponseWriter, r *http.Request, params GetNotificationInfoParams) {
+	var request GetNotificationInfoRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationInfo(ctx, request.(GetNotificationInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationInfo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNotificationInfoResponseObject); ok {
+		if err := validResponse.VisitGetNotificationInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNotificationList operation middleware
+func (sh *strictHandler) GetNotificationList(w http.ResponseWriter, r *http.Request, params GetNotificationListParams) {
+	var request GetNotificationListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationList(ctx, request.(GetNotificationListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNotificationListResponseObject); ok {
+		if err := validResponse.VisitGetNotificationListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteNotificationRule operation middleware
+func (sh *strictHandler) DeleteNotificationRule(w http.ResponseWriter, r *http.Request, params DeleteNotificationRuleParams) {
+	var request DeleteNotificationRuleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNotificationRule(ctx, request.(DeleteNotificationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNotificationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteNotificationRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteNotificationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostNotificationRule operation middleware
+func (sh *strictHandler) PostNotificationRule(w http.ResponseWriter, r *http.Request) {
+	var request PostNotificationRuleRequestObject
+
+	var body PostNotificationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNotificationRule(ctx, request.(PostNotificationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNotificationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostNotificationRuleResponseObject); ok {
+		if err := validResponse.VisitPostNotificationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutNotificationRule operation middleware
+func (sh *strictHandler) PutNotificationRule(w http.ResponseWriter, r *http.Request) {
+	var request PutNotificationRuleRequestObject
+
+	var body PutNotificationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutNotificationRule(ctx, request.(PutNotificationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutNotificationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutNotificationRuleResponseObject); ok {
+		if err := validResponse.VisitPutNotificationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNotificationRuleList operation middleware
+func (sh *strictHandler) GetNotificationRuleList(w http.ResponseWriter, r *http.Request) {
+	var request GetNotificationRuleListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationRuleList(ctx, request.(GetNotificationRuleListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationRuleList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNotificationRuleListResponseObject); ok {
+		if err := validResponse.VisitGetNotificationRuleListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutNotificationStatus operation middleware
+func (sh *strictHandler) PutNotificationStatus(w http.ResponseWriter, r *http.Request) {
+	var request PutNotificationStatusRequestObject
+
+	var body PutNotificationStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutNotificationStatus(ctx, request.(PutNotificationStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutNotificationStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutNotificationStatusResponseObject); ok {
+		if err := validResponse.VisitPutNotificationStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPortabilityExport operation middleware
+func (sh *strictHandler) GetPortabilityExport(w http.ResponseWriter, r *http.Request, params GetPortabilityExportParams) {
+	var request GetPortabilityExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPortabilityExport(ctx, request.(GetPortabilityExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPortabilityExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPortabilityExportResponseObject); ok {
+		if err := validResponse.VisitGetPortabilityExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPortabilityImport operation middleware
+func (sh *strictHandler) PostPortabilityImport(w http.ResponseWriter, r *http.Request) {
+	var request PostPortabilityImportRequestObject
 
-type PostWheelsResponseObject interface {
-	VisitPostWheelsResponse(w http.ResponseWriter) error
-}
+	request.Body = r.Body
 
-type PostWheels201JSONResponse WheelResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPortabilityImport(ctx, request.(PostPortabilityImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPortabilityImport")
+	}
 
-func (response PostWheels201JSONResponse) VisitPostWheelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPortabilityImportResponseObject); ok {
+		if err := validResponse.VisitPostPortabilityImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutWheelsRequestObject struct {
-	Body *PutWheelsJSONRequestBody
-}
+// PostPosition operation middleware
+func (sh *strictHandler) PostPosition(w http.ResponseWriter, r *http.Request) {
+	var request PostPositionRequestObject
 
-type PutWheelsResponseObject interface {
-	VisitPutWheelsResponse(w http.ResponseWriter) error
-}
+	var body PostPositionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PutWheels200JSONResponse WheelResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPosition(ctx, request.(PostPositionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPosition")
+	}
 
-func (response PutWheels200JSONResponse) VisitPutWheelsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPositionResponseObject); ok {
+		if err := validResponse.VisitPostPositionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWheelsStateNumberRequestObject struct {
-	StateNumber string `json:"state_number"`
-}
+// GetPositionCarroute operation middleware
+func (sh *strictHandler) GetPositionCarroute(w http.ResponseWriter, r *http.Request, params GetPositionCarrouteParams) {
+	var request GetPositionCarrouteRequestObject
 
-type GetWheelsStateNumberResponseObject interface {
-	VisitGetWheelsStateNumberResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetWheelsStateNumber200JSONResponse []WheelsDataForDevice
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPositionCarroute(ctx, request.(GetPositionCarrouteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPositionCarroute")
+	}
 
-func (response GetWheelsStateNumber200JSONResponse) VisitGetWheelsStateNumberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPositionCarrouteResponseObject); ok {
+		if err := validResponse.VisitGetPositionCarrouteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get a single Auto by ID
-	// (GET /auto)
-	GetAuto(ctx context.Context, request GetAutoRequestObject) (GetAutoResponseObject, error)
-	// Register a Auto
-	// (POST /auto)
-	PostAuto(ctx context.Context, request PostAutoRequestObject) (PostAutoResponseObject, error)
-	// Get Auto and its wheels by car ID
-	// (GET /auto/info)
-	GetAutoInfo(ctx context.Context, request GetAutoInfoRequestObject) (GetAutoInfoResponseObject, error)
-	// Get list of Autos
-	// (GET /auto/list)
-	GetAutoList(ctx context.Context, request GetAutoListRequestObject) (GetAutoListResponseObject, error)
-	// Add a new breakage from MQTT data
-	// (POST /breakage)
-	PostBreakage(ctx context.Context, request PostBreakageRequestObject) (PostBreakageResponseObject, error)
-	// Get a list of breakages for a specific car
-	// (GET /breakage/list)
-	GetBreakageList(ctx context.Context, request GetBreakageListRequestObject) (GetBreakageListResponseObject, error)
-	// Add a driver
-	// (POST /driver)
-	PostDriver(ctx context.Context, request PostDriverRequestObject) (PostDriverResponseObject, error)
-	// Driver information
-	// (GET /driver/info)
-	GetDriverInfo(ctx context.Context, request GetDriverInfoRequestObject) (GetDriverInfoResponseObject, error)
-	// Drivers info
-	// (GET /driver/list)
-	GetDriverList(ctx context.Context, request GetDriverListRequestObject) (GetDriverListResponseObject, error)
-	// Update the driver's worked hours
-	// (PUT /driver/worktime)
-	PutDriverWorktime(ctx context.Context, request PutDriverWorktimeRequestObject) (PutDriverWorktimeResponseObject, error)
-	// User login
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Update car mileage
-	// (PUT /mileage)
-	PutMileage(ctx context.Context, request PutMileageRequestObject) (PutMileageResponseObject, error)
-	// Change the status of all notifications for a specific user
-	// (PUT /notification/allstatus)
-	PutNotificationAllstatus(ctx context.Context, request PutNotificationAllstatusRequestObject) (PutNotificationAllstatusResponseObject, error)
-	// Get detailed information about a specific notification
-	// (GET /notification/info)
-	GetNotificationInfo(ctx context.Context, request GetNotificationInfoRequestObject) (GetNotificationInfoResponseObject, error)
-	// Get list of notifications based on status
-	// (GET /notification/list)
-	GetNotificationList(ctx context.Context, request GetNotificationListRequestObject) (GetNotificationListResponseObject, error)
-	// Change the status of a specific notification
-	// (PUT /notification/status)
-	PutNotificationStatus(ctx context.Context, request PutNotificationStatusRequestObject) (PutNotificationStatusResponseObject, error)
-	// Add car position from MQTT
-	// (POST /position)
-	PostPosition(ctx context.Context, request PostPositionRequestObject) (PostPositionResponseObject, error)
-	// Get the route of a car
-	// (GET /position/carroute)
-	GetPositionCarroute(ctx context.Context, request GetPositionCarrouteRequestObject) (GetPositionCarrouteResponseObject, error)
-	// Get current car positions
-	// (GET /position/listcurrent)
-	GetPositionListcurrent(ctx context.Context, request GetPositionListcurrentRequestObject) (GetPositionListcurrentResponseObject, error)
-	// Get list of cars
-	// (GET /positions/listcars)
-	GetPositionsListcars(ctx context.Context, request GetPositionsListcarsRequestObject) (GetPositionsListcarsResponseObject, error)
-	// Get data by wheel ID
-	// (GET /pressuredata)
-	GetPressuredata(ctx context.Context, request GetPressuredataRequestObject) (GetPressuredataResponseObject, error)
-	// Refresh access token
-	// (POST /refresh)
-	PostRefresh(ctx context.Context, request PostRefreshRequestObject) (PostRefreshResponseObject, error)
-	// Generate report
-	// (GET /report)
-	GetReport(ctx context.Context, request GetReportRequestObject) (GetReportResponseObject, error)
-	// Update an existing sensor
-	// (POST /sensordata)
-	PostSensordata(ctx context.Context, request PostSensordataRequestObject) (PostSensordataResponseObject, error)
-	// Provides actual data by car ID
-	// (GET /sensors)
-	GetSensors(ctx context.Context, request GetSensorsRequestObject) (GetSensorsResponseObject, error)
-	// Get data by wheel ID
-	// (GET /temperaturedata)
-	GetTemperaturedata(ctx context.Context, request GetTemperaturedataRequestObject) (GetTemperaturedataResponseObject, error)
-	// Get user details
-	// (GET /user)
-	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
-	// User registration
-	// (POST /user)
-	PostUser(ctx context.Context, request PostUserRequestObject) (PostUserResponseObject, error)
-	// Update user password
-	// (PUT /user)
-	PutUser(ctx context.Context, request PutUserRequestObject) (PutUserResponseObject, error)
-	// Update user details
-	// (PUT /userinfo)
-	PutUserinfo(ctx context.Context, request PutUserinfoRequestObject) (PutUserinfoResponseObject, error)
-	// Get wheel data
-	// (GET /wheels)
-	GetWheels(ctx context.Context, request GetWheelsRequestObject) (GetWheelsResponseObject, error)
-	// Register a wheel
-	// (POST /wheels)
-	PostWheels(ctx context.Context, request PostWheelsRequestObject) (PostWheelsResponseObject, error)
-	// Update wheel data
-	// (PUT /wheels)
-	PutWheels(ctx context.Context, request PutWheelsRequestObject) (PutWheelsResponseObject, error)
-	// Get wheels by state number
-	// (GET /wheels/{state_number})
-	GetWheelsStateNumber(ctx context.Context, request GetWheelsStateNumberRequestObject) (GetWheelsStateNumberResponseObject, error)
-}
+// GetPositionDrivertrips operation middleware
+func (sh *strictHandler) GetPositionDrivertrips(w http.ResponseWriter, r *http.Request, params GetPositionDrivertripsParams) {
+	var request GetPositionDrivertripsRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPositionDrivertrips(ctx, request.(GetPositionDrivertripsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPositionDrivertrips")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPositionDrivertripsResponseObject); ok {
+		if err := validResponse.VisitGetPositionDrivertripsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// DeletePositionGeofence operation middleware
+func (sh *strictHandler) DeletePositionGeofence(w http.ResponseWriter, r *http.Request, params DeletePositionGeofenceParams) {
+	var request DeletePositionGeofenceRequestObject
 
-// GetAuto operation middleware
-func (sh *strictHandler) GetAuto(w http.ResponseWriter, r *http.Request, params GetAutoParams) {
-	var request GetAutoRequestObject
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePositionGeofence(ctx, request.(DeletePositionGeofenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePositionGeofence")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePositionGeofenceResponseObject); ok {
+		if err := validResponse.VisitDeletePositionGeofenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	request.Params = params
+// PostPositionGeofence operation middleware
+func (sh *strictHandler) PostPositionGeofence(w http.ResponseWriter, r *http.Request) {
+	var request PostPositionGeofenceRequestObject
+
+	var body PostPositionGeofenceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuto(ctx, request.(GetAutoRequestObject))
+		return sh.ssi.PostPositionGeofence(ctx, request.(PostPositionGeofenceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuto")
+		handler = middleware(handler, "PostPositionGeofence")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAutoResponseObject); ok {
-		if err := validResponse.VisitGetAutoResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostPositionGeofenceResponseObject); ok {
+		if err := validResponse.VisitPostPositionGeofenceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2952,11 +11394,11 @@ func (sh *strictHandler) GetAuto(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// PostAuto operation middleware
-func (sh *strictHandler) PostAuto(w http.ResponseWriter, r *http.Request) {
-	var request PostAutoRequestObject
+// PutPositionGeofence operation middleware
+func (sh *strictHandler) PutPositionGeofence(w http.ResponseWriter, r *http.Request) {
+	var request PutPositionGeofenceRequestObject
 
-	var body PostAutoJSONRequestBody
+	var body PutPositionGeofenceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2964,18 +11406,18 @@ func (sh *strictHandler) PostAuto(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuto(ctx, request.(PostAutoRequestObject))
+		return sh.ssi.PutPositionGeofence(ctx, request.(PutPositionGeofenceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuto")
+		handler = middleware(handler, "PutPositionGeofence")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostAutoResponseObject); ok {
-		if err := validResponse.VisitPostAutoResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutPositionGeofenceResponseObject); ok {
+		if err := validResponse.VisitPutPositionGeofenceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2983,25 +11425,73 @@ func (sh *strictHandler) PostAuto(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetAutoInfo operation middleware
-func (sh *strictHandler) GetAutoInfo(w http.ResponseWriter, r *http.Request, params GetAutoInfoParams) {
-	var request GetAutoInfoRequestObject
+// GetPositionGeofenceList operation middleware
+func (sh *strictHandler) GetPositionGeofenceList(w http.ResponseWriter, r *http.Request) {
+	var request GetPositionGeofenceListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPositionGeofenceList(ctx, request.(GetPositionGeofenceListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPositionGeofenceList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPositionGeofenceListResponseObject); ok {
+		if err := validResponse.VisitGetPositionGeofenceListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPositionListcurrent operation middleware
+func (sh *strictHandler) GetPositionListcurrent(w http.ResponseWriter, r *http.Request) {
+	var request GetPositionListcurrentRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPositionListcurrent(ctx, request.(GetPositionListcurrentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPositionListcurrent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPositionListcurrentResponseObject); ok {
+		if err := validResponse.VisitGetPositionListcurrentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPositionsListcars operation middleware
+func (sh *strictHandler) GetPositionsListcars(w http.ResponseWriter, r *http.Request, params GetPositionsListcarsParams) {
+	var request GetPositionsListcarsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAutoInfo(ctx, request.(GetAutoInfoRequestObject))
+		return sh.ssi.GetPositionsListcars(ctx, request.(GetPositionsListcarsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAutoInfo")
+		handler = middleware(handler, "GetPositionsListcars")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAutoInfoResponseObject); ok {
-		if err := validResponse.VisitGetAutoInfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPositionsListcarsResponseObject); ok {
+		if err := validResponse.VisitGetPositionsListcarsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3009,25 +11499,25 @@ func (sh *strictHandler) GetAutoInfo(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// GetAutoList operation middleware
-func (sh *strictHandler) GetAutoList(w http.ResponseWriter, r *http.Request, params GetAutoListParams) {
-	var request GetAutoListRequestObject
+// GetPressuredata operation middleware
+func (sh *strictHandler) GetPressuredata(w http.ResponseWriter, r *http.Request, params GetPressuredataParams) {
+	var request GetPressuredataRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAutoList(ctx, request.(GetAutoListRequestObject))
+		return sh.ssi.GetPressuredata(ctx, request.(GetPressuredataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAutoList")
+		handler = middleware(handler, "GetPressuredata")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAutoListResponseObject); ok {
-		if err := validResponse.VisitGetAutoListResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPressuredataResponseObject); ok {
+		if err := validResponse.VisitGetPressuredataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3035,30 +11525,47 @@ func (sh *strictHandler) GetAutoList(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// PostBreakage operation middleware
-func (sh *strictHandler) PostBreakage(w http.ResponseWriter, r *http.Request) {
-	var request PostBreakageRequestObject
+// PostRefresh operation middleware
+func (sh *strictHandler) PostRefresh(w http.ResponseWriter, r *http.Request) {
+	var request PostRefreshRequestObject
 
-	var body PostBreakageJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRefresh(ctx, request.(PostRefreshRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRefresh")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostRefreshResponseObject); ok {
+		if err := validResponse.VisitPostRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReport operation middleware
+func (sh *strictHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	var request GetReportRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostBreakage(ctx, request.(PostBreakageRequestObject))
+		return sh.ssi.GetReport(ctx, request.(GetReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostBreakage")
+		handler = middleware(handler, "GetReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostBreakageResponseObject); ok {
-		if err := validResponse.VisitPostBreakageResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReportResponseObject); ok {
+		if err := validResponse.VisitGetReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3066,25 +11573,25 @@ func (sh *strictHandler) PostBreakage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetBreakageList operation middleware
-func (sh *strictHandler) GetBreakageList(w http.ResponseWriter, r *http.Request, params GetBreakageListParams) {
-	var request GetBreakageListRequestObject
+// GetSearch operation middleware
+func (sh *strictHandler) GetSearch(w http.ResponseWriter, r *http.Request, params GetSearchParams) {
+	var request GetSearchRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBreakageList(ctx, request.(GetBreakageListRequestObject))
+		return sh.ssi.GetSearch(ctx, request.(GetSearchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBreakageList")
+		handler = middleware(handler, "GetSearch")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetBreakageListResponseObject); ok {
-		if err := validResponse.VisitGetBreakageListResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSearchResponseObject); ok {
+		if err := validResponse.VisitGetSearchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3092,11 +11599,11 @@ func (sh *strictHandler) GetBreakageList(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// PostDriver operation middleware
-func (sh *strictHandler) PostDriver(w http.ResponseWriter, r *http.Request) {
-	var request PostDriverRequestObject
+// PostSensordata operation middleware
+func (sh *strictHandler) PostSensordata(w http.ResponseWriter, r *http.Request) {
+	var request PostSensordataRequestObject
 
-	var body PostDriverJSONRequestBody
+	var body PostSensordataJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3104,18 +11611,18 @@ func (sh *strictHandler) PostDriver(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDriver(ctx, request.(PostDriverRequestObject))
+		return sh.ssi.PostSensordata(ctx, request.(PostSensordataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDriver")
+		handler = middleware(handler, "PostSensordata")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostDriverResponseObject); ok {
-		if err := validResponse.VisitPostDriverResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostSensordataResponseObject); ok {
+		if err := validResponse.VisitPostSensordataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3123,25 +11630,25 @@ func (sh *strictHandler) PostDriver(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetDriverInfo operation middleware
-func (sh *strictHandler) GetDriverInfo(w http.ResponseWriter, r *http.Request, params GetDriverInfoParams) {
-	var request GetDriverInfoRequestObject
+// GetSensors operation middleware
+func (sh *strictHandler) GetSensors(w http.ResponseWriter, r *http.Request, params GetSensorsParams) {
+	var request GetSensorsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDriverInfo(ctx, request.(GetDriverInfoRequestObject))
+		return sh.ssi.GetSensors(ctx, request.(GetSensorsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDriverInfo")
+		handler = middleware(handler, "GetSensors")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDriverInfoResponseObject); ok {
-		if err := validResponse.VisitGetDriverInfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSensorsResponseObject); ok {
+		if err := validResponse.VisitGetSensorsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3149,25 +11656,25 @@ func (sh *strictHandler) GetDriverInfo(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// GetDriverList operation middleware
-func (sh *strictHandler) GetDriverList(w http.ResponseWriter, r *http.Request, params GetDriverListParams) {
-	var request GetDriverListRequestObject
+// GetTemperaturedata operation middleware
+func (sh *strictHandler) GetTemperaturedata(w http.ResponseWriter, r *http.Request, params GetTemperaturedataParams) {
+	var request GetTemperaturedataRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDriverList(ctx, request.(GetDriverListRequestObject))
+		return sh.ssi.GetTemperaturedata(ctx, request.(GetTemperaturedataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDriverList")
+		handler = middleware(handler, "GetTemperaturedata")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDriverListResponseObject); ok {
-		if err := validResponse.VisitGetDriverListResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTemperaturedataResponseObject); ok {
+		if err := validResponse.VisitGetTemperaturedataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3175,30 +11682,23 @@ func (sh *strictHandler) GetDriverList(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// PutDriverWorktime operation middleware
-func (sh *strictHandler) PutDriverWorktime(w http.ResponseWriter, r *http.Request) {
-	var request PutDriverWorktimeRequestObject
-
-	var body PutDriverWorktimeJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetTotp operation middleware
+func (sh *strictHandler) GetTotp(w http.ResponseWriter, r *http.Request) {
+	var request GetTotpRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutDriverWorktime(ctx, request.(PutDriverWorktimeRequestObject))
+		return sh.ssi.GetTotp(ctx, request.(GetTotpRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutDriverWorktime")
+		handler = middleware(handler, "GetTotp")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutDriverWorktimeResponseObject); ok {
-		if err := validResponse.VisitPutDriverWorktimeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTotpResponseObject); ok {
+		if err := validResponse.VisitGetTotpResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3206,11 +11706,11 @@ func (sh *strictHandler) PutDriverWorktime(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
-	var request PostLoginRequestObject
+// PostTotpConfirm operation middleware
+func (sh *strictHandler) PostTotpConfirm(w http.ResponseWriter, r *http.Request) {
+	var request PostTotpConfirmRequestObject
 
-	var body PostLoginJSONRequestBody
+	var body PostTotpConfirmJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3218,18 +11718,18 @@ func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.PostTotpConfirm(ctx, request.(PostTotpConfirmRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostTotpConfirm")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTotpConfirmResponseObject); ok {
+		if err := validResponse.VisitPostTotpConfirmResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3237,11 +11737,11 @@ func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PutMileage operation middleware
-func (sh *strictHandler) PutMileage(w http.ResponseWriter, r *http.Request) {
-	var request PutMileageRequestObject
+// PostTotpDisable operation middleware
+func (sh *strictHandler) PostTotpDisable(w http.ResponseWriter, r *http.Request) {
+	var request PostTotpDisableRequestObject
 
-	var body PutMileageJSONRequestBody
+	var body PostTotpDisableJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3249,18 +11749,18 @@ func (sh *strictHandler) PutMileage(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutMileage(ctx, request.(PutMileageRequestObject))
+		return sh.ssi.PostTotpDisable(ctx, request.(PostTotpDisableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutMileage")
+		handler = middleware(handler, "PostTotpDisable")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutMileageResponseObject); ok {
-		if err := validResponse.VisitPutMileageResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTotpDisableResponseObject); ok {
+		if err := validResponse.VisitPostTotpDisableResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3268,11 +11768,35 @@ func (sh *strictHandler) PutMileage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PutNotificationAllstatus operation middleware
-func (sh *strictHandler) PutNotificationAllstatus(w http.ResponseWriter, r *http.Request) {
-	var request PutNotificationAllstatusRequestObject
+// PostTotpEnroll operation middleware
+func (sh *strictHandler) PostTotpEnroll(w http.ResponseWriter, r *http.Request) {
+	var request PostTotpEnrollRequestObject
 
-	var body PutNotificationAllstatusJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTotpEnroll(ctx, request.(PostTotpEnrollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTotpEnroll")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTotpEnrollResponseObject); ok {
+		if err := validResponse.VisitPostTotpEnrollResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutTotpPolicy operation middleware
+func (sh *strictHandler) PutTotpPolicy(w http.ResponseWriter, r *http.Request) {
+	var request PutTotpPolicyRequestObject
+
+	var body PutTotpPolicyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3280,18 +11804,18 @@ func (sh *strictHandler) PutNotificationAllstatus(w http.ResponseWriter, r *http
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutNotificationAllstatus(ctx, request.(PutNotificationAllstatusRequestObject))
+		return sh.ssi.PutTotpPolicy(ctx, request.(PutTotpPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutNotificationAllstatus")
+		handler = middleware(handler, "PutTotpPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutNotificationAllstatusResponseObject); ok {
-		if err := validResponse.VisitPutNotificationAllstatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutTotpPolicyResponseObject); ok {
+		if err := validResponse.VisitPutTotpPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3299,25 +11823,30 @@ func (sh *strictHandler) PutNotificationAllstatus(w http.ResponseWriter, r *http
 	}
 }
 
-// GetNotificationInfo operation middleware
-func (sh *strictHandler) GetNotificationInfo(w http.ResponseWriter, r *http.Request, params GetNotificationInfoParams) {
-	var request GetNotificationInfoRequestObject
+// PostTotpRecoverycodes operation middleware
+func (sh *strictHandler) PostTotpRecoverycodes(w http.ResponseWriter, r *http.Request) {
+	var request PostTotpRecoverycodesRequestObject
 
-	request.Params = params
+	var body PostTotpRecoverycodesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetNotificationInfo(ctx, request.(GetNotificationInfoRequestObject))
+		return sh.ssi.PostTotpRecoverycodes(ctx, request.(PostTotpRecoverycodesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetNotificationInfo")
+		handler = middleware(handler, "PostTotpRecoverycodes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetNotificationInfoResponseObject); ok {
-		if err := validResponse.VisitGetNotificationInfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTotpRecoverycodesResponseObject); ok {
+		if err := validResponse.VisitPostTotpRecoverycodesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3325,25 +11854,23 @@ func (sh *strictHandler) GetNotificationInfo(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetNotificationList operation middleware
-func (sh *strictHandler) GetNotificationList(w http.ResponseWriter, r *http.Request, params GetNotificationListParams) {
-	var request GetNotificationListRequestObject
-
-	request.Params = params
+// GetUser operation middleware
+func (sh *strictHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	var request GetUserRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetNotificationList(ctx, request.(GetNotificationListRequestObject))
+		return sh.ssi.GetUser(ctx, request.(GetUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetNotificationList")
+		handler = middleware(handler, "GetUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetNotificationListResponseObject); ok {
-		if err := validResponse.VisitGetNotificationListResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUserResponseObject); ok {
+		if err := validResponse.VisitGetUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3351,11 +11878,11 @@ func (sh *strictHandler) GetNotificationList(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// PutNotificationStatus operation middleware
-func (sh *strictHandler) PutNotificationStatus(w http.ResponseWriter, r *http.Request) {
-	var request PutNotificationStatusRequestObject
+// PostUser operation middleware
+func (sh *strictHandler) PostUser(w http.ResponseWriter, r *http.Request) {
+	var request PostUserRequestObject
 
-	var body PutNotificationStatusJSONRequestBody
+	var body PostUserJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3363,18 +11890,18 @@ func (sh *strictHandler) PutNotificationStatus(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutNotificationStatus(ctx, request.(PutNotificationStatusRequestObject))
+		return sh.ssi.PostUser(ctx, request.(PostUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutNotificationStatus")
+		handler = middleware(handler, "PostUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutNotificationStatusResponseObject); ok {
-		if err := validResponse.VisitPutNotificationStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUserResponseObject); ok {
+		if err := validResponse.VisitPostUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3382,11 +11909,11 @@ func (sh *strictHandler) PutNotificationStatus(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPosition operation middleware
-func (sh *strictHandler) PostPosition(w http.ResponseWriter, r *http.Request) {
-	var request PostPositionRequestObject
+// PutUser operation middleware
+func (sh *strictHandler) PutUser(w http.ResponseWriter, r *http.Request) {
+	var request PutUserRequestObject
 
-	var body PostPositionJSONRequestBody
+	var body PutUserJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3394,18 +11921,18 @@ func (sh *strictHandler) PostPosition(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPosition(ctx, request.(PostPositionRequestObject))
+		return sh.ssi.PutUser(ctx, request.(PutUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPosition")
+		handler = middleware(handler, "PutUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPositionResponseObject); ok {
-		if err := validResponse.VisitPostPositionResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutUserResponseObject); ok {
+		if err := validResponse.VisitPutUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3413,25 +11940,30 @@ func (sh *strictHandler) PostPosition(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetPositionCarroute operation middleware
-func (sh *strictHandler) GetPositionCarroute(w http.ResponseWriter, r *http.Request, params GetPositionCarrouteParams) {
-	var request GetPositionCarrouteRequestObject
+// PutUserinfo operation middleware
+func (sh *strictHandler) PutUserinfo(w http.ResponseWriter, r *http.Request) {
+	var request PutUserinfoRequestObject
 
-	request.Params = params
+	var body PutUserinfoJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPositionCarroute(ctx, request.(GetPositionCarrouteRequestObject))
+		return sh.ssi.PutUserinfo(ctx, request.(PutUserinfoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPositionCarroute")
+		handler = middleware(handler, "PutUserinfo")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPositionCarrouteResponseObject); ok {
-		if err := validResponse.VisitGetPositionCarrouteResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutUserinfoResponseObject); ok {
+		if err := validResponse.VisitPutUserinfoResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3439,23 +11971,25 @@ func (sh *strictHandler) GetPositionCarroute(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetPositionListcurrent operation middleware
-func (sh *strictHandler) GetPositionListcurrent(w http.ResponseWriter, r *http.Request) {
-	var request GetPositionListcurrentRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, params DeleteWebhookParams) {
+	var request DeleteWebhookRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPositionListcurrent(ctx, request.(GetPositionListcurrentRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPositionListcurrent")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPositionListcurrentResponseObject); ok {
-		if err := validResponse.VisitGetPositionListcurrentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3463,25 +11997,30 @@ func (sh *strictHandler) GetPositionListcurrent(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetPositionsListcars operation middleware
-func (sh *strictHandler) GetPositionsListcars(w http.ResponseWriter, r *http.Request, params GetPositionsListcarsParams) {
-	var request GetPositionsListcarsRequestObject
+// PostWebhook operation middleware
+func (sh *strictHandler) PostWebhook(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhookRequestObject
 
-	request.Params = params
+	var body PostWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPositionsListcars(ctx, request.(GetPositionsListcarsRequestObject))
+		return sh.ssi.PostWebhook(ctx, request.(PostWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPositionsListcars")
+		handler = middleware(handler, "PostWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPositionsListcarsResponseObject); ok {
-		if err := validResponse.VisitGetPositionsListcarsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhookResponseObject); ok {
+		if err := validResponse.VisitPostWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3489,25 +12028,30 @@ func (sh *strictHandler) GetPositionsListcars(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetPressuredata operation middleware
-func (sh *strictHandler) GetPressuredata(w http.ResponseWriter, r *http.Request, params GetPressuredataParams) {
-	var request GetPressuredataRequestObject
+// PutWebhook operation middleware
+func (sh *strictHandler) PutWebhook(w http.ResponseWriter, r *http.Request) {
+	var request PutWebhookRequestObject
 
-	request.Params = params
+	var body PutWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPressuredata(ctx, request.(GetPressuredataRequestObject))
+		return sh.ssi.PutWebhook(ctx, request.(PutWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPressuredata")
+		handler = middleware(handler, "PutWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPressuredataResponseObject); ok {
-		if err := validResponse.VisitGetPressuredataResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutWebhookResponseObject); ok {
+		if err := validResponse.VisitPutWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3515,23 +12059,25 @@ func (sh *strictHandler) GetPressuredata(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// PostRefresh operation middleware
-func (sh *strictHandler) PostRefresh(w http.ResponseWriter, r *http.Request) {
-	var request PostRefreshRequestObject
+// GetWebhookDeliveryAttempts operation middleware
+func (sh *strictHandler) GetWebhookDeliveryAttempts(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveryAttemptsParams) {
+	var request GetWebhookDeliveryAttemptsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRefresh(ctx, request.(PostRefreshRequestObject))
+		return sh.ssi.GetWebhookDeliveryAttempts(ctx, request.(GetWebhookDeliveryAttemptsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRefresh")
+		handler = middleware(handler, "GetWebhookDeliveryAttempts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostRefreshResponseObject); ok {
-		if err := validResponse.VisitPostRefreshResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookDeliveryAttemptsResponseObject); ok {
+		if err := validResponse.VisitGetWebhookDeliveryAttemptsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3539,23 +12085,25 @@ func (sh *strictHandler) PostRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetReport operation middleware
-func (sh *strictHandler) GetReport(w http.ResponseWriter, r *http.Request) {
-	var request GetReportRequestObject
+// GetWebhookDeliveryList operation middleware
+func (sh *strictHandler) GetWebhookDeliveryList(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveryListParams) {
+	var request GetWebhookDeliveryListRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetReport(ctx, request.(GetReportRequestObject))
+		return sh.ssi.GetWebhookDeliveryList(ctx, request.(GetWebhookDeliveryListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetReport")
+		handler = middleware(handler, "GetWebhookDeliveryList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetReportResponseObject); ok {
-		if err := validResponse.VisitGetReportResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookDeliveryListResponseObject); ok {
+		if err := validResponse.VisitGetWebhookDeliveryListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3563,11 +12111,11 @@ func (sh *strictHandler) GetReport(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostSensordata operation middleware
-func (sh *strictHandler) PostSensordata(w http.ResponseWriter, r *http.Request) {
-	var request PostSensordataRequestObject
+// PutWebhookDeliveryReplay operation middleware
+func (sh *strictHandler) PutWebhookDeliveryReplay(w http.ResponseWriter, r *http.Request) {
+	var request PutWebhookDeliveryReplayRequestObject
 
-	var body PostSensordataJSONRequestBody
+	var body PutWebhookDeliveryReplayJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3575,18 +12123,18 @@ func (sh *strictHandler) PostSensordata(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSensordata(ctx, request.(PostSensordataRequestObject))
+		return sh.ssi.PutWebhookDeliveryReplay(ctx, request.(PutWebhookDeliveryReplayRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSensordata")
+		handler = middleware(handler, "PutWebhookDeliveryReplay")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostSensordataResponseObject); ok {
-		if err := validResponse.VisitPostSensordataResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutWebhookDeliveryReplayResponseObject); ok {
+		if err := validResponse.VisitPutWebhookDeliveryReplayResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3594,25 +12142,23 @@ func (sh *strictHandler) PostSensordata(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetSensors operation middleware
-func (sh *strictHandler) GetSensors(w http.ResponseWriter, r *http.Request, params GetSensorsParams) {
-	var request GetSensorsRequestObject
-
-	request.Params = params
+// GetWebhookList operation middleware
+func (sh *strictHandler) GetWebhookList(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhookListRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSensors(ctx, request.(GetSensorsRequestObject))
+		return sh.ssi.GetWebhookList(ctx, request.(GetWebhookListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSensors")
+		handler = middleware(handler, "GetWebhookList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSensorsResponseObject); ok {
-		if err := validResponse.VisitGetSensorsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookListResponseObject); ok {
+		if err := validResponse.VisitGetWebhookListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3620,25 +12166,25 @@ func (sh *strictHandler) GetSensors(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// GetTemperaturedata operation middleware
-func (sh *strictHandler) GetTemperaturedata(w http.ResponseWriter, r *http.Request, params GetTemperaturedataParams) {
-	var request GetTemperaturedataRequestObject
+// GetWheels operation middleware
+func (sh *strictHandler) GetWheels(w http.ResponseWriter, r *http.Request, params GetWheelsParams) {
+	var request GetWheelsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTemperaturedata(ctx, request.(GetTemperaturedataRequestObject))
+		return sh.ssi.GetWheels(ctx, request.(GetWheelsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTemperaturedata")
+		handler = middleware(handler, "GetWheels")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTemperaturedataResponseObject); ok {
-		if err := validResponse.VisitGetTemperaturedataResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWheelsResponseObject); ok {
+		if err := validResponse.VisitGetWheelsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3646,23 +12192,30 @@ func (sh *strictHandler) GetTemperaturedata(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetUser operation middleware
-func (sh *strictHandler) GetUser(w http.ResponseWriter, r *http.Request) {
-	var request GetUserRequestObject
+// PostWheels operation middleware
+func (sh *strictHandler) PostWheels(w http.ResponseWriter, r *http.Request) {
+	var request PostWheelsRequestObject
+
+	var body PostWheelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUser(ctx, request.(GetUserRequestObject))
+		return sh.ssi.PostWheels(ctx, request.(PostWheelsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUser")
+		handler = middleware(handler, "PostWheels")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUserResponseObject); ok {
-		if err := validResponse.VisitGetUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWheelsResponseObject); ok {
+		if err := validResponse.VisitPostWheelsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3670,11 +12223,11 @@ func (sh *strictHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostUser operation middleware
-func (sh *strictHandler) PostUser(w http.ResponseWriter, r *http.Request) {
-	var request PostUserRequestObject
+// PutWheels operation middleware
+func (sh *strictHandler) PutWheels(w http.ResponseWriter, r *http.Request) {
+	var request PutWheelsRequestObject
 
-	var body PostUserJSONRequestBody
+	var body PutWheelsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3682,18 +12235,18 @@ func (sh *strictHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUser(ctx, request.(PostUserRequestObject))
+		return sh.ssi.PutWheels(ctx, request.(PutWheelsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUser")
+		handler = middleware(handler, "PutWheels")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostUserResponseObject); ok {
-		if err := validResponse.VisitPostUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutWheelsResponseObject); ok {
+		if err := validResponse.VisitPutWheelsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3701,30 +12254,25 @@ func (sh *strictHandler) PostUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PutUser operation middleware
-func (sh *strictHandler) PutUser(w http.ResponseWriter, r *http.Request) {
-	var request PutUserRequestObject
+// GetWheelsStateNumber operation middleware
+func (sh *strictHandler) GetWheelsStateNumber(w http.ResponseWriter, r *http.Request, stateNumber string) {
+	var request GetWheelsStateNumberRequestObject
 
-	var body PutUserJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.StateNumber = stateNumber
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutUser(ctx, request.(PutUserRequestObject))
+		return sh.ssi.GetWheelsStateNumber(ctx, request.(GetWheelsStateNumberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutUser")
+		handler = middleware(handler, "GetWheelsStateNumber")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutUserResponseObject); ok {
-		if err := validResponse.VisitPutUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWheelsStateNumberResponseObject); ok {
+		if err := validResponse.VisitGetWheelsStateNumberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3732,30 +12280,25 @@ func (sh *strictHandler) PutUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PutUserinfo operation middleware
-func (sh *strictHandler) PutUserinfo(w http.ResponseWriter, r *http.Request) {
-	var request PutUserinfoRequestObject
+// GetWorkOrder operation middleware
+func (sh *strictHandler) GetWorkOrder(w http.ResponseWriter, r *http.Request, params GetWorkOrderParams) {
+	var request GetWorkOrderRequestObject
 
-	var body PutUserinfoJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutUserinfo(ctx, request.(PutUserinfoRequestObject))
+		return sh.ssi.GetWorkOrder(ctx, request.(GetWorkOrderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutUserinfo")
+		handler = middleware(handler, "GetWorkOrder")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutUserinfoResponseObject); ok {
-		if err := validResponse.VisitPutUserinfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkOrderResponseObject); ok {
+		if err := validResponse.VisitGetWorkOrderResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3763,25 +12306,30 @@ func (sh *strictHandler) PutUserinfo(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetWheels operation middleware
-func (sh *strictHandler) GetWheels(w http.ResponseWriter, r *http.Request, params GetWheelsParams) {
-	var request GetWheelsRequestObject
+// PostWorkOrder operation middleware
+func (sh *strictHandler) PostWorkOrder(w http.ResponseWriter, r *http.Request) {
+	var request PostWorkOrderRequestObject
 
-	request.Params = params
+	var body PostWorkOrderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetWheels(ctx, request.(GetWheelsRequestObject))
+		return sh.ssi.PostWorkOrder(ctx, request.(PostWorkOrderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetWheels")
+		handler = middleware(handler, "PostWorkOrder")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetWheelsResponseObject); ok {
-		if err := validResponse.VisitGetWheelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWorkOrderResponseObject); ok {
+		if err := validResponse.VisitPostWorkOrderResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3789,11 +12337,11 @@ func (sh *strictHandler) GetWheels(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// PostWheels operation middleware
-func (sh *strictHandler) PostWheels(w http.ResponseWriter, r *http.Request) {
-	var request PostWheelsRequestObject
+// PutWorkOrderCancel operation middleware
+func (sh *strictHandler) PutWorkOrderCancel(w http.ResponseWriter, r *http.Request) {
+	var request PutWorkOrderCancelRequestObject
 
-	var body PostWheelsJSONRequestBody
+	var body PutWorkOrderCancelJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3801,18 +12349,18 @@ func (sh *strictHandler) PostWheels(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostWheels(ctx, request.(PostWheelsRequestObject))
+		return sh.ssi.PutWorkOrderCancel(ctx, request.(PutWorkOrderCancelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostWheels")
+		handler = middleware(handler, "PutWorkOrderCancel")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostWheelsResponseObject); ok {
-		if err := validResponse.VisitPostWheelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutWorkOrderCancelResponseObject); ok {
+		if err := validResponse.VisitPutWorkOrderCancelResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3820,11 +12368,11 @@ func (sh *strictHandler) PostWheels(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PutWheels operation middleware
-func (sh *strictHandler) PutWheels(w http.ResponseWriter, r *http.Request) {
-	var request PutWheelsRequestObject
+// PutWorkOrderComplete operation middleware
+func (sh *strictHandler) PutWorkOrderComplete(w http.ResponseWriter, r *http.Request) {
+	var request PutWorkOrderCompleteRequestObject
 
-	var body PutWheelsJSONRequestBody
+	var body PutWorkOrderCompleteJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -3832,18 +12380,18 @@ func (sh *strictHandler) PutWheels(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutWheels(ctx, request.(PutWheelsRequestObject))
+		return sh.ssi.PutWorkOrderComplete(ctx, request.(PutWorkOrderCompleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutWheels")
+		handler = middleware(handler, "PutWorkOrderComplete")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutWheelsResponseObject); ok {
-		if err := validResponse.VisitPutWheelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutWorkOrderCompleteResponseObject); ok {
+		if err := validResponse.VisitPutWorkOrderCompleteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3851,25 +12399,25 @@ func (sh *strictHandler) PutWheels(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetWheelsStateNumber operation middleware
-func (sh *strictHandler) GetWheelsStateNumber(w http.ResponseWriter, r *http.Request, stateNumber string) {
-	var request GetWheelsStateNumberRequestObject
+// GetWorkOrderList operation middleware
+func (sh *strictHandler) GetWorkOrderList(w http.ResponseWriter, r *http.Request, params GetWorkOrderListParams) {
+	var request GetWorkOrderListRequestObject
 
-	request.StateNumber = stateNumber
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetWheelsStateNumber(ctx, request.(GetWheelsStateNumberRequestObject))
+		return sh.ssi.GetWorkOrderList(ctx, request.(GetWorkOrderListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetWheelsStateNumber")
+		handler = middleware(handler, "GetWorkOrderList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetWheelsStateNumberResponseObject); ok {
-		if err := validResponse.VisitGetWheelsStateNumberResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkOrderListResponseObject); ok {
+		if err := validResponse.VisitGetWorkOrderListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1,25 +1,33 @@
 package server
 
 import (
+	"archive/zip"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/VikaPaz/algalar/internal/logging"
 	"github.com/VikaPaz/algalar/internal/models"
 	"github.com/VikaPaz/algalar/internal/server/rest"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/sirupsen/logrus"
 	"github.com/tealeg/xlsx"
 )
 
 type Service interface {
-	RegisterAuto(ctx context.Context, car models.Car) (models.Car, error)
+	RegisterAuto(ctx context.Context, car models.Car, wheels []models.Wheel) (models.Car, []models.Wheel, error)
 	RegisterUser(ctx context.Context, user models.User) error
 	UpdateUser(ctx context.Context, user models.User) (string, error)
 	UpdateUserPassword(ctx context.Context, newPassword string) error
@@ -28,10 +36,10 @@ type Service interface {
 	UpdateWheelData(ctx context.Context, wheel models.Wheel) error
 	GetWheelData(ctx context.Context, id string) (models.Wheel, error)
 	GenerateReport(ctx context.Context) ([]models.ReportData, error)
-	IsCreatred(table string, key string, val any) (bool, error)
+	IsCreatred(ctx context.Context, table string, key string, val any) (bool, error)
 	GetAutoData(ctx context.Context, id string) (models.Car, error)
 	GetAutoWheelsData(ctx context.Context, id string) (models.CarWithWheels, error)
-	GetAutoList(ctx context.Context, offset int, limit int) ([]models.Car, error)
+	GetAutoList(ctx context.Context, filter models.CarFilter, page models.PageRequest) (models.Page[models.Car], error)
 	GetWheelsData(ctx context.Context, stateNumber string) ([]models.Wheel, error)
 	NewSensorData(ctx context.Context, newData models.SensorData) (models.SensorData, error)
 	SensorsDataByCarID(ctx context.Context, carID string) ([]models.SensorsData, error)
@@ -39,33 +47,102 @@ type Service interface {
 	Pressuredata(ctx context.Context, filter models.PressureDataByWheelIDFilter) ([]models.PressureData, error)
 	CreateDriver(ctx context.Context, driver models.Driver) (models.Driver, error)
 	GetAutoDataByStateNumber(ctx context.Context, stateNumber string) (models.Car, error)
-	GetDriversList(ctx context.Context, offset int, limit int) ([]models.DriverStatisticsResponse, error)
+	GetDriversList(ctx context.Context, filter models.DriverFilter, page models.PageRequest) (models.Page[models.DriverStatisticsResponse], error)
 	GetDriverInfo(ctx context.Context, driverID string) (models.DriverInfoResponse, error)
-	GetDriverByCaDviceNum(ctx context.Context, deviceNum string) (models.Driver, error)
-	UpdateDriverWorktime(ctx context.Context, deviceNum string, workedTime int) error
+	GetDriverByCaDviceNum(ctx context.Context, deviceNum string, at time.Time) (models.Driver, error)
+	UpdateDriverWorktime(ctx context.Context, deviceNum string, workedTime int, endedAt time.Time) error
 	CreatePosition(ctx context.Context, position models.Position) (models.Position, error)
 	GetCarRoutePositions(ctx context.Context, carID string, from time.Time, to time.Time) ([]models.Position, error)
 	GetCurrentCarPositions(ctx context.Context) ([]models.CurrentPositionResponse, error)
 	GetCurrentCarPositionsByPoints(ctx context.Context, pointA models.Point, pointB models.Point) ([]models.CurrentPositionResponse, error)
 	RegisterBeakege(ctx context.Context, breakege models.Breakage) (models.Breakage, error)
 	CreateBreakageFromMqtt(ctx context.Context, breakage models.BreakageFromMqtt) (models.Breakage, error)
-	GetBreakagesByCarId(ctx context.Context, carID string) ([]models.BreakageInfo, error)
+	GetBreakagesByCarId(ctx context.Context, filter models.BreakageFilter, page models.PageRequest) (models.Page[models.BreakageInfo], error)
+	GetBreakage(ctx context.Context, breakageID string) (models.BreakageDetails, error)
+	UpdateBreakageRepair(ctx context.Context, breakageID string, repair models.BreakageRepair) (models.BreakageDetails, error)
+	ChangeBreakageStatus(ctx context.Context, breakageID string, status string, note *string) (models.BreakageDetails, error)
+	GetBreakageHistory(ctx context.Context, breakageID string) ([]models.BreakageHistoryEntry, error)
+	CreateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error)
+	UpdateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error)
+	DeleteBreakageType(ctx context.Context, typeID string) error
+	GetBreakageTypes(ctx context.Context) ([]models.BreakageType, error)
+	GetBreakageStats(ctx context.Context, q models.BreakageStatsQuery) ([]models.BreakageStats, error)
+	CreateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error)
+	UpdateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error)
+	GetMaintenancePlans(ctx context.Context) ([]models.MaintenancePlan, error)
+	GetUpcomingMaintenance(ctx context.Context, carID *string) ([]models.MaintenanceDue, error)
+	OpenWorkOrder(ctx context.Context, o models.WorkOrder) (models.WorkOrder, error)
+	GetWorkOrder(ctx context.Context, orderID string) (models.WorkOrder, error)
+	GetWorkOrders(ctx context.Context, filter models.WorkOrderFilter, page models.PageRequest) (models.Page[models.WorkOrder], error)
+	CompleteWorkOrder(ctx context.Context, c models.WorkOrderCompletion) (models.WorkOrder, error)
+	CancelWorkOrder(ctx context.Context, orderID string) (models.WorkOrder, error)
+	CreateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error)
+	UpdateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, ruleID string) error
+	GetNotificationRules(ctx context.Context) ([]models.NotificationRule, error)
+	GetNotificationDeliveries(ctx context.Context, notificationID string) ([]models.NotificationDelivery, error)
+	CreateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error)
+	UpdateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error)
+	DeleteGeofence(ctx context.Context, geofenceID string) error
+	GetGeofences(ctx context.Context) ([]models.Geofence, error)
+	CreateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error)
+	UpdateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error)
+	DeleteWebhook(ctx context.Context, webhookID string) error
+	GetWebhooks(ctx context.Context) ([]models.WebhookEndpoint, error)
+	GetWebhookDeliveries(ctx context.Context, filter models.WebhookDeliveryFilter, page models.PageRequest) (models.Page[models.WebhookDelivery], error)
+	GetWebhookAttempts(ctx context.Context, deliveryID string) ([]models.WebhookAttempt, error)
+	ReplayWebhookDelivery(ctx context.Context, deliveryID string) (models.WebhookDelivery, error)
 	CreateNotification(ctx context.Context, new models.Notification) (models.Notification, error)
 	UpdateNotificationStatus(ctx context.Context, id string, status string) error
 	UpdateAllNotificationsStatus(ctx context.Context, status string) error
 	GetNotificationInfo(ctx context.Context, notificationID string) (models.NotificationInfo, error)
-	GetNotificationList(ctx context.Context, status *string, limit, offset int) ([]models.NotificationListItem, error)
+	GetNotificationList(ctx context.Context, filter models.NotificationFilter, page models.PageRequest) (models.Page[models.NotificationListItem], error)
 	UpdateWheelsMilagelData(ctx context.Context, update models.UpdateMileage) error
+	GetAuditLog(ctx context.Context, filter models.AuditFilter, page models.PageRequest) (models.Page[models.AuditEntry], error)
+	ExportAuditLog(ctx context.Context, filter models.AuditFilter, w models.AuditWriter) error
+	Search(ctx context.Context, query models.SearchQuery) ([]models.SearchResult, error)
+	Import(ctx context.Context, batch models.ImportBatch, dryRun bool) (models.ImportResult, error)
+	ExportEntity(ctx context.Context, entity string, filter models.ExportFilter, w models.RecordWriter) error
+	AuditExport(ctx context.Context, filter models.ExportFilter, format string) error
+	Restore(ctx context.Context, src models.RecordSource) ([]models.RestoreResult, error)
+	AssignDriver(ctx context.Context, assignment models.DriverAssignment) (models.DriverAssignment, error)
+	UnassignDriver(ctx context.Context, driverID string, endedAt time.Time) (models.DriverAssignment, error)
+	GetAssignments(ctx context.Context, filter models.AssignmentFilter, page models.PageRequest) (models.Page[models.DriverAssignment], error)
+	GetDriverTrips(ctx context.Context, driverID string, from time.Time, to time.Time) ([]models.DriverTrip, error)
+	StartWorkSession(ctx context.Context, session models.WorkSession) (models.WorkSession, error)
+	EndWorkSession(ctx context.Context, driverID string, endedAt time.Time) (models.WorkSession, error)
+	GetWorkSessions(ctx context.Context, filter models.WorkSessionFilter, page models.PageRequest) (models.Page[models.WorkSession], error)
+	GetWorkSummary(ctx context.Context, driverID string, from time.Time, to time.Time) (models.WorkSummary, error)
+	GetWorkViolations(ctx context.Context, filter models.WorkViolationFilter, page models.PageRequest) (models.Page[models.WorkViolation], error)
+	GetTimesheet(ctx context.Context, driverID string, month time.Time) (models.Timesheet, error)
+	UpdateDriver(ctx context.Context, driver models.Driver) (models.DriverInfoResponse, error)
+	DeactivateDriver(ctx context.Context, driverID string) (models.DriverInfoResponse, error)
+	AttachDriverDocument(ctx context.Context, doc models.DriverDocument) (models.DriverDocument, error)
+	GetDriverDocuments(ctx context.Context, driverID string) ([]models.DriverDocument, error)
+	GetDriverDocument(ctx context.Context, documentID string) (models.DriverDocument, error)
+	DeleteDriverDocument(ctx context.Context, documentID string) error
+	ClaimIdempotencyKey(ctx context.Context, k models.IdempotencyKey, ttl time.Duration) (models.IdempotencyKey, error)
+	SaveIdempotentResponse(ctx context.Context, k models.IdempotencyKey) error
+	ReleaseIdempotencyKey(ctx context.Context, k models.IdempotencyKey) error
 }
 
 type AuthService interface {
 	GenerateAccessToken(userID string) (string, error)
 	GenerateRefreshToken(userID string) (string, *time.Time, error)
 	ValidateRefreshToken(refreshToken string) (string, error)
-	GetUserID(login, password string) (string, error)
-	SaveRefreshToken(userID string, refreshToken string, expiration time.Time) error
-	GetRefreshToken(userID string) (string, error)
-	UpdateRefreshToken(userID string, token string, expiration time.Time) error
+	GetUserID(ctx context.Context, login, password string) (string, error)
+	SaveRefreshToken(ctx context.Context, userID string, refreshToken string, expiration time.Time) error
+	GetRefreshToken(ctx context.Context, userID string) (string, error)
+	UpdateRefreshToken(ctx context.Context, userID string, token string, expiration time.Time) error
+	GetTwoFactorState(ctx context.Context, userID string) (models.TwoFactorState, error)
+	SetTwoFactorPolicy(ctx context.Context, userID string, required bool) error
+	GenerateChallengeToken(userID string) (string, error)
+	ValidateChallengeToken(challengeToken string) (string, error)
+	EnrollTOTP(ctx context.Context, userID string) (models.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID string, code string) ([]string, error)
+	VerifySecondFactor(ctx context.Context, userID string, code string, recoveryCode string) error
+	DisableTOTP(ctx context.Context, userID string, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error)
 }
 
 type ServImplemented struct {
@@ -77,6 +154,9 @@ type ServImplemented struct {
 
 type Config struct {
 	SigningKey string
+	// IdempotencyTTL is how long the response to a request with an
+	// Idempotency-Key is kept for its retries.
+	IdempotencyTTL time.Duration
 }
 
 func NewServer(conf Config, svc Service, auth AuthService, logger *logrus.Logger) *ServImplemented {
@@ -92,118 +172,179 @@ func NewServer(conf Config, svc Service, auth AuthService, logger *logrus.Logger
 func (s *ServImplemented) PostLogin(w http.ResponseWriter, r *http.Request) {
 	var loginDetails rest.LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&loginDetails); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
 		return
 	}
 
-	userID, err := s.auth.GetUserID(string(loginDetails.Email), loginDetails.Password)
+	if err := validateLogin(loginDetails); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	userID, err := s.auth.GetUserID(r.Context(), string(loginDetails.Email), loginDetails.Password)
+	if errors.Is(err, models.ErrNoContent) {
+		err = models.ErrInvalidCredentials
+	}
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	state, err := s.auth.GetTwoFactorState(r.Context(), userID)
 	if err != nil {
-		s.log.Error(err)
-		if err == models.ErrNoContent {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, err)
+		return
+	}
+
+	if state.Enabled || state.Required {
+		challengeToken, err := s.auth.GenerateChallengeToken(userID)
+		if err != nil {
+			s.writeError(w, r, err)
 			return
 		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		enrollmentRequired := !state.Enabled
+		response := rest.LoginResponse{
+			ChallengeToken:     &challengeToken,
+			EnrollmentRequired: &enrollmentRequired,
+			TwoFactorRequired:  true,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
 		return
 	}
 
-	accessToken, err := s.auth.GenerateAccessToken(userID)
+	accessToken, refreshToken, err := s.issueTokens(r.Context(), userID)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	refreshToken, exp, err := s.auth.GenerateRefreshToken(userID)
+	response := rest.LoginResponse{
+		AccessToken:  &accessToken,
+		RefreshToken: &refreshToken,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Complete login with a TOTP or recovery code
+// (POST /login/totp)
+func (s *ServImplemented) PostLoginTotp(w http.ResponseWriter, r *http.Request) {
+	var req rest.TotpLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateTotpLogin(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	userID, err := s.auth.ValidateChallengeToken(req.ChallengeToken)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	token, err := s.auth.GetRefreshToken(userID)
-	if err == models.ErrNoContent {
-		err = nil
+	var code, recoveryCode string
+	if req.Code != nil {
+		code = *req.Code
+	}
+	if req.RecoveryCode != nil {
+		recoveryCode = *req.RecoveryCode
 	}
+
+	state, err := s.auth.GetTwoFactorState(r.Context(), userID)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
-	if token == "" {
-		err = s.auth.SaveRefreshToken(userID, refreshToken, *exp)
+
+	response := rest.TotpLoginResponse{}
+	if state.Enabled {
+		err = s.auth.VerifySecondFactor(r.Context(), userID, code, recoveryCode)
 	} else {
-		err = s.auth.UpdateRefreshToken(userID, refreshToken, *exp)
+		// Enrollment forced by company policy is finished with the first code.
+		var recoveryCodes []string
+		recoveryCodes, err = s.auth.ConfirmTOTP(r.Context(), userID, code)
+		response.RecoveryCodes = &recoveryCodes
 	}
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	response := rest.TokenResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+	response.AccessToken, response.RefreshToken, err = s.issueTokens(r.Context(), userID)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
 	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// Start TOTP enrollment during login when company policy requires it
+// (POST /login/totp/enroll)
+func (s *ServImplemented) PostLoginTotpEnroll(w http.ResponseWriter, r *http.Request) {
+	var req rest.TotpChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	userID, err := s.auth.ValidateChallengeToken(req.ChallengeToken)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.enrollTOTP(w, r, userID)
 }
 
 func (s *ServImplemented) PostRefresh(w http.ResponseWriter, r *http.Request) {
 	headAuth, err := getTokenFromHeader(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, fmt.Errorf("%w: %v", models.ErrUnauthorized, err))
 		return
 	}
 
 	userID, err := s.auth.ValidateRefreshToken(headAuth)
 	if err != nil {
-		if err == models.ErrInvalidRefreshToken {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	token, err := s.auth.GetRefreshToken(userID)
+	token, err := s.auth.GetRefreshToken(r.Context(), userID)
 	if err != nil {
-		if err == models.ErrNoContent {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
 	if token != headAuth {
-		err := errors.New(fmt.Sprintf("refresh token not found or expired: %v", models.ErrInvalidRefreshToken))
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRefreshToken, "refresh token not found or expired"))
 		return
 	}
 
 	accessToken, err := s.auth.GenerateAccessToken(userID)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
 	refreshToken, exp, err := s.auth.GenerateRefreshToken(userID)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	err = s.auth.UpdateRefreshToken(userID, refreshToken, *exp)
+	err = s.auth.UpdateRefreshToken(r.Context(), userID, refreshToken, *exp)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -216,33 +357,197 @@ func (s *ServImplemented) PostRefresh(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusCreated)
 }
 
+// Two-factor
+// Get two-factor authentication status
+// (GET /totp)
+func (s *ServImplemented) GetTotp(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	state, err := s.auth.GetTwoFactorState(ctx, ctx.Value(models.UserIDKey).(string))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	response := rest.TotpStatusResponse{
+		Enabled:           state.Enabled,
+		Required:          state.Required,
+		RecoveryCodesLeft: state.RecoveryCodesLeft,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Start TOTP enrollment
+// (POST /totp/enroll)
+func (s *ServImplemented) PostTotpEnroll(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	s.enrollTOTP(w, r, ctx.Value(models.UserIDKey).(string))
+}
+
+// Confirm TOTP enrollment and enable two-factor authentication
+// (POST /totp/confirm)
+func (s *ServImplemented) PostTotpConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.TotpCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateTotpCode(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	codes, err := s.auth.ConfirmTOTP(ctx, ctx.Value(models.UserIDKey).(string), req.Code)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rest.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// Disable two-factor authentication
+// (POST /totp/disable)
+func (s *ServImplemented) PostTotpDisable(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.TotpCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateTotpCode(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := s.auth.DisableTOTP(ctx, ctx.Value(models.UserIDKey).(string), req.Code); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Regenerate recovery codes
+// (POST /totp/recoverycodes)
+func (s *ServImplemented) PostTotpRecoverycodes(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.TotpCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateTotpCode(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	codes, err := s.auth.RegenerateRecoveryCodes(ctx, ctx.Value(models.UserIDKey).(string), req.Code)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rest.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// Set the company two-factor authentication policy
+// (PUT /totp/policy)
+func (s *ServImplemented) PutTotpPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.TotpPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := s.auth.SetTwoFactorPolicy(ctx, ctx.Value(models.UserIDKey).(string), req.Required); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *ServImplemented) enrollTOTP(w http.ResponseWriter, r *http.Request, userID string) {
+	enrollment, err := s.auth.EnrollTOTP(r.Context(), userID)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	response := rest.TotpEnrollResponse{
+		Secret: enrollment.Secret,
+		Url:    enrollment.URL,
+		QrCode: enrollment.QRCode,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
 // User
 func (s *ServImplemented) PostUser(w http.ResponseWriter, r *http.Request) {
 	var userInfo rest.UserRegistration
 	if err := json.NewDecoder(r.Body).Decode(&userInfo); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateUserRegistration(userInfo); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
 	var user models.User = ToNewUser(userInfo)
 
-	ok, err := s.service.IsCreatred("users", "login", user.Login)
+	ok, err := s.service.IsCreatred(r.Context(), "users", "login", user.Login)
 	if ok {
-		err := models.ErrAlreadyExists
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrAlreadyExists, "login is already registered"))
 		return
 	}
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
 	if err := s.service.RegisterUser(r.Context(), user); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -253,31 +558,32 @@ func (s *ServImplemented) PostUser(w http.ResponseWriter, r *http.Request) {
 func (s *ServImplemented) PutUserinfo(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var userInfo rest.UserDetails
 	if err := json.NewDecoder(r.Body).Decode(&userInfo); err != nil {
-		s.log.Error(err)
-		http.Error(w, "Invalid input: "+err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateUserDetails(userInfo); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	var user models.User = models.User{
-		INN:      *userInfo.Inn,
-		Name:     *userInfo.FirstName,
-		Surname:  *userInfo.LastName,
-		Gender:   *userInfo.Gender,
-		Login:    *userInfo.Email,
-		Timezone: *userInfo.TimeZone,
-		Phone:    *userInfo.Phone,
+	// Fields missing from the request keep their current values.
+	user, err := s.service.GetUserDetails(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
+	ApplyUserDetails(&user, userInfo)
 
 	_, err = s.service.UpdateUser(ctx, user)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -287,20 +593,23 @@ func (s *ServImplemented) PutUserinfo(w http.ResponseWriter, r *http.Request) {
 func (s *ServImplemented) PutUser(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var req rest.UpdatePassword
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateUpdatePassword(req); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
 	if err := s.service.UpdateUserPassword(ctx, req.NewPassword); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -309,18 +618,13 @@ func (s *ServImplemented) PutUser(w http.ResponseWriter, r *http.Request) {
 func (s *ServImplemented) GetUser(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	user, err := s.service.GetUserDetails(ctx)
 	if err != nil {
-		s.log.Error(err)
-		if err == models.ErrNoContent {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -335,41 +639,55 @@ func (s *ServImplemented) GetUser(w http.ResponseWriter, r *http.Request) {
 func (s *ServImplemented) PostAuto(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var req rest.AutoRegistration
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
 		return
 	}
 
-	user_id := ctx.Value("user_id").(string)
+	if err := validateAutoRegistration(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	user_id := ctx.Value(models.UserIDKey).(string)
 	car := ToCar(user_id, req)
 
-	ok, err := s.service.IsCreatred("cars", "state_number", car.StateNumber)
+	ok, err := s.service.IsCreatred(ctx, "cars", "state_number", car.StateNumber)
 	if ok {
-		err := models.ErrAlreadyExists
-		s.log.Error(err)
-		http.Error(w, "already exists", http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrAlreadyExists, "state number is already registered"))
 		return
 	}
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	car, err = s.service.RegisterAuto(ctx, car)
+	var wheels []models.Wheel
+	if req.Wheels != nil {
+		for _, wheel := range *req.Wheels {
+			wheels = append(wheels, ToAutoWheel(wheel))
+		}
+	}
+
+	car, wheels, err = s.service.RegisterAuto(ctx, car, wheels)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
 	res := ToAutoResponse(car)
+	if len(wheels) > 0 {
+		resWheels := make([]rest.WheelResponse, len(wheels))
+		for i, wheel := range wheels {
+			resWheels[i] = ToWheelResponse(wheel)
+		}
+		res.Wheels = &resWheels
+	}
 	w.WriteHeader(http.StatusCreated)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(res)
@@ -378,13 +696,12 @@ func (s *ServImplemented) PostAuto(w http.ResponseWriter, r *http.Request) {
 func (s *ServImplemented) GetAuto(w http.ResponseWriter, r *http.Request, params rest.GetAutoParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 	autoData, err := s.service.GetAutoData(ctx, params.CarId)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 	res := ToAutoResponse(autoData)
@@ -396,13 +713,12 @@ func (s *ServImplemented) GetAuto(w http.ResponseWriter, r *http.Request, params
 func (s *ServImplemented) GetAutoInfo(w http.ResponseWriter, r *http.Request, params rest.GetAutoInfoParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 	autoWheelsData, err := s.service.GetAutoWheelsData(ctx, params.CarId)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 	autoData := rest.AutoResponse{
@@ -430,24 +746,31 @@ func (s *ServImplemented) GetAutoInfo(w http.ResponseWriter, r *http.Request, pa
 func (s *ServImplemented) GetAutoList(w http.ResponseWriter, r *http.Request, params rest.GetAutoListParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
+		return
+	}
+
+	page, err := pageRequest(params.Limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	autoList, err := s.service.GetAutoList(ctx, params.Offset, params.Limit)
+	filter := models.CarFilter{Brand: params.Brand, Type: params.CarType}
+	autoList, err := s.service.GetAutoList(ctx, filter, page)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	res := make([]rest.AutoResponse, len(autoList))
-	for i, val := range autoList {
+	res := make([]rest.AutoResponse, len(autoList.Items))
+	for i, val := range autoList.Items {
 		res[i] = ToAutoResponse(val)
 	}
 
-	w.WriteHeader(http.StatusOK)
+	writePageHeaders(w, r, autoList)
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(res)
 }
 
@@ -456,19 +779,22 @@ func (s *ServImplemented) GetAutoList(w http.ResponseWriter, r *http.Request, pa
 func (s *ServImplemented) PutMileage(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrUnauthorizedRequest, err)
-		http.Error(w, models.ErrUnauthorizedRequest.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var req rest.UpdateMileageRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Errorf("%v: %v", models.ErrInvalidRequestBody, err)
-		http.Error(w, models.ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateUpdateMileage(req); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Mileage data parsed successfully. DeviceNum: %s, NewMileage: %f", req.DeviceNum, req.NewMileage)
+	logging.FromContext(r.Context(), s.log).Debugf("Mileage data parsed successfully. DeviceNum: %s, NewMileage: %f", req.DeviceNum, req.NewMileage)
 
 	var update = models.UpdateMileage{
 		DeviceNum: req.DeviceNum,
@@ -477,12 +803,11 @@ func (s *ServImplemented) PutMileage(w http.ResponseWriter, r *http.Request) {
 
 	err = s.service.UpdateWheelsMilagelData(ctx, update)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToUpdateMileage, err)
-		http.Error(w, models.ErrFailedToUpdateMileage.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToUpdateMileage, err))
 		return
 	}
 
-	s.log.Debugf("Mileage data updated successfully for device number: %s", req.DeviceNum)
+	logging.FromContext(r.Context(), s.log).Debugf("Mileage data updated successfully for device number: %s", req.DeviceNum)
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -490,22 +815,30 @@ func (s *ServImplemented) PutMileage(w http.ResponseWriter, r *http.Request) {
 func (s *ServImplemented) PostWheels(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var req rest.WheelRegistration
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateWheelRegistration(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := s.checkWheelPlacement(ctx, req.AutoId, req.AxleNumber); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
 	var wheel models.Wheel = ToNewWheel(req)
 	new, err := s.service.RegisterWheel(ctx, wheel)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -519,21 +852,29 @@ func (s *ServImplemented) PostWheels(w http.ResponseWriter, r *http.Request) {
 func (s *ServImplemented) PutWheels(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var req rest.WheelChange
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateWheelChange(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := s.checkWheelPlacement(ctx, req.AutoId, req.AxleNumber); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
 	var wheel models.Wheel = ToWheel(req)
 	if err := s.service.UpdateWheelData(ctx, wheel); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -543,16 +884,23 @@ func (s *ServImplemented) PutWheels(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+func (s *ServImplemented) checkWheelPlacement(ctx context.Context, carID string, axleNumber int) error {
+	car, err := s.service.GetAutoData(ctx, carID)
+	if err != nil {
+		return err
+	}
+	return validateWheelPlacement(car, axleNumber)
+}
+
 func (s *ServImplemented) GetWheels(w http.ResponseWriter, r *http.Request, params rest.GetWheelsParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 	wheelData, err := s.service.GetWheelData(ctx, params.Id)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 	res := ToWheelResponse(wheelData)
@@ -564,14 +912,13 @@ func (s *ServImplemented) GetWheels(w http.ResponseWriter, r *http.Request, para
 func (s *ServImplemented) GetWheelsStateNumber(w http.ResponseWriter, r *http.Request, stateNumber string) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	dataList, err := s.service.GetWheelsData(ctx, stateNumber)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -592,14 +939,18 @@ func (s *ServImplemented) GetWheelsStateNumber(w http.ResponseWriter, r *http.Re
 func (s *ServImplemented) PostSensordata(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var req rest.NewSensorData
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateSensorData(req); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -607,8 +958,7 @@ func (s *ServImplemented) PostSensordata(w http.ResponseWriter, r *http.Request)
 
 	_, err = s.service.NewSensorData(ctx, newData)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -620,14 +970,13 @@ func (s *ServImplemented) PostSensordata(w http.ResponseWriter, r *http.Request)
 func (s *ServImplemented) GetSensors(w http.ResponseWriter, r *http.Request, params rest.GetSensorsParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	sensors, err := s.service.SensorsDataByCarID(ctx, params.CarId)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -648,7 +997,12 @@ func (s *ServImplemented) GetSensors(w http.ResponseWriter, r *http.Request, par
 func (s *ServImplemented) GetTemperaturedata(w http.ResponseWriter, r *http.Request, params rest.GetTemperaturedataParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validatePeriod("from", params.From, "to", params.To); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -660,8 +1014,7 @@ func (s *ServImplemented) GetTemperaturedata(w http.ResponseWriter, r *http.Requ
 
 	data, err := s.service.Temperaturedata(ctx, filter)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -683,7 +1036,12 @@ func (s *ServImplemented) GetTemperaturedata(w http.ResponseWriter, r *http.Requ
 func (s *ServImplemented) GetPressuredata(w http.ResponseWriter, r *http.Request, params rest.GetPressuredataParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validatePeriod("from", params.From, "to", params.To); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -695,8 +1053,7 @@ func (s *ServImplemented) GetPressuredata(w http.ResponseWriter, r *http.Request
 
 	data, err := s.service.Pressuredata(ctx, filter)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -719,29 +1076,27 @@ func (s *ServImplemented) GetPressuredata(w http.ResponseWriter, r *http.Request
 func (s *ServImplemented) PostDriver(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
 	var req rest.DriverRegistration
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateDriverRegistration(req); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	user_id := ctx.Value("user_id").(string)
+	user_id := ctx.Value(models.UserIDKey).(string)
 	var newDriver models.Driver = ToNewDriver(user_id, req)
 
 	car, err := s.service.GetAutoDataByStateNumber(ctx, req.StateNumber)
 	if err != nil {
-		s.log.Error(err)
-		if err == models.ErrNoContent {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
@@ -749,657 +1104,2285 @@ func (s *ServImplemented) PostDriver(w http.ResponseWriter, r *http.Request) {
 
 	_, err = s.service.CreateDriver(ctx, newDriver)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
 }
 
-// Driver statistics
-// (GET /driver/list)
-func (s *ServImplemented) GetDriverList(w http.ResponseWriter, r *http.Request, params rest.GetDriverListParams) {
+// Update a driver
+// (PUT /driver)
+func (s *ServImplemented) PutDriver(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	drivers, err := s.service.GetDriversList(ctx, params.Limit, params.Offset)
-	if err != nil {
-		s.log.Error(err)
-		if err == models.ErrNoContent {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	var req rest.DriverUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
 		return
 	}
 
-	res := make([]rest.DriverStatisticsResponse, len(drivers))
+	if err := validateDriverUpdate(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
 
-	for i, driver := range drivers {
-		res[i] = ToDriverResponse(driver)
+	driverInfo, err := s.service.UpdateDriver(ctx, ToDriverUpdate(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(res)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToDriverInfoResponse(req.DriverId, driverInfo)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
 }
 
-// Driver information
-// (GET /driver/info)
-func (s *ServImplemented) GetDriverInfo(w http.ResponseWriter, r *http.Request, params rest.GetDriverInfoParams) {
+// Deactivate a driver
+// (PUT /driver/deactivate)
+func (s *ServImplemented) PutDriverDeactivate(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	driverID := params.DriverId
-
-	driverInfo, err := s.service.GetDriverInfo(ctx, driverID.String())
-	if err != nil {
-		s.log.Error(err)
-		if err == models.ErrDriverNotFound {
-			http.Error(w, "Driver not found", http.StatusNotFound)
-			return
-		}
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	var req rest.DriverDeactivateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
 		return
 	}
 
-	response := rest.DriverInfoResponse{
-		Name:       driverInfo.Name,
-		Surname:    driverInfo.Surname,
-		MiddleName: driverInfo.MiddleName,
-		Phone:      driverInfo.Phone,
-		Birthday:   driverInfo.Birthday,
+	driverInfo, err := s.service.DeactivateDriver(ctx, req.DriverId.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		s.log.Error(err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(ToDriverInfoResponse(req.DriverId, driverInfo)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
 	}
 }
 
-// Update the driver's worked hours
-// (PUT /driver/worktime)
-func (s *ServImplemented) PutDriverWorktime(w http.ResponseWriter, r *http.Request) {
+// Attach a document to a driver
+// (POST /driver/document)
+func (s *ServImplemented) PostDriverDocument(w http.ResponseWriter, r *http.Request, params rest.PostDriverDocumentParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	var request rest.WorkTimeUpdateRequest
-
-	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		s.log.Error(err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+	if err := validateDriverDocument(params); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	if request.DeviceNum == "" || request.WorkedTime <= 0 {
-		s.log.Error("Missing or invalid fields")
-		http.Error(w, "Missing or invalid fields", http.StatusBadRequest)
+	content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDocumentFileSize))
+	if err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, fmt.Sprintf("the file must not exceed %d MB", maxDocumentFileSize>>20)))
+		return
+	}
+	if len(content) == 0 {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, "the file is empty"))
 		return
 	}
 
-	err = s.service.UpdateDriverWorktime(ctx, request.DeviceNum, request.WorkedTime)
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	doc, err := s.service.AttachDriverDocument(ctx, models.DriverDocument{
+		IDDriver:    params.DriverId.String(),
+		Type:        params.Type,
+		FileName:    params.FileName,
+		ContentType: contentType,
+		Content:     content,
+	})
 	if err != nil {
-		s.log.Error(err)
-		if err == models.ErrDriverNotFound {
-			http.Error(w, "Driver not found", http.StatusNotFound)
-			return
-		}
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToDriverDocumentResponse(doc)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
 }
 
-// Position
-// Add car position from MQTT
-// (POST /position)
-func (s *ServImplemented) PostPosition(w http.ResponseWriter, r *http.Request) {
+// Download a document of a driver
+// (GET /driver/document)
+func (s *ServImplemented) GetDriverDocument(w http.ResponseWriter, r *http.Request, params rest.GetDriverDocumentParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	var req rest.PositionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+	doc, err := s.service.GetDriverDocument(ctx, params.DocumentId.String())
+	if err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	if len(req.Point) != 2 {
-		err := fmt.Errorf("invalid point coordinates")
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+	w.Header().Set("Content-Type", doc.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": doc.FileName}))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(doc.Content); err != nil {
+		logging.FromContext(r.Context(), s.log).Error(err)
 	}
+}
 
-	position := models.Position{
-		DeviceNumber: req.DeviceNumber,
-		Location: models.Point{
-			Latitude:  float32(req.Point[0]),
-			Longitude: float32(req.Point[1]),
-		},
-		CreatedAt: req.CreatedAt,
+// Delete a document of a driver
+// (DELETE /driver/document)
+func (s *ServImplemented) DeleteDriverDocument(w http.ResponseWriter, r *http.Request, params rest.DeleteDriverDocumentParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
 
-	if _, err := s.service.CreatePosition(ctx, position); err != nil {
-		s.log.Error(err)
-		http.Error(w, "failed to add car position"+err.Error(), http.StatusInternalServerError)
+	if err := s.service.DeleteDriverDocument(ctx, params.DocumentId.String()); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(http.StatusNoContent)
 }
 
-// Get the route of a car
-// (GET /position/carroute)
-func (s *ServImplemented) GetPositionCarroute(w http.ResponseWriter, r *http.Request, params rest.GetPositionCarrouteParams) {
+// Documents of a driver
+// (GET /driver/document/list)
+func (s *ServImplemented) GetDriverDocumentList(w http.ResponseWriter, r *http.Request, params rest.GetDriverDocumentListParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrUnauthorized, err)
-		http.Error(w, models.ErrUnauthorized.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Received request to fetch car route for car_id=%s, time_from=%v, time_to=%v", params.CarId, params.TimeFrom, params.TimeTo)
-
-	carInfo, err := s.service.GetAutoData(ctx, params.CarId.String())
+	docs, err := s.service.GetDriverDocuments(ctx, params.DriverId.String())
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchCarData, err)
-		http.Error(w, models.ErrFailedToFetchCarData.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Fetched car info: %+v", carInfo)
+	res := make([]rest.DriverDocumentResponse, len(docs))
+	for i, doc := range docs {
+		res[i] = ToDriverDocumentResponse(doc)
+	}
 
-	var res = rest.RouteCarResponse{
-		Brand:       carInfo.Brand,
-		StateNumber: carInfo.StateNumber,
-		UniqueId:    carInfo.IDUnicum,
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
 	}
+}
 
-	res.CarId, err = uuid.Parse(carInfo.ID)
+// Driver statistics
+// (GET /driver/list)
+func (s *ServImplemented) GetDriverList(w http.ResponseWriter, r *http.Request, params rest.GetDriverListParams) {
+	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrInvalidCarID, err)
-		http.Error(w, models.ErrInvalidCarID.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Fetching route positions for car_id=%s", params.CarId)
-
-	positions, err := s.service.GetCarRoutePositions(ctx, params.CarId.String(), params.TimeFrom, params.TimeTo)
+	page, err := pageRequest(params.Limit, params.Offset, params.Cursor, params.Sort)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchRoutePositions, err)
-		http.Error(w, models.ErrFailedToFetchRoutePositions.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	if len(positions) == 0 {
-		s.log.Debugf("%v: No route positions found for car_id=%s", models.ErrNoContent, params.CarId)
-		http.Error(w, models.ErrNoContent.Error(), http.StatusNoContent)
+	filter := models.DriverFilter{IncludeInactive: params.IncludeInactive != nil && *params.IncludeInactive}
+	drivers, err := s.service.GetDriversList(ctx, filter, page)
+	if err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Successfully fetched %d route positions for car_id=%s", len(positions), params.CarId)
+	res := make([]rest.DriverStatisticsResponse, len(drivers.Items))
 
-	route := make([]rest.Position, len(positions))
-	for i, val := range positions {
-		route[i] = rest.Position{
-			Point:     []float32{val.Location.Latitude, val.Location.Longitude},
-			CreatedAt: val.CreatedAt,
-		}
+	for i, driver := range drivers.Items {
+		res[i] = ToDriverResponse(driver)
 	}
 
-	res.Positions = route
-
+	writePageHeaders(w, r, drivers)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(res); err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
-		http.Error(w, models.ErrFailedToEncodeResponse.Error(), http.StatusInternalServerError)
-	}
+	json.NewEncoder(w).Encode(res)
 }
 
-// Get current car positions
-// (GET /position/listcurrent)
-func (s *ServImplemented) GetPositionListcurrent(w http.ResponseWriter, r *http.Request) {
+// Driver information
+// (GET /driver/info)
+func (s *ServImplemented) GetDriverInfo(w http.ResponseWriter, r *http.Request, params rest.GetDriverInfoParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrUnauthorized, err)
-		http.Error(w, models.ErrUnauthorized.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Received request to fetch current car positions for user_id=%s", ctx.Value("user_id"))
+	driverID := params.DriverId
 
-	positions, err := s.service.GetCurrentCarPositions(ctx)
+	driverInfo, err := s.service.GetDriverInfo(ctx, driverID.String())
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchPositions, err)
-		http.Error(w, fmt.Sprintf("%v: %v", models.ErrFailedToFetchPositions, err), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	if len(positions) == 0 {
-		s.log.Debugf("%v: No positions found for user_id=%s", models.ErrNoContent, ctx.Value("user_id"))
-		http.Error(w, models.ErrNoContent.Error(), http.StatusNoContent)
-		return
-	}
-
-	res := make([]rest.PositionCurrentListResponse, len(positions))
-	for i, val := range positions {
-		carID, err := uuid.Parse(val.IDCar)
-		if err != nil {
-			s.log.Errorf("%v: %v", models.ErrInvalidUUID, err)
-			http.Error(w, fmt.Sprintf("%v: %v", models.ErrFailedToFetchPositions, err), http.StatusInternalServerError)
-			return
-		}
-		res[i] = rest.PositionCurrentListResponse{
-			CarId:    carID,
-			Point:    []float32{val.Point.Latitude, val.Point.Longitude},
-			UniqueId: val.IDUnicum,
-		}
-	}
-
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(res); err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
-		http.Error(w, fmt.Sprintf("%v: %v", models.ErrFailedToEncodeResponse, err), http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(ToDriverInfoResponse(driverID, driverInfo)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
 	}
 }
 
-// Get list of cars
-// (GET /positions/listcars)
-func (s *ServImplemented) GetPositionsListcars(w http.ResponseWriter, r *http.Request, params rest.GetPositionsListcarsParams) {
+// Update the driver's worked hours
+// (PUT /driver/worktime)
+func (s *ServImplemented) PutDriverWorktime(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrUnauthorized, err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Fetching car positions list: userID=%s, offset=%d, limit=%d", ctx.Value("user_id"), params.Offset, params.Limit)
+	var request rest.WorkTimeUpdateRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateWorkTimeUpdate(request); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
 
-	cars, err := s.service.GetAutoList(ctx, params.Offset, params.Limit)
+	var endedAt time.Time
+	if request.EndedAt != nil {
+		endedAt = *request.EndedAt
+	}
+	err = s.service.UpdateDriverWorktime(ctx, request.DeviceNum, request.WorkedTime, endedAt)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchCars, err)
-		http.Error(w, models.ErrFailedToFetchCars.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	if len(cars) == 0 {
-		s.log.Debugf("No cars found for userID=%s", ctx.Value("user_id"))
-		http.Error(w, models.ErrNoContent.Error(), http.StatusNoContent)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Assign a driver to a car
+// (POST /driver/assignment)
+func (s *ServImplemented) PostDriverAssignment(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Successfully fetched %d cars for userID=%s", len(cars), ctx.Value("user_id"))
+	var req rest.DriverAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
 
-	res := make([]rest.PositionCarListResponse, len(cars))
-	for i, val := range cars {
-		id, err := uuid.Parse(val.ID)
-		if err != nil {
-			s.log.Errorf("%v: %v", models.ErrInvalidUUID, err)
-			http.Error(w, models.ErrInvalidUUID.Error(), http.StatusInternalServerError)
-			return
-		}
+	if err := validateDriverAssignment(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
 
-		res[i] = rest.PositionCarListResponse{
-			CarId:       &id,
-			Brand:       &val.Brand,
-			StateNumber: &val.StateNumber,
-			UniqueId:    &val.IDUnicum,
-		}
+	assignment, err := s.service.AssignDriver(ctx, ToDriverAssignment(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-
-	if err := json.NewEncoder(w).Encode(res); err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
-		http.Error(w, models.ErrFailedToEncodeResponse.Error(), http.StatusInternalServerError)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToDriverAssignmentResponse(assignment)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
 	}
 }
 
-// Notifications
-// Change the status of all notifications for a specific user
-// (PUT /notification/allstatus)
-func (s *ServImplemented) PutNotificationAllstatus(w http.ResponseWriter, r *http.Request) {
+// Unassign a driver from their car
+// (PUT /driver/assignment/end)
+func (s *ServImplemented) PutDriverAssignmentEnd(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	var req rest.ChangeAllNotificationsStatusRequest
+	var req rest.DriverUnassignRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
 		return
 	}
 
-	if req.Status == "" {
-		err := fmt.Errorf("missing required field: status")
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if err := validateDriverUnassign(req); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	err = s.service.UpdateAllNotificationsStatus(ctx, req.Status)
+	var endedAt time.Time
+	if req.EndedAt != nil {
+		endedAt = *req.EndedAt
+	}
+	assignment, err := s.service.UnassignDriver(ctx, req.DriverId.String(), endedAt)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, "failed to update notifications status", http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToDriverAssignmentResponse(assignment)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
 }
 
-// Get detailed information about a specific notification
-// (GET /notification/info)
-func (s *ServImplemented) GetNotificationInfo(w http.ResponseWriter, r *http.Request, params rest.GetNotificationInfoParams) {
+// History of driver assignments
+// (GET /driver/assignment/list)
+func (s *ServImplemented) GetDriverAssignmentList(w http.ResponseWriter, r *http.Request, params rest.GetDriverAssignmentListParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	if params.Id == uuid.Nil {
-		err := fmt.Errorf("missing required parameter: id")
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	page, err := pageRequest(params.Limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	if err := validateOptionalPeriod(params.From, params.To); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	var notificationInfo models.NotificationInfo
-
-	notificationInfo, err = s.service.GetNotificationInfo(ctx, params.Id.String())
+	assignments, err := s.service.GetAssignments(ctx, ToAssignmentFilter(params), page)
 	if err != nil {
-		if errors.Is(err, models.ErrNoContent) {
-			s.log.Error(err)
-			http.Error(w, "notification not found", http.StatusNotFound)
-			return
-		}
-		s.log.Error(err)
-		http.Error(w, "failed to fetch notification info", http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	var res rest.NotificationInfoResponse = rest.NotificationInfoResponse{
-		Description: notificationInfo.Description,
-		DriverName:  notificationInfo.DriverName,
-		Location: []float32{
-			notificationInfo.Location.Latitude,
-			notificationInfo.Location.Longitude,
-		},
-		CreatedAt: notificationInfo.CreatedAt,
+	res := make([]rest.DriverAssignmentResponse, len(assignments.Items))
+	for i, val := range assignments.Items {
+		res[i] = ToDriverAssignmentResponse(val)
 	}
 
+	writePageHeaders(w, r, assignments)
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(res); err != nil {
-		s.log.Error(err)
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
-		return
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
 	}
 }
 
-// Get list of notifications based on status
-// (GET /notification/list)
-func (s *ServImplemented) GetNotificationList(w http.ResponseWriter, r *http.Request, params rest.GetNotificationListParams) {
+// Record a work session of a driver
+// (POST /driver/work-session)
+func (s *ServImplemented) PostDriverWorkSession(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrUnauthorizedRequest, err)
-		http.Error(w, models.ErrUnauthorizedRequest.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	var status *string
-	if params.Status != nil {
-		status = params.Status
-	}
-
-	s.log.Debugf("Received request to fetch notifications with status: %v, limit: %d, offset: %d", status, params.Limit, params.Offset)
-
-	notifications, err := s.service.GetNotificationList(ctx, status, params.Limit, params.Offset)
-	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToRetrieveNotifications, err)
-		http.Error(w, models.ErrFailedToRetrieveNotifications.Error(), http.StatusInternalServerError)
+	var req rest.WorkSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
 		return
 	}
 
-	if len(notifications) == 0 {
-		s.log.Debugf("%v: No notifications found", models.ErrNoContent)
-		http.Error(w, models.ErrNoContent.Error(), http.StatusNoContent)
+	if err := validateWorkSession(req); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Successfully retrieved %d notifications", len(notifications))
-
-	res := make([]rest.NotificationListResponse, len(notifications))
-	for i, val := range notifications {
-		res[i] = ToNotificationListResponse(val)
+	session, err := s.service.StartWorkSession(ctx, ToWorkSession(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(res); err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
-		http.Error(w, models.ErrFailedToEncodeResponse.Error(), http.StatusInternalServerError)
-		return
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToWorkSessionResponse(session)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
 	}
-
-	s.log.Debugf("Response successfully encoded and sent")
-	w.WriteHeader(http.StatusOK)
 }
 
-// Change the status of a specific notification
-// (PUT /notification/status)
-func (s *ServImplemented) PutNotificationStatus(w http.ResponseWriter, r *http.Request) {
+// End the open work session of a driver
+// (PUT /driver/work-session/end)
+func (s *ServImplemented) PutDriverWorkSessionEnd(w http.ResponseWriter, r *http.Request) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	var req rest.ChangeNotificationStatusRequest
+	var req rest.WorkSessionEndRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Error(err)
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
 		return
 	}
 
-	if req.Id == uuid.Nil || req.Status == "" {
-		err := fmt.Errorf("missing required fields: id or status")
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if err := validateWorkSessionEnd(req); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	err = s.service.UpdateNotificationStatus(ctx, req.Id.String(), req.Status)
+	var endedAt time.Time
+	if req.EndedAt != nil {
+		endedAt = *req.EndedAt
+	}
+	session, err := s.service.EndWorkSession(ctx, req.DriverId.String(), endedAt)
 	if err != nil {
-		if errors.Is(err, models.ErrNoContent) {
-			s.log.Error(err)
-			http.Error(w, "notification not found", http.StatusNotFound)
-			return
-		}
-		s.log.Error(err)
-		http.Error(w, "failed to update notification status", http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToWorkSessionResponse(session)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
 }
 
-// Breakages
-// Add a new breakage from MQTT data
-// (POST /breakage)
-func (s *ServImplemented) PostBreakage(w http.ResponseWriter, r *http.Request) {
+// Log of work sessions
+// (GET /driver/work-session/list)
+func (s *ServImplemented) GetDriverWorkSessionList(w http.ResponseWriter, r *http.Request, params rest.GetDriverWorkSessionListParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrUnauthorizedRequest, err)
-		http.Error(w, models.ErrUnauthorizedRequest.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Received request to create a breakage from user_id=%s", ctx.Value("user_id"))
-
-	var req rest.BreakageFromMqttRequest
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.log.Errorf("%v: %v", models.ErrInvalidRequestBody, err)
-		http.Error(w, models.ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+	page, err := pageRequest(params.Limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
 		return
 	}
-
-	if len(req.Point) != 2 {
-		s.log.Errorf("%v", models.ErrInvalidPointFormat)
-		http.Error(w, models.ErrInvalidPointFormat.Error(), http.StatusBadRequest)
+	if err := validateOptionalPeriod(params.From, params.To); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	point := models.Point{
-		Latitude:  req.Point[0],
-		Longitude: req.Point[1],
-	}
-
-	s.log.Debugf("Fetching driver by device number: %s", req.DeviceNum)
-	driver, err := s.service.GetDriverByCaDviceNum(ctx, req.DeviceNum)
+	sessions, err := s.service.GetWorkSessions(ctx, ToWorkSessionFilter(params), page)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchDriver, err)
-		http.Error(w, models.ErrFailedToFetchDriver.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	breakage := models.Breakage{
-		CarID:       driver.IDCar,
-		DriverID:    driver.ID,
-		Location:    point,
-		Type:        req.Type,
-		Description: req.Description,
-		CreatedAt:   req.Datetime,
+	res := make([]rest.WorkSessionResponse, len(sessions.Items))
+	for i, val := range sessions.Items {
+		res[i] = ToWorkSessionResponse(val)
 	}
 
-	s.log.Debugf("Creating breakage: %+v", breakage)
-	newBreakage, err := s.service.RegisterBeakege(ctx, breakage)
+	writePageHeaders(w, r, sessions)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Daily and weekly work totals of a driver
+// (GET /driver/work-time)
+func (s *ServImplemented) GetDriverWorkTime(w http.ResponseWriter, r *http.Request, params rest.GetDriverWorkTimeParams) {
+	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToCreateBreakage, err)
-		http.Error(w, models.ErrFailedToCreateBreakage.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	notification := models.Notification{
-		IDCar:      newBreakage.CarID,
-		IDBreakage: newBreakage.ID,
-		Note:       breakage.Description,
-		Status:     models.StatusNew,
-		CreatedAt:  time.Now(),
+	if err := validateWorkTimePeriod(params.From.Time, params.To.Time); err != nil {
+		s.writeError(w, r, err)
+		return
 	}
 
-	s.log.Debugf("Creating notification: %+v", notification)
-	if _, err := s.service.CreateNotification(ctx, notification); err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToCreateNotification, err)
-		http.Error(w, models.ErrFailedToCreateNotification.Error(), http.StatusInternalServerError)
+	summary, err := s.service.GetWorkSummary(ctx, params.DriverId.String(), params.From.Time, params.To.Time)
+	if err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Breakage and notification successfully created for user_id=%s", ctx.Value("user_id"))
-	w.WriteHeader(http.StatusCreated)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToWorkTimeSummaryResponse(summary)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
 }
 
-// Get a list of breakages for a specific car
-// (GET /breakage/list)
-func (s *ServImplemented) GetBreakageList(w http.ResponseWriter, r *http.Request, params rest.GetBreakageListParams) {
+// Violations of the rest-time rules
+// (GET /driver/work-violation/list)
+func (s *ServImplemented) GetDriverWorkViolationList(w http.ResponseWriter, r *http.Request, params rest.GetDriverWorkViolationListParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		s.log.Errorf("Unauthorized request: %v", err)
-		http.Error(w, models.ErrUnauthorizedRequest.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	s.log.Debugf("Fetching breakages for car ID: %s", params.CarId.String())
-
-	breakages, err := s.service.GetBreakagesByCarId(ctx, params.CarId.String())
+	page, err := pageRequest(params.Limit, params.Offset, params.Cursor, params.Sort)
 	if err != nil {
-		s.log.Errorf("Failed to fetch breakages: %v", err)
-		http.Error(w, models.ErrFailedToFetchBreakages.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
+		return
+	}
+	if err := validateOptionalPeriod(params.From, params.To); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	if err := validateWorkViolationType(params.Type); err != nil {
+		s.writeError(w, r, err)
 		return
 	}
 
-	res := make([]rest.BreakageListResponse, len(breakages))
-
-	for i, val := range breakages {
-		id, err := uuid.Parse(val.ID)
-		if err != nil {
-			s.log.Errorf("Failed to fetch breakages: %v", err)
-			http.Error(w, models.ErrFailedToFetchBreakages.Error(), http.StatusInternalServerError)
-			return
-		}
-		res[i] = rest.BreakageListResponse{
-			Id:          &id,
-			DriverName:  &val.DriverName,
-			StateNumber: &val.StateNumber,
-			Type:        &val.Type,
-			Description: &val.Description,
-			Datetime:    &val.CreatedAt,
-		}
+	violations, err := s.service.GetWorkViolations(ctx, ToWorkViolationFilter(params), page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
 	}
 
-	s.log.Debugf("Successfully fetched %d breakages for car ID: %s", len(breakages), params.CarId.String())
+	res := make([]rest.WorkViolationResponse, len(violations.Items))
+	for i, val := range violations.Items {
+		res[i] = ToWorkViolationResponse(val)
+	}
 
-	w.WriteHeader(http.StatusOK)
+	writePageHeaders(w, r, violations)
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(res); err != nil {
-		s.log.Errorf("Failed to encode response: %v", err)
-		http.Error(w, models.ErrFailedToEncodeResponse.Error(), http.StatusInternalServerError)
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
 	}
 }
 
-// Report
-func (s *ServImplemented) GetReport(w http.ResponseWriter, r *http.Request) {
+// Monthly timesheet of a driver
+// (GET /driver/timesheet)
+func (s *ServImplemented) GetDriverTimesheet(w http.ResponseWriter, r *http.Request, params rest.GetDriverTimesheetParams) {
 	ctx, err := s.getUserID(r)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		s.writeError(w, r, err)
 		return
 	}
 
-	reportData, err := s.service.GenerateReport(ctx)
+	month, err := time.Parse("2006-01", params.Month)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, withDetails(models.ErrInvalidParameter, "month must be formatted as YYYY-MM"))
 		return
 	}
 
-	file := xlsx.NewFile()
-	sheet, err := file.AddSheet("Report")
+	timesheet, err := s.service.GetTimesheet(ctx, params.DriverId.String(), month)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
 		return
 	}
 
-	header := sheet.AddRow()
-	header.AddCell().Value = "Id Wheel"
-	header.AddCell().Value = "State Number"
+	file, err := timesheetFile(timesheet)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=timesheet-%s.xlsx", timesheet.Month.Format("2006-01")))
+
+	if err := file.Write(w); err != nil {
+		logging.FromContext(r.Context(), s.log).Error(err)
+	}
+}
+
+// timesheetFile lays out a timesheet as a workbook with a sheet of days, one
+// of weeks and one of violations. Times are in the company's time zone.
+func timesheetFile(t models.Timesheet) (*xlsx.File, error) {
+	loc := t.Month.Location()
+	file := xlsx.NewFile()
+
+	days, err := file.AddSheet("Days")
+	if err != nil {
+		return nil, err
+	}
+	driver := days.AddRow()
+	driver.AddCell().Value = "Driver"
+	driver.AddCell().Value = strings.TrimSpace(strings.Join([]string{t.Driver.Surname, t.Driver.Name, t.Driver.MiddleName}, " "))
+	driver.AddCell().Value = "Month"
+	driver.AddCell().Value = t.Month.Format("2006-01")
+
+	header := days.AddRow()
+	for _, title := range []string{"Date", "First Start", "Last End", "Periods", "Worked (h)", "Longest Rest (h)"} {
+		header.AddCell().Value = title
+	}
+
+	var total time.Duration
+	for _, day := range t.Summary.Days {
+		row := days.AddRow()
+		row.AddCell().Value = day.Date.Format(time.DateOnly)
+		if day.FirstStart != nil {
+			row.AddCell().Value = day.FirstStart.In(loc).Format("15:04")
+			row.AddCell().Value = day.LastEnd.In(loc).Format("15:04")
+		} else {
+			row.AddCell()
+			row.AddCell()
+		}
+		row.AddCell().Value = strconv.Itoa(day.Periods)
+		row.AddCell().Value = strconv.FormatFloat(day.Worked.Hours(), 'f', 2, 64)
+		row.AddCell().Value = strconv.FormatFloat(day.LongestRest.Hours(), 'f', 2, 64)
+		total += day.Worked
+	}
+
+	totalRow := days.AddRow()
+	totalRow.AddCell().Value = "Total"
+	totalRow.AddCell()
+	totalRow.AddCell()
+	totalRow.AddCell()
+	totalRow.AddCell().Value = strconv.FormatFloat(total.Hours(), 'f', 2, 64)
+
+	weeks, err := file.AddSheet("Weeks")
+	if err != nil {
+		return nil, err
+	}
+	header = weeks.AddRow()
+	header.AddCell().Value = "Week Of"
+	header.AddCell().Value = "Worked (h)"
+	for _, week := range t.Summary.Weeks {
+		row := weeks.AddRow()
+		row.AddCell().Value = week.Start.Format(time.DateOnly)
+		row.AddCell().Value = strconv.FormatFloat(week.Worked.Hours(), 'f', 2, 64)
+	}
+
+	violations, err := file.AddSheet("Violations")
+	if err != nil {
+		return nil, err
+	}
+	header = violations.AddRow()
+	for _, title := range []string{"Type", "Period Start", "Period End", "Limit (min)", "Actual (min)", "Note"} {
+		header.AddCell().Value = title
+	}
+	for _, v := range t.Violations {
+		row := violations.AddRow()
+		row.AddCell().Value = v.Type
+		row.AddCell().Value = v.PeriodStart.In(loc).Format("2006-01-02 15:04")
+		row.AddCell().Value = v.PeriodEnd.In(loc).Format("2006-01-02 15:04")
+		row.AddCell().Value = strconv.Itoa(v.LimitMinutes)
+		row.AddCell().Value = strconv.Itoa(v.ActualMinutes)
+		row.AddCell().Value = v.Note
+	}
+
+	return file, nil
+}
+
+// Position
+// Add car position from MQTT
+// (POST /position)
+func (s *ServImplemented) PostPosition(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.PositionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validatePosition(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	position := models.Position{
+		DeviceNumber: req.DeviceNumber,
+		Location: models.Point{
+			Latitude:  float32(req.Point[0]),
+			Longitude: float32(req.Point[1]),
+		},
+		CreatedAt: req.CreatedAt,
+	}
+
+	if _, err := s.service.CreatePosition(ctx, position); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Get the route of a car
+// (GET /position/carroute)
+func (s *ServImplemented) GetPositionCarroute(w http.ResponseWriter, r *http.Request, params rest.GetPositionCarrouteParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validatePeriod("time_from", params.TimeFrom, "time_to", params.TimeTo); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Received request to fetch car route for car_id=%s, time_from=%v, time_to=%v", params.CarId, params.TimeFrom, params.TimeTo)
+
+	carInfo, err := s.service.GetAutoData(ctx, params.CarId.String())
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToFetchCarData, err))
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Fetched car info: %+v", logging.Redact(carInfo))
+
+	var res = rest.RouteCarResponse{
+		Brand:       carInfo.Brand,
+		StateNumber: carInfo.StateNumber,
+		UniqueId:    carInfo.IDUnicum,
+	}
+
+	res.CarId, err = uuid.Parse(carInfo.ID)
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrInvalidCarID, err))
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Fetching route positions for car_id=%s", params.CarId)
+
+	positions, err := s.service.GetCarRoutePositions(ctx, params.CarId.String(), params.TimeFrom, params.TimeTo)
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToFetchRoutePositions, err))
+		return
+	}
+
+	if len(positions) == 0 {
+		logging.FromContext(r.Context(), s.log).Debugf("%v: No route positions found for car_id=%s", models.ErrNoContent, params.CarId)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Successfully fetched %d route positions for car_id=%s", len(positions), params.CarId)
+
+	res.Positions = ToPositions(positions)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get the trips of a driver
+// (GET /position/drivertrips)
+func (s *ServImplemented) GetPositionDrivertrips(w http.ResponseWriter, r *http.Request, params rest.GetPositionDrivertripsParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validatePeriod("time_from", params.TimeFrom, "time_to", params.TimeTo); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	trips, err := s.service.GetDriverTrips(ctx, params.DriverId.String(), params.TimeFrom, params.TimeTo)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if len(trips) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	res := make([]rest.DriverTripResponse, len(trips))
+	for i, val := range trips {
+		res[i] = rest.DriverTripResponse{
+			Assignment: ToDriverAssignmentResponse(val.Assignment),
+			Positions:  ToPositions(val.Positions),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Add a geofence
+// (POST /position/geofence)
+func (s *ServImplemented) PostPositionGeofence(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.GeofenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateGeofence(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	geofence, err := s.service.CreateGeofence(ctx, ToGeofence(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToGeofenceResponse(geofence)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Replace a geofence
+// (PUT /position/geofence)
+func (s *ServImplemented) PutPositionGeofence(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.GeofenceUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateGeofenceUpdate(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	update := ToGeofence(ToGeofenceRequest(req))
+	update.ID = req.Id.String()
+	geofence, err := s.service.UpdateGeofence(ctx, update)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToGeofenceResponse(geofence)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Remove a geofence
+// (DELETE /position/geofence)
+func (s *ServImplemented) DeletePositionGeofence(w http.ResponseWriter, r *http.Request, params rest.DeletePositionGeofenceParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := s.service.DeleteGeofence(ctx, params.GeofenceId.String()); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// The geofences of the company
+// (GET /position/geofence/list)
+func (s *ServImplemented) GetPositionGeofenceList(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	geofences, err := s.service.GetGeofences(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.GeofenceResponse, len(geofences))
+	for i, geofence := range geofences {
+		res[i] = ToGeofenceResponse(geofence)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get current car positions
+// (GET /position/listcurrent)
+func (s *ServImplemented) GetPositionListcurrent(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Received request to fetch current car positions for user_id=%s", ctx.Value(models.UserIDKey))
+
+	positions, err := s.service.GetCurrentCarPositions(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if len(positions) == 0 {
+		logging.FromContext(r.Context(), s.log).Debugf("%v: No positions found for user_id=%s", models.ErrNoContent, ctx.Value(models.UserIDKey))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	res := make([]rest.PositionCurrentListResponse, len(positions))
+	for i, val := range positions {
+		carID, err := uuid.Parse(val.IDCar)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		res[i] = rest.PositionCurrentListResponse{
+			CarId:    carID,
+			Point:    []float32{val.Point.Latitude, val.Point.Longitude},
+			UniqueId: val.IDUnicum,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get list of cars
+// (GET /positions/listcars)
+func (s *ServImplemented) GetPositionsListcars(w http.ResponseWriter, r *http.Request, params rest.GetPositionsListcarsParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	page, err := pageRequest(params.Limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Fetching car positions list: userID=%s, offset=%d, limit=%d", ctx.Value(models.UserIDKey), page.Offset, page.Limit)
+
+	filter := models.CarFilter{Brand: params.Brand, Type: params.CarType}
+	carsPage, err := s.service.GetAutoList(ctx, filter, page)
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToFetchCars, err))
+		return
+	}
+	cars := carsPage.Items
+
+	if len(cars) == 0 {
+		logging.FromContext(r.Context(), s.log).Debugf("No cars found for userID=%s", ctx.Value(models.UserIDKey))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Successfully fetched %d cars for userID=%s", len(cars), ctx.Value(models.UserIDKey))
+
+	res := make([]rest.PositionCarListResponse, len(cars))
+	for i, val := range cars {
+		id, err := uuid.Parse(val.ID)
+		if err != nil {
+			s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrInvalidUUID, err))
+			return
+		}
+
+		res[i] = rest.PositionCarListResponse{
+			CarId:       &id,
+			Brand:       &val.Brand,
+			StateNumber: &val.StateNumber,
+			UniqueId:    &val.IDUnicum,
+		}
+	}
+
+	writePageHeaders(w, r, carsPage)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Notifications
+// Change the status of all notifications for a specific user
+// (PUT /notification/allstatus)
+func (s *ServImplemented) PutNotificationAllstatus(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.ChangeAllNotificationsStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateAllNotificationsStatus(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.service.UpdateAllNotificationsStatus(ctx, req.Status)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Get detailed information about a specific notification
+// (GET /notification/info)
+func (s *ServImplemented) GetNotificationInfo(w http.ResponseWriter, r *http.Request, params rest.GetNotificationInfoParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if params.Id == uuid.Nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidParameter, "missing required parameter: id"))
+		return
+	}
+
+	var notificationInfo models.NotificationInfo
+
+	notificationInfo, err = s.service.GetNotificationInfo(ctx, params.Id.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := ToNotificationInfoResponse(notificationInfo)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get list of notifications based on status
+// (GET /notification/list)
+func (s *ServImplemented) GetNotificationList(w http.ResponseWriter, r *http.Request, params rest.GetNotificationListParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	page, err := pageRequest(params.Limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validateOptionalPeriod(params.From, params.To); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	filter := models.NotificationFilter{
+		Status:         params.Status,
+		Type:           params.Type,
+		MinSeverity:    params.MinSeverity,
+		BreakageType:   params.BreakageType,
+		BreakageStatus: params.BreakageStatus,
+		From:           params.From,
+		To:             params.To,
+	}
+	if filter.BreakageStatus != nil {
+		if err := validateBreakageStatuses("breakage_status", []string{*filter.BreakageStatus}); err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+	}
+	if err := validateNotificationFilter(filter); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Received request to fetch notifications with status: %v, limit: %d, offset: %d", filter.Status, page.Limit, page.Offset)
+
+	notificationsPage, err := s.service.GetNotificationList(ctx, filter, page)
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToRetrieveNotifications, err))
+		return
+	}
+	notifications := notificationsPage.Items
+
+	if len(notifications) == 0 {
+		logging.FromContext(r.Context(), s.log).Debugf("%v: No notifications found", models.ErrNoContent)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Successfully retrieved %d notifications", len(notifications))
+
+	res := make([]rest.NotificationListResponse, len(notifications))
+	for i, val := range notifications {
+		res[i] = ToNotificationListResponse(val)
+	}
+
+	writePageHeaders(w, r, notificationsPage)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Response successfully encoded and sent")
+	w.WriteHeader(http.StatusOK)
+}
+
+// Change the status of a specific notification
+// (PUT /notification/status)
+func (s *ServImplemented) PutNotificationStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.ChangeNotificationStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateNotificationStatus(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	err = s.service.UpdateNotificationStatus(ctx, req.Id.String(), req.Status)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Add a notification routing rule
+// (POST /notification/rule)
+func (s *ServImplemented) PostNotificationRule(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.NotificationRuleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateNotificationRule(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	rule, err := s.service.CreateNotificationRule(ctx, ToNotificationRule(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToNotificationRuleResponse(rule)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Replace a notification routing rule
+// (PUT /notification/rule)
+func (s *ServImplemented) PutNotificationRule(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.NotificationRuleUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateNotificationRuleUpdate(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	update := ToNotificationRule(ToNotificationRuleRequest(req))
+	update.ID = req.Id.String()
+	rule, err := s.service.UpdateNotificationRule(ctx, update)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToNotificationRuleResponse(rule)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Remove a notification routing rule
+// (DELETE /notification/rule)
+func (s *ServImplemented) DeleteNotificationRule(w http.ResponseWriter, r *http.Request, params rest.DeleteNotificationRuleParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := s.service.DeleteNotificationRule(ctx, params.RuleId.String()); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// The notification routing rules of the company
+// (GET /notification/rule/list)
+func (s *ServImplemented) GetNotificationRuleList(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	rules, err := s.service.GetNotificationRules(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.NotificationRuleResponse, len(rules))
+	for i, rule := range rules {
+		res[i] = ToNotificationRuleResponse(rule)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Delivery status of a notification on each channel it was routed to
+// (GET /notification/deliveries)
+func (s *ServImplemented) GetNotificationDeliveries(w http.ResponseWriter, r *http.Request, params rest.GetNotificationDeliveriesParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	deliveries, err := s.service.GetNotificationDeliveries(ctx, params.Id.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.NotificationDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		res[i] = ToNotificationDeliveryResponse(d)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Webhooks
+// Register a webhook endpoint
+// (POST /webhook)
+func (s *ServImplemented) PostWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.WebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateWebhook(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	endpoint, err := s.service.CreateWebhook(ctx, ToWebhookEndpoint(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	// The secret is only ever shown here.
+	res := ToWebhookResponse(endpoint)
+	res.Secret = &endpoint.Secret
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Replace a webhook endpoint
+// (PUT /webhook)
+func (s *ServImplemented) PutWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.WebhookUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateWebhookUpdate(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	update := ToWebhookEndpoint(ToWebhookRequest(req))
+	update.ID = req.Id.String()
+	endpoint, err := s.service.UpdateWebhook(ctx, update)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToWebhookResponse(endpoint)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Remove a webhook endpoint with its deliveries
+// (DELETE /webhook)
+func (s *ServImplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, params rest.DeleteWebhookParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := s.service.DeleteWebhook(ctx, params.WebhookId.String()); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// The webhook endpoints of the company
+// (GET /webhook/list)
+func (s *ServImplemented) GetWebhookList(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	endpoints, err := s.service.GetWebhooks(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.WebhookResponse, len(endpoints))
+	for i, endpoint := range endpoints {
+		res[i] = ToWebhookResponse(endpoint)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get the webhook delivery log of the company
+// (GET /webhook/delivery/list)
+func (s *ServImplemented) GetWebhookDeliveryList(w http.ResponseWriter, r *http.Request, params rest.GetWebhookDeliveryListParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	limit := defaultBreakagePageLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	page, err := pageRequest(limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validateWebhookDeliveryFilter(params); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	filter := models.WebhookDeliveryFilter{
+		IDEndpoint: fromUUIDPtr(params.WebhookId),
+		Status:     params.Status,
+		EventType:  params.EventType,
+	}
+	deliveriesPage, err := s.service.GetWebhookDeliveries(ctx, filter, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.WebhookDeliveryResponse, len(deliveriesPage.Items))
+	for i, d := range deliveriesPage.Items {
+		res[i] = ToWebhookDeliveryResponse(d)
+	}
+
+	writePageHeaders(w, r, deliveriesPage)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Attempts of a webhook delivery
+// (GET /webhook/delivery/attempts)
+func (s *ServImplemented) GetWebhookDeliveryAttempts(w http.ResponseWriter, r *http.Request, params rest.GetWebhookDeliveryAttemptsParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	attempts, err := s.service.GetWebhookAttempts(ctx, params.DeliveryId.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.WebhookAttemptResponse, len(attempts))
+	for i, a := range attempts {
+		res[i] = ToWebhookAttemptResponse(a)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Deliver a webhook delivery again
+// (PUT /webhook/delivery/replay)
+func (s *ServImplemented) PutWebhookDeliveryReplay(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.WebhookReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if req.Id == uuid.Nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidInput, "id is required"))
+		return
+	}
+
+	delivery, err := s.service.ReplayWebhookDelivery(ctx, req.Id.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToWebhookDeliveryResponse(delivery)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Breakages
+// Add a new breakage from MQTT data
+// (POST /breakage)
+func (s *ServImplemented) PostBreakage(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Received request to create a breakage from user_id=%s", ctx.Value(models.UserIDKey))
+
+	var req rest.BreakageFromMqttRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateBreakage(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	point := models.Point{
+		Latitude:  req.Point[0],
+		Longitude: req.Point[1],
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Fetching driver by device number: %s", req.DeviceNum)
+	driver, err := s.service.GetDriverByCaDviceNum(ctx, req.DeviceNum, req.Datetime)
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToFetchDriver, err))
+		return
+	}
+
+	breakage := models.Breakage{
+		CarID:       driver.IDCar,
+		DriverID:    driver.ID,
+		Location:    point,
+		Type:        req.Type,
+		Description: req.Description,
+		CreatedAt:   req.Datetime,
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Creating breakage: %+v", logging.Redact(breakage))
+	newBreakage, err := s.service.RegisterBeakege(ctx, breakage)
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToCreateBreakage, err))
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Breakage %s and its notification successfully created for user_id=%s", newBreakage.ID, ctx.Value(models.UserIDKey))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Get a list of breakages for a specific car
+// (GET /breakage/list)
+func (s *ServImplemented) GetBreakageList(w http.ResponseWriter, r *http.Request, params rest.GetBreakageListParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	limit := defaultBreakagePageLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	page, err := pageRequest(limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validateOptionalPeriod(params.From, params.To); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	statuses := ToBreakageStatuses(params.Status)
+	if err := validateBreakageStatuses("status", statuses); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	if err := validateSeverity(params.Severity); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Fetching breakages for car ID: %s", params.CarId.String())
+
+	filter := models.BreakageFilter{
+		IDCar:    params.CarId.String(),
+		Type:     params.Type,
+		Severity: params.Severity,
+		Statuses: statuses,
+		From:     params.From,
+		To:       params.To,
+	}
+	breakagesPage, err := s.service.GetBreakagesByCarId(ctx, filter, page)
+	if err != nil {
+		s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToFetchBreakages, err))
+		return
+	}
+	breakages := breakagesPage.Items
+
+	res := make([]rest.BreakageListResponse, len(breakages))
+
+	for i, val := range breakages {
+		id, err := uuid.Parse(val.ID)
+		if err != nil {
+			s.writeError(w, r, fmt.Errorf("%w: %w", models.ErrFailedToFetchBreakages, err))
+			return
+		}
+		res[i] = rest.BreakageListResponse{
+			Id:              &id,
+			DriverName:      &val.DriverName,
+			StateNumber:     &val.StateNumber,
+			Type:            &val.Type,
+			TypeName:        val.TypeName,
+			Severity:        val.Severity,
+			MustStop:        &val.MustStop,
+			Description:     &val.Description,
+			Status:          &val.Status,
+			Assignee:        val.Assignee,
+			Cost:            val.Cost,
+			Datetime:        &val.CreatedAt,
+			ResolvedAt:      val.ResolvedAt,
+			DowntimeMinutes: &val.DowntimeMinutes,
+		}
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Successfully fetched %d breakages for car ID: %s", len(breakages), params.CarId.String())
+
+	writePageHeaders(w, r, breakagesPage)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get a breakage with the state of its repair
+// (GET /breakage)
+func (s *ServImplemented) GetBreakage(w http.ResponseWriter, r *http.Request, params rest.GetBreakageParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	breakage, err := s.service.GetBreakage(ctx, params.BreakageId.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToBreakageResponse(breakage)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Update the repair details of a breakage
+// (PUT /breakage)
+func (s *ServImplemented) PutBreakage(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.BreakageUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateBreakageUpdate(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	breakage, err := s.service.UpdateBreakageRepair(ctx, req.Id.String(), ToBreakageRepair(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToBreakageResponse(breakage)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Change the status of a breakage
+// (PUT /breakage/status)
+func (s *ServImplemented) PutBreakageStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.BreakageStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateBreakageStatus(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	breakage, err := s.service.ChangeBreakageStatus(ctx, req.Id.String(), req.Status, req.Note)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToBreakageResponse(breakage)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Status changes of a breakage
+// (GET /breakage/history)
+func (s *ServImplemented) GetBreakageHistory(w http.ResponseWriter, r *http.Request, params rest.GetBreakageHistoryParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	history, err := s.service.GetBreakageHistory(ctx, params.BreakageId.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.BreakageStatusChangeResponse, len(history))
+	for i, entry := range history {
+		res[i] = ToBreakageStatusChangeResponse(entry)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Add a type to the breakage catalogue
+// (POST /breakage/type)
+func (s *ServImplemented) PostBreakageType(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.BreakageTypeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateBreakageType(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	t, err := s.service.CreateBreakageType(ctx, ToBreakageType(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToBreakageTypeResponse(t)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Replace a type of the breakage catalogue
+// (PUT /breakage/type)
+func (s *ServImplemented) PutBreakageType(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.BreakageTypeUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateBreakageTypeUpdate(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	update := ToBreakageType(ToBreakageTypeRequest(req))
+	update.ID = req.Id.String()
+	t, err := s.service.UpdateBreakageType(ctx, update)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToBreakageTypeResponse(t)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Remove a type from the breakage catalogue
+// (DELETE /breakage/type)
+func (s *ServImplemented) DeleteBreakageType(w http.ResponseWriter, r *http.Request, params rest.DeleteBreakageTypeParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := s.service.DeleteBreakageType(ctx, params.TypeId.String()); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// The breakage catalogue of the company
+// (GET /breakage/type/list)
+func (s *ServImplemented) GetBreakageTypeList(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	types, err := s.service.GetBreakageTypes(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.BreakageTypeResponse, len(types))
+	for i, t := range types {
+		res[i] = ToBreakageTypeResponse(t)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Breakage frequency by type, car model or driver
+// (GET /breakage/stats)
+func (s *ServImplemented) GetBreakageStats(w http.ResponseWriter, r *http.Request, params rest.GetBreakageStatsParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validateBreakageStats(params); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	stats, err := s.service.GetBreakageStats(ctx, models.BreakageStatsQuery{
+		GroupBy: params.GroupBy,
+		From:    params.From,
+		To:      params.To,
+	})
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.BreakageStatsResponse, len(stats))
+	for i, val := range stats {
+		res[i] = ToBreakageStatsResponse(val)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Add a maintenance plan
+// (POST /maintenance/plan)
+func (s *ServImplemented) PostMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.MaintenancePlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateMaintenancePlan(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	plan, err := s.service.CreateMaintenancePlan(ctx, ToMaintenancePlan(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToMaintenancePlanResponse(plan)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Replace a maintenance plan
+// (PUT /maintenance/plan)
+func (s *ServImplemented) PutMaintenancePlan(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.MaintenancePlanUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateMaintenancePlanUpdate(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	update := ToMaintenancePlan(ToMaintenancePlanRequest(req))
+	update.ID = req.Id.String()
+	plan, err := s.service.UpdateMaintenancePlan(ctx, update)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToMaintenancePlanResponse(plan)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// The maintenance plans of the company
+// (GET /maintenance/plan/list)
+func (s *ServImplemented) GetMaintenancePlanList(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	plans, err := s.service.GetMaintenancePlans(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.MaintenancePlanResponse, len(plans))
+	for i, plan := range plans {
+		res[i] = ToMaintenancePlanResponse(plan)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Upcoming maintenance
+// (GET /maintenance/upcoming)
+func (s *ServImplemented) GetMaintenanceUpcoming(w http.ResponseWriter, r *http.Request, params rest.GetMaintenanceUpcomingParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var carID *string
+	if params.CarId != nil {
+		id := params.CarId.String()
+		carID = &id
+	}
+
+	tasks, err := s.service.GetUpcomingMaintenance(ctx, carID)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.MaintenanceTaskResponse, len(tasks))
+	for i, task := range tasks {
+		res[i] = ToMaintenanceTaskResponse(task)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Open a work order from a breakage or a maintenance task
+// (POST /work-order)
+func (s *ServImplemented) PostWorkOrder(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.WorkOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateWorkOrder(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	order, err := s.service.OpenWorkOrder(ctx, ToWorkOrder(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(ToWorkOrderResponse(order)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get a work order
+// (GET /work-order)
+func (s *ServImplemented) GetWorkOrder(w http.ResponseWriter, r *http.Request, params rest.GetWorkOrderParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	order, err := s.service.GetWorkOrder(ctx, params.WorkOrderId.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToWorkOrderResponse(order)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Get a list of work orders
+// (GET /work-order/list)
+func (s *ServImplemented) GetWorkOrderList(w http.ResponseWriter, r *http.Request, params rest.GetWorkOrderListParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	limit := defaultBreakagePageLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	page, err := pageRequest(limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if err := validateWorkOrderStatus(params.Status); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	filter := models.WorkOrderFilter{Status: params.Status}
+	if params.CarId != nil {
+		id := params.CarId.String()
+		filter.IDCar = &id
+	}
+	ordersPage, err := s.service.GetWorkOrders(ctx, filter, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.WorkOrderResponse, len(ordersPage.Items))
+	for i, order := range ordersPage.Items {
+		res[i] = ToWorkOrderResponse(order)
+	}
+
+	writePageHeaders(w, r, ordersPage)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Complete an open work order
+// (PUT /work-order/complete)
+func (s *ServImplemented) PutWorkOrderComplete(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.WorkOrderCompleteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if err := validateWorkOrderCompletion(req); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	order, err := s.service.CompleteWorkOrder(ctx, ToWorkOrderCompletion(req))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToWorkOrderResponse(order)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Cancel an open work order
+// (PUT /work-order/cancel)
+func (s *ServImplemented) PutWorkOrderCancel(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	var req rest.WorkOrderCancelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidRequestBody, err.Error()))
+		return
+	}
+
+	if req.Id == uuid.Nil {
+		s.writeError(w, r, withDetails(models.ErrInvalidInput, "id is required"))
+		return
+	}
+
+	order, err := s.service.CancelWorkOrder(ctx, req.Id.String())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ToWorkOrderResponse(order)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Report
+func (s *ServImplemented) GetReport(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	reportData, err := s.service.GenerateReport(ctx)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	file := xlsx.NewFile()
+	sheet, err := file.AddSheet("Report")
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	header := sheet.AddRow()
+	header.AddCell().Value = "Id Wheel"
+	header.AddCell().Value = "State Number"
 	header.AddCell().Value = "Tire Brand"
 	header.AddCell().Value = "Mileage"
 	header.AddCell().Value = "Temp Out of Bounds"
@@ -1420,10 +3403,333 @@ func (s *ServImplemented) GetReport(w http.ResponseWriter, r *http.Request) {
 
 	err = file.Write(w)
 	if err != nil {
-		s.log.Error(err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		s.writeError(w, r, err)
+		return
+	}
+}
+
+// Audit
+// Get the audit log of the company
+// (GET /audit/list)
+func (s *ServImplemented) GetAuditList(w http.ResponseWriter, r *http.Request, params rest.GetAuditListParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	limit := defaultBreakagePageLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	page, err := pageRequest(limit, params.Offset, params.Cursor, params.Sort)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	filter := ToAuditFilter(params.ActorId, params.Action, params.ResourceType, params.ResourceId, params.From, params.To)
+
+	entries, err := s.service.GetAuditLog(ctx, filter, page)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.AuditEntryResponse, len(entries.Items))
+	for i, val := range entries.Items {
+		res[i] = ToAuditEntryResponse(val)
+	}
+
+	writePageHeaders(w, r, entries)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// auditCSVWriter writes audit entries as rows of the CSV export.
+type auditCSVWriter struct {
+	csv *csv.Writer
+}
+
+func (w auditCSVWriter) WriteAuditEntry(entry models.AuditEntry) error {
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return err
+	}
+	return w.csv.Write([]string{
+		entry.CreatedAt.Format(time.RFC3339),
+		entry.ActorID,
+		entry.Action,
+		entry.ResourceType,
+		entry.ResourceID,
+		string(changes),
+		entry.Metadata.IP,
+		entry.Metadata.UserAgent,
+		entry.Metadata.Method,
+		entry.Metadata.Path,
+	})
+}
+
+// Export the audit log as CSV
+// (GET /audit/export)
+func (s *ServImplemented) GetAuditExport(w http.ResponseWriter, r *http.Request, params rest.GetAuditExportParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	filter := ToAuditFilter(params.ActorId, params.Action, params.ResourceType, params.ResourceId, params.From, params.To)
+
+	// The entries are streamed as they are read, which may take longer than
+	// the server's write timeout.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=audit.csv")
+	w.WriteHeader(http.StatusOK)
+
+	// Once streaming has started errors can no longer be reported in the
+	// response, so they are only logged.
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"Created At", "Actor", "Action", "Resource Type", "Resource ID", "Changes", "IP", "User Agent", "Method", "Path"})
+	if err := s.service.ExportAuditLog(ctx, filter, auditCSVWriter{csv: writer}); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("export of audit log: %v", err)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		logging.FromContext(r.Context(), s.log).Error(err)
+	}
+}
+
+// Search cars, drivers, wheels and breakages of the company
+// (GET /search)
+func (s *ServImplemented) GetSearch(w http.ResponseWriter, r *http.Request, params rest.GetSearchParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	query := ToSearchQuery(params)
+	if err := validateSearch(query); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	results, err := s.service.Search(ctx, query)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	res := make([]rest.SearchResultResponse, len(results))
+	for i, val := range results {
+		res[i] = ToSearchResultResponse(val)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Import cars, wheels or drivers from a CSV or XLSX file
+// (POST /import/{kind})
+func (s *ServImplemented) PostImportKind(w http.ResponseWriter, r *http.Request, kind string, params rest.PostImportKindParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if _, err := importTemplate(kind); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	records, err := readImportFile(r, w)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	batch, err := parseImport(kind, records)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+	result, err := s.service.Import(ctx, batch, dryRun)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	if !dryRun && len(result.Errors) > 0 {
+		s.writeError(w, r, withDetails(models.ErrImportRejected, result.Errors))
+		return
+	}
+
+	logging.FromContext(r.Context(), s.log).Debugf("Import of %s: %d rows, %d created, %d errors", kind, result.Rows, result.Created, len(result.Errors))
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ToImportResultResponse(result)); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
+}
+
+// Download an empty import file with the columns of a kind
+// (GET /import/{kind}/template)
+func (s *ServImplemented) GetImportKindTemplate(w http.ResponseWriter, r *http.Request, kind string, params rest.GetImportKindTemplateParams) {
+	if _, err := s.getUserID(r); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	header, err := importTemplate(kind)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	format := "csv"
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != "csv" && format != "xlsx" {
+		s.writeError(w, r, withDetails(models.ErrInvalidParameter, "format must be csv or xlsx"))
+		return
+	}
+
+	if err := writeImportTemplate(w, kind, format, header); err != nil {
+		logging.FromContext(r.Context(), s.log).Error(err)
+	}
+}
+
+// Export all of the company's data as a zip archive
+// (GET /portability/export)
+func (s *ServImplemented) GetPortabilityExport(w http.ResponseWriter, r *http.Request, params rest.GetPortabilityExportParams) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	format := models.ExportFormatJSONL
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != models.ExportFormatJSONL && format != models.ExportFormatCSV {
+		s.writeError(w, r, withDetails(models.ErrInvalidParameter, "format must be jsonl or csv"))
+		return
+	}
+	if err := validateOptionalPeriod(params.From, params.To); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	companyID, _ := ctx.Value(models.UserIDKey).(string)
+	filter := models.ExportFilter{IDCompany: companyID, From: params.From, To: params.To}
+	manifest := exportManifest{
+		Version:    exportArchiveVersion,
+		Format:     format,
+		CompanyID:  companyID,
+		From:       params.From,
+		To:         params.To,
+		ExportedAt: time.Now().UTC(),
+	}
+
+	// The archive is streamed as it is read, which may take longer than the
+	// server's write timeout.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"export-%s.zip\"", manifest.ExportedAt.Format("20060102-150405")))
+	w.WriteHeader(http.StatusOK)
+
+	// Once streaming has started errors can no longer be reported in the
+	// response; the archive is left without its manifest and central
+	// directory, so that it cannot be mistaken for a complete one.
+	archive := zip.NewWriter(w)
+	for _, entity := range models.PortableEntities {
+		name := exportFileName(entity, format)
+		file, err := archive.Create(name)
+		if err != nil {
+			logging.FromContext(r.Context(), s.log).Errorf("export of %s: %v", entity, err)
+			return
+		}
+		writer, count := newRecordWriter(format, file)
+		if err := s.service.ExportEntity(ctx, entity, filter, writer); err != nil {
+			logging.FromContext(r.Context(), s.log).Errorf("export of %s: %v", entity, err)
+			return
+		}
+		manifest.Entities = append(manifest.Entities, exportManifestEntity{Name: entity, File: name, Records: count()})
+	}
+
+	file, err := archive.Create(exportManifestName)
+	if err == nil {
+		err = json.NewEncoder(file).Encode(manifest)
+	}
+	if err == nil {
+		err = archive.Close()
+	}
+	if err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("export manifest: %v", err)
+		return
+	}
+
+	if err := s.service.AuditExport(ctx, filter, format); err != nil {
+		logging.FromContext(r.Context(), s.log).Error(err)
+	}
+}
+
+// Import an export archive into the company
+// (POST /portability/import)
+func (s *ServImplemented) PostPortabilityImport(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.getUserID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	// Archives of a whole fleet take longer to upload than the server's
+	// read timeout.
+	_ = http.NewResponseController(w).SetReadDeadline(time.Time{})
+
+	file, archive, err := spoolArchive(http.MaxBytesReader(w, r.Body, maxArchiveSize))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	manifest, err := readManifest(archive)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+
+	results, err := s.service.Restore(ctx, newArchiveSource(archive, manifest.Format))
+	if err != nil {
+		s.writeError(w, r, err)
 		return
 	}
+
+	res := make([]rest.RestoreResultResponse, len(results))
+	for i, val := range results {
+		res[i] = ToRestoreResultResponse(val)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logging.FromContext(r.Context(), s.log).Errorf("%v: %v", models.ErrFailedToEncodeResponse, err)
+	}
 }
 
 // User
@@ -1453,6 +3759,31 @@ func ToUserDetails(user models.User) rest.UserDetails {
 	}
 }
 
+// ApplyUserDetails overwrites the fields of user that are set in a partial update.
+func ApplyUserDetails(user *models.User, details rest.UserDetails) {
+	if details.Inn != nil {
+		user.INN = *details.Inn
+	}
+	if details.FirstName != nil {
+		user.Name = *details.FirstName
+	}
+	if details.LastName != nil {
+		user.Surname = *details.LastName
+	}
+	if details.Gender != nil {
+		user.Gender = *details.Gender
+	}
+	if details.Email != nil {
+		user.Login = *details.Email
+	}
+	if details.TimeZone != nil {
+		user.Timezone = *details.TimeZone
+	}
+	if details.Phone != nil {
+		user.Phone = *details.Phone
+	}
+}
+
 // Car
 func ToCar(idCompany string, AutoRegistration rest.AutoRegistration) models.Car {
 	return models.Car{
@@ -1499,6 +3830,25 @@ func ToNewWheel(new rest.WheelRegistration) models.Wheel {
 	}
 }
 
+func ToAutoWheel(wheel rest.AutoWheel) models.Wheel {
+	return models.Wheel{
+		AxisNumber:     wheel.AxleNumber,
+		Position:       wheel.WheelPosition,
+		SensorNumber:   wheel.SensorNumber,
+		Size:           wheel.TireSize,
+		Cost:           wheel.TireCost,
+		Brand:          wheel.TireBrand,
+		Model:          wheel.TireModel,
+		Mileage:        wheel.Mileage,
+		MinTemperature: wheel.MinTemperature,
+		MinPressure:    wheel.MinPressure,
+		MaxTemperature: wheel.MaxTemperature,
+		MaxPressure:    wheel.MaxPressure,
+		Ngp:            &wheel.Ngp,
+		Tkvh:           &wheel.Tkvh,
+	}
+}
+
 func ToWheel(wheel rest.WheelChange) models.Wheel {
 	return models.Wheel{
 		ID:             wheel.Id,
@@ -1582,6 +3932,72 @@ func ToNewDriver(idCompany string, new rest.DriverRegistration) models.Driver {
 		Phone:     new.Phone,
 		Birthday:  new.Birthday.Time,
 		Rating:    10,
+		Licence:   ToLicence(new.LicenceNumber, new.LicenceCategories, new.LicenceExpiresAt, new.MedicalExpiresAt),
+	}
+}
+
+func ToDriverUpdate(req rest.DriverUpdateRequest) models.Driver {
+	return models.Driver{
+		ID:       req.DriverId.String(),
+		Name:     req.Name,
+		Surname:  req.Surname,
+		Middle:   req.MiddleName,
+		Phone:    req.Phone,
+		Birthday: req.Birthday.Time,
+		Licence:  ToLicence(req.LicenceNumber, req.LicenceCategories, req.LicenceExpiresAt, req.MedicalExpiresAt),
+	}
+}
+
+func ToLicence(number *string, categories *[]string, licenceExpiresAt, medicalExpiresAt *openapi_types.Date) models.Licence {
+	licence := models.Licence{LicenceNumber: number}
+	if categories != nil {
+		licence.LicenceCategories = *categories
+	}
+	if licenceExpiresAt != nil {
+		licence.LicenceExpiresAt = &licenceExpiresAt.Time
+	}
+	if medicalExpiresAt != nil {
+		licence.MedicalExpiresAt = &medicalExpiresAt.Time
+	}
+	return licence
+}
+
+func ToDriverInfoResponse(id uuid.UUID, driver models.DriverInfoResponse) rest.DriverInfoResponse {
+	res := rest.DriverInfoResponse{
+		Id:            id,
+		Name:          driver.Name,
+		Surname:       driver.Surname,
+		MiddleName:    driver.MiddleName,
+		Phone:         driver.Phone,
+		Birthday:      driver.Birthday,
+		LicenceNumber: driver.LicenceNumber,
+		DeactivatedAt: driver.DeactivatedAt,
+	}
+	if driver.LicenceCategories != nil {
+		res.LicenceCategories = &driver.LicenceCategories
+	}
+	if driver.LicenceExpiresAt != nil {
+		res.LicenceExpiresAt = &openapi_types.Date{Time: *driver.LicenceExpiresAt}
+	}
+	if driver.MedicalExpiresAt != nil {
+		res.MedicalExpiresAt = &openapi_types.Date{Time: *driver.MedicalExpiresAt}
+	}
+	if driver.Rating != nil {
+		rating := ToDriverRatingResponse(*driver.Rating)
+		res.Rating = &rating
+	}
+	return res
+}
+
+func ToDriverDocumentResponse(doc models.DriverDocument) rest.DriverDocumentResponse {
+	return rest.DriverDocumentResponse{
+		Id:          uuid.MustParse(doc.ID),
+		DriverId:    uuid.MustParse(doc.IDDriver),
+		Type:        doc.Type,
+		FileName:    doc.FileName,
+		ContentType: doc.ContentType,
+		Size:        doc.Size,
+		CreatedAt:   doc.CreatedAt,
 	}
 }
 
@@ -1593,17 +4009,586 @@ func ToDriverResponse(driver models.DriverStatisticsResponse) rest.DriverStatist
 		Experience:     driver.Experience,
 		Rating:         driver.Rating,
 		WorkedTime:     driver.WorkedTime,
+		Rank:           driver.Rank,
+		Active:         driver.Active,
+	}
+}
+
+func ToDriverRatingResponse(rating models.DriverRating) rest.DriverRatingResponse {
+	return rest.DriverRatingResponse{
+		Rating:             rating.Rating,
+		Safety:             rating.Safety,
+		Smoothness:         rating.Smoothness,
+		SpeedDiscipline:    rating.SpeedDiscipline,
+		TireCare:           rating.TireCare,
+		Experience:         rating.Experience,
+		Breakages:          rating.Breakages,
+		HarshEvents:        rating.HarshEvents,
+		DrivenHours:        rating.DrivenSeconds / 3600,
+		SpeedingHours:      rating.SpeedingSeconds / 3600,
+		DistanceKm:         rating.DistanceKm,
+		UnderInflatedHours: rating.UnderInflatedSeconds / 3600,
+		WindowStart:        rating.WindowStart,
+		CalculatedAt:       rating.CalculatedAt,
+	}
+}
+
+func ToNotificationRule(req rest.NotificationRuleRequest) models.NotificationRule {
+	n := models.NotificationRule{
+		Name:        strings.TrimSpace(req.Name),
+		EventTypes:  []string{},
+		MinSeverity: req.MinSeverity,
+		CarIDs:      []string{},
+		Channel:     req.Channel,
+		Target:      strings.TrimSpace(req.Target),
+		QuietFrom:   req.QuietFrom,
+		QuietTo:     req.QuietTo,
+		Active:      req.Active == nil || *req.Active,
+	}
+	if req.EventTypes != nil {
+		n.EventTypes = *req.EventTypes
+	}
+	if req.CarIds != nil {
+		for _, id := range *req.CarIds {
+			n.CarIDs = append(n.CarIDs, id.String())
+		}
+	}
+	return n
+}
+
+// ToNotificationRuleRequest returns the fields of req other than its ID.
+func ToNotificationRuleRequest(req rest.NotificationRuleUpdateRequest) rest.NotificationRuleRequest {
+	return rest.NotificationRuleRequest{
+		Name:        req.Name,
+		EventTypes:  req.EventTypes,
+		MinSeverity: req.MinSeverity,
+		CarIds:      req.CarIds,
+		Channel:     req.Channel,
+		Target:      req.Target,
+		QuietFrom:   req.QuietFrom,
+		QuietTo:     req.QuietTo,
+		Active:      req.Active,
+	}
+}
+
+func ToNotificationRuleResponse(n models.NotificationRule) rest.NotificationRuleResponse {
+	carIDs := make([]uuid.UUID, len(n.CarIDs))
+	for i, id := range n.CarIDs {
+		carIDs[i] = uuid.MustParse(id)
+	}
+	eventTypes := n.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return rest.NotificationRuleResponse{
+		Id:          uuid.MustParse(n.ID),
+		Name:        n.Name,
+		EventTypes:  eventTypes,
+		MinSeverity: n.MinSeverity,
+		CarIds:      carIDs,
+		Channel:     n.Channel,
+		Target:      n.Target,
+		QuietFrom:   n.QuietFrom,
+		QuietTo:     n.QuietTo,
+		Active:      n.Active,
+		CreatedAt:   n.CreatedAt,
+		UpdatedAt:   n.UpdatedAt,
+	}
+}
+
+func ToNotificationDeliveryResponse(d models.NotificationDelivery) rest.NotificationDeliveryResponse {
+	return rest.NotificationDeliveryResponse{
+		Id:            uuid.MustParse(d.ID),
+		RuleId:        toUUIDPtr(d.IDRule),
+		Channel:       d.Channel,
+		Target:        d.Target,
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		LastError:     d.LastError,
+		SentAt:        d.SentAt,
+		CreatedAt:     d.CreatedAt,
+	}
+}
+
+// Webhooks
+func ToWebhookEndpoint(req rest.WebhookRequest) models.WebhookEndpoint {
+	e := models.WebhookEndpoint{
+		URL:         strings.TrimSpace(req.Url),
+		Description: req.Description,
+		EventTypes:  []string{},
+		Active:      req.Active == nil || *req.Active,
+	}
+	if req.EventTypes != nil {
+		e.EventTypes = *req.EventTypes
+	}
+	return e
+}
+
+// ToWebhookRequest returns the fields of req other than its ID.
+func ToWebhookRequest(req rest.WebhookUpdateRequest) rest.WebhookRequest {
+	return rest.WebhookRequest{
+		Url:         req.Url,
+		Description: req.Description,
+		EventTypes:  req.EventTypes,
+		Active:      req.Active,
+	}
+}
+
+// ToWebhookResponse leaves the secret of e out.
+func ToWebhookResponse(e models.WebhookEndpoint) rest.WebhookResponse {
+	eventTypes := e.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return rest.WebhookResponse{
+		Id:          uuid.MustParse(e.ID),
+		Url:         e.URL,
+		Description: e.Description,
+		EventTypes:  eventTypes,
+		Active:      e.Active,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func ToWebhookDeliveryResponse(d models.WebhookDelivery) rest.WebhookDeliveryResponse {
+	// Payloads are written by the service as JSON objects.
+	var payload map[string]interface{}
+	_ = json.Unmarshal(d.Payload, &payload)
+
+	return rest.WebhookDeliveryResponse{
+		Id:             uuid.MustParse(d.ID),
+		WebhookId:      uuid.MustParse(d.IDEndpoint),
+		EventId:        uuid.MustParse(d.IDEvent),
+		EventType:      d.EventType,
+		Payload:        payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastError:      d.LastError,
+		LastStatusCode: d.LastStatusCode,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+func ToWebhookAttemptResponse(a models.WebhookAttempt) rest.WebhookAttemptResponse {
+	return rest.WebhookAttemptResponse{
+		Id:          uuid.MustParse(a.ID),
+		AttemptedAt: a.AttemptedAt,
+		StatusCode:  a.StatusCode,
+		Error:       a.Error,
+		DurationMs:  int(a.Duration.Milliseconds()),
 	}
 }
 
 func ToNotificationListResponse(new models.NotificationListItem) rest.NotificationListResponse {
 	return rest.NotificationListResponse{
-		Id:           uuid.MustParse(new.ID),
-		StateNumber:  new.StateNumber,
-		Brand:        new.Brand,
-		BreakageType: new.BreakageType,
-		CreatedAt:    new.CreatedAt,
+		Id:             uuid.MustParse(new.ID),
+		Type:           new.Type,
+		Severity:       new.Severity,
+		Status:         new.Status,
+		CarId:          toUUIDPtr(new.IDCar),
+		Note:           new.Note,
+		StateNumber:    new.StateNumber,
+		Brand:          new.Brand,
+		BreakageType:   new.BreakageType,
+		BreakageStatus: new.BreakageStatus,
+		CreatedAt:      new.CreatedAt,
+	}
+}
+
+func ToNotificationInfoResponse(n models.NotificationInfo) rest.NotificationInfoResponse {
+	res := rest.NotificationInfoResponse{
+		Id:          uuid.MustParse(n.ID),
+		Type:        n.Type,
+		Severity:    n.Severity,
+		Status:      n.Status,
+		CarId:       toUUIDPtr(n.IDCar),
+		Description: n.Description,
+		DriverName:  n.DriverName,
+		Location: []float32{
+			n.Location.Latitude,
+			n.Location.Longitude,
+		},
+		BreakageStatus: n.BreakageStatus,
+		CreatedAt:      n.CreatedAt,
+	}
+
+	if b := n.Breakage; b != nil {
+		res.Breakage = &rest.BreakageNotice{
+			Id:          uuid.MustParse(b.ID),
+			Type:        b.Type,
+			TypeId:      toUUIDPtr(b.TypeID),
+			Status:      b.Status,
+			Description: b.Description,
+			DriverId:    b.DriverID,
+			DriverName:  b.DriverName,
+			Latitude:    b.Latitude,
+			Longitude:   b.Longitude,
+		}
+	}
+	if v := n.WorkViolation; v != nil {
+		res.WorkViolation = &rest.WorkViolationNotice{
+			Id:            uuid.MustParse(v.ID),
+			DriverId:      uuid.MustParse(v.DriverID),
+			DriverName:    v.DriverName,
+			Type:          v.Type,
+			PeriodStart:   v.PeriodStart,
+			PeriodEnd:     v.PeriodEnd,
+			LimitMinutes:  v.LimitMinutes,
+			ActualMinutes: v.ActualMinutes,
+		}
+	}
+	if e := n.DocumentExpiry; e != nil {
+		res.DocumentExpiry = &rest.DocumentExpiryNotice{
+			Id:         uuid.MustParse(e.ID),
+			DriverId:   uuid.MustParse(e.DriverID),
+			DriverName: e.DriverName,
+			Document:   e.Document,
+			ExpiresAt:  e.ExpiresAt,
+		}
+	}
+	if m := n.MaintenanceDue; m != nil {
+		res.MaintenanceDue = &rest.MaintenanceDueNotice{
+			TaskId:     uuid.MustParse(m.TaskID),
+			PlanId:     uuid.MustParse(m.PlanID),
+			PlanName:   m.PlanName,
+			WheelId:    toUUIDPtr(m.WheelID),
+			Reason:     m.Reason,
+			DueAt:      m.DueAt,
+			DueMileage: m.DueMileage,
+			Status:     m.Status,
+		}
+	}
+	if t := n.SensorThreshold; t != nil {
+		res.SensorThreshold = &rest.SensorThresholdNotice{
+			WheelId:      uuid.MustParse(t.WheelID),
+			DeviceNumber: t.DeviceNumber,
+			SensorNumber: t.SensorNumber,
+			Metric:       t.Metric,
+			Value:        t.Value,
+			Min:          t.Min,
+			Max:          t.Max,
+			MeasuredAt:   t.MeasuredAt,
+		}
+	}
+	if o := n.SensorOffline; o != nil {
+		res.SensorOffline = &rest.SensorOfflineNotice{
+			DeviceNumber: o.DeviceNumber,
+			LastSeenAt:   o.LastSeenAt,
+		}
+	}
+	if g := n.Geofence; g != nil {
+		res.Geofence = &rest.GeofenceNotice{
+			GeofenceId: uuid.MustParse(g.GeofenceID),
+			Geofence:   g.Geofence,
+			Transition: g.Transition,
+			Latitude:   g.Latitude,
+			Longitude:  g.Longitude,
+			OccurredAt: g.OccurredAt,
+		}
+	}
+	return res
+}
+
+// Breakage workflow
+func ToBreakageStatuses(param *string) []string {
+	var statuses []string
+	if param != nil {
+		for _, status := range strings.Split(*param, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses
+}
+
+func ToBreakageRepair(req rest.BreakageUpdateRequest) models.BreakageRepair {
+	return models.BreakageRepair{
+		Assignee:    req.Assignee,
+		RepairNotes: req.RepairNotes,
+		Cost:        req.Cost,
+	}
+}
+
+func ToBreakageResponse(b models.BreakageDetails) rest.BreakageResponse {
+	resp := rest.BreakageResponse{
+		Id:              uuid.MustParse(b.ID),
+		CarId:           uuid.MustParse(b.IDCar),
+		StateNumber:     b.StateNumber,
+		DriverName:      b.DriverName,
+		Point:           []float32{b.Location.Latitude, b.Location.Longitude},
+		Type:            b.Type,
+		TypeName:        b.TypeName,
+		Severity:        b.Severity,
+		MustStop:        b.MustStop,
+		Description:     b.Description,
+		Status:          b.Status,
+		Assignee:        b.Assignee,
+		RepairNotes:     b.RepairNotes,
+		Cost:            b.Cost,
+		Datetime:        b.CreatedAt,
+		ResolvedAt:      b.ResolvedAt,
+		UpdatedAt:       b.UpdatedAt,
+		DowntimeMinutes: b.DowntimeMinutes,
+	}
+	if b.IDType != nil {
+		id := uuid.MustParse(*b.IDType)
+		resp.TypeId = &id
+	}
+	return resp
+}
+
+func ToBreakageType(req rest.BreakageTypeRequest) models.BreakageType {
+	t := models.BreakageType{
+		Code:          strings.TrimSpace(req.Code),
+		Name:          strings.TrimSpace(req.Name),
+		Severity:      req.Severity,
+		MustStop:      req.MustStop != nil && *req.MustStop,
+		Component:     req.Component,
+		WheelPosition: req.WheelPosition,
+		DeviceCodes:   []string{},
+	}
+	if req.DeviceCodes != nil {
+		for _, code := range *req.DeviceCodes {
+			if code = strings.TrimSpace(code); code != "" && !slices.Contains(t.DeviceCodes, code) {
+				t.DeviceCodes = append(t.DeviceCodes, code)
+			}
+		}
+	}
+	return t
+}
+
+// ToBreakageTypeRequest returns the fields of req other than its ID.
+func ToBreakageTypeRequest(req rest.BreakageTypeUpdateRequest) rest.BreakageTypeRequest {
+	return rest.BreakageTypeRequest{
+		Code:          req.Code,
+		Name:          req.Name,
+		Severity:      req.Severity,
+		MustStop:      req.MustStop,
+		Component:     req.Component,
+		WheelPosition: req.WheelPosition,
+		DeviceCodes:   req.DeviceCodes,
+	}
+}
+
+func ToBreakageTypeResponse(t models.BreakageType) rest.BreakageTypeResponse {
+	return rest.BreakageTypeResponse{
+		Id:            uuid.MustParse(t.ID),
+		Code:          t.Code,
+		Name:          t.Name,
+		Severity:      t.Severity,
+		MustStop:      t.MustStop,
+		Component:     t.Component,
+		WheelPosition: t.WheelPosition,
+		DeviceCodes:   t.DeviceCodes,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+	}
+}
+
+func ToBreakageStatsResponse(stats models.BreakageStats) rest.BreakageStatsResponse {
+	return rest.BreakageStatsResponse{
+		Key:           stats.Key,
+		Name:          stats.Name,
+		Severity:      stats.Severity,
+		Count:         stats.Count,
+		MustStopCount: stats.MustStopCount,
+		LastAt:        stats.LastAt,
+	}
+}
+
+func ToMaintenancePlan(req rest.MaintenancePlanRequest) models.MaintenancePlan {
+	p := models.MaintenancePlan{
+		Name:            strings.TrimSpace(req.Name),
+		Kind:            req.Kind,
+		Trigger:         req.Trigger,
+		MileageInterval: req.MileageInterval,
+		IntervalDays:    req.IntervalDays,
+		Condition:       req.Condition,
+		Active:          req.Active == nil || *req.Active,
+	}
+	if req.CarId != nil {
+		id := req.CarId.String()
+		p.IDCar = &id
+	}
+	return p
+}
+
+// ToMaintenancePlanRequest returns the fields of req other than its ID.
+func ToMaintenancePlanRequest(req rest.MaintenancePlanUpdateRequest) rest.MaintenancePlanRequest {
+	return rest.MaintenancePlanRequest{
+		CarId:           req.CarId,
+		Name:            req.Name,
+		Kind:            req.Kind,
+		Trigger:         req.Trigger,
+		MileageInterval: req.MileageInterval,
+		IntervalDays:    req.IntervalDays,
+		Condition:       req.Condition,
+		Active:          req.Active,
+	}
+}
+
+func ToMaintenancePlanResponse(p models.MaintenancePlan) rest.MaintenancePlanResponse {
+	return rest.MaintenancePlanResponse{
+		Id:              uuid.MustParse(p.ID),
+		CarId:           toUUIDPtr(p.IDCar),
+		Name:            p.Name,
+		Kind:            p.Kind,
+		Trigger:         p.Trigger,
+		MileageInterval: p.MileageInterval,
+		IntervalDays:    p.IntervalDays,
+		Condition:       p.Condition,
+		Active:          p.Active,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+func ToMaintenanceTaskResponse(t models.MaintenanceDue) rest.MaintenanceTaskResponse {
+	return rest.MaintenanceTaskResponse{
+		Id:            uuid.MustParse(t.ID),
+		PlanId:        uuid.MustParse(t.IDPlan),
+		PlanName:      t.PlanName,
+		Kind:          t.Kind,
+		CarId:         uuid.MustParse(t.IDCar),
+		StateNumber:   t.StateNumber,
+		WheelId:       toUUIDPtr(t.IDWheel),
+		WheelPosition: t.WheelPosition,
+		Reason:        t.Reason,
+		Status:        t.Status,
+		DueAt:         t.DueAt,
+		DueMileage:    t.DueMileage,
+		Mileage:       t.Mileage,
+		Overdue:       t.Overdue,
+		WorkOrderId:   toUUIDPtr(t.IDWorkOrder),
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+func ToWorkOrder(req rest.WorkOrderRequest) models.WorkOrder {
+	return models.WorkOrder{
+		IDBreakage: fromUUIDPtr(req.BreakageId),
+		IDTask:     fromUUIDPtr(req.TaskId),
+		IDWheel:    fromUUIDPtr(req.WheelId),
+		Title:      strings.TrimSpace(req.Title),
+		Assignee:   req.Assignee,
+		Notes:      req.Notes,
+	}
+}
+
+func ToWorkOrderCompletion(req rest.WorkOrderCompleteRequest) models.WorkOrderCompletion {
+	c := models.WorkOrderCompletion{
+		IDWorkOrder:  req.Id.String(),
+		Notes:        req.Notes,
+		Cost:         req.Cost,
+		ResetMileage: req.ResetMileage != nil && *req.ResetMileage,
+	}
+	if req.Tire != nil {
+		c.Tire = &models.TireReplacement{
+			Brand: strings.TrimSpace(req.Tire.Brand),
+			Model: strings.TrimSpace(req.Tire.Model),
+			Size:  req.Tire.Size,
+		}
+		if req.Tire.Cost != nil {
+			c.Tire.Cost = *req.Tire.Cost
+		}
+	}
+	return c
+}
+
+func ToWorkOrderResponse(o models.WorkOrder) rest.WorkOrderResponse {
+	return rest.WorkOrderResponse{
+		Id:          uuid.MustParse(o.ID),
+		CarId:       uuid.MustParse(o.IDCar),
+		WheelId:     toUUIDPtr(o.IDWheel),
+		BreakageId:  toUUIDPtr(o.IDBreakage),
+		TaskId:      toUUIDPtr(o.IDTask),
+		Title:       o.Title,
+		Status:      o.Status,
+		Assignee:    o.Assignee,
+		Notes:       o.Notes,
+		Cost:        o.Cost,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+		CompletedAt: o.CompletedAt,
+	}
+}
+
+// toUUIDPtr parses an optional ID read from the database.
+func toUUIDPtr(id *string) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	parsed := uuid.MustParse(*id)
+	return &parsed
+}
+
+// fromUUIDPtr formats an optional ID of a request.
+func fromUUIDPtr(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	formatted := id.String()
+	return &formatted
+}
+
+func ToBreakageStatusChangeResponse(entry models.BreakageHistoryEntry) rest.BreakageStatusChangeResponse {
+	return rest.BreakageStatusChangeResponse{
+		Id:         uuid.MustParse(entry.ID),
+		FromStatus: entry.FromStatus,
+		ToStatus:   entry.ToStatus,
+		Note:       entry.Note,
+		ChangedBy:  uuid.MustParse(entry.ChangedBy),
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+// Audit
+func ToAuditFilter(actorID *uuid.UUID, action, resourceType, resourceID *string, from, to *time.Time) models.AuditFilter {
+	filter := models.AuditFilter{
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		From:         from,
+		To:           to,
+	}
+	if actorID != nil {
+		id := actorID.String()
+		filter.ActorID = &id
+	}
+	return filter
+}
+
+func ToAuditEntryResponse(entry models.AuditEntry) rest.AuditEntryResponse {
+	changes := make(map[string]rest.AuditChange, len(entry.Changes))
+	for key, val := range entry.Changes {
+		changes[key] = rest.AuditChange{Before: val.Before, After: val.After}
+	}
+
+	res := rest.AuditEntryResponse{
+		Id:           uuid.MustParse(entry.ID),
+		Action:       entry.Action,
+		ResourceType: entry.ResourceType,
+		Changes:      changes,
+		CreatedAt:    entry.CreatedAt,
+		Metadata: &rest.AuditMetadata{
+			Ip:        &entry.Metadata.IP,
+			UserAgent: &entry.Metadata.UserAgent,
+			Method:    &entry.Metadata.Method,
+			Path:      &entry.Metadata.Path,
+		},
+	}
+	if entry.ActorID != "" {
+		res.ActorId = &entry.ActorID
 	}
+	if entry.ResourceID != "" {
+		res.ResourceId = &entry.ResourceID
+	}
+	return res
 }
 
 func validateToken(tokenStr string, jwtSecret string) (*models.Claims, error) {
@@ -1641,14 +4626,277 @@ func getTokenFromHeader(r *http.Request) (string, error) {
 func (s *ServImplemented) getUserID(r *http.Request) (context.Context, error) {
 	tokenStr, err := getTokenFromHeader(r)
 	if err != nil {
-		return nil, fmt.Errorf("authorization: %w", err)
+		return nil, fmt.Errorf("%w: %v", models.ErrUnauthorized, err)
 	}
 
 	claims, err := validateToken(tokenStr, s.conf.SigningKey)
 	if err != nil {
-		return nil, fmt.Errorf("authorization: %w", err)
+		return nil, fmt.Errorf("%w: %v", models.ErrUnauthorized, err)
 	}
 
-	ctx := context.WithValue(r.Context(), "user_id", claims.UserID)
+	logging.SetCompanyID(r.Context(), claims.UserID)
+	ctx := context.WithValue(r.Context(), models.UserIDKey, claims.UserID)
 	return ctx, nil
 }
+
+// issueTokens creates an access/refresh pair and stores the refresh token for the user.
+func (s *ServImplemented) issueTokens(ctx context.Context, userID string) (string, string, error) {
+	accessToken, err := s.auth.GenerateAccessToken(userID)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, exp, err := s.auth.GenerateRefreshToken(userID)
+	if err != nil {
+		return "", "", err
+	}
+
+	token, err := s.auth.GetRefreshToken(ctx, userID)
+	if err == models.ErrNoContent {
+		err = nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	if token == "" {
+		err = s.auth.SaveRefreshToken(ctx, userID, refreshToken, *exp)
+	} else {
+		err = s.auth.UpdateRefreshToken(ctx, userID, refreshToken, *exp)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+func ToSearchQuery(params rest.GetSearchParams) models.SearchQuery {
+	query := models.SearchQuery{
+		Text:  strings.TrimSpace(params.Q),
+		Limit: defaultSearchLimit,
+	}
+	if params.Types != nil {
+		for _, typ := range strings.Split(*params.Types, ",") {
+			if typ = strings.TrimSpace(typ); typ != "" {
+				query.Types = append(query.Types, typ)
+			}
+		}
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	return query
+}
+
+func ToSearchResultResponse(res models.SearchResult) rest.SearchResultResponse {
+	resp := rest.SearchResultResponse{
+		Type:         res.Type,
+		Id:           uuid.MustParse(res.ID),
+		Title:        res.Title,
+		MatchedField: res.MatchedField,
+		Score:        res.Score,
+	}
+	if res.Subtitle != "" {
+		resp.Subtitle = &res.Subtitle
+	}
+	if res.IDCar != "" {
+		resp.CarId = &res.IDCar
+	}
+	return resp
+}
+
+func ToImportResultResponse(result models.ImportResult) rest.ImportResultResponse {
+	errs := make([]rest.ImportRowError, len(result.Errors))
+	for i, e := range result.Errors {
+		errs[i] = rest.ImportRowError{Row: e.Row, Column: e.Column, Message: e.Message}
+	}
+	return rest.ImportResultResponse{
+		Kind:    result.Kind,
+		DryRun:  result.DryRun,
+		Rows:    result.Rows,
+		Created: result.Created,
+		Errors:  errs,
+	}
+}
+
+func ToRestoreResultResponse(res models.RestoreResult) rest.RestoreResultResponse {
+	return rest.RestoreResultResponse{
+		Entity:  res.Entity,
+		Read:    res.Read,
+		Created: res.Created,
+		Skipped: res.Skipped,
+	}
+}
+
+func ToDriverAssignment(req rest.DriverAssignmentRequest) models.DriverAssignment {
+	assignment := models.DriverAssignment{
+		IDDriver: req.DriverId.String(),
+		IDCar:    req.CarId.String(),
+	}
+	if req.StartedAt != nil {
+		assignment.StartedAt = *req.StartedAt
+	}
+	return assignment
+}
+
+func ToAssignmentFilter(params rest.GetDriverAssignmentListParams) models.AssignmentFilter {
+	filter := models.AssignmentFilter{From: params.From, To: params.To}
+	if params.DriverId != nil {
+		id := params.DriverId.String()
+		filter.IDDriver = &id
+	}
+	if params.CarId != nil {
+		id := params.CarId.String()
+		filter.IDCar = &id
+	}
+	return filter
+}
+
+func ToDriverAssignmentResponse(assignment models.DriverAssignment) rest.DriverAssignmentResponse {
+	return rest.DriverAssignmentResponse{
+		Id:          uuid.MustParse(assignment.ID),
+		DriverId:    uuid.MustParse(assignment.IDDriver),
+		CarId:       uuid.MustParse(assignment.IDCar),
+		StateNumber: assignment.StateNumber,
+		StartedAt:   assignment.StartedAt,
+		EndedAt:     assignment.EndedAt,
+	}
+}
+
+func ToPositions(positions []models.Position) []rest.Position {
+	res := make([]rest.Position, len(positions))
+	for i, val := range positions {
+		res[i] = rest.Position{
+			Point:     []float32{val.Location.Latitude, val.Location.Longitude},
+			CreatedAt: val.CreatedAt,
+		}
+		if val.IDDriver != nil {
+			id := uuid.MustParse(*val.IDDriver)
+			res[i].DriverId = &id
+		}
+	}
+	return res
+}
+
+// Geofences
+func ToGeofence(req rest.GeofenceRequest) models.Geofence {
+	return models.Geofence{
+		Name:   strings.TrimSpace(req.Name),
+		Center: models.Point{Latitude: float32(req.Latitude), Longitude: float32(req.Longitude)},
+		Radius: req.Radius,
+		Active: req.Active == nil || *req.Active,
+	}
+}
+
+// ToGeofenceRequest returns the fields of req other than its ID.
+func ToGeofenceRequest(req rest.GeofenceUpdateRequest) rest.GeofenceRequest {
+	return rest.GeofenceRequest{
+		Name:      req.Name,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Radius:    req.Radius,
+		Active:    req.Active,
+	}
+}
+
+func ToGeofenceResponse(g models.Geofence) rest.GeofenceResponse {
+	return rest.GeofenceResponse{
+		Id:        uuid.MustParse(g.ID),
+		Name:      g.Name,
+		Latitude:  float64(g.Center.Latitude),
+		Longitude: float64(g.Center.Longitude),
+		Radius:    g.Radius,
+		Active:    g.Active,
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}
+}
+
+// Work sessions
+func ToWorkSession(req rest.WorkSessionRequest) models.WorkSession {
+	session := models.WorkSession{
+		IDDriver: req.DriverId.String(),
+		EndedAt:  req.EndedAt,
+	}
+	if req.CarId != nil {
+		id := req.CarId.String()
+		session.IDCar = &id
+	}
+	if req.StartedAt != nil {
+		session.StartedAt = *req.StartedAt
+	}
+	return session
+}
+
+func ToWorkSessionFilter(params rest.GetDriverWorkSessionListParams) models.WorkSessionFilter {
+	filter := models.WorkSessionFilter{From: params.From, To: params.To}
+	if params.DriverId != nil {
+		id := params.DriverId.String()
+		filter.IDDriver = &id
+	}
+	return filter
+}
+
+func ToWorkSessionResponse(session models.WorkSession) rest.WorkSessionResponse {
+	res := rest.WorkSessionResponse{
+		Id:        uuid.MustParse(session.ID),
+		DriverId:  uuid.MustParse(session.IDDriver),
+		Source:    session.Source,
+		StartedAt: session.StartedAt,
+		EndedAt:   session.EndedAt,
+	}
+	if session.IDCar != nil {
+		id := uuid.MustParse(*session.IDCar)
+		res.CarId = &id
+		res.StateNumber = &session.StateNumber
+	}
+	return res
+}
+
+func ToWorkTimeSummaryResponse(summary models.WorkSummary) rest.WorkTimeSummaryResponse {
+	res := rest.WorkTimeSummaryResponse{
+		DriverId: uuid.MustParse(summary.IDDriver),
+		Days:     make([]rest.WorkDayResponse, len(summary.Days)),
+		Weeks:    make([]rest.WorkWeekResponse, len(summary.Weeks)),
+	}
+	for i, day := range summary.Days {
+		res.Days[i] = rest.WorkDayResponse{
+			Date:               openapi_types.Date{Time: day.Date},
+			FirstStart:         day.FirstStart,
+			LastEnd:            day.LastEnd,
+			Periods:            day.Periods,
+			WorkedMinutes:      int(day.Worked.Minutes()),
+			LongestRestMinutes: int(day.LongestRest.Minutes()),
+		}
+	}
+	for i, week := range summary.Weeks {
+		res.Weeks[i] = rest.WorkWeekResponse{
+			Start:         openapi_types.Date{Time: week.Start},
+			WorkedMinutes: int(week.Worked.Minutes()),
+		}
+	}
+	return res
+}
+
+func ToWorkViolationFilter(params rest.GetDriverWorkViolationListParams) models.WorkViolationFilter {
+	filter := models.WorkViolationFilter{Type: params.Type, From: params.From, To: params.To}
+	if params.DriverId != nil {
+		id := params.DriverId.String()
+		filter.IDDriver = &id
+	}
+	return filter
+}
+
+func ToWorkViolationResponse(v models.WorkViolation) rest.WorkViolationResponse {
+	return rest.WorkViolationResponse{
+		Id:            uuid.MustParse(v.ID),
+		DriverId:      uuid.MustParse(v.IDDriver),
+		Type:          v.Type,
+		PeriodStart:   v.PeriodStart,
+		PeriodEnd:     v.PeriodEnd,
+		LimitMinutes:  v.LimitMinutes,
+		ActualMinutes: v.ActualMinutes,
+		Note:          v.Note,
+		CreatedAt:     v.CreatedAt,
+	}
+}
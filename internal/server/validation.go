@@ -0,0 +1,817 @@
+package server
+
+import (
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/VikaPaz/algalar/internal/notify"
+	"github.com/VikaPaz/algalar/internal/server/rest"
+	"github.com/google/uuid"
+)
+
+// The limits below mirror the constraints declared in docs/swagger.yaml.
+const (
+	minPasswordLength  = 8
+	maxAxleCount       = 10
+	minTimezone        = -12
+	maxTimezone        = 14
+	maxPageLimit       = 1000
+	minSearchLength    = 2
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	maxWorkTimeDays    = 366
+	maxLicenceNumber   = 100
+	maxFileName        = 255
+	maxAssignee        = 100
+	maxBreakageCode    = 100
+	maxBreakageName    = 100
+	maxRepairNotes     = 1000
+	maxPlanName        = 100
+	maxWorkOrderTitle  = 255
+	maxTireName        = 100
+	maxRuleName        = 100
+	maxRuleTarget      = 255
+	maxWebhookURL      = 2048
+	maxWebhookDesc     = 255
+	maxGeofenceName    = 100
+	minGeofenceRadius  = 10
+	maxGeofenceRadius  = 100000
+	// maxDocumentFileSize is the largest driver document accepted, in bytes.
+	maxDocumentFileSize = 10 << 20
+)
+
+var innPattern = regexp.MustCompile(`^(\d{10}|\d{12})$`)
+
+// phonePattern matches a phone number in E.164 format.
+var phonePattern = regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+
+// FieldError describes a single invalid field of a request.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// validator collects field errors so that a client gets every problem with a
+// request at once instead of fixing them one by one.
+type validator struct {
+	errs []FieldError
+}
+
+func (v *validator) add(field, format string, args ...any) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) check(ok bool, field, format string, args ...any) {
+	if !ok {
+		v.add(field, format, args...)
+	}
+}
+
+func (v *validator) required(field, value string) bool {
+	if value == "" {
+		v.add(field, "is required")
+		return false
+	}
+	return true
+}
+
+func (v *validator) email(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if _, err := mail.ParseAddress(value); err != nil {
+		v.add(field, "must be a valid email address")
+	}
+}
+
+func (v *validator) password(field, value string) {
+	v.check(len(value) >= minPasswordLength, field, "must be at least %d characters long", minPasswordLength)
+}
+
+func (v *validator) inn(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	v.check(innPattern.MatchString(value), field, "must consist of 10 or 12 digits")
+}
+
+func (v *validator) timezone(field string, value int) {
+	v.check(value >= minTimezone && value <= maxTimezone, field, "must be between %d and %d", minTimezone, maxTimezone)
+}
+
+func (v *validator) uuid(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if _, err := uuid.Parse(value); err != nil {
+		v.add(field, "must be a valid UUID")
+	}
+}
+
+func (v *validator) timestamp(field string, value time.Time) {
+	v.check(!value.IsZero(), field, "is required")
+}
+
+func (v *validator) point(field string, point []float32) {
+	if len(point) != 2 {
+		v.add(field, "must contain exactly two coordinates: latitude and longitude")
+		return
+	}
+	v.check(point[0] >= -90 && point[0] <= 90, field+"[0]", "latitude must be between -90 and 90")
+	v.check(point[1] >= -180 && point[1] <= 180, field+"[1]", "longitude must be between -180 and 180")
+}
+
+func (v *validator) page(limit, offset int) {
+	v.check(limit >= 0 && limit <= maxPageLimit, "limit", "must be between 0 and %d", maxPageLimit)
+	v.check(offset >= 0, "offset", "must not be negative")
+}
+
+func (v *validator) period(fromField string, from time.Time, toField string, to time.Time) {
+	v.check(!to.Before(from), toField, "must not be before %s", fromField)
+}
+
+// err returns nil when the request is valid, otherwise ErrInvalidInput with
+// the collected field errors as details.
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return withDetails(models.ErrInvalidInput, v.errs)
+}
+
+func validateLogin(req rest.LoginRequest) error {
+	var v validator
+	v.email("email", string(req.Email))
+	v.required("password", req.Password)
+	return v.err()
+}
+
+func validateUserRegistration(req rest.UserRegistration) error {
+	var v validator
+	v.email("email", req.Email)
+	v.password("password", req.Password)
+	v.required("firstName", req.FirstName)
+	v.required("lastName", req.LastName)
+	v.required("gender", req.Gender)
+	v.required("phone", req.Phone)
+	v.inn("inn", req.Inn)
+	v.timezone("timeZone", req.TimeZone)
+	return v.err()
+}
+
+// validateUserDetails checks only the fields present in a partial update.
+func validateUserDetails(req rest.UserDetails) error {
+	var v validator
+	if req.Email != nil {
+		v.email("email", *req.Email)
+	}
+	if req.FirstName != nil {
+		v.required("firstName", *req.FirstName)
+	}
+	if req.LastName != nil {
+		v.required("lastName", *req.LastName)
+	}
+	if req.Gender != nil {
+		v.required("gender", *req.Gender)
+	}
+	if req.Phone != nil {
+		v.required("phone", *req.Phone)
+	}
+	if req.Inn != nil {
+		v.inn("inn", *req.Inn)
+	}
+	if req.TimeZone != nil {
+		v.timezone("timeZone", *req.TimeZone)
+	}
+	return v.err()
+}
+
+func validateUpdatePassword(req rest.UpdatePassword) error {
+	var v validator
+	v.password("newPassword", req.NewPassword)
+	return v.err()
+}
+
+func validateAutoRegistration(req rest.AutoRegistration) error {
+	var v validator
+	validateAuto(&v, req)
+	if req.Wheels != nil {
+		type placement struct{ axle, position int }
+		taken := make(map[placement]bool)
+		for i, w := range *req.Wheels {
+			var wv validator
+			wheel := ToAutoWheel(w)
+			validateWheel(&wv, wheel)
+			wv.check(wheel.AxisNumber <= req.AxleCount, "axleNumber", "must not exceed the car's axle count of %d", req.AxleCount)
+			p := placement{wheel.AxisNumber, wheel.Position}
+			wv.check(!taken[p], "wheelPosition", "is taken by another wheel on axle %d", wheel.AxisNumber)
+			taken[p] = true
+			for _, e := range wv.errs {
+				v.add(fmt.Sprintf("wheels[%d].%s", i, e.Field), "%s", e.Message)
+			}
+		}
+	}
+	return v.err()
+}
+
+func validateAuto(v *validator, req rest.AutoRegistration) {
+	v.required("deviceNumber", req.DeviceNumber)
+	v.required("uniqueId", req.UniqueId)
+	v.required("autoType", req.AutoType)
+	v.required("stateNumber", req.StateNumber)
+	v.required("brand", req.Brand)
+	v.check(req.AxleCount >= 1 && req.AxleCount <= maxAxleCount, "axleCount", "must be between 1 and %d", maxAxleCount)
+}
+
+// validateWheel checks the fields shared by wheel registration, update and
+// import, which refer to the car differently. The axle number is checked
+// against the car in validateWheelPlacement.
+func validateWheel(v *validator, wheel models.Wheel) {
+	v.check(wheel.AxisNumber >= 1, "axleNumber", "must be at least 1")
+	v.check(wheel.Position >= 1, "wheelPosition", "must be at least 1")
+	v.required("sensorNumber", wheel.SensorNumber)
+	v.check(wheel.Size > 0, "tireSize", "must be positive")
+	v.check(wheel.Cost >= 0, "tireCost", "must not be negative")
+	v.check(wheel.Mileage >= 0, "mileage", "must not be negative")
+	v.check(wheel.MinPressure >= 0, "minPressure", "must not be negative")
+	v.check(wheel.MinPressure < wheel.MaxPressure, "maxPressure", "must be greater than minPressure")
+	v.check(wheel.MinTemperature < wheel.MaxTemperature, "maxTemperature", "must be greater than minTemperature")
+	if wheel.Ngp != nil {
+		v.check(*wheel.Ngp >= 0, "ngp", "must not be negative")
+	}
+	if wheel.Tkvh != nil {
+		v.check(*wheel.Tkvh >= 0, "tkvh", "must not be negative")
+	}
+}
+
+func validateWheelRegistration(req rest.WheelRegistration) error {
+	var v validator
+	v.uuid("autoId", req.AutoId)
+	validateWheel(&v, ToNewWheel(req))
+	return v.err()
+}
+
+func validateWheelChange(req rest.WheelChange) error {
+	var v validator
+	v.required("id", req.Id)
+	v.uuid("autoId", req.AutoId)
+	validateWheel(&v, ToWheel(req))
+	return v.err()
+}
+
+// validateWheelPlacement checks that the wheel is mounted on an existing axle
+// of the car.
+func validateWheelPlacement(car models.Car, axleNumber int) error {
+	var v validator
+	v.check(axleNumber <= car.CountAxis, "axleNumber", "must not exceed the car's axle count of %d", car.CountAxis)
+	return v.err()
+}
+
+// validateSensorData requires every field: the schema marks them optional,
+// but a reading without any of them cannot be stored.
+func validateSensorData(req rest.NewSensorData) error {
+	var v validator
+	if req.DeviceNumber == nil {
+		v.add("device_number", "is required")
+	} else {
+		v.required("device_number", *req.DeviceNumber)
+	}
+	if req.SensorNumber == nil {
+		v.add("sensor_number", "is required")
+	} else {
+		v.required("sensor_number", *req.SensorNumber)
+	}
+	if req.Pressure == nil {
+		v.add("pressure", "is required")
+	} else {
+		v.check(*req.Pressure >= 0, "pressure", "must not be negative")
+	}
+	if req.Temperature == nil {
+		v.add("temperature", "is required")
+	}
+	if req.Time == nil {
+		v.add("time", "is required")
+	} else {
+		v.timestamp("time", *req.Time)
+	}
+	return v.err()
+}
+
+func validateDriverRegistration(req rest.DriverRegistration) error {
+	var v validator
+	validateDriver(&v, req)
+	return v.err()
+}
+
+func validateDriver(v *validator, req rest.DriverRegistration) {
+	v.required("name", req.Name)
+	v.required("surname", req.Surname)
+	v.required("phone", req.Phone)
+	v.required("state_number", req.StateNumber)
+	v.timestamp("birthday", req.Birthday.Time)
+	v.check(req.Birthday.Time.Before(time.Now()), "birthday", "must be in the past")
+	validateLicence(v, req.LicenceNumber, req.LicenceCategories)
+}
+
+func validateDriverUpdate(req rest.DriverUpdateRequest) error {
+	var v validator
+	v.required("name", req.Name)
+	v.required("surname", req.Surname)
+	v.required("phone", req.Phone)
+	v.timestamp("birthday", req.Birthday.Time)
+	v.check(req.Birthday.Time.Before(time.Now()), "birthday", "must be in the past")
+	validateLicence(&v, req.LicenceNumber, req.LicenceCategories)
+	return v.err()
+}
+
+func validateLicence(v *validator, number *string, categories *[]string) {
+	if number != nil {
+		v.check(utf8.RuneCountInString(*number) <= maxLicenceNumber, "licence_number", "must be at most %d characters long", maxLicenceNumber)
+	}
+	if categories != nil {
+		for _, category := range *categories {
+			v.check(slices.Contains(models.LicenceCategories, category), "licence_categories",
+				"unknown category %q, use any of %s", category, strings.Join(models.LicenceCategories, ", "))
+		}
+	}
+}
+
+func validateDriverDocument(params rest.PostDriverDocumentParams) error {
+	var v validator
+	v.check(slices.Contains(models.DocumentTypes, params.Type), "type", "unknown type %q, use one of %s", params.Type, strings.Join(models.DocumentTypes, ", "))
+	if v.required("file_name", strings.TrimSpace(params.FileName)) {
+		v.check(utf8.RuneCountInString(params.FileName) <= maxFileName, "file_name", "must be at most %d characters long", maxFileName)
+	}
+	return v.err()
+}
+
+func validateWorkTimeUpdate(req rest.WorkTimeUpdateRequest) error {
+	var v validator
+	v.required("device_num", req.DeviceNum)
+	v.check(req.WorkedTime > 0, "worked_time", "must be positive")
+	if req.EndedAt != nil {
+		v.check(!req.EndedAt.After(time.Now()), "ended_at", "must not be in the future")
+	}
+	return v.err()
+}
+
+func validateWorkSession(req rest.WorkSessionRequest) error {
+	var v validator
+	if req.StartedAt != nil {
+		v.check(!req.StartedAt.After(time.Now()), "started_at", "must not be in the future")
+	}
+	if req.EndedAt != nil {
+		v.check(!req.EndedAt.After(time.Now()), "ended_at", "must not be in the future")
+		if req.StartedAt != nil {
+			v.period("started_at", *req.StartedAt, "ended_at", *req.EndedAt)
+		} else {
+			v.add("started_at", "is required with ended_at")
+		}
+	}
+	return v.err()
+}
+
+func validateWorkSessionEnd(req rest.WorkSessionEndRequest) error {
+	var v validator
+	if req.EndedAt != nil {
+		v.check(!req.EndedAt.After(time.Now()), "ended_at", "must not be in the future")
+	}
+	return v.err()
+}
+
+func validateWorkTimePeriod(from time.Time, to time.Time) error {
+	var v validator
+	v.period("from", from, "to", to)
+	v.check(to.Sub(from) <= maxWorkTimeDays*24*time.Hour, "to", "must be at most %d days after from", maxWorkTimeDays)
+	return v.err()
+}
+
+func validateWorkViolationType(typ *string) error {
+	var v validator
+	if typ != nil {
+		v.check(slices.Contains(models.WorkViolationTypes, *typ), "type", "unknown type %q, use one of %s", *typ, strings.Join(models.WorkViolationTypes, ", "))
+	}
+	return v.err()
+}
+
+func validateDriverAssignment(req rest.DriverAssignmentRequest) error {
+	var v validator
+	if req.StartedAt != nil {
+		v.check(!req.StartedAt.After(time.Now()), "started_at", "must not be in the future")
+	}
+	return v.err()
+}
+
+func validateDriverUnassign(req rest.DriverUnassignRequest) error {
+	var v validator
+	if req.EndedAt != nil {
+		v.check(!req.EndedAt.After(time.Now()), "ended_at", "must not be in the future")
+	}
+	return v.err()
+}
+
+func validatePosition(req rest.PositionRequest) error {
+	var v validator
+	v.required("device_number", req.DeviceNumber)
+	v.point("point", req.Point)
+	v.timestamp("created_at", req.CreatedAt)
+	return v.err()
+}
+
+func validateUpdateMileage(req rest.UpdateMileageRequest) error {
+	var v validator
+	v.required("device_num", req.DeviceNum)
+	v.check(req.NewMileage >= 0, "new_mileage", "must not be negative")
+	return v.err()
+}
+
+func validateBreakage(req rest.BreakageFromMqttRequest) error {
+	var v validator
+	v.required("device_num", req.DeviceNum)
+	v.required("type", req.Type)
+	v.required("description", req.Description)
+	v.timestamp("datetime", req.Datetime)
+	v.point("point", req.Point)
+	return v.err()
+}
+
+func validateBreakageUpdate(req rest.BreakageUpdateRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	if req.Assignee != nil {
+		v.check(utf8.RuneCountInString(*req.Assignee) <= maxAssignee, "assignee", "must be at most %d characters long", maxAssignee)
+	}
+	if req.RepairNotes != nil {
+		v.check(utf8.RuneCountInString(*req.RepairNotes) <= maxRepairNotes, "repair_notes", "must be at most %d characters long", maxRepairNotes)
+	}
+	if req.Cost != nil {
+		v.check(*req.Cost >= 0, "cost", "must not be negative")
+	}
+	return v.err()
+}
+
+func validateBreakageType(req rest.BreakageTypeRequest) error {
+	var v validator
+	validateBreakageTypeFields(&v, req)
+	return v.err()
+}
+
+func validateBreakageTypeUpdate(req rest.BreakageTypeUpdateRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	validateBreakageTypeFields(&v, ToBreakageTypeRequest(req))
+	return v.err()
+}
+
+func validateBreakageTypeFields(v *validator, req rest.BreakageTypeRequest) {
+	if v.required("code", strings.TrimSpace(req.Code)) {
+		v.check(utf8.RuneCountInString(req.Code) <= maxBreakageCode, "code", "must be at most %d characters long", maxBreakageCode)
+	}
+	if v.required("name", strings.TrimSpace(req.Name)) {
+		v.check(utf8.RuneCountInString(req.Name) <= maxBreakageName, "name", "must be at most %d characters long", maxBreakageName)
+	}
+	v.check(slices.Contains(models.Severities, req.Severity), "severity", "unknown severity %q, use one of %s", req.Severity, strings.Join(models.Severities, ", "))
+	if req.Component != nil {
+		v.check(slices.Contains(models.Components, *req.Component), "component", "unknown component %q, use one of %s", *req.Component, strings.Join(models.Components, ", "))
+	}
+	if req.WheelPosition != nil {
+		v.check(*req.WheelPosition >= 1, "wheel_position", "must be positive")
+		v.check(req.Component != nil && *req.Component == models.ComponentWheel, "wheel_position", "is only allowed with the %s component", models.ComponentWheel)
+	}
+	if req.DeviceCodes != nil {
+		for _, code := range *req.DeviceCodes {
+			v.check(utf8.RuneCountInString(code) <= maxBreakageCode, "device_codes", "must be at most %d characters long", maxBreakageCode)
+		}
+	}
+}
+
+func validateSeverity(severity *string) error {
+	var v validator
+	if severity != nil {
+		v.check(slices.Contains(models.Severities, *severity), "severity", "unknown severity %q, use one of %s", *severity, strings.Join(models.Severities, ", "))
+	}
+	return v.err()
+}
+
+func validateBreakageStats(params rest.GetBreakageStatsParams) error {
+	var v validator
+	v.check(slices.Contains(models.BreakageStatsGroupings, params.GroupBy), "group_by", "unknown grouping %q, use one of %s",
+		params.GroupBy, strings.Join(models.BreakageStatsGroupings, ", "))
+	if params.From != nil && params.To != nil {
+		v.period("from", *params.From, "to", *params.To)
+	}
+	return v.err()
+}
+
+func validateMaintenancePlan(req rest.MaintenancePlanRequest) error {
+	var v validator
+	validateMaintenancePlanFields(&v, req)
+	return v.err()
+}
+
+func validateMaintenancePlanUpdate(req rest.MaintenancePlanUpdateRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	validateMaintenancePlanFields(&v, ToMaintenancePlanRequest(req))
+	return v.err()
+}
+
+// validateMaintenancePlanFields checks that a plan sets the field of its
+// trigger and no field of another trigger.
+func validateMaintenancePlanFields(v *validator, req rest.MaintenancePlanRequest) {
+	if v.required("name", strings.TrimSpace(req.Name)) {
+		v.check(utf8.RuneCountInString(req.Name) <= maxPlanName, "name", "must be at most %d characters long", maxPlanName)
+	}
+	v.check(slices.Contains(models.MaintenanceKinds, req.Kind), "kind", "unknown kind %q, use one of %s",
+		req.Kind, strings.Join(models.MaintenanceKinds, ", "))
+	v.check(slices.Contains(models.MaintenanceTriggers, req.Trigger), "trigger", "unknown trigger %q, use one of %s",
+		req.Trigger, strings.Join(models.MaintenanceTriggers, ", "))
+
+	byMileage := req.Trigger == models.MaintenanceByMileage
+	v.check(byMileage == (req.MileageInterval != nil), "mileage_interval", "is required for, and only allowed with, the %s trigger", models.MaintenanceByMileage)
+	if req.MileageInterval != nil {
+		v.check(*req.MileageInterval > 0, "mileage_interval", "must be positive")
+	}
+	byInterval := req.Trigger == models.MaintenanceByInterval
+	v.check(byInterval == (req.IntervalDays != nil), "interval_days", "is required for, and only allowed with, the %s trigger", models.MaintenanceByInterval)
+	if req.IntervalDays != nil {
+		v.check(*req.IntervalDays >= 1, "interval_days", "must be positive")
+	}
+	byCondition := req.Trigger == models.MaintenanceByCondition
+	v.check(byCondition == (req.Condition != nil), "condition", "is required for, and only allowed with, the %s trigger", models.MaintenanceByCondition)
+	if req.Condition != nil {
+		v.check(slices.Contains(models.MaintenanceConditions, *req.Condition), "condition", "unknown condition %q, use one of %s",
+			*req.Condition, strings.Join(models.MaintenanceConditions, ", "))
+	}
+}
+
+func validateWorkOrder(req rest.WorkOrderRequest) error {
+	var v validator
+	if v.required("title", strings.TrimSpace(req.Title)) {
+		v.check(utf8.RuneCountInString(req.Title) <= maxWorkOrderTitle, "title", "must be at most %d characters long", maxWorkOrderTitle)
+	}
+	v.check((req.BreakageId == nil) != (req.TaskId == nil), "breakage_id", "exactly one of breakage_id and task_id is required")
+	if req.WheelId != nil {
+		v.check(req.BreakageId != nil, "wheel_id", "is only allowed with breakage_id, a task has its own wheel")
+	}
+	if req.Assignee != nil {
+		v.check(utf8.RuneCountInString(*req.Assignee) <= maxAssignee, "assignee", "must be at most %d characters long", maxAssignee)
+	}
+	if req.Notes != nil {
+		v.check(utf8.RuneCountInString(*req.Notes) <= maxRepairNotes, "notes", "must be at most %d characters long", maxRepairNotes)
+	}
+	return v.err()
+}
+
+func validateWorkOrderCompletion(req rest.WorkOrderCompleteRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	if req.Notes != nil {
+		v.check(utf8.RuneCountInString(*req.Notes) <= maxRepairNotes, "notes", "must be at most %d characters long", maxRepairNotes)
+	}
+	if req.Cost != nil {
+		v.check(*req.Cost >= 0, "cost", "must not be negative")
+	}
+	if req.Tire != nil {
+		if v.required("tire.brand", strings.TrimSpace(req.Tire.Brand)) {
+			v.check(utf8.RuneCountInString(req.Tire.Brand) <= maxTireName, "tire.brand", "must be at most %d characters long", maxTireName)
+		}
+		if v.required("tire.model", strings.TrimSpace(req.Tire.Model)) {
+			v.check(utf8.RuneCountInString(req.Tire.Model) <= maxTireName, "tire.model", "must be at most %d characters long", maxTireName)
+		}
+		v.check(req.Tire.Size > 0, "tire.size", "must be positive")
+		if req.Tire.Cost != nil {
+			v.check(*req.Tire.Cost >= 0, "tire.cost", "must not be negative")
+		}
+	}
+	return v.err()
+}
+
+func validateWorkOrderStatus(status *string) error {
+	var v validator
+	if status != nil {
+		v.check(slices.Contains(models.WorkOrderStatuses, *status), "status", "unknown status %q, use one of %s",
+			*status, strings.Join(models.WorkOrderStatuses, ", "))
+	}
+	return v.err()
+}
+
+func validateNotificationRule(req rest.NotificationRuleRequest) error {
+	var v validator
+	validateNotificationRuleFields(&v, req)
+	return v.err()
+}
+
+func validateNotificationRuleUpdate(req rest.NotificationRuleUpdateRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	validateNotificationRuleFields(&v, ToNotificationRuleRequest(req))
+	return v.err()
+}
+
+func validateNotificationRuleFields(v *validator, req rest.NotificationRuleRequest) {
+	if v.required("name", strings.TrimSpace(req.Name)) {
+		v.check(utf8.RuneCountInString(req.Name) <= maxRuleName, "name", "must be at most %d characters long", maxRuleName)
+	}
+	if req.EventTypes != nil {
+		for _, event := range *req.EventTypes {
+			v.check(slices.Contains(models.NotificationEvents, event), "event_types", "unknown event %q, use one of %s",
+				event, strings.Join(models.NotificationEvents, ", "))
+		}
+	}
+	if req.MinSeverity != nil {
+		v.check(slices.Contains(models.Severities, *req.MinSeverity), "min_severity", "unknown severity %q, use one of %s",
+			*req.MinSeverity, strings.Join(models.Severities, ", "))
+	}
+	v.check(slices.Contains(models.NotificationChannels, req.Channel), "channel", "unknown channel %q, use one of %s",
+		req.Channel, strings.Join(models.NotificationChannels, ", "))
+
+	target := strings.TrimSpace(req.Target)
+	if v.required("target", target) {
+		v.check(utf8.RuneCountInString(target) <= maxRuleTarget, "target", "must be at most %d characters long", maxRuleTarget)
+		switch req.Channel {
+		case models.ChannelEmail:
+			v.email("target", target)
+		case models.ChannelWebhook:
+			u, err := url.Parse(target)
+			v.check(err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != "", "target", "must be an http or https URL")
+		case models.ChannelSMS:
+			v.check(phonePattern.MatchString(target), "target", "must be a phone number in international format, e.g. +79991234567")
+		case models.ChannelTelegram:
+			_, err := strconv.ParseInt(target, 10, 64)
+			v.check(err == nil || strings.HasPrefix(target, "@"), "target", "must be a chat ID or an @channel name")
+		}
+	}
+
+	v.check((req.QuietFrom == nil) == (req.QuietTo == nil), "quiet_from", "quiet_from and quiet_to are set together")
+	if req.QuietFrom != nil && req.QuietTo != nil {
+		v.check(*req.QuietFrom >= 0 && *req.QuietFrom < 24*60, "quiet_from", "must be between 0 and 1439 minutes after midnight")
+		v.check(*req.QuietTo >= 0 && *req.QuietTo < 24*60, "quiet_to", "must be between 0 and 1439 minutes after midnight")
+		v.check(*req.QuietFrom != *req.QuietTo, "quiet_to", "must differ from quiet_from")
+	}
+}
+
+func validateGeofence(req rest.GeofenceRequest) error {
+	var v validator
+	validateGeofenceFields(&v, req)
+	return v.err()
+}
+
+func validateGeofenceUpdate(req rest.GeofenceUpdateRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	validateGeofenceFields(&v, ToGeofenceRequest(req))
+	return v.err()
+}
+
+func validateGeofenceFields(v *validator, req rest.GeofenceRequest) {
+	if v.required("name", strings.TrimSpace(req.Name)) {
+		v.check(utf8.RuneCountInString(req.Name) <= maxGeofenceName, "name", "must be at most %d characters long", maxGeofenceName)
+	}
+	v.check(req.Latitude >= -90 && req.Latitude <= 90, "latitude", "must be between -90 and 90")
+	v.check(req.Longitude >= -180 && req.Longitude <= 180, "longitude", "must be between -180 and 180")
+	v.check(req.Radius >= minGeofenceRadius && req.Radius <= maxGeofenceRadius, "radius", "must be between %d and %d meters",
+		minGeofenceRadius, maxGeofenceRadius)
+}
+
+func validateWebhook(req rest.WebhookRequest) error {
+	var v validator
+	validateWebhookFields(&v, req)
+	return v.err()
+}
+
+func validateWebhookUpdate(req rest.WebhookUpdateRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	validateWebhookFields(&v, ToWebhookRequest(req))
+	return v.err()
+}
+
+func validateWebhookFields(v *validator, req rest.WebhookRequest) {
+	target := strings.TrimSpace(req.Url)
+	if v.required("url", target) {
+		v.check(len(target) <= maxWebhookURL, "url", "must be at most %d characters long", maxWebhookURL)
+		u, err := url.Parse(target)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			v.add("url", "must be an https URL")
+		} else {
+			v.check(isPublicHost(u.Hostname()), "url", "must not point to a private, loopback or link-local address")
+		}
+	}
+	if req.Description != nil {
+		v.check(utf8.RuneCountInString(*req.Description) <= maxWebhookDesc, "description", "must be at most %d characters long", maxWebhookDesc)
+	}
+	if req.EventTypes != nil {
+		for _, event := range *req.EventTypes {
+			v.check(slices.Contains(models.WebhookEventTypes, event), "event_types", "unknown event %q, use one of %s",
+				event, strings.Join(models.WebhookEventTypes, ", "))
+		}
+	}
+}
+
+// isPublicHost reports whether host is a name or a public address. Names
+// are resolved when a delivery is sent, and the address checked again then.
+func isPublicHost(host string) bool {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return notify.IsPublicAddr(addr)
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	return host != "localhost" && !strings.HasSuffix(host, ".localhost")
+}
+
+func validateWebhookDeliveryFilter(params rest.GetWebhookDeliveryListParams) error {
+	var v validator
+	if params.Status != nil {
+		v.check(slices.Contains(models.WebhookStatuses, *params.Status), "status", "unknown status %q, use one of %s",
+			*params.Status, strings.Join(models.WebhookStatuses, ", "))
+	}
+	if params.EventType != nil {
+		v.check(slices.Contains(models.WebhookEventTypes, *params.EventType), "event_type", "unknown event %q, use one of %s",
+			*params.EventType, strings.Join(models.WebhookEventTypes, ", "))
+	}
+	return v.err()
+}
+
+func validateBreakageStatus(req rest.BreakageStatusRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	v.check(slices.Contains(models.BreakageStatuses, req.Status), "status", "unknown status %q, use one of %s", req.Status, strings.Join(models.BreakageStatuses, ", "))
+	if req.Note != nil {
+		v.check(utf8.RuneCountInString(*req.Note) <= maxRepairNotes, "note", "must be at most %d characters long", maxRepairNotes)
+	}
+	return v.err()
+}
+
+func validateBreakageStatuses(field string, statuses []string) error {
+	var v validator
+	for _, status := range statuses {
+		v.check(slices.Contains(models.BreakageStatuses, status), field, "unknown status %q, use any of %s", status, strings.Join(models.BreakageStatuses, ", "))
+	}
+	return v.err()
+}
+
+func validateNotificationFilter(f models.NotificationFilter) error {
+	var v validator
+	if f.Type != nil {
+		v.check(slices.Contains(models.NotificationEvents, *f.Type), "type", "unknown type %q, use one of %s",
+			*f.Type, strings.Join(models.NotificationEvents, ", "))
+	}
+	if f.MinSeverity != nil {
+		v.check(slices.Contains(models.Severities, *f.MinSeverity), "min_severity", "unknown severity %q, use one of %s",
+			*f.MinSeverity, strings.Join(models.Severities, ", "))
+	}
+	return v.err()
+}
+
+func validateNotificationStatus(req rest.ChangeNotificationStatusRequest) error {
+	var v validator
+	v.check(req.Id != uuid.Nil, "id", "is required")
+	v.required("status", req.Status)
+	return v.err()
+}
+
+func validateAllNotificationsStatus(req rest.ChangeAllNotificationsStatusRequest) error {
+	var v validator
+	v.required("status", req.Status)
+	return v.err()
+}
+
+func validateTotpCode(req rest.TotpCodeRequest) error {
+	var v validator
+	v.required("code", req.Code)
+	return v.err()
+}
+
+func validateTotpLogin(req rest.TotpLoginRequest) error {
+	var v validator
+	v.required("challengeToken", req.ChallengeToken)
+	if (req.Code == nil || *req.Code == "") && (req.RecoveryCode == nil || *req.RecoveryCode == "") {
+		v.add("code", "either code or recoveryCode is required")
+	}
+	return v.err()
+}
+
+func validateSearch(query models.SearchQuery) error {
+	var v validator
+	v.check(utf8.RuneCountInString(query.Text) >= minSearchLength, "q", "must be at least %d characters long", minSearchLength)
+	for _, typ := range query.Types {
+		v.check(slices.Contains(models.SearchTypes, typ), "types", "unknown type %q, use any of %s", typ, strings.Join(models.SearchTypes, ", "))
+	}
+	v.check(query.Limit >= 1 && query.Limit <= maxSearchLimit, "limit", "must be between 1 and %d", maxSearchLimit)
+	return v.err()
+}
+
+func validatePeriod(fromField string, from time.Time, toField string, to time.Time) error {
+	var v validator
+	v.period(fromField, from, toField, to)
+	return v.err()
+}
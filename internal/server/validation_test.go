@@ -0,0 +1,445 @@
+package server
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/VikaPaz/algalar/internal/server/rest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// invalidFields returns the fields err reports as invalid, nil for a valid
+// request.
+func invalidFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	assert.ErrorIs(t, err, models.ErrInvalidInput)
+	var detailed *detailedError
+	if !errors.As(err, &detailed) {
+		t.Fatalf("error has no field details: %v", err)
+	}
+	var fields []string
+	for _, e := range detailed.details.([]FieldError) {
+		fields = append(fields, e.Field)
+	}
+	return fields
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestValidateUserRegistration(t *testing.T) {
+	valid := rest.UserRegistration{
+		Email:     "owner@example.com",
+		Password:  "secret123",
+		FirstName: "Ivan",
+		LastName:  "Petrov",
+		Gender:    "male",
+		Phone:     "+79991234567",
+		Inn:       "7707083893",
+		TimeZone:  3,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *rest.UserRegistration)
+		want   []string
+	}{
+		{name: "valid", modify: func(r *rest.UserRegistration) {}},
+		{name: "twelve digit inn", modify: func(r *rest.UserRegistration) { r.Inn = "500100732259" }},
+		{name: "easternmost timezone", modify: func(r *rest.UserRegistration) { r.TimeZone = maxTimezone }},
+		{name: "westernmost timezone", modify: func(r *rest.UserRegistration) { r.TimeZone = minTimezone }},
+		{name: "invalid email", modify: func(r *rest.UserRegistration) { r.Email = "owner" }, want: []string{"email"}},
+		{name: "short password", modify: func(r *rest.UserRegistration) { r.Password = "secret1" }, want: []string{"password"}},
+		{name: "eleven digit inn", modify: func(r *rest.UserRegistration) { r.Inn = "77070838931" }, want: []string{"inn"}},
+		{name: "inn with letters", modify: func(r *rest.UserRegistration) { r.Inn = "77070838AB" }, want: []string{"inn"}},
+		{name: "timezone too far east", modify: func(r *rest.UserRegistration) { r.TimeZone = maxTimezone + 1 }, want: []string{"timeZone"}},
+		{name: "timezone too far west", modify: func(r *rest.UserRegistration) { r.TimeZone = minTimezone - 1 }, want: []string{"timeZone"}},
+		{
+			name:   "every problem at once",
+			modify: func(r *rest.UserRegistration) { *r = rest.UserRegistration{TimeZone: 20} },
+			want:   []string{"email", "password", "firstName", "lastName", "gender", "phone", "inn", "timeZone"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.modify(&req)
+			assert.Equal(t, tt.want, invalidFields(t, validateUserRegistration(req)))
+		})
+	}
+}
+
+func TestValidateNotificationRule(t *testing.T) {
+	valid := rest.NotificationRuleRequest{Name: "Dispatch", Channel: models.ChannelSMS, Target: "+79991234567"}
+
+	tests := []struct {
+		name   string
+		modify func(r *rest.NotificationRuleRequest)
+		want   []string
+	}{
+		{name: "valid", modify: func(r *rest.NotificationRuleRequest) {}},
+		{name: "shortest phone", modify: func(r *rest.NotificationRuleRequest) { r.Target = "+1234567" }},
+		{name: "longest phone", modify: func(r *rest.NotificationRuleRequest) { r.Target = "+123456789012345" }},
+		{name: "phone without plus", modify: func(r *rest.NotificationRuleRequest) { r.Target = "79991234567" }, want: []string{"target"}},
+		{name: "phone with leading zero", modify: func(r *rest.NotificationRuleRequest) { r.Target = "+09991234567" }, want: []string{"target"}},
+		{name: "phone too long", modify: func(r *rest.NotificationRuleRequest) { r.Target = "+1234567890123456" }, want: []string{"target"}},
+		{name: "phone with spaces", modify: func(r *rest.NotificationRuleRequest) { r.Target = "+7 999 123 45 67" }, want: []string{"target"}},
+		{
+			name: "email",
+			modify: func(r *rest.NotificationRuleRequest) {
+				r.Channel, r.Target = models.ChannelEmail, "dispatch@example.com"
+			},
+		},
+		{
+			name:   "invalid email",
+			modify: func(r *rest.NotificationRuleRequest) { r.Channel, r.Target = models.ChannelEmail, "dispatch" },
+			want:   []string{"target"},
+		},
+		{
+			name:   "telegram chat",
+			modify: func(r *rest.NotificationRuleRequest) { r.Channel, r.Target = models.ChannelTelegram, "-1001234567890" },
+		},
+		{
+			name:   "telegram channel",
+			modify: func(r *rest.NotificationRuleRequest) { r.Channel, r.Target = models.ChannelTelegram, "@dispatch" },
+		},
+		{
+			name:   "invalid telegram target",
+			modify: func(r *rest.NotificationRuleRequest) { r.Channel, r.Target = models.ChannelTelegram, "dispatch" },
+			want:   []string{"target"},
+		},
+		{
+			name: "webhook",
+			modify: func(r *rest.NotificationRuleRequest) {
+				r.Channel, r.Target = models.ChannelWebhook, "https://example.com/hook"
+			},
+		},
+		{
+			name:   "webhook without scheme",
+			modify: func(r *rest.NotificationRuleRequest) { r.Channel, r.Target = models.ChannelWebhook, "example.com/hook" },
+			want:   []string{"target"},
+		},
+		{name: "unknown channel", modify: func(r *rest.NotificationRuleRequest) { r.Channel = "pager" }, want: []string{"channel"}},
+		{name: "blank name", modify: func(r *rest.NotificationRuleRequest) { r.Name = "  " }, want: []string{"name"}},
+		{name: "longest name", modify: func(r *rest.NotificationRuleRequest) { r.Name = strings.Repeat("ж", maxRuleName) }},
+		{name: "long name", modify: func(r *rest.NotificationRuleRequest) { r.Name = strings.Repeat("ж", maxRuleName+1) }, want: []string{"name"}},
+		{name: "missing target", modify: func(r *rest.NotificationRuleRequest) { r.Target = "" }, want: []string{"target"}},
+		{name: "known events", modify: func(r *rest.NotificationRuleRequest) { r.EventTypes = &models.NotificationEvents }},
+		{
+			name:   "unknown event",
+			modify: func(r *rest.NotificationRuleRequest) { r.EventTypes = &[]string{models.EventBreakage, "flat_tire"} },
+			want:   []string{"event_types"},
+		},
+		{name: "known severity", modify: func(r *rest.NotificationRuleRequest) { r.MinSeverity = ptr(models.SeverityHigh) }},
+		{name: "unknown severity", modify: func(r *rest.NotificationRuleRequest) { r.MinSeverity = ptr("urgent") }, want: []string{"min_severity"}},
+		{name: "quiet hours", modify: func(r *rest.NotificationRuleRequest) { r.QuietFrom, r.QuietTo = ptr(22*60), ptr(7*60) }},
+		{name: "quiet hours without end", modify: func(r *rest.NotificationRuleRequest) { r.QuietFrom = ptr(22 * 60) }, want: []string{"quiet_from"}},
+		{
+			name:   "quiet hours after midnight",
+			modify: func(r *rest.NotificationRuleRequest) { r.QuietFrom, r.QuietTo = ptr(22*60), ptr(24*60) },
+			want:   []string{"quiet_to"},
+		},
+		{
+			name:   "empty quiet hours",
+			modify: func(r *rest.NotificationRuleRequest) { r.QuietFrom, r.QuietTo = ptr(60), ptr(60) },
+			want:   []string{"quiet_to"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.modify(&req)
+			assert.Equal(t, tt.want, invalidFields(t, validateNotificationRule(req)))
+		})
+	}
+}
+
+func TestValidateWebhook(t *testing.T) {
+	tests := []struct {
+		name string
+		req  rest.WebhookRequest
+		want []string
+	}{
+		{name: "https", req: rest.WebhookRequest{Url: "https://hooks.example.com/fleet"}},
+		{name: "public address", req: rest.WebhookRequest{Url: "https://93.184.216.34/fleet"}},
+		{name: "http", req: rest.WebhookRequest{Url: "http://hooks.example.com/fleet"}, want: []string{"url"}},
+		{name: "no host", req: rest.WebhookRequest{Url: "https:///fleet"}, want: []string{"url"}},
+		{name: "missing", req: rest.WebhookRequest{Url: " "}, want: []string{"url"}},
+		{name: "too long", req: rest.WebhookRequest{Url: "https://example.com/" + strings.Repeat("a", maxWebhookURL)}, want: []string{"url"}},
+		{name: "localhost", req: rest.WebhookRequest{Url: "https://localhost:8443/fleet"}, want: []string{"url"}},
+		{name: "localhost subdomain", req: rest.WebhookRequest{Url: "https://api.localhost./fleet"}, want: []string{"url"}},
+		{name: "loopback", req: rest.WebhookRequest{Url: "https://127.0.0.1/fleet"}, want: []string{"url"}},
+		{name: "ipv6 loopback", req: rest.WebhookRequest{Url: "https://[::1]/fleet"}, want: []string{"url"}},
+		{name: "private", req: rest.WebhookRequest{Url: "https://10.0.0.5/fleet"}, want: []string{"url"}},
+		{name: "private range", req: rest.WebhookRequest{Url: "https://192.168.1.10/fleet"}, want: []string{"url"}},
+		{name: "link-local", req: rest.WebhookRequest{Url: "https://169.254.169.254/latest"}, want: []string{"url"}},
+		{name: "unspecified", req: rest.WebhookRequest{Url: "https://0.0.0.0/fleet"}, want: []string{"url"}},
+		{
+			name: "known events",
+			req:  rest.WebhookRequest{Url: "https://hooks.example.com/fleet", EventTypes: &models.WebhookEventTypes},
+		},
+		{
+			name: "unknown event",
+			req:  rest.WebhookRequest{Url: "https://hooks.example.com/fleet", EventTypes: &[]string{"car.deleted"}},
+			want: []string{"event_types"},
+		},
+		{
+			name: "long description",
+			req:  rest.WebhookRequest{Url: "https://hooks.example.com/fleet", Description: ptr(strings.Repeat("a", maxWebhookDesc+1))},
+			want: []string{"description"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, invalidFields(t, validateWebhook(tt.req)))
+		})
+	}
+}
+
+func TestValidateBreakageStatus(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name string
+		req  rest.BreakageStatusRequest
+		want []string
+	}{
+		{name: "acknowledged", req: rest.BreakageStatusRequest{Id: id, Status: models.BreakageStatusAcknowledged}},
+		{name: "closed with a note", req: rest.BreakageStatusRequest{Id: id, Status: models.BreakageStatusClosed, Note: ptr("replaced")}},
+		{name: "unknown status", req: rest.BreakageStatusRequest{Id: id, Status: "fixed"}, want: []string{"status"}},
+		{name: "missing id", req: rest.BreakageStatusRequest{Status: models.BreakageStatusResolved}, want: []string{"id"}},
+		{
+			name: "long note",
+			req:  rest.BreakageStatusRequest{Id: id, Status: models.BreakageStatusResolved, Note: ptr(strings.Repeat("ж", maxRepairNotes+1))},
+			want: []string{"note"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, invalidFields(t, validateBreakageStatus(tt.req)))
+		})
+	}
+}
+
+func TestValidateBreakageStatuses(t *testing.T) {
+	assert.Nil(t, invalidFields(t, validateBreakageStatuses("status", models.BreakageStatuses)))
+	assert.Equal(t, []string{"status"}, invalidFields(t, validateBreakageStatuses("status", []string{models.BreakageStatusReported, "open"})))
+}
+
+func TestValidateBreakageType(t *testing.T) {
+	wheel := models.ComponentWheel
+	valid := rest.BreakageTypeRequest{Code: "low_pressure", Name: "Low pressure", Severity: models.SeverityHigh}
+
+	tests := []struct {
+		name   string
+		modify func(r *rest.BreakageTypeRequest)
+		want   []string
+	}{
+		{name: "valid", modify: func(r *rest.BreakageTypeRequest) {}},
+		{name: "wheel position", modify: func(r *rest.BreakageTypeRequest) { r.Component, r.WheelPosition = &wheel, ptr(2) }},
+		{name: "blank code", modify: func(r *rest.BreakageTypeRequest) { r.Code = " " }, want: []string{"code"}},
+		{name: "long code", modify: func(r *rest.BreakageTypeRequest) { r.Code = strings.Repeat("a", maxBreakageCode+1) }, want: []string{"code"}},
+		{name: "long name", modify: func(r *rest.BreakageTypeRequest) { r.Name = strings.Repeat("a", maxBreakageName+1) }, want: []string{"name"}},
+		{name: "unknown severity", modify: func(r *rest.BreakageTypeRequest) { r.Severity = "urgent" }, want: []string{"severity"}},
+		{name: "unknown component", modify: func(r *rest.BreakageTypeRequest) { r.Component = ptr("wing") }, want: []string{"component"}},
+		{name: "wheel position of no wheel", modify: func(r *rest.BreakageTypeRequest) { r.WheelPosition = ptr(2) }, want: []string{"wheel_position"}},
+		{
+			name:   "zero wheel position",
+			modify: func(r *rest.BreakageTypeRequest) { r.Component, r.WheelPosition = &wheel, ptr(0) },
+			want:   []string{"wheel_position"},
+		},
+		{
+			name: "long device code",
+			modify: func(r *rest.BreakageTypeRequest) {
+				r.DeviceCodes = &[]string{"lp", strings.Repeat("a", maxBreakageCode+1)}
+			},
+			want: []string{"device_codes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.modify(&req)
+			assert.Equal(t, tt.want, invalidFields(t, validateBreakageType(req)))
+		})
+	}
+}
+
+func TestValidateEnums(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{name: "known severity", err: validateSeverity(ptr(models.SeverityLow))},
+		{name: "no severity", err: validateSeverity(nil)},
+		{name: "unknown severity", err: validateSeverity(ptr("urgent")), want: []string{"severity"}},
+		{name: "known work order status", err: validateWorkOrderStatus(ptr(models.WorkOrderCompleted))},
+		{name: "unknown work order status", err: validateWorkOrderStatus(ptr("done")), want: []string{"status"}},
+		{name: "known work violation", err: validateWorkViolationType(ptr(models.WorkViolationDailyRest))},
+		{name: "unknown work violation", err: validateWorkViolationType(ptr("speeding")), want: []string{"type"}},
+		{name: "known delivery status", err: validateWebhookDeliveryFilter(rest.GetWebhookDeliveryListParams{Status: ptr(models.WebhookStatuses[0])})},
+		{
+			name: "unknown delivery status",
+			err:  validateWebhookDeliveryFilter(rest.GetWebhookDeliveryListParams{Status: ptr("lost")}),
+			want: []string{"status"},
+		},
+		{
+			name: "unknown delivery event",
+			err:  validateWebhookDeliveryFilter(rest.GetWebhookDeliveryListParams{EventType: ptr("car.deleted")}),
+			want: []string{"event_type"},
+		},
+		{name: "known notification type", err: validateNotificationFilter(models.NotificationFilter{Type: ptr(models.EventBreakage)})},
+		{
+			name: "unknown notification filter",
+			err:  validateNotificationFilter(models.NotificationFilter{Type: ptr("flat_tire"), MinSeverity: ptr("urgent")}),
+			want: []string{"type", "min_severity"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, invalidFields(t, tt.err))
+		})
+	}
+}
+
+func TestValidatePosition(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		req  rest.PositionRequest
+		want []string
+	}{
+		{name: "valid", req: rest.PositionRequest{DeviceNumber: "D1", Point: []float32{55.75, 37.61}, CreatedAt: now}},
+		{name: "poles and antimeridian", req: rest.PositionRequest{DeviceNumber: "D1", Point: []float32{-90, 180}, CreatedAt: now}},
+		{name: "latitude out of range", req: rest.PositionRequest{DeviceNumber: "D1", Point: []float32{90.5, 37.61}, CreatedAt: now}, want: []string{"point[0]"}},
+		{name: "longitude out of range", req: rest.PositionRequest{DeviceNumber: "D1", Point: []float32{55.75, -181}, CreatedAt: now}, want: []string{"point[1]"}},
+		{name: "one coordinate", req: rest.PositionRequest{DeviceNumber: "D1", Point: []float32{55.75}, CreatedAt: now}, want: []string{"point"}},
+		{name: "empty", req: rest.PositionRequest{}, want: []string{"device_number", "point", "created_at"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, invalidFields(t, validatePosition(tt.req)))
+		})
+	}
+}
+
+func TestValidateSearch(t *testing.T) {
+	tests := []struct {
+		name  string
+		query models.SearchQuery
+		want  []string
+	}{
+		{name: "valid", query: models.SearchQuery{Text: "ab", Limit: defaultSearchLimit}},
+		{name: "two letters", query: models.SearchQuery{Text: "жа", Limit: maxSearchLimit}},
+		{name: "short text", query: models.SearchQuery{Text: "ж", Limit: defaultSearchLimit}, want: []string{"q"}},
+		{name: "unknown type", query: models.SearchQuery{Text: "ab", Types: []string{"planet"}, Limit: defaultSearchLimit}, want: []string{"types"}},
+		{name: "zero limit", query: models.SearchQuery{Text: "ab"}, want: []string{"limit"}},
+		{name: "limit too large", query: models.SearchQuery{Text: "ab", Limit: maxSearchLimit + 1}, want: []string{"limit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, invalidFields(t, validateSearch(tt.query)))
+		})
+	}
+}
+
+func TestValidatePeriods(t *testing.T) {
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{name: "period", err: validatePeriod("from", from, "to", from.Add(time.Hour))},
+		{name: "empty period", err: validatePeriod("from", from, "to", from)},
+		{name: "reversed period", err: validatePeriod("from", from, "to", from.Add(-time.Hour)), want: []string{"to"}},
+		{name: "open period", err: validateOptionalPeriod(&from, nil)},
+		{name: "reversed optional period", err: validateOptionalPeriod(&from, ptr(from.Add(-time.Hour))), want: []string{"to"}},
+		{name: "longest work time period", err: validateWorkTimePeriod(from, from.AddDate(0, 0, maxWorkTimeDays))},
+		{name: "long work time period", err: validateWorkTimePeriod(from, from.AddDate(0, 0, maxWorkTimeDays+1)), want: []string{"to"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, invalidFields(t, tt.err))
+		})
+	}
+}
+
+func TestPageRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int
+		offset *int
+		cursor *string
+		want   []string
+	}{
+		{name: "first page", limit: defaultBreakagePageLimit},
+		{name: "offset", limit: maxPageLimit, offset: ptr(100)},
+		{name: "cursor", limit: 10, cursor: ptr("next")},
+		{name: "limit too large", limit: maxPageLimit + 1, want: []string{"limit"}},
+		{name: "negative limit", limit: -1, want: []string{"limit"}},
+		{name: "negative offset", limit: 10, offset: ptr(-1), want: []string{"offset"}},
+		{name: "offset with cursor", limit: 10, offset: ptr(0), cursor: ptr("next"), want: []string{"offset"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := pageRequest(tt.limit, tt.offset, tt.cursor, nil)
+			assert.Equal(t, tt.want, invalidFields(t, err))
+			assert.Equal(t, tt.limit, page.Limit)
+		})
+	}
+}
+
+func TestValidateGeofence(t *testing.T) {
+	valid := rest.GeofenceRequest{Name: "Depot", Latitude: 55.75, Longitude: 37.62, Radius: 500}
+
+	tests := []struct {
+		name   string
+		modify func(r *rest.GeofenceRequest)
+		want   []string
+	}{
+		{name: "valid", modify: func(r *rest.GeofenceRequest) {}},
+		{name: "smallest", modify: func(r *rest.GeofenceRequest) { r.Radius = minGeofenceRadius }},
+		{name: "largest", modify: func(r *rest.GeofenceRequest) { r.Radius = maxGeofenceRadius }},
+		{name: "pole and antimeridian", modify: func(r *rest.GeofenceRequest) { r.Latitude, r.Longitude = 90, -180 }},
+		{name: "blank name", modify: func(r *rest.GeofenceRequest) { r.Name = " " }, want: []string{"name"}},
+		{name: "long name", modify: func(r *rest.GeofenceRequest) { r.Name = strings.Repeat("ж", maxGeofenceName+1) }, want: []string{"name"}},
+		{name: "latitude out of range", modify: func(r *rest.GeofenceRequest) { r.Latitude = -90.5 }, want: []string{"latitude"}},
+		{name: "longitude out of range", modify: func(r *rest.GeofenceRequest) { r.Longitude = 180.5 }, want: []string{"longitude"}},
+		{name: "small radius", modify: func(r *rest.GeofenceRequest) { r.Radius = minGeofenceRadius - 1 }, want: []string{"radius"}},
+		{name: "large radius", modify: func(r *rest.GeofenceRequest) { r.Radius = maxGeofenceRadius + 1 }, want: []string{"radius"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.modify(&req)
+			assert.Equal(t, tt.want, invalidFields(t, validateGeofence(req)))
+		})
+	}
+
+	assert.Equal(t, []string{"id"}, invalidFields(t, validateGeofenceUpdate(rest.GeofenceUpdateRequest{
+		Name: "Depot", Latitude: 55.75, Longitude: 37.62, Radius: 500,
+	})))
+}
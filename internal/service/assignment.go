@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Driver assignment
+// AssignDriver assigns a driver of the company to a car from StartedAt, or
+// from now if it is not set.
+func (s *Service) AssignDriver(ctx context.Context, assignment models.DriverAssignment) (models.DriverAssignment, error) {
+	ctx, span := tracer.Start(ctx, "Service.AssignDriver")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	assignment.IDCompany = id
+	if assignment.StartedAt.IsZero() {
+		assignment.StartedAt = time.Now()
+	}
+
+	var res models.DriverAssignment
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.AssignDriver(ctx, assignment)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionAssign, models.AuditResourceDriverAssignment, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.DriverAssignment{}, err
+	}
+	return res, nil
+}
+
+// UnassignDriver ends the current assignment of a driver of the company at
+// endedAt, or now if it is zero.
+func (s *Service) UnassignDriver(ctx context.Context, driverID string, endedAt time.Time) (models.DriverAssignment, error) {
+	ctx, span := tracer.Start(ctx, "Service.UnassignDriver")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.DriverAssignment{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	if endedAt.IsZero() {
+		endedAt = time.Now()
+	}
+
+	var res models.DriverAssignment
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.UnassignDriver(ctx, id, driverID, endedAt)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUnassign, models.AuditResourceDriverAssignment, res.ID,
+			map[string]any{"EndedAt": nil}, map[string]any{"EndedAt": res.EndedAt})
+	})
+	if err != nil {
+		return models.DriverAssignment{}, err
+	}
+	return res, nil
+}
+
+func (s *Service) GetAssignments(ctx context.Context, filter models.AssignmentFilter, page models.PageRequest) (models.Page[models.DriverAssignment], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetAssignments")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Page[models.DriverAssignment]{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.GetAssignments(ctx, filter, page)
+}
+
+// GetDriverTrips returns the trips a driver of the company drove between
+// from and to, one for each assignment overlapping the period.
+func (s *Service) GetDriverTrips(ctx context.Context, driverID string, from time.Time, to time.Time) ([]models.DriverTrip, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetDriverTrips")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetDriverTrips(ctx, id, driverID, from, to)
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Audit
+func (s *Service) GetAuditLog(ctx context.Context, filter models.AuditFilter, page models.PageRequest) (models.Page[models.AuditEntry], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetAuditLog")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Page[models.AuditEntry]{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.GetAuditLog(ctx, filter, page)
+}
+
+// ExportAuditLog streams the audit entries of the company matching filter
+// to w.
+func (s *Service) ExportAuditLog(ctx context.Context, filter models.AuditFilter, w models.AuditWriter) error {
+	ctx, span := tracer.Start(ctx, "Service.ExportAuditLog")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.ExportAuditLog(ctx, filter, w)
+}
+
+// audit records a mutation made by the user from ctx. It is to be called in
+// the unit of work of the mutation, so that neither is written without the
+// other.
+func (s *Service) audit(ctx context.Context, companyID string, action string, resourceType string, resourceID string, before any, after any) error {
+	actorID, _ := ctx.Value(models.UserIDKey).(string)
+	if companyID == "" {
+		companyID = actorID
+	}
+	meta, _ := ctx.Value(models.RequestMetaKey).(models.RequestMeta)
+
+	entry := models.AuditEntry{
+		IDCompany:    companyID,
+		ActorID:      actorID,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Changes:      auditDiff(before, after),
+		Metadata:     meta,
+	}
+
+	if _, err := s.repo.CreateAuditEntry(ctx, entry); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to write audit entry %s %s/%s: %v", action, resourceType, resourceID, err)
+		return err
+	}
+	return nil
+}
+
+// auditDiff returns the fields whose values differ between two snapshots of a resource.
+// Either side may be nil for creations.
+func auditDiff(before any, after any) map[string]models.AuditChange {
+	beforeFields := auditFields(before)
+	afterFields := auditFields(after)
+
+	changes := make(map[string]models.AuditChange)
+	for key, val := range afterFields {
+		if prev, ok := beforeFields[key]; !ok || !reflect.DeepEqual(prev, val) {
+			changes[key] = models.AuditChange{Before: beforeFields[key], After: val}
+		}
+	}
+	for key, prev := range beforeFields {
+		if _, ok := afterFields[key]; !ok {
+			changes[key] = models.AuditChange{Before: prev}
+		}
+	}
+	return changes
+}
+
+func auditFields(val any) map[string]any {
+	fields := make(map[string]any)
+	if val == nil {
+		return fields
+	}
+
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(raw, &fields)
+
+	for _, name := range secretFields(val) {
+		delete(fields, name)
+	}
+	return fields
+}
+
+// secretFields returns the JSON names of the fields of a struct tagged
+// `log:"secret"`, which are never written to the audit log.
+func secretFields(val any) []string {
+	t := reflect.TypeOf(val)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Tag.Get("log") != logging.TagSecret {
+			continue
+		}
+		name := field.Name
+		if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+			name = tag
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// deviceRepo updates the driver and wheels of a car by its device, the way
+// the ingestion endpoints do, and records the audit entries written.
+type deviceRepo struct {
+	Repository
+	audited []models.AuditEntry
+}
+
+func (r *deviceRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *deviceRepo) UpdateDriverWorktime(ctx context.Context, deviceNum string, workedTime int, endedAt time.Time) (models.WorkSession, error) {
+	return models.WorkSession{ID: "s1", IDCompany: "c1", IDDriver: "d1", StartedAt: endedAt.Add(-time.Hour), EndedAt: &endedAt}, nil
+}
+
+func (r *deviceRepo) UpdateWheelsMilagelData(ctx context.Context, update models.UpdateMileage) ([]models.Wheel, error) {
+	return []models.Wheel{{ID: "w1", IDCompany: "c1", Mileage: 110}, {ID: "w2", IDCompany: "c1", Mileage: 210}}, nil
+}
+
+func (r *deviceRepo) CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error) {
+	r.audited = append(r.audited, entry)
+	return entry, nil
+}
+
+func TestAuditDiffStripsSecrets(t *testing.T) {
+	tests := []struct {
+		name   string
+		before any
+		after  any
+		want   []string
+	}{
+		{
+			name:  "user password",
+			after: models.User{ID: "u1", Login: "login", Password: "pass"},
+			want:  []string{"Password"},
+		},
+		{
+			name:   "totp secret",
+			before: models.TOTP{UserID: "u1", Secret: "old"},
+			after:  &models.TOTP{UserID: "u1", Secret: "new", Enabled: true},
+			want:   []string{"Secret"},
+		},
+		{
+			name:  "webhook secret",
+			after: models.WebhookEndpoint{ID: "w1", URL: "https://example.com", Secret: "whsec"},
+			want:  []string{"Secret"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := auditDiff(tt.before, tt.after)
+			assert.NotEmpty(t, changes)
+			for _, name := range tt.want {
+				assert.NotContains(t, changes, name)
+			}
+		})
+	}
+}
+
+// nopMetrics discards the metrics of the service.
+type nopMetrics struct{}
+
+func (nopMetrics) SensorReadingIngested(companyID string) {}
+func (nopMetrics) PositionIngested(companyID string)      {}
+func (nopMetrics) BreakageIngested(companyID string)      {}
+func (nopMetrics) MileageUpdated(companyID string)        {}
+func (nopMetrics) SetSilentDevices(counts map[string]int) {}
+
+func TestDeviceUpdatesAuditOwner(t *testing.T) {
+	ctx := context.Background()
+	repo := &deviceRepo{}
+	s := NewService(repo, nopMetrics{}, logrus.New())
+
+	err := s.UpdateDriverWorktime(ctx, "dev1", 60, time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	err = s.UpdateWheelsMilagelData(ctx, models.UpdateMileage{DeviceNum: "dev1", Mileage: 10})
+	assert.NoError(t, err)
+
+	var resources []string
+	for _, e := range repo.audited {
+		assert.Equal(t, "c1", e.IDCompany)
+		resources = append(resources, e.ResourceType+" "+e.ResourceID)
+	}
+	assert.Equal(t, []string{
+		models.AuditResourceDriver + " d1",
+		models.AuditResourceWheel + " w1",
+		models.AuditResourceWheel + " w2",
+	}, resources)
+}
@@ -1,19 +1,36 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/VikaPaz/algalar/internal/models"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/VikaPaz/algalar/internal/service/auth")
+
 type AuthRepository interface {
-	CreateRefreshToken(userID string, refreshToken string, expiration time.Time) error
-	GetRefresToken(userID string) (string, error)
-	UpdateRefreshToken(userID string, token string, expiration time.Time) error
-	GetIDByLoginAndPassword(email, password string) (string, error)
+	CreateRefreshToken(ctx context.Context, userID string, refreshToken string, expiration time.Time) error
+	GetRefresToken(ctx context.Context, userID string) (string, error)
+	UpdateRefreshToken(ctx context.Context, userID string, token string, expiration time.Time) error
+	GetIDByLoginAndPassword(ctx context.Context, email, password string) (string, error)
+	GetUserLogin(ctx context.Context, userID string) (string, error)
+	GetTwoFactorPolicy(ctx context.Context, userID string) (bool, error)
+	SetTwoFactorPolicy(ctx context.Context, userID string, required bool) error
+	GetTOTP(ctx context.Context, userID string) (models.TOTP, error)
+	SaveTOTPSecret(ctx context.Context, userID string, secret string) error
+	EnableTOTP(ctx context.Context, userID string, step int64, codeHashes []string) error
+	UpdateTOTPLastStep(ctx context.Context, userID string, step int64) error
+	FailTOTPAttempt(ctx context.Context, userID string, maxAttempts int, lockedUntil time.Time) (bool, error)
+	ResetTOTPAttempts(ctx context.Context, userID string) error
+	DeleteTOTP(ctx context.Context, userID string) error
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID string) (int, error)
 }
 
 type Claims struct {
@@ -28,11 +45,14 @@ type AuthService struct {
 }
 
 type Config struct {
-	Salt              string
-	AccessSigningKey  string
-	RefreshSigningKey string
-	AccessTTL         time.Duration
-	RefreshTTL        time.Duration
+	Salt                string
+	AccessSigningKey    string
+	RefreshSigningKey   string
+	ChallengeSigningKey string
+	AccessTTL           time.Duration
+	RefreshTTL          time.Duration
+	ChallengeTTL        time.Duration
+	TOTPIssuer          string
 }
 
 func NewService(conf Config, repo AuthRepository, log *logrus.Logger) *AuthService {
@@ -109,18 +129,30 @@ func (s *AuthService) ValidateRefreshToken(refreshToken string) (string, error)
 	return claims.UserID, nil
 }
 
-func (s *AuthService) SaveRefreshToken(userID string, refreshToken string, expiration time.Time) error {
-	return s.repo.CreateRefreshToken(userID, refreshToken, expiration)
+func (s *AuthService) SaveRefreshToken(ctx context.Context, userID string, refreshToken string, expiration time.Time) error {
+	ctx, span := tracer.Start(ctx, "AuthService.SaveRefreshToken")
+	defer span.End()
+
+	return s.repo.CreateRefreshToken(ctx, userID, refreshToken, expiration)
 }
 
-func (s *AuthService) GetUserID(login, password string) (string, error) {
-	return s.repo.GetIDByLoginAndPassword(login, password)
+func (s *AuthService) GetUserID(ctx context.Context, login, password string) (string, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.GetUserID")
+	defer span.End()
+
+	return s.repo.GetIDByLoginAndPassword(ctx, login, password)
 }
 
-func (s *AuthService) GetRefreshToken(userID string) (string, error) {
-	return s.repo.GetRefresToken(userID)
+func (s *AuthService) GetRefreshToken(ctx context.Context, userID string) (string, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.GetRefreshToken")
+	defer span.End()
+
+	return s.repo.GetRefresToken(ctx, userID)
 }
 
-func (s *AuthService) UpdateRefreshToken(userID string, token string, expiration time.Time) error {
-	return s.repo.UpdateRefreshToken(userID, token, expiration)
+func (s *AuthService) UpdateRefreshToken(ctx context.Context, userID string, token string, expiration time.Time) error {
+	ctx, span := tracer.Start(ctx, "AuthService.UpdateRefreshToken")
+	defer span.End()
+
+	return s.repo.UpdateRefreshToken(ctx, userID, token, expiration)
 }
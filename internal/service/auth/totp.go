@@ -0,0 +1,342 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	challengeIssuer   = "auth-server-2fa"
+	totpPeriod        = 30
+	totpSkew          = 1
+	qrCodeSize        = 256
+	recoveryCodeCount = 10
+
+	// maxTOTPAttempts failed second factor attempts in a row lock it for
+	// totpLockout, which outlives the login challenge they were made with.
+	maxTOTPAttempts = 5
+	totpLockout     = 15 * time.Minute
+)
+
+func (s *AuthService) GetTwoFactorState(ctx context.Context, userID string) (models.TwoFactorState, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.GetTwoFactorState")
+	defer span.End()
+
+	var state models.TwoFactorState
+
+	required, err := s.repo.GetTwoFactorPolicy(ctx, userID)
+	if err != nil {
+		return state, err
+	}
+	state.Required = required
+
+	secret, err := s.repo.GetTOTP(ctx, userID)
+	if err == models.ErrNoContent {
+		return state, nil
+	}
+	if err != nil {
+		return state, err
+	}
+	state.Enabled = secret.Enabled
+
+	if state.Enabled {
+		state.RecoveryCodesLeft, err = s.repo.CountRecoveryCodes(ctx, userID)
+		if err != nil {
+			return state, err
+		}
+	}
+
+	return state, nil
+}
+
+func (s *AuthService) SetTwoFactorPolicy(ctx context.Context, userID string, required bool) error {
+	ctx, span := tracer.Start(ctx, "AuthService.SetTwoFactorPolicy")
+	defer span.End()
+
+	return s.repo.SetTwoFactorPolicy(ctx, userID, required)
+}
+
+// GenerateChallengeToken issues a short-lived token proving that the password step of the login passed.
+func (s *AuthService) GenerateChallengeToken(userID string) (string, error) {
+	claims := &Claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    challengeIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.conf.ChallengeTTL)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	sign, err := token.SignedString([]byte(s.conf.ChallengeSigningKey))
+	if err != nil {
+		s.log.Errorf("failed to sign challenge: %v", err)
+		return "", err
+	}
+
+	return sign, nil
+}
+
+func (s *AuthService) ValidateChallengeToken(challengeToken string) (string, error) {
+	token, err := jwt.ParseWithClaims(challengeToken, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method")
+		}
+		return []byte(s.conf.ChallengeSigningKey), nil
+	})
+
+	if err != nil || !token.Valid {
+		return "", models.ErrInvalidChallengeToken
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok || claims.UserID == "" || claims.Issuer != challengeIssuer {
+		return "", models.ErrInvalidChallengeToken
+	}
+
+	return claims.UserID, nil
+}
+
+// EnrollTOTP provisions a new secret. It stays inactive until confirmed with ConfirmTOTP.
+func (s *AuthService) EnrollTOTP(ctx context.Context, userID string) (models.TOTPEnrollment, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.EnrollTOTP")
+	defer span.End()
+
+	current, err := s.repo.GetTOTP(ctx, userID)
+	if err != nil && err != models.ErrNoContent {
+		return models.TOTPEnrollment{}, err
+	}
+	if current.Enabled {
+		return models.TOTPEnrollment{}, models.ErrTwoFactorAlreadyEnabled
+	}
+
+	login, err := s.repo.GetUserLogin(ctx, userID)
+	if err != nil {
+		return models.TOTPEnrollment{}, err
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      s.conf.TOTPIssuer,
+		AccountName: login,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("failed to generate totp key: %v", err)
+		return models.TOTPEnrollment{}, err
+	}
+
+	img, err := key.Image(qrCodeSize, qrCodeSize)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("failed to render totp qr code: %v", err)
+		return models.TOTPEnrollment{}, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("failed to encode totp qr code: %v", err)
+		return models.TOTPEnrollment{}, err
+	}
+
+	if err := s.repo.SaveTOTPSecret(ctx, userID, key.Secret()); err != nil {
+		return models.TOTPEnrollment{}, err
+	}
+
+	return models.TOTPEnrollment{
+		Secret: key.Secret(),
+		URL:    key.URL(),
+		QRCode: buf.Bytes(),
+	}, nil
+}
+
+// ConfirmTOTP checks the first code of a pending secret, enables 2FA and returns fresh recovery codes.
+func (s *AuthService) ConfirmTOTP(ctx context.Context, userID string, code string) ([]string, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.ConfirmTOTP")
+	defer span.End()
+
+	current, err := s.repo.GetTOTP(ctx, userID)
+	if err == models.ErrNoContent {
+		return nil, models.ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	if current.Enabled {
+		return nil, models.ErrTwoFactorAlreadyEnabled
+	}
+
+	step, ok := matchTOTP(current, code, time.Now())
+	if !ok {
+		return nil, models.ErrInvalidTOTPCode
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("failed to generate recovery codes: %v", err)
+		return nil, err
+	}
+
+	if err := s.repo.EnableTOTP(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// VerifySecondFactor accepts either a TOTP code or an unused recovery code.
+// After maxTOTPAttempts failures in a row it refuses every code for
+// totpLockout.
+func (s *AuthService) VerifySecondFactor(ctx context.Context, userID string, code string, recoveryCode string) error {
+	ctx, span := tracer.Start(ctx, "AuthService.VerifySecondFactor")
+	defer span.End()
+
+	current, err := s.repo.GetTOTP(ctx, userID)
+	if err == models.ErrNoContent || (err == nil && !current.Enabled) {
+		return models.ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if current.LockedUntil != nil && now.Before(*current.LockedUntil) {
+		return models.ErrTooManyTOTPAttempts
+	}
+
+	if recoveryCode != "" {
+		ok, err := s.repo.UseRecoveryCode(ctx, userID, hashRecoveryCode(recoveryCode))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return s.failSecondFactor(ctx, userID, now)
+		}
+		return s.repo.ResetTOTPAttempts(ctx, userID)
+	}
+
+	step, ok := matchTOTP(current, code, now)
+	if !ok {
+		return s.failSecondFactor(ctx, userID, now)
+	}
+
+	return s.repo.UpdateTOTPLastStep(ctx, userID, step)
+}
+
+// failSecondFactor counts a failed attempt and returns the error to report
+// for it.
+func (s *AuthService) failSecondFactor(ctx context.Context, userID string, now time.Time) error {
+	locked, err := s.repo.FailTOTPAttempt(ctx, userID, maxTOTPAttempts, now.Add(totpLockout))
+	if err != nil {
+		return err
+	}
+	if locked {
+		logging.FromContext(ctx, s.log).Warnf("second factor of user %s locked after %d failed attempts", userID, maxTOTPAttempts)
+		return models.ErrTooManyTOTPAttempts
+	}
+	return models.ErrInvalidTOTPCode
+}
+
+func (s *AuthService) DisableTOTP(ctx context.Context, userID string, code string) error {
+	ctx, span := tracer.Start(ctx, "AuthService.DisableTOTP")
+	defer span.End()
+
+	required, err := s.repo.GetTwoFactorPolicy(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if required {
+		return models.ErrTwoFactorRequired
+	}
+
+	if err := s.VerifySecondFactor(ctx, userID, code, ""); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteTOTP(ctx, userID)
+}
+
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.RegenerateRecoveryCodes")
+	defer span.End()
+
+	if err := s.VerifySecondFactor(ctx, userID, code, ""); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("failed to generate recovery codes: %v", err)
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// matchTOTP returns the time step the code belongs to. Steps already used are rejected so a code can't be replayed.
+func matchTOTP(secret models.TOTP, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return 0, false
+	}
+
+	for i := -totpSkew; i <= totpSkew; i++ {
+		at := now.Add(time.Duration(i*totpPeriod) * time.Second)
+		expected, err := totp.GenerateCodeCustom(secret.Secret, at, totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			return 0, false
+		}
+
+		step := at.Unix() / totpPeriod
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 && step > secret.LastUsedStep {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		raw := make([]byte, 7)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw))[:10]
+		codes[i] = code[:5] + "-" + code[5:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "JBSWY3DPEHPK3PXP"
+
+func codeAt(t *testing.T, at time.Time) string {
+	code, err := totp.GenerateCodeCustom(testSecret, at, totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	assert.NoError(t, err)
+	return code
+}
+
+func TestMatchTOTP(t *testing.T) {
+	now := time.Date(2026, 3, 2, 8, 0, 10, 0, time.UTC)
+	step := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name     string
+		lastUsed int64
+		code     string
+		step     int64
+		ok       bool
+	}{
+		{name: "current step", code: codeAt(t, now), step: step, ok: true},
+		{name: "previous step", code: codeAt(t, now.Add(-totpPeriod*time.Second)), step: step - 1, ok: true},
+		{name: "next step", code: codeAt(t, now.Add(totpPeriod*time.Second)), step: step + 1, ok: true},
+		{name: "outside the skew", code: codeAt(t, now.Add(-2*totpPeriod*time.Second))},
+		{name: "replayed step", lastUsed: step, code: codeAt(t, now)},
+		{name: "step before the last used", lastUsed: step, code: codeAt(t, now.Add(-totpPeriod*time.Second))},
+		{name: "step after the last used", lastUsed: step, code: codeAt(t, now.Add(totpPeriod*time.Second)), step: step + 1, ok: true},
+		{name: "surrounding spaces", code: " " + codeAt(t, now) + " ", step: step, ok: true},
+		{name: "empty", code: ""},
+		{name: "wrong code", code: "000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := matchTOTP(models.TOTP{Secret: testSecret, Enabled: true, LastUsedStep: tt.lastUsed}, tt.code, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.step, got)
+		})
+	}
+}
+
+// totpRepo keeps the second factor of a single user in memory.
+type totpRepo struct {
+	AuthRepository
+	secret         models.TOTP
+	recoveryCodes  map[string]bool
+	failedAttempts int
+}
+
+func (r *totpRepo) GetTOTP(ctx context.Context, userID string) (models.TOTP, error) {
+	return r.secret, nil
+}
+
+func (r *totpRepo) UpdateTOTPLastStep(ctx context.Context, userID string, step int64) error {
+	if step <= r.secret.LastUsedStep {
+		return models.ErrInvalidTOTPCode
+	}
+	r.secret.LastUsedStep = step
+	r.failedAttempts = 0
+	return nil
+}
+
+func (r *totpRepo) UseRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	unused, ok := r.recoveryCodes[codeHash]
+	if !ok || !unused {
+		return false, nil
+	}
+	r.recoveryCodes[codeHash] = false
+	return true, nil
+}
+
+func (r *totpRepo) FailTOTPAttempt(ctx context.Context, userID string, maxAttempts int, lockedUntil time.Time) (bool, error) {
+	r.failedAttempts++
+	if r.failedAttempts < maxAttempts {
+		return false, nil
+	}
+	r.failedAttempts = 0
+	r.secret.LockedUntil = &lockedUntil
+	return true, nil
+}
+
+func (r *totpRepo) ResetTOTPAttempts(ctx context.Context, userID string) error {
+	r.failedAttempts = 0
+	return nil
+}
+
+func newTOTPRepo(t *testing.T) (*totpRepo, []string) {
+	codes, hashes, err := newRecoveryCodes()
+	assert.NoError(t, err)
+
+	repo := &totpRepo{
+		secret:        models.TOTP{UserID: "1", Secret: testSecret, Enabled: true},
+		recoveryCodes: make(map[string]bool),
+	}
+	for _, hash := range hashes {
+		repo.recoveryCodes[hash] = true
+	}
+	return repo, codes
+}
+
+func TestVerifySecondFactorRecoveryCodeOnce(t *testing.T) {
+	repo, codes := newTOTPRepo(t)
+	s := NewService(Config{}, repo, logrus.New())
+
+	err := s.VerifySecondFactor(context.Background(), "1", "", codes[0])
+	assert.NoError(t, err)
+
+	err = s.VerifySecondFactor(context.Background(), "1", "", codes[0])
+	assert.ErrorIs(t, err, models.ErrInvalidTOTPCode)
+
+	// Recovery codes are matched regardless of case and dashes.
+	err = s.VerifySecondFactor(context.Background(), "1", "", strings.ToUpper(strings.ReplaceAll(codes[1], "-", "")))
+	assert.NoError(t, err)
+}
+
+func TestVerifySecondFactorReplay(t *testing.T) {
+	repo, _ := newTOTPRepo(t)
+	s := NewService(Config{}, repo, logrus.New())
+
+	code := codeAt(t, time.Now())
+	err := s.VerifySecondFactor(context.Background(), "1", code, "")
+	assert.NoError(t, err)
+
+	err = s.VerifySecondFactor(context.Background(), "1", code, "")
+	assert.ErrorIs(t, err, models.ErrInvalidTOTPCode)
+}
+
+func TestVerifySecondFactorLocks(t *testing.T) {
+	repo, codes := newTOTPRepo(t)
+	s := NewService(Config{}, repo, logrus.New())
+
+	for i := 1; i < maxTOTPAttempts; i++ {
+		err := s.VerifySecondFactor(context.Background(), "1", "000000", "")
+		assert.ErrorIs(t, err, models.ErrInvalidTOTPCode)
+	}
+	err := s.VerifySecondFactor(context.Background(), "1", "", "wrong-code")
+	assert.ErrorIs(t, err, models.ErrTooManyTOTPAttempts)
+
+	// While locked, valid codes are refused too and recovery codes are kept.
+	err = s.VerifySecondFactor(context.Background(), "1", codeAt(t, time.Now()), "")
+	assert.ErrorIs(t, err, models.ErrTooManyTOTPAttempts)
+	err = s.VerifySecondFactor(context.Background(), "1", "", codes[0])
+	assert.ErrorIs(t, err, models.ErrTooManyTOTPAttempts)
+	assert.True(t, repo.recoveryCodes[hashRecoveryCode(codes[0])])
+
+	// Once the lock is over the codes work again.
+	past := time.Now().Add(-time.Second)
+	repo.secret.LockedUntil = &past
+	err = s.VerifySecondFactor(context.Background(), "1", "", codes[0])
+	assert.NoError(t, err)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Breakage workflow
+func (s *Service) GetBreakage(ctx context.Context, breakageID string) (models.BreakageDetails, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetBreakage")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.BreakageDetails{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetBreakage(ctx, id, breakageID)
+}
+
+// UpdateBreakageRepair replaces the repair details of a breakage of the
+// company and returns the updated breakage.
+func (s *Service) UpdateBreakageRepair(ctx context.Context, breakageID string, repair models.BreakageRepair) (models.BreakageDetails, error) {
+	ctx, span := tracer.Start(ctx, "Service.UpdateBreakageRepair")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.BreakageDetails{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetBreakage(ctx, id, breakageID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.UpdateBreakageRepair(ctx, id, breakageID, repair); err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceBreakage, breakageID, before.BreakageRepair, repair)
+	})
+	if err != nil {
+		return models.BreakageDetails{}, err
+	}
+	return s.repo.GetBreakage(ctx, id, breakageID)
+}
+
+// ChangeBreakageStatus moves a breakage of the company to status and returns
+// the updated breakage.
+func (s *Service) ChangeBreakageStatus(ctx context.Context, breakageID string, status string, note *string) (models.BreakageDetails, error) {
+	ctx, span := tracer.Start(ctx, "Service.ChangeBreakageStatus")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.BreakageDetails{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		entry, err := s.repo.ChangeBreakageStatus(ctx, models.BreakageStatusChange{
+			IDCompany:  id,
+			IDBreakage: breakageID,
+			Status:     status,
+			Note:       note,
+			ChangedBy:  id,
+			ChangedAt:  time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionTransition, models.AuditResourceBreakage, breakageID,
+			map[string]any{"Status": entry.FromStatus}, map[string]any{"Status": entry.ToStatus})
+	})
+	if err != nil {
+		return models.BreakageDetails{}, err
+	}
+	return s.repo.GetBreakage(ctx, id, breakageID)
+}
+
+// GetBreakageHistory returns the status changes of a breakage of the company.
+func (s *Service) GetBreakageHistory(ctx context.Context, breakageID string) ([]models.BreakageHistoryEntry, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetBreakageHistory")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	if _, err := s.repo.GetBreakage(ctx, id, breakageID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetBreakageHistory(ctx, id, breakageID)
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Breakage catalogue
+// CreateBreakageType adds a type to the breakage catalogue of the company.
+func (s *Service) CreateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error) {
+	ctx, span := tracer.Start(ctx, "Service.CreateBreakageType")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	t.IDCompany = id
+
+	var res models.BreakageType
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateBreakageType(ctx, t)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceBreakageType, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.BreakageType{}, err
+	}
+	return res, nil
+}
+
+// UpdateBreakageType replaces a type of the breakage catalogue of the
+// company.
+func (s *Service) UpdateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error) {
+	ctx, span := tracer.Start(ctx, "Service.UpdateBreakageType")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.BreakageType{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	t.IDCompany = id
+
+	var res models.BreakageType
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetBreakageType(ctx, id, t.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err = s.repo.UpdateBreakageType(ctx, t)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceBreakageType, res.ID, before, res)
+	})
+	if err != nil {
+		return models.BreakageType{}, err
+	}
+	return res, nil
+}
+
+// DeleteBreakageType removes a type from the breakage catalogue of the
+// company.
+func (s *Service) DeleteBreakageType(ctx context.Context, typeID string) error {
+	ctx, span := tracer.Start(ctx, "Service.DeleteBreakageType")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		res, err := s.repo.DeleteBreakageType(ctx, id, typeID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionDelete, models.AuditResourceBreakageType, res.ID, res, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Service) GetBreakageTypes(ctx context.Context) ([]models.BreakageType, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetBreakageTypes")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetBreakageTypes(ctx, id)
+}
+
+// GetBreakageStats counts the breakages of the company by type, car model or
+// driver.
+func (s *Service) GetBreakageStats(ctx context.Context, q models.BreakageStatsQuery) ([]models.BreakageStats, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetBreakageStats")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	q.IDCompany = id
+
+	return s.repo.GetBreakageStats(ctx, q)
+}
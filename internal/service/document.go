@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// DocumentsParams are the parameters of the document expiry worker.
+type DocumentsParams struct {
+	Interval time.Duration
+	// Notice is how long before a licence or medical certificate expires a
+	// notification is raised.
+	Notice time.Duration
+}
+
+// Driver documents
+// AttachDriverDocument attaches a document to a driver of the company.
+func (s *Service) AttachDriverDocument(ctx context.Context, doc models.DriverDocument) (models.DriverDocument, error) {
+	ctx, span := tracer.Start(ctx, "Service.AttachDriverDocument")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.DriverDocument{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	doc.IDCompany = id
+
+	var res models.DriverDocument
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateDriverDocument(ctx, doc)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceDriverDocument, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.DriverDocument{}, err
+	}
+	return res, nil
+}
+
+func (s *Service) GetDriverDocuments(ctx context.Context, driverID string) ([]models.DriverDocument, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetDriverDocuments")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetDriverDocuments(ctx, id, driverID)
+}
+
+func (s *Service) GetDriverDocument(ctx context.Context, documentID string) (models.DriverDocument, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetDriverDocument")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.DriverDocument{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetDriverDocument(ctx, id, documentID)
+}
+
+// DeleteDriverDocument deletes a document of a driver of the company.
+func (s *Service) DeleteDriverDocument(ctx context.Context, documentID string) error {
+	ctx, span := tracer.Start(ctx, "Service.DeleteDriverDocument")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		res, err := s.repo.DeleteDriverDocument(ctx, id, documentID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionDelete, models.AuditResourceDriverDocument, res.ID, res, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Expiry alerts
+// CheckDocumentExpiry periodically raises a notification for every licence
+// and medical certificate of an active driver that expires within
+// params.Notice. It blocks until ctx is cancelled.
+func (s *Service) CheckDocumentExpiry(ctx context.Context, params DocumentsParams) {
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.checkDocumentExpiry(ctx, params.Notice)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) checkDocumentExpiry(ctx context.Context, notice time.Duration) {
+	ctx, span := tracer.Start(ctx, "Service.checkDocumentExpiry")
+	defer span.End()
+
+	now := time.Now()
+	alerts, err := s.repo.GetExpiringDocuments(ctx, now.Add(notice))
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get expiring documents: %v", err)
+		return
+	}
+	if len(alerts) == 0 {
+		return
+	}
+
+	for i := range alerts {
+		alerts[i].Note = expiryNote(alerts[i], now)
+	}
+
+	created, err := s.repo.SaveExpiryAlerts(ctx, alerts)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to save expiry alerts: %v", err)
+		return
+	}
+
+	logging.FromContext(ctx, s.log).Debugf("Raised %d expiry alerts", len(created))
+}
+
+// expiryNote describes an alert. A document is valid through its expiry
+// date.
+func expiryNote(a models.ExpiryAlert, now time.Time) string {
+	document := "Driving licence"
+	if a.Type == models.ExpiryMedicalCertificate {
+		document = "Medical certificate"
+	}
+	date := a.ExpiresAt.Format(time.DateOnly)
+	if now.Format(time.DateOnly) > date {
+		return fmt.Sprintf("%s expired on %s", document, date)
+	}
+	return fmt.Sprintf("%s expires on %s", document, date)
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371000
+
+// CreateGeofence adds a geofence to the company.
+func (s *Service) CreateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error) {
+	ctx, span := tracer.Start(ctx, "Service.CreateGeofence")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Geofence{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	g.IDCompany = id
+
+	var res models.Geofence
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateGeofence(ctx, g)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceGeofence, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.Geofence{}, err
+	}
+	return res, nil
+}
+
+// UpdateGeofence replaces a geofence of the company.
+func (s *Service) UpdateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error) {
+	ctx, span := tracer.Start(ctx, "Service.UpdateGeofence")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Geofence{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	g.IDCompany = id
+
+	var res models.Geofence
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetGeofence(ctx, id, g.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err = s.repo.UpdateGeofence(ctx, g)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceGeofence, res.ID, before, res)
+	})
+	if err != nil {
+		return models.Geofence{}, err
+	}
+	return res, nil
+}
+
+// DeleteGeofence removes a geofence of the company.
+func (s *Service) DeleteGeofence(ctx context.Context, geofenceID string) error {
+	ctx, span := tracer.Start(ctx, "Service.DeleteGeofence")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.DeleteGeofence(ctx, id, geofenceID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionDelete, models.AuditResourceGeofence, geofenceID, before, nil)
+	})
+}
+
+// GetGeofences returns the geofences of the company.
+func (s *Service) GetGeofences(ctx context.Context) ([]models.Geofence, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetGeofences")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetGeofences(ctx, id)
+}
+
+// checkGeofences notifies the company of the car, and its webhook endpoints,
+// of every active geofence the car entered or left moving from prev to
+// position. The first position of a car and positions older than prev cross
+// no boundary.
+func (s *Service) checkGeofences(ctx context.Context, car models.Car, prev *models.CurrentPosition, position models.Position) error {
+	if prev == nil || position.CreatedAt.Before(prev.UpdateAt) {
+		return nil
+	}
+
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		geofences, err := s.repo.GetActiveGeofences(ctx, car.IDCompany)
+		if err != nil {
+			return err
+		}
+
+		for _, g := range geofences {
+			transition, crossed := geofenceCrossing(g, prev.Location, position.Location)
+			if !crossed {
+				continue
+			}
+
+			note := models.NoteGeofenceEnter
+			if transition == models.GeofenceExit {
+				note = models.NoteGeofenceExit
+			}
+			n := models.GeofenceNotification{
+				IDCompany: car.IDCompany,
+				IDCar:     car.ID,
+				Note:      fmt.Sprintf(note, car.StateNumber, g.Name),
+				Notice: models.GeofenceNotice{
+					GeofenceID: g.ID,
+					Geofence:   g.Name,
+					Transition: transition,
+					Latitude:   position.Location.Latitude,
+					Longitude:  position.Location.Longitude,
+					OccurredAt: position.CreatedAt,
+				},
+				CreatedAt: time.Now(),
+			}
+			if _, err := s.repo.CreateGeofenceNotification(ctx, n); err != nil {
+				return fmt.Errorf("%w: %w", models.ErrFailedToCreateNotification, err)
+			}
+			if err := s.enqueue(ctx, car.IDCompany, models.WebhookGeofenceCrossed, geofenceWebhookData(n)); err != nil {
+				return err
+			}
+
+			logging.FromContext(ctx, s.log).Debugf("Car %s crossed geofence %s: %s", car.ID, g.ID, transition)
+		}
+		return nil
+	})
+}
+
+// geofenceCrossing reports whether a car moving from one point to another
+// crosses the boundary of g, and whether it enters or leaves g.
+func geofenceCrossing(g models.Geofence, from models.Point, to models.Point) (transition string, crossed bool) {
+	wasInside := distance(g.Center, from) <= g.Radius
+	isInside := distance(g.Center, to) <= g.Radius
+	switch {
+	case !wasInside && isInside:
+		return models.GeofenceEnter, true
+	case wasInside && !isInside:
+		return models.GeofenceExit, true
+	default:
+		return "", false
+	}
+}
+
+// distance returns the great-circle distance between two points in meters.
+func distance(a models.Point, b models.Point) float64 {
+	lat1 := float64(a.Latitude) * math.Pi / 180
+	lat2 := float64(b.Latitude) * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (float64(b.Longitude) - float64(a.Longitude)) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
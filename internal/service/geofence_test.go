@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	depot          = models.Geofence{ID: "g1", IDCompany: "c1", Name: "Depot", Center: models.Point{Latitude: 55.75, Longitude: 37.62}, Radius: 500, Active: true}
+	inDepot        = models.Point{Latitude: 55.751, Longitude: 37.62}
+	outsideDepot   = models.Point{Latitude: 55.76, Longitude: 37.62}
+	geofenceCar    = models.Car{ID: "car1", IDCompany: "c1", StateNumber: "A123BC", DeviceNumber: "D1"}
+	lastPositionAt = time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+)
+
+// positionRepo stores positions of geofenceCar, whose current position is
+// prev, and records the geofence notifications created and the webhook
+// events queued.
+type positionRepo struct {
+	Repository
+	prev      *models.CurrentPosition
+	geofences []models.Geofence
+
+	notified []models.GeofenceNotification
+	events   []models.WebhookEvent
+}
+
+func (r *positionRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *positionRepo) GetCarByDeviceNumber(ctx context.Context, device string) (models.Car, error) {
+	return geofenceCar, nil
+}
+
+func (r *positionRepo) GetCarPositionForUpdate(ctx context.Context, carID string) (models.CurrentPosition, error) {
+	if r.prev == nil {
+		return models.CurrentPosition{}, models.ErrNoContent
+	}
+	return *r.prev, nil
+}
+
+func (r *positionRepo) CreatePosition(ctx context.Context, position models.Position) (models.Position, error) {
+	return position, nil
+}
+
+func (r *positionRepo) CreateOrUpdateCarsPosition(ctx context.Context, position models.CurrentPosition) (models.CurrentPosition, error) {
+	return position, nil
+}
+
+func (r *positionRepo) GetActiveGeofences(ctx context.Context, companyID string) ([]models.Geofence, error) {
+	return r.geofences, nil
+}
+
+func (r *positionRepo) CreateGeofenceNotification(ctx context.Context, n models.GeofenceNotification) (string, error) {
+	r.notified = append(r.notified, n)
+	return "n1", nil
+}
+
+func (r *positionRepo) EnqueueWebhookEvent(ctx context.Context, e models.WebhookEvent) error {
+	r.events = append(r.events, e)
+	return nil
+}
+
+func TestGeofenceCrossing(t *testing.T) {
+	tests := []struct {
+		name           string
+		from, to       models.Point
+		wantTransition string
+		wantCrossed    bool
+	}{
+		{name: "enter", from: outsideDepot, to: inDepot, wantTransition: models.GeofenceEnter, wantCrossed: true},
+		{name: "exit", from: inDepot, to: outsideDepot, wantTransition: models.GeofenceExit, wantCrossed: true},
+		{name: "stay inside", from: inDepot, to: depot.Center},
+		{name: "stay outside", from: outsideDepot, to: models.Point{Latitude: 55.77, Longitude: 37.62}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transition, crossed := geofenceCrossing(depot, tt.from, tt.to)
+			assert.Equal(t, tt.wantTransition, transition)
+			assert.Equal(t, tt.wantCrossed, crossed)
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// A thousandth of a degree of latitude is about 111 meters.
+	assert.InDelta(t, 111.2, distance(depot.Center, inDepot), 0.5)
+	assert.Zero(t, distance(inDepot, inDepot))
+}
+
+func TestCreatePositionChecksGeofences(t *testing.T) {
+	ctx := context.WithValue(context.Background(), models.UserIDKey, "c1")
+	at := lastPositionAt.Add(time.Minute)
+
+	tests := []struct {
+		name       string
+		prev       *models.CurrentPosition
+		position   models.Position
+		wantNotice []models.GeofenceNotice
+		wantNotes  []string
+	}{
+		{
+			name:     "enter",
+			prev:     &models.CurrentPosition{IDCar: "car1", Location: outsideDepot, UpdateAt: lastPositionAt},
+			position: models.Position{DeviceNumber: "D1", Location: inDepot, CreatedAt: at},
+			wantNotice: []models.GeofenceNotice{{GeofenceID: "g1", Geofence: "Depot", Transition: models.GeofenceEnter,
+				Latitude: inDepot.Latitude, Longitude: inDepot.Longitude, OccurredAt: at}},
+			wantNotes: []string{"Автомобиль A123BC въехал в геозону «Depot»"},
+		},
+		{
+			name:     "exit",
+			prev:     &models.CurrentPosition{IDCar: "car1", Location: inDepot, UpdateAt: lastPositionAt},
+			position: models.Position{DeviceNumber: "D1", Location: outsideDepot, CreatedAt: at},
+			wantNotice: []models.GeofenceNotice{{GeofenceID: "g1", Geofence: "Depot", Transition: models.GeofenceExit,
+				Latitude: outsideDepot.Latitude, Longitude: outsideDepot.Longitude, OccurredAt: at}},
+			wantNotes: []string{"Автомобиль A123BC выехал из геозоны «Depot»"},
+		},
+		{
+			name:     "first position",
+			position: models.Position{DeviceNumber: "D1", Location: inDepot, CreatedAt: at},
+		},
+		{
+			name:     "position older than the current one",
+			prev:     &models.CurrentPosition{IDCar: "car1", Location: outsideDepot, UpdateAt: lastPositionAt},
+			position: models.Position{DeviceNumber: "D1", Location: inDepot, CreatedAt: lastPositionAt.Add(-time.Minute)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &positionRepo{prev: tt.prev, geofences: []models.Geofence{depot}}
+			s := NewService(repo, nopMetrics{}, logrus.New())
+
+			_, err := s.CreatePosition(ctx, tt.position)
+			assert.NoError(t, err)
+
+			var notices []models.GeofenceNotice
+			var notes []string
+			for _, n := range repo.notified {
+				assert.Equal(t, "c1", n.IDCompany)
+				assert.Equal(t, "car1", n.IDCar)
+				notices = append(notices, n.Notice)
+				notes = append(notes, n.Note)
+			}
+			assert.Equal(t, tt.wantNotice, notices)
+			assert.Equal(t, tt.wantNotes, notes)
+
+			// Every crossing is also sent to the webhook endpoints.
+			assert.Len(t, repo.events, len(tt.wantNotice))
+			for i, e := range repo.events {
+				assert.Equal(t, "c1", e.IDCompany)
+				assert.Equal(t, models.WebhookGeofenceCrossed, e.Type)
+
+				var envelope struct {
+					Data models.GeofenceWebhookData `json:"data"`
+				}
+				assert.NoError(t, json.Unmarshal(e.Payload, &envelope))
+				assert.Equal(t, models.GeofenceWebhookData{
+					GeofenceID: "g1",
+					Geofence:   "Depot",
+					CarID:      "car1",
+					Transition: tt.wantNotice[i].Transition,
+					Latitude:   tt.wantNotice[i].Latitude,
+					Longitude:  tt.wantNotice[i].Longitude,
+					OccurredAt: at,
+				}, envelope.Data)
+			}
+		})
+	}
+}
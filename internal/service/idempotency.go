@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// ClaimIdempotencyKey records that the company is making the request of k,
+// for ttl. The retry of a completed request gets that request back, with the
+// response to replay. Reusing the key of another request fails with
+// ErrIdempotencyKeyReused, and of one in progress with
+// ErrIdempotencyKeyInProgress.
+func (s *Service) ClaimIdempotencyKey(ctx context.Context, k models.IdempotencyKey, ttl time.Duration) (models.IdempotencyKey, error) {
+	ctx, span := tracer.Start(ctx, "Service.ClaimIdempotencyKey")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.IdempotencyKey{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	k.IDCompany = id
+
+	now := time.Now()
+	k.ExpiresAt = now.Add(ttl)
+	res, claimed, err := s.repo.ClaimIdempotencyKey(ctx, k, now)
+	if err != nil {
+		return models.IdempotencyKey{}, err
+	}
+	if claimed {
+		return res, nil
+	}
+
+	if res.Route != k.Route || res.RequestHash != k.RequestHash {
+		return models.IdempotencyKey{}, models.ErrIdempotencyKeyReused
+	}
+	if res.StatusCode == nil {
+		return models.IdempotencyKey{}, models.ErrIdempotencyKeyInProgress
+	}
+	logging.FromContext(ctx, s.log).Debugf("Replaying response to idempotency key %s of company %s", k.Key, id)
+	return res, nil
+}
+
+// SaveIdempotentResponse completes the request of a claimed key with its
+// response. It is saved even if the client is gone, as it is the response its
+// retry needs.
+func (s *Service) SaveIdempotentResponse(ctx context.Context, k models.IdempotencyKey) error {
+	ctx, span := tracer.Start(ctx, "Service.SaveIdempotentResponse")
+	defer span.End()
+
+	return s.repo.SaveIdempotentResponse(context.WithoutCancel(ctx), k)
+}
+
+// ReleaseIdempotencyKey forgets a claimed key whose request failed, so that
+// the request can be retried with it.
+func (s *Service) ReleaseIdempotencyKey(ctx context.Context, k models.IdempotencyKey) error {
+	ctx, span := tracer.Start(ctx, "Service.ReleaseIdempotencyKey")
+	defer span.End()
+
+	return s.repo.ReleaseIdempotencyKey(context.WithoutCancel(ctx), k.IDCompany, k.Key)
+}
+
+// PruneIdempotencyKeys deletes expired idempotency keys every interval until
+// ctx is done.
+func (s *Service) PruneIdempotencyKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.pruneIdempotencyKeys(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) pruneIdempotencyKeys(ctx context.Context) {
+	ctx, span := tracer.Start(ctx, "Service.pruneIdempotencyKeys")
+	defer span.End()
+
+	if _, err := s.repo.DeleteExpiredIdempotencyKeys(ctx, time.Now()); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to delete expired idempotency keys: %v", err)
+	}
+}
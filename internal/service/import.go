@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Import
+// Import checks batch against the stored data and, unless dryRun is set or
+// some row is invalid, creates all of its records at once. Cars are resolved
+// by state number among the company's cars.
+func (s *Service) Import(ctx context.Context, batch models.ImportBatch, dryRun bool) (models.ImportResult, error) {
+	ctx, span := tracer.Start(ctx, "Service.Import")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.ImportResult{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	batch.IDCompany = id
+
+	if err := s.resolveImport(ctx, &batch); err != nil {
+		return models.ImportResult{}, err
+	}
+
+	sort.SliceStable(batch.Errors, func(i, j int) bool {
+		return batch.Errors[i].Row < batch.Errors[j].Row
+	})
+	result := models.ImportResult{
+		Kind:   batch.Kind,
+		DryRun: dryRun,
+		Rows:   batch.Rows,
+		Errors: batch.Errors,
+	}
+	if dryRun || len(batch.Errors) > 0 {
+		logging.FromContext(ctx, s.log).Debugf("Import of %d %s not applied: dry run %t, %d errors", batch.Rows, batch.Kind, dryRun, len(batch.Errors))
+		return result, nil
+	}
+
+	err := s.repo.InLongTx(ctx, func(ctx context.Context) error {
+		created, err := s.repo.Import(ctx, batch)
+		if err != nil {
+			return err
+		}
+		result.Created = created
+
+		return s.audit(ctx, id, models.AuditActionImport, importAuditResource(batch.Kind), "", nil, map[string]any{"Created": created})
+	})
+	if err != nil {
+		return models.ImportResult{}, err
+	}
+	return result, nil
+}
+
+// resolveImport fills in the company and car of every record of batch and
+// adds an error for each row that conflicts with the stored data or with an
+// earlier row.
+func (s *Service) resolveImport(ctx context.Context, batch *models.ImportBatch) error {
+	var stateNumbers []string
+	for _, row := range batch.Cars {
+		stateNumbers = append(stateNumbers, row.Car.StateNumber)
+	}
+	for _, row := range batch.Wheels {
+		stateNumbers = append(stateNumbers, row.StateNumber)
+	}
+	for _, row := range batch.Drivers {
+		stateNumbers = append(stateNumbers, row.StateNumber)
+	}
+	if len(stateNumbers) == 0 {
+		return nil
+	}
+
+	cars, err := s.repo.GetCarsByStateNumbers(ctx, stateNumbers)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]models.Car, len(cars))
+	for _, car := range cars {
+		existing[car.StateNumber] = car
+	}
+
+	rowError := func(row int, column, message string) {
+		batch.Errors = append(batch.Errors, models.ImportRowError{Row: row, Column: column, Message: message})
+	}
+	// companyCar returns the company's car, as other companies' cars must not
+	// be told apart from missing ones.
+	companyCar := func(row int, stateNumber string) (models.Car, bool) {
+		car, ok := existing[stateNumber]
+		if !ok || car.IDCompany != batch.IDCompany {
+			rowError(row, "stateNumber", "no car with this state number")
+			return models.Car{}, false
+		}
+		return car, true
+	}
+
+	seenCars := make(map[string]int)
+	for i, row := range batch.Cars {
+		if first, ok := seenCars[row.Car.StateNumber]; ok {
+			rowError(row.Row, "stateNumber", fmt.Sprintf("duplicates row %d", first))
+			continue
+		}
+		seenCars[row.Car.StateNumber] = row.Row
+		if _, ok := existing[row.Car.StateNumber]; ok {
+			rowError(row.Row, "stateNumber", "state number is already registered")
+			continue
+		}
+		batch.Cars[i].Car.IDCompany = batch.IDCompany
+	}
+
+	type wheelPlace struct {
+		idCar    string
+		axle     int
+		position int
+	}
+	seenWheels := make(map[wheelPlace]int)
+	for i, row := range batch.Wheels {
+		car, ok := companyCar(row.Row, row.StateNumber)
+		if !ok {
+			continue
+		}
+		if row.Wheel.AxisNumber > car.CountAxis {
+			rowError(row.Row, "axleNumber", fmt.Sprintf("must not exceed the car's axle count of %d", car.CountAxis))
+			continue
+		}
+		place := wheelPlace{car.ID, row.Wheel.AxisNumber, row.Wheel.Position}
+		if first, ok := seenWheels[place]; ok {
+			rowError(row.Row, "wheelPosition", fmt.Sprintf("duplicates row %d", first))
+			continue
+		}
+		seenWheels[place] = row.Row
+		batch.Wheels[i].Wheel.IDCompany = batch.IDCompany
+		batch.Wheels[i].Wheel.IDCar = car.ID
+	}
+
+	// A car is driven by one driver at a time, so each driver of the file is
+	// assigned to a different car.
+	seenDrivers := make(map[string]int)
+	for i, row := range batch.Drivers {
+		car, ok := companyCar(row.Row, row.StateNumber)
+		if !ok {
+			continue
+		}
+		if first, ok := seenDrivers[car.ID]; ok {
+			rowError(row.Row, "stateNumber", fmt.Sprintf("the car is already assigned to the driver of row %d", first))
+			continue
+		}
+		seenDrivers[car.ID] = row.Row
+		batch.Drivers[i].Driver.IDCompany = batch.IDCompany
+		batch.Drivers[i].Driver.IDCar = car.ID
+	}
+
+	return nil
+}
+
+func importAuditResource(kind string) string {
+	switch kind {
+	case models.ImportKindWheels:
+		return models.AuditResourceWheel
+	case models.ImportKindDrivers:
+		return models.AuditResourceDriver
+	default:
+		return models.AuditResourceCar
+	}
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// MaintenanceParams are the parameters of the maintenance worker.
+type MaintenanceParams struct {
+	Interval time.Duration
+	// Notice is how long before a plan by interval makes a car due its task
+	// is recorded.
+	Notice time.Duration
+	// MileageNotice is how many km before a plan by mileage makes a wheel due
+	// its task is recorded.
+	MileageNotice float64
+}
+
+// Maintenance plans
+// CreateMaintenancePlan adds a maintenance plan to the company.
+func (s *Service) CreateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error) {
+	ctx, span := tracer.Start(ctx, "Service.CreateMaintenancePlan")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.MaintenancePlan{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	p.IDCompany = id
+
+	var res models.MaintenancePlan
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateMaintenancePlan(ctx, p)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceMaintenancePlan, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.MaintenancePlan{}, err
+	}
+	return res, nil
+}
+
+// UpdateMaintenancePlan replaces a maintenance plan of the company.
+func (s *Service) UpdateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error) {
+	ctx, span := tracer.Start(ctx, "Service.UpdateMaintenancePlan")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.MaintenancePlan{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	p.IDCompany = id
+
+	var res models.MaintenancePlan
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetMaintenancePlan(ctx, id, p.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err = s.repo.UpdateMaintenancePlan(ctx, p)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceMaintenancePlan, res.ID, before, res)
+	})
+	if err != nil {
+		return models.MaintenancePlan{}, err
+	}
+	return res, nil
+}
+
+// GetMaintenancePlans returns the maintenance plans of the company.
+func (s *Service) GetMaintenancePlans(ctx context.Context) ([]models.MaintenancePlan, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetMaintenancePlans")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetMaintenancePlans(ctx, id)
+}
+
+// Maintenance tasks
+// CheckMaintenance periodically records a task, and raises a notification,
+// for the maintenance that active plans make due within params.Notice or
+// params.MileageNotice km. It blocks until ctx is cancelled.
+func (s *Service) CheckMaintenance(ctx context.Context, params MaintenanceParams) {
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.checkMaintenance(ctx, params)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) checkMaintenance(ctx context.Context, params MaintenanceParams) {
+	ctx, span := tracer.Start(ctx, "Service.checkMaintenance")
+	defer span.End()
+
+	now := time.Now()
+	due, err := s.repo.GetDueMaintenance(ctx, now, now.Add(params.Notice), params.MileageNotice)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get due maintenance: %v", err)
+		return
+	}
+	if len(due) == 0 {
+		return
+	}
+
+	for i := range due {
+		due[i].Reason = maintenanceReason(due[i])
+	}
+
+	created, err := s.repo.SaveMaintenanceTasks(ctx, due)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to save maintenance tasks: %v", err)
+		return
+	}
+
+	logging.FromContext(ctx, s.log).Debugf("Recorded %d maintenance tasks", len(created))
+}
+
+// maintenanceReason describes due maintenance.
+func maintenanceReason(d models.MaintenanceDue) string {
+	what := fmt.Sprintf("%s of %s", d.PlanName, d.StateNumber)
+	if d.WheelPosition != nil {
+		what += fmt.Sprintf(", wheel %d,", *d.WheelPosition)
+	}
+	switch {
+	case d.DueMileage != nil:
+		return fmt.Sprintf("%s is due at %.0f km", what, *d.DueMileage)
+	case d.DueAt != nil && d.Overdue:
+		return fmt.Sprintf("%s is due since %s", what, d.DueAt.Format(time.DateOnly))
+	case d.DueAt != nil:
+		return fmt.Sprintf("%s is due on %s", what, d.DueAt.Format(time.DateOnly))
+	}
+	return what + " is due"
+}
+
+// GetUpcomingMaintenance returns the open maintenance tasks of the company,
+// of a car of it if carID is set.
+func (s *Service) GetUpcomingMaintenance(ctx context.Context, carID *string) ([]models.MaintenanceDue, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetUpcomingMaintenance")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetUpcomingMaintenance(ctx, models.MaintenanceFilter{IDCompany: id, IDCar: carID}, time.Now())
+}
+
+// Work orders
+// OpenWorkOrder opens a work order of the company from a breakage or a
+// maintenance task.
+func (s *Service) OpenWorkOrder(ctx context.Context, o models.WorkOrder) (models.WorkOrder, error) {
+	ctx, span := tracer.Start(ctx, "Service.OpenWorkOrder")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	o.IDCompany = id
+	o.CreatedBy = id
+
+	var res models.WorkOrder
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateWorkOrder(ctx, o)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceWorkOrder, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.WorkOrder{}, err
+	}
+	return res, nil
+}
+
+// GetWorkOrder returns a work order of the company.
+func (s *Service) GetWorkOrder(ctx context.Context, orderID string) (models.WorkOrder, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWorkOrder")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetWorkOrder(ctx, id, orderID)
+}
+
+// GetWorkOrders returns a page of the work orders of the company.
+func (s *Service) GetWorkOrders(ctx context.Context, filter models.WorkOrderFilter, page models.PageRequest) (models.Page[models.WorkOrder], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWorkOrders")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Page[models.WorkOrder]{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.GetWorkOrders(ctx, filter, page)
+}
+
+// CompleteWorkOrder completes an open work order of the company, updating
+// the wheel it is for and its maintenance task.
+func (s *Service) CompleteWorkOrder(ctx context.Context, c models.WorkOrderCompletion) (models.WorkOrder, error) {
+	ctx, span := tracer.Start(ctx, "Service.CompleteWorkOrder")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	c.IDCompany = id
+	c.CompletedAt = time.Now()
+
+	var res models.WorkOrder
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CompleteWorkOrder(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionTransition, models.AuditResourceWorkOrder, res.ID,
+			map[string]any{"Status": models.WorkOrderOpen}, map[string]any{"Status": res.Status, "Tire": c.Tire, "ResetMileage": c.ResetMileage})
+	})
+	if err != nil {
+		return models.WorkOrder{}, err
+	}
+	return res, nil
+}
+
+// CancelWorkOrder cancels an open work order of the company.
+func (s *Service) CancelWorkOrder(ctx context.Context, orderID string) (models.WorkOrder, error) {
+	ctx, span := tracer.Start(ctx, "Service.CancelWorkOrder")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WorkOrder{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	var res models.WorkOrder
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CancelWorkOrder(ctx, id, orderID, time.Now())
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionTransition, models.AuditResourceWorkOrder, res.ID,
+			map[string]any{"Status": models.WorkOrderOpen}, map[string]any{"Status": res.Status})
+	})
+	if err != nil {
+		return models.WorkOrder{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// maintenanceRepo returns the due maintenance it holds and records the
+// tasks saved and the audit entries written.
+type maintenanceRepo struct {
+	Repository
+	due      []models.MaintenanceDue
+	dueErr   error
+	auditErr error
+
+	now           time.Time
+	before        time.Time
+	mileageNotice float64
+	saved         []models.MaintenanceDue
+	audited       []models.AuditEntry
+}
+
+func (r *maintenanceRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *maintenanceRepo) GetDueMaintenance(ctx context.Context, now time.Time, before time.Time, mileageNotice float64) ([]models.MaintenanceDue, error) {
+	r.now, r.before, r.mileageNotice = now, before, mileageNotice
+	return r.due, r.dueErr
+}
+
+func (r *maintenanceRepo) SaveMaintenanceTasks(ctx context.Context, due []models.MaintenanceDue) ([]models.MaintenanceDue, error) {
+	r.saved = due
+	return due, nil
+}
+
+func (r *maintenanceRepo) CompleteWorkOrder(ctx context.Context, c models.WorkOrderCompletion) (models.WorkOrder, error) {
+	return models.WorkOrder{ID: c.IDWorkOrder, Status: models.WorkOrderCompleted}, nil
+}
+
+func (r *maintenanceRepo) CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error) {
+	if r.auditErr != nil {
+		return models.AuditEntry{}, r.auditErr
+	}
+	r.audited = append(r.audited, entry)
+	return entry, nil
+}
+
+func TestMaintenanceReason(t *testing.T) {
+	dueAt := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	dueMileage := 15000.0
+	position := 3
+
+	tests := []struct {
+		name string
+		due  models.MaintenanceDue
+		want string
+	}{
+		{
+			name: "by interval",
+			due:  models.MaintenanceDue{PlanName: "Inspection", StateNumber: "A123BC", MaintenanceTask: models.MaintenanceTask{DueAt: &dueAt}},
+			want: "Inspection of A123BC is due on 2026-03-02",
+		},
+		{
+			name: "overdue",
+			due: models.MaintenanceDue{PlanName: "Inspection", StateNumber: "A123BC", Overdue: true,
+				MaintenanceTask: models.MaintenanceTask{DueAt: &dueAt}},
+			want: "Inspection of A123BC is due since 2026-03-02",
+		},
+		{
+			name: "wheel by mileage",
+			due: models.MaintenanceDue{PlanName: "Rotation", StateNumber: "A123BC", WheelPosition: &position,
+				MaintenanceTask: models.MaintenanceTask{DueMileage: &dueMileage}},
+			want: "Rotation of A123BC, wheel 3, is due at 15000 km",
+		},
+		{
+			name: "by condition",
+			due:  models.MaintenanceDue{PlanName: "Replacement", StateNumber: "A123BC"},
+			want: "Replacement of A123BC is due",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, maintenanceReason(tt.due))
+		})
+	}
+}
+
+func TestCheckMaintenance(t *testing.T) {
+	dueMileage := 15000.0
+	params := MaintenanceParams{Notice: 7 * 24 * time.Hour, MileageNotice: 500}
+
+	tests := []struct {
+		name      string
+		due       []models.MaintenanceDue
+		dueErr    error
+		wantSaved []string
+	}{
+		{
+			name: "due maintenance",
+			due: []models.MaintenanceDue{{PlanName: "Rotation", StateNumber: "A123BC",
+				MaintenanceTask: models.MaintenanceTask{DueMileage: &dueMileage}}},
+			wantSaved: []string{"Rotation of A123BC is due at 15000 km"},
+		},
+		{
+			name: "nothing due",
+		},
+		{
+			name:   "failed to get due maintenance",
+			dueErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &maintenanceRepo{due: tt.due, dueErr: tt.dueErr}
+			s := NewService(repo, nil, logrus.New())
+
+			s.checkMaintenance(context.Background(), params)
+
+			assert.Equal(t, params.Notice, repo.before.Sub(repo.now))
+			assert.Equal(t, params.MileageNotice, repo.mileageNotice)
+			var saved []string
+			for _, d := range repo.saved {
+				saved = append(saved, d.Reason)
+			}
+			assert.Equal(t, tt.wantSaved, saved)
+		})
+	}
+}
+
+func TestCompleteWorkOrderAudit(t *testing.T) {
+	ctx := context.WithValue(context.Background(), models.UserIDKey, "c1")
+
+	repo := &maintenanceRepo{}
+	s := NewService(repo, nil, logrus.New())
+	res, err := s.CompleteWorkOrder(ctx, models.WorkOrderCompletion{IDWorkOrder: "o1"})
+	assert.NoError(t, err)
+	assert.Equal(t, models.WorkOrderCompleted, res.Status)
+	if assert.Len(t, repo.audited, 1) {
+		assert.Equal(t, models.AuditResourceWorkOrder, repo.audited[0].ResourceType)
+		assert.Equal(t, "o1", repo.audited[0].ResourceID)
+	}
+
+	repo = &maintenanceRepo{auditErr: errors.New("connection refused")}
+	s = NewService(repo, nil, logrus.New())
+	_, err = s.CompleteWorkOrder(ctx, models.WorkOrderCompletion{IDWorkOrder: "o1"})
+	assert.Error(t, err)
+}
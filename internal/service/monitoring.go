@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+)
+
+// MonitorSilentDevices periodically counts the devices of every company that
+// have not reported within threshold, publishes the counts to the metrics and
+// notifies the companies of the devices that went silent. It blocks until ctx
+// is cancelled.
+func (s *Service) MonitorSilentDevices(ctx context.Context, threshold time.Duration, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.updateSilentDevices(ctx, threshold)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) updateSilentDevices(ctx context.Context, threshold time.Duration) {
+	ctx, span := tracer.Start(ctx, "Service.updateSilentDevices")
+	defer span.End()
+
+	since := time.Now().Add(-threshold)
+	counts, err := s.repo.CountSilentDevices(ctx, since)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to count silent devices: %v", err)
+		return
+	}
+	s.metrics.SetSilentDevices(counts)
+
+	if _, err := s.repo.CreateSensorOfflineNotifications(ctx, since); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to notify of silent devices: %v", err)
+	}
+}
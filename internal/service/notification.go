@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// checkSensorThresholds notifies the company of the wheel of the sensor when
+// the reading is out of the bounds of the wheel. Readings of sensors of no
+// wheel and bounds that are not set are not checked.
+func (s *Service) checkSensorThresholds(ctx context.Context, data models.SensorData) error {
+	wheel, err := s.repo.GetWheelBySensor(ctx, data.DeviceNumber, data.SensorNumber)
+	if errors.Is(err, models.ErrNoContent) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	checks := []struct {
+		metric   string
+		value    float32
+		min, max *float32
+	}{
+		{models.MetricPressure, data.Pressure, wheel.MinPressure, wheel.MaxPressure},
+		{models.MetricTemperature, data.Temperature, wheel.MinTemperature, wheel.MaxTemperature},
+	}
+	for _, c := range checks {
+		if !outOfBounds(c.value, c.min, c.max) {
+			continue
+		}
+
+		n := models.SensorThresholdNotification{
+			IDCompany: wheel.IDCompany,
+			IDCar:     wheel.IDCar,
+			Note: fmt.Sprintf(models.NoteSensorThreshold,
+				wheel.Position, wheel.AxisNumber, models.MetricNames[c.metric], c.value, boundsText(c.min, c.max)),
+			Notice: models.SensorThresholdNotice{
+				WheelID:      wheel.ID,
+				DeviceNumber: data.DeviceNumber,
+				SensorNumber: data.SensorNumber,
+				Metric:       c.metric,
+				Value:        c.value,
+				Min:          c.min,
+				Max:          c.max,
+				MeasuredAt:   data.Time,
+			},
+			CreatedAt: time.Now(),
+		}
+		created, err := s.repo.CreateSensorThresholdNotification(ctx, n)
+		if err != nil {
+			return fmt.Errorf("%w: %w", models.ErrFailedToCreateNotification, err)
+		}
+		if created {
+			logging.FromContext(ctx, s.log).Debugf("Sensor %s of device %s out of %s range", data.SensorNumber, data.DeviceNumber, c.metric)
+		}
+	}
+	return nil
+}
+
+// outOfBounds reports whether value is below min or above max. Nil bounds
+// are not set.
+func outOfBounds(value float32, min, max *float32) bool {
+	return (min != nil && value < *min) || (max != nil && value > *max)
+}
+
+// boundsText describes the bounds of a reading in a note.
+func boundsText(min, max *float32) string {
+	switch {
+	case min != nil && max != nil:
+		return fmt.Sprintf("%.2f–%.2f", *min, *max)
+	case min != nil:
+		return fmt.Sprintf("не ниже %.2f", *min)
+	default:
+		return fmt.Sprintf("не выше %.2f", *max)
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// thresholdRepo records the sensor threshold notifications created for the
+// wheel it holds. A notification is not created again for a wheel and
+// metric that already has one, as the unique index does.
+type thresholdRepo struct {
+	Repository
+	wheel         *models.SensorWheel
+	notifications []models.SensorThresholdNotification
+	open          map[string]bool
+}
+
+func (r *thresholdRepo) GetWheelBySensor(ctx context.Context, deviceNumber string, sensorNumber string) (models.SensorWheel, error) {
+	if r.wheel == nil {
+		return models.SensorWheel{}, models.ErrNoContent
+	}
+	return *r.wheel, nil
+}
+
+func (r *thresholdRepo) CreateSensorThresholdNotification(ctx context.Context, n models.SensorThresholdNotification) (bool, error) {
+	key := n.Notice.WheelID + "/" + n.Notice.Metric
+	if r.open[key] {
+		return false, nil
+	}
+	if r.open == nil {
+		r.open = make(map[string]bool)
+	}
+	r.open[key] = true
+	r.notifications = append(r.notifications, n)
+	return true, nil
+}
+
+func bound(v float32) *float32 {
+	return &v
+}
+
+func TestCheckSensorThresholds(t *testing.T) {
+	wheel := models.SensorWheel{
+		ID:             "w1",
+		IDCompany:      "c1",
+		IDCar:          "car1",
+		AxisNumber:     1,
+		Position:       2,
+		MinPressure:    bound(6),
+		MaxPressure:    bound(8),
+		MinTemperature: bound(-40),
+		MaxTemperature: bound(90),
+	}
+	at := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		wheel   *models.SensorWheel
+		data    models.SensorData
+		metrics []string
+	}{
+		{
+			name:  "in range",
+			wheel: &wheel,
+			data:  models.SensorData{Pressure: 7, Temperature: 30, Time: at},
+		},
+		{
+			name:  "on the bounds",
+			wheel: &wheel,
+			data:  models.SensorData{Pressure: 8, Temperature: -40, Time: at},
+		},
+		{
+			name:    "pressure too low",
+			wheel:   &wheel,
+			data:    models.SensorData{Pressure: 5.5, Temperature: 30, Time: at},
+			metrics: []string{models.MetricPressure},
+		},
+		{
+			name:    "both out of range",
+			wheel:   &wheel,
+			data:    models.SensorData{Pressure: 9, Temperature: 95, Time: at},
+			metrics: []string{models.MetricPressure, models.MetricTemperature},
+		},
+		{
+			name:  "bounds not set",
+			wheel: &models.SensorWheel{ID: "w2", IDCompany: "c1", IDCar: "car1"},
+			data:  models.SensorData{Pressure: 100, Temperature: 200, Time: at},
+		},
+		{
+			name:    "only max set",
+			wheel:   &models.SensorWheel{ID: "w3", IDCompany: "c1", IDCar: "car1", MaxTemperature: bound(90)},
+			data:    models.SensorData{Pressure: 0, Temperature: 120, Time: at},
+			metrics: []string{models.MetricTemperature},
+		},
+		{
+			name: "sensor of no wheel",
+			data: models.SensorData{Pressure: 100, Temperature: 200, Time: at},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &thresholdRepo{wheel: tt.wheel}
+			s := NewService(repo, nil, logrus.New())
+
+			err := s.checkSensorThresholds(context.Background(), tt.data)
+			assert.NoError(t, err)
+
+			var metrics []string
+			for _, n := range repo.notifications {
+				assert.Equal(t, tt.wheel.IDCompany, n.IDCompany)
+				assert.Equal(t, tt.wheel.ID, n.Notice.WheelID)
+				assert.Equal(t, at, n.Notice.MeasuredAt)
+				metrics = append(metrics, n.Notice.Metric)
+			}
+			assert.Equal(t, tt.metrics, metrics)
+		})
+	}
+}
+
+func TestCheckSensorThresholdsNotifiesOnce(t *testing.T) {
+	repo := &thresholdRepo{wheel: &models.SensorWheel{ID: "w1", IDCompany: "c1", IDCar: "car1", Position: 2, AxisNumber: 1,
+		MinPressure: bound(6), MaxPressure: bound(8)}}
+	s := NewService(repo, nil, logrus.New())
+
+	for _, pressure := range []float32{9, 9.5, 10} {
+		err := s.checkSensorThresholds(context.Background(), models.SensorData{Pressure: pressure})
+		assert.NoError(t, err)
+	}
+
+	if assert.Len(t, repo.notifications, 1) {
+		n := repo.notifications[0]
+		assert.Equal(t, float32(9), n.Notice.Value)
+		assert.Equal(t, "Колесо 2 оси 1: давление 9.00 вне допустимых пределов 6.00–8.00", n.Note)
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Portability
+// ExportEntity streams the company's records of entity to w.
+func (s *Service) ExportEntity(ctx context.Context, entity string, filter models.ExportFilter, w models.RecordWriter) error {
+	ctx, span := tracer.Start(ctx, "Service.ExportEntity")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.ExportEntity(ctx, entity, filter, w)
+}
+
+// AuditExport records that the company's data has been exported with filter.
+func (s *Service) AuditExport(ctx context.Context, filter models.ExportFilter, format string) error {
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.audit(ctx, id, models.AuditActionExport, models.AuditResourceCompany, id, nil, map[string]any{
+		"Format": format,
+		"From":   filter.From,
+		"To":     filter.To,
+	})
+}
+
+// Restore creates the records of an export archive for the company.
+func (s *Service) Restore(ctx context.Context, src models.RecordSource) ([]models.RestoreResult, error) {
+	ctx, span := tracer.Start(ctx, "Service.Restore")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	var results []models.RestoreResult
+	err := s.repo.InLongTx(ctx, func(ctx context.Context) error {
+		var err error
+		results, err = s.repo.Restore(ctx, id, src)
+		if err != nil {
+			return err
+		}
+
+		created := 0
+		for _, res := range results {
+			created += res.Created
+		}
+		logging.FromContext(ctx, s.log).Debugf("Restored %d records for userID=%s", created, id)
+
+		return s.audit(ctx, id, models.AuditActionImport, models.AuditResourceCompany, id, nil, map[string]any{"Created": created})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
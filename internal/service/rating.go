@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// The rating out of 10 is the sum of these components. Each behaviour
+// component loses its points linearly as the driver's rate of the behaviour
+// approaches the rate at which it is worth nothing.
+const (
+	maxSafety          = 3
+	maxSmoothness      = 2
+	maxSpeedDiscipline = 2
+	maxTireCare        = 2
+	maxExperience      = 1
+
+	// Breakages per 100 hours driven.
+	worstBreakageRate = 5
+	// Harsh brakings and accelerations per 10 hours driven.
+	worstHarshEventRate = 10
+	// Share of the driven time spent speeding.
+	worstSpeedingShare = 0.2
+	// Hours driven on under-inflated tires after an alert.
+	worstUnderInflatedHours = 10
+	// Worked hours at which a driver is fully experienced.
+	fullExperienceHours = 1000
+
+	// ratingMaxGap is the longest gap between two position reports that is
+	// still taken as continuous driving.
+	ratingMaxGap = 5 * time.Minute
+)
+
+// RatingParams are the parameters of the driver rating worker.
+type RatingParams struct {
+	Interval          time.Duration
+	Window            time.Duration
+	SpeedLimit        float64
+	HarshAcceleration float64
+}
+
+// RateDrivers periodically recalculates the rating of every driver from their
+// behaviour over the last params.Window. It blocks until ctx is cancelled.
+func (s *Service) RateDrivers(ctx context.Context, params RatingParams) {
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.updateDriverRatings(ctx, params)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) updateDriverRatings(ctx context.Context, params RatingParams) {
+	ctx, span := tracer.Start(ctx, "Service.updateDriverRatings")
+	defer span.End()
+
+	now := time.Now()
+	query := models.BehaviourQuery{
+		Since:             now.Add(-params.Window),
+		SpeedLimit:        params.SpeedLimit,
+		HarshAcceleration: params.HarshAcceleration,
+		MaxGap:            ratingMaxGap,
+	}
+	behaviour, err := s.repo.GetDriverBehaviour(ctx, query)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to measure driver behaviour: %v", err)
+		return
+	}
+
+	ratings := make([]models.DriverRating, len(behaviour))
+	for i, b := range behaviour {
+		ratings[i] = rateDriver(b)
+		ratings[i].WindowStart = query.Since
+		ratings[i].CalculatedAt = now
+	}
+	if err := s.repo.SaveDriverRatings(ctx, ratings); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to save driver ratings: %v", err)
+		return
+	}
+
+	logging.FromContext(ctx, s.log).Debugf("Rated %d drivers", len(ratings))
+}
+
+// rateDriver scores behaviour. A driver who has not driven during the window
+// keeps full points for the behaviour components.
+func rateDriver(b models.DriverBehaviour) models.DriverRating {
+	drivenHours := b.DrivenSeconds / 3600
+
+	var breakageRate, harshEventRate, speedingShare float64
+	if drivenHours > 0 {
+		breakageRate = float64(b.Breakages) / drivenHours * 100
+		harshEventRate = float64(b.HarshEvents) / drivenHours * 10
+		speedingShare = b.SpeedingSeconds / b.DrivenSeconds
+	} else if b.Breakages > 0 {
+		// Breakages while standing still are still the driver's.
+		breakageRate = worstBreakageRate
+	}
+
+	rating := models.DriverRating{
+		DriverBehaviour: b,
+		Safety:          component(maxSafety, breakageRate, worstBreakageRate),
+		Smoothness:      component(maxSmoothness, harshEventRate, worstHarshEventRate),
+		SpeedDiscipline: component(maxSpeedDiscipline, speedingShare, worstSpeedingShare),
+		TireCare:        component(maxTireCare, b.UnderInflatedSeconds/3600, worstUnderInflatedHours),
+		Experience:      round(maxExperience * math.Min(1, float64(b.WorkedTime)/60/fullExperienceHours)),
+	}
+	rating.Rating = rating.Safety + rating.Smoothness + rating.SpeedDiscipline + rating.TireCare + rating.Experience
+	return rating
+}
+
+// component returns the points left of points for a rate of a behaviour that
+// is worth nothing at worst.
+func component(points float64, rate float64, worst float64) float32 {
+	return round(points * math.Max(0, 1-rate/worst))
+}
+
+// round rounds points to hundredths, so that the shown components add up to
+// the shown rating.
+func round(points float64) float32 {
+	return float32(math.Round(points*100) / 100)
+}
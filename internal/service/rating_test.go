@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateDriver(t *testing.T) {
+	tests := []struct {
+		name      string
+		behaviour models.DriverBehaviour
+		want      models.DriverRating
+	}{
+		{
+			name:      "not driven",
+			behaviour: models.DriverBehaviour{},
+			want:      models.DriverRating{Rating: 9, Safety: 3, Smoothness: 2, SpeedDiscipline: 2, TireCare: 2},
+		},
+		{
+			name:      "breakage while not driven",
+			behaviour: models.DriverBehaviour{Breakages: 1},
+			want:      models.DriverRating{Rating: 6, Safety: 0, Smoothness: 2, SpeedDiscipline: 2, TireCare: 2},
+		},
+		{
+			name: "half of every behaviour",
+			behaviour: models.DriverBehaviour{
+				WorkedTime:           500 * 60,
+				Breakages:            1,
+				HarshEvents:          50,
+				DrivenSeconds:        100 * 3600,
+				SpeedingSeconds:      10 * 3600,
+				UnderInflatedSeconds: 5 * 3600,
+			},
+			want: models.DriverRating{Rating: 5.9, Safety: 2.4, Smoothness: 1, SpeedDiscipline: 1, TireCare: 1, Experience: 0.5},
+		},
+		{
+			name: "worse than worst",
+			behaviour: models.DriverBehaviour{
+				WorkedTime:           2000 * 60,
+				Breakages:            10,
+				HarshEvents:          200,
+				DrivenSeconds:        10 * 3600,
+				SpeedingSeconds:      10 * 3600,
+				UnderInflatedSeconds: 20 * 3600,
+			},
+			want: models.DriverRating{Rating: 1, Experience: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rateDriver(tt.behaviour)
+			assert.Equal(t, tt.behaviour, got.DriverBehaviour)
+			assert.InDelta(t, tt.want.Safety, got.Safety, 1e-6)
+			assert.InDelta(t, tt.want.Smoothness, got.Smoothness, 1e-6)
+			assert.InDelta(t, tt.want.SpeedDiscipline, got.SpeedDiscipline, 1e-6)
+			assert.InDelta(t, tt.want.TireCare, got.TireCare, 1e-6)
+			assert.InDelta(t, tt.want.Experience, got.Experience, 1e-6)
+			assert.InDelta(t, tt.want.Rating, got.Rating, 1e-5)
+		})
+	}
+}
+
+func TestComponent(t *testing.T) {
+	tests := []struct {
+		name   string
+		points float64
+		rate   float64
+		worst  float64
+		want   float32
+	}{
+		{"no occurrences", 2, 0, 10, 2},
+		{"rounded to hundredths", 2, 1, 3, 1.33},
+		{"at worst", 3, 5, 5, 0},
+		{"beyond worst", 3, 50, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, component(tt.points, tt.rate, tt.worst))
+		})
+	}
+}
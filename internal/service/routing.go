@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// maxDeliveryError is how much of the error of a failed delivery attempt is
+// recorded.
+const maxDeliveryError = 500
+
+// deliveryError returns the message of err cut to maxDeliveryError bytes
+// without splitting a character, since errors often quote responses.
+func deliveryError(err error) string {
+	msg := err.Error()
+	if len(msg) <= maxDeliveryError {
+		return msg
+	}
+	cut := maxDeliveryError
+	for cut > 0 && !utf8.RuneStart(msg[cut]) {
+		cut--
+	}
+	return msg[:cut]
+}
+
+// Sender delivers messages through a channel.
+type Sender interface {
+	Send(ctx context.Context, m models.Message) error
+}
+
+// SetSender makes notifications routed to channel be delivered by sender. It
+// must be called before the service is used.
+func (s *Service) SetSender(channel string, sender Sender) {
+	if s.senders == nil {
+		s.senders = make(map[string]Sender)
+	}
+	s.senders[channel] = sender
+}
+
+// DeliveryParams are the parameters of the notification delivery worker.
+type DeliveryParams struct {
+	Interval  time.Duration
+	BatchSize int
+	// MaxAttempts is how many times a delivery is attempted before it fails.
+	MaxAttempts int
+	// RetryBackoff is the wait before the second attempt of a delivery,
+	// doubled before each further one.
+	RetryBackoff time.Duration
+}
+
+// Notification rules
+// CreateNotificationRule adds a notification rule to the company.
+func (s *Service) CreateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error) {
+	ctx, span := tracer.Start(ctx, "Service.CreateNotificationRule")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.NotificationRule{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	n.IDCompany = id
+
+	if err := s.checkChannel(n.Channel); err != nil {
+		return models.NotificationRule{}, err
+	}
+
+	var res models.NotificationRule
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateNotificationRule(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceNotificationRule, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.NotificationRule{}, err
+	}
+	return res, nil
+}
+
+// UpdateNotificationRule replaces a notification rule of the company.
+func (s *Service) UpdateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error) {
+	ctx, span := tracer.Start(ctx, "Service.UpdateNotificationRule")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.NotificationRule{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	n.IDCompany = id
+
+	if err := s.checkChannel(n.Channel); err != nil {
+		return models.NotificationRule{}, err
+	}
+
+	var res models.NotificationRule
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetNotificationRule(ctx, id, n.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err = s.repo.UpdateNotificationRule(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceNotificationRule, res.ID, before, res)
+	})
+	if err != nil {
+		return models.NotificationRule{}, err
+	}
+	return res, nil
+}
+
+// DeleteNotificationRule removes a notification rule of the company.
+func (s *Service) DeleteNotificationRule(ctx context.Context, ruleID string) error {
+	ctx, span := tracer.Start(ctx, "Service.DeleteNotificationRule")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.DeleteNotificationRule(ctx, id, ruleID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionDelete, models.AuditResourceNotificationRule, ruleID, before, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNotificationRules returns the notification rules of the company.
+func (s *Service) GetNotificationRules(ctx context.Context) ([]models.NotificationRule, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetNotificationRules")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetNotificationRules(ctx, id)
+}
+
+// checkChannel reports models.ErrInvalidParameter if no sender delivers
+// through channel.
+func (s *Service) checkChannel(channel string) error {
+	if _, ok := s.senders[channel]; !ok {
+		return fmt.Errorf("%w: the %s channel is not configured on this server", models.ErrInvalidParameter, channel)
+	}
+	return nil
+}
+
+// GetNotificationDeliveries returns the deliveries of a notification of the
+// company.
+func (s *Service) GetNotificationDeliveries(ctx context.Context, notificationID string) ([]models.NotificationDelivery, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetNotificationDeliveries")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetNotificationDeliveries(ctx, id, notificationID)
+}
+
+// Delivery
+// DeliverNotifications periodically routes new notifications to the channels
+// of the matching rules and attempts the deliveries that are due. It blocks
+// until ctx is cancelled.
+func (s *Service) DeliverNotifications(ctx context.Context, params DeliveryParams) {
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.routeNotifications(ctx, params)
+		s.sendDeliveries(ctx, params)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) routeNotifications(ctx context.Context, params DeliveryParams) {
+	ctx, span := tracer.Start(ctx, "Service.routeNotifications")
+	defer span.End()
+
+	notifications, err := s.repo.GetUnroutedNotifications(ctx, params.BatchSize)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get notifications to route: %v", err)
+		return
+	}
+	if len(notifications) == 0 {
+		return
+	}
+
+	var companyIDs []string
+	for _, n := range notifications {
+		if !slices.Contains(companyIDs, n.IDCompany) {
+			companyIDs = append(companyIDs, n.IDCompany)
+		}
+	}
+
+	rules, err := s.repo.GetActiveNotificationRules(ctx, companyIDs)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get notification rules: %v", err)
+		return
+	}
+	timezones, err := s.repo.GetCompanyTimezones(ctx, companyIDs)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get company timezones: %v", err)
+		return
+	}
+
+	rulesByCompany := make(map[string][]models.NotificationRule)
+	for _, rule := range rules {
+		rulesByCompany[rule.IDCompany] = append(rulesByCompany[rule.IDCompany], rule)
+	}
+
+	now := time.Now()
+	var routing models.Routing
+	for _, n := range notifications {
+		routing.NotificationIDs = append(routing.NotificationIDs, n.ID)
+		e, err := newWebhookEvent(n.IDCompany, models.WebhookNotificationCreated, notificationWebhookData(n))
+		if err != nil {
+			logging.FromContext(ctx, s.log).Errorf("Failed to publish webhook event of notification %s: %v", n.ID, err)
+		} else {
+			routing.Events = append(routing.Events, e)
+		}
+		for _, rule := range rulesByCompany[n.IDCompany] {
+			if !ruleMatches(rule, n) {
+				continue
+			}
+			routing.Deliveries = append(routing.Deliveries, models.NotificationDelivery{
+				IDNotification: n.ID,
+				IDRule:         &rule.ID,
+				Channel:        rule.Channel,
+				Target:         rule.Target,
+				NextAttemptAt:  quietUntil(rule, now, timezones[n.IDCompany]),
+			})
+		}
+	}
+
+	if err := s.repo.RouteNotifications(ctx, routing); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to route notifications: %v", err)
+	}
+}
+
+// ruleMatches reports whether rule routes n.
+func ruleMatches(rule models.NotificationRule, n models.RoutedNotification) bool {
+	if len(rule.EventTypes) > 0 && !slices.Contains(rule.EventTypes, n.Event) {
+		return false
+	}
+	if len(rule.CarIDs) > 0 && (n.IDCar == nil || !slices.Contains(rule.CarIDs, *n.IDCar)) {
+		return false
+	}
+	if rule.MinSeverity != nil {
+		if n.Severity == nil {
+			return false
+		}
+		return slices.Index(models.Severities, *n.Severity) >= slices.Index(models.Severities, *rule.MinSeverity)
+	}
+	return true
+}
+
+// quietUntil returns when the quiet hours of rule that now falls in end, or
+// now if it is outside of them. utcOffset is the UTC offset of the company in
+// hours.
+func quietUntil(rule models.NotificationRule, now time.Time, utcOffset int) time.Time {
+	if rule.QuietFrom == nil || rule.QuietTo == nil || *rule.QuietFrom == *rule.QuietTo {
+		return now
+	}
+	from, to := *rule.QuietFrom, *rule.QuietTo
+
+	local := now.UTC().Add(time.Duration(utcOffset) * time.Hour)
+	minute := local.Hour()*60 + local.Minute()
+
+	quiet := from <= minute && minute < to
+	if from > to {
+		// The quiet hours span midnight.
+		quiet = minute >= from || minute < to
+	}
+	if !quiet {
+		return now
+	}
+
+	wait := (to - minute + 24*60) % (24 * 60)
+	return now.Truncate(time.Minute).Add(time.Duration(wait) * time.Minute)
+}
+
+func (s *Service) sendDeliveries(ctx context.Context, params DeliveryParams) {
+	ctx, span := tracer.Start(ctx, "Service.sendDeliveries")
+	defer span.End()
+
+	pending, err := s.repo.GetPendingDeliveries(ctx, time.Now(), params.BatchSize)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get pending deliveries: %v", err)
+		return
+	}
+
+	for _, p := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+
+		d := p.NotificationDelivery
+		d.Attempts++
+		err := s.send(ctx, p)
+		now := time.Now()
+		if err == nil {
+			d.Status = models.DeliverySent
+			d.SentAt = &now
+			d.LastError = nil
+		} else {
+			msg := deliveryError(err)
+			d.LastError = &msg
+			if d.Attempts >= params.MaxAttempts {
+				d.Status = models.DeliveryFailed
+			} else {
+				d.NextAttemptAt = now.Add(params.RetryBackoff << (d.Attempts - 1))
+			}
+			logging.FromContext(ctx, s.log).Warnf("Attempt %d of delivery %s through %s failed: %v", d.Attempts, d.ID, d.Channel, err)
+		}
+
+		if err := s.repo.SaveDeliveryAttempt(ctx, d); err != nil {
+			logging.FromContext(ctx, s.log).Errorf("Failed to save attempt of delivery %s: %v", d.ID, err)
+		}
+	}
+}
+
+// send delivers the notification of p through its channel.
+func (s *Service) send(ctx context.Context, p models.PendingDelivery) error {
+	sender, ok := s.senders[p.Channel]
+	if !ok {
+		return fmt.Errorf("%w: %s", models.ErrChannelNotConfigured, p.Channel)
+	}
+
+	n := p.Notification
+	return sender.Send(ctx, models.Message{
+		Channel:        p.Channel,
+		Target:         p.Target,
+		Subject:        n.Note,
+		Text:           fmt.Sprintf("%s\n%s", n.Note, n.CreatedAt.Format(time.DateTime)),
+		IDNotification: n.ID,
+		Event:          n.Event,
+		Severity:       n.Severity,
+		CreatedAt:      n.CreatedAt,
+	})
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleMatches(t *testing.T) {
+	car1, car2 := "car1", "car2"
+	low, high := models.SeverityLow, models.SeverityHigh
+	medium, critical := models.SeverityMedium, models.SeverityCritical
+
+	tests := []struct {
+		name string
+		rule models.NotificationRule
+		n    models.RoutedNotification
+		want bool
+	}{
+		{
+			name: "empty rule matches every notification",
+			n:    models.RoutedNotification{Event: models.EventDocumentExpiry},
+			want: true,
+		},
+		{
+			name: "event type",
+			rule: models.NotificationRule{EventTypes: []string{models.EventBreakage, models.EventMaintenanceDue}},
+			n:    models.RoutedNotification{Event: models.EventMaintenanceDue},
+			want: true,
+		},
+		{
+			name: "other event type",
+			rule: models.NotificationRule{EventTypes: []string{models.EventBreakage}},
+			n:    models.RoutedNotification{Event: models.EventWorkViolation},
+			want: false,
+		},
+		{
+			name: "car",
+			rule: models.NotificationRule{CarIDs: []string{car1}},
+			n:    models.RoutedNotification{Event: models.EventBreakage, IDCar: &car1},
+			want: true,
+		},
+		{
+			name: "other car",
+			rule: models.NotificationRule{CarIDs: []string{car1}},
+			n:    models.RoutedNotification{Event: models.EventBreakage, IDCar: &car2},
+			want: false,
+		},
+		{
+			name: "cars do not match notifications about no car",
+			rule: models.NotificationRule{CarIDs: []string{car1}},
+			n:    models.RoutedNotification{Event: models.EventWorkViolation},
+			want: false,
+		},
+		{
+			name: "more severe",
+			rule: models.NotificationRule{MinSeverity: &medium},
+			n:    models.RoutedNotification{Event: models.EventBreakage, Severity: &critical},
+			want: true,
+		},
+		{
+			name: "as severe",
+			rule: models.NotificationRule{MinSeverity: &high},
+			n:    models.RoutedNotification{Event: models.EventBreakage, Severity: &high},
+			want: true,
+		},
+		{
+			name: "less severe",
+			rule: models.NotificationRule{MinSeverity: &medium},
+			n:    models.RoutedNotification{Event: models.EventBreakage, Severity: &low},
+			want: false,
+		},
+		{
+			name: "severity does not match notifications without one",
+			rule: models.NotificationRule{MinSeverity: &low},
+			n:    models.RoutedNotification{Event: models.EventDocumentExpiry},
+			want: false,
+		},
+		{
+			name: "every condition",
+			rule: models.NotificationRule{EventTypes: []string{models.EventBreakage}, CarIDs: []string{car1}, MinSeverity: &high},
+			n:    models.RoutedNotification{Event: models.EventBreakage, IDCar: &car1, Severity: &medium},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ruleMatches(tt.rule, tt.n))
+		})
+	}
+}
+
+func TestQuietUntil(t *testing.T) {
+	minutes := func(hour int, minute int) *int {
+		m := hour*60 + minute
+		return &m
+	}
+	utc := func(day int, hour int, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+	daytime := models.NotificationRule{QuietFrom: minutes(13, 0), QuietTo: minutes(14, 0)}
+	overnight := models.NotificationRule{QuietFrom: minutes(22, 0), QuietTo: minutes(7, 0)}
+
+	tests := []struct {
+		name   string
+		rule   models.NotificationRule
+		now    time.Time
+		offset int
+		want   time.Time
+	}{
+		{
+			name:   "no quiet hours",
+			now:    utc(2, 10, 30),
+			offset: 3,
+			want:   utc(2, 10, 30),
+		},
+		{
+			name:   "empty quiet hours",
+			rule:   models.NotificationRule{QuietFrom: minutes(13, 0), QuietTo: minutes(13, 0)},
+			now:    utc(2, 10, 30),
+			offset: 3,
+			want:   utc(2, 10, 30),
+		},
+		{
+			name:   "within daytime quiet hours",
+			rule:   daytime,
+			now:    utc(2, 10, 30),
+			offset: 3,
+			want:   utc(2, 11, 0),
+		},
+		{
+			name:   "after daytime quiet hours",
+			rule:   daytime,
+			now:    utc(2, 12, 0),
+			offset: 3,
+			want:   utc(2, 12, 0),
+		},
+		{
+			name:   "overnight quiet hours before midnight",
+			rule:   overnight,
+			now:    utc(2, 20, 30),
+			offset: 3,
+			want:   utc(3, 4, 0),
+		},
+		{
+			name:   "overnight quiet hours after midnight",
+			rule:   overnight,
+			now:    utc(2, 23, 0),
+			offset: 3,
+			want:   utc(3, 4, 0),
+		},
+		{
+			name:   "overnight quiet hours start",
+			rule:   overnight,
+			now:    utc(2, 19, 0),
+			offset: 3,
+			want:   utc(3, 4, 0),
+		},
+		{
+			name:   "overnight quiet hours end",
+			rule:   overnight,
+			now:    utc(3, 4, 0),
+			offset: 3,
+			want:   utc(3, 4, 0),
+		},
+		{
+			name:   "outside overnight quiet hours",
+			rule:   overnight,
+			now:    utc(2, 9, 0),
+			offset: 3,
+			want:   utc(2, 9, 0),
+		},
+		{
+			name:   "negative offset",
+			rule:   overnight,
+			now:    utc(2, 3, 30),
+			offset: -5,
+			want:   utc(2, 12, 0),
+		},
+		{
+			name:   "seconds are dropped",
+			rule:   daytime,
+			now:    utc(2, 10, 30).Add(20 * time.Second),
+			offset: 3,
+			want:   utc(2, 11, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, quietUntil(tt.rule, tt.now, tt.offset))
+		})
+	}
+}
+
+func TestDeliveryError(t *testing.T) {
+	ascii := strings.Repeat("a", maxDeliveryError)
+	// Cyrillic letters take two bytes, so the limit falls inside one.
+	cyrillic := "a" + strings.Repeat("ж", maxDeliveryError)
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"short", errors.New("connection refused"), "connection refused"},
+		{"at the limit", errors.New(ascii), ascii},
+		{"cut", errors.New(ascii + "b"), ascii},
+		{"cut before a split character", errors.New(cyrillic), cyrillic[:maxDeliveryError-1]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := deliveryError(tt.err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, utf8.ValidString(got))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+// Search
+func (s *Service) Search(ctx context.Context, query models.SearchQuery) ([]models.SearchResult, error) {
+	ctx, span := tracer.Start(ctx, "Service.Search")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	query.IDCompany = id
+
+	results, err := s.repo.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx, s.log).Debugf("Search for %d types returned %d results", len(query.Types), len(results))
+	return results, nil
+}
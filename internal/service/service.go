@@ -2,65 +2,178 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/VikaPaz/algalar/internal/logging"
 	"github.com/VikaPaz/algalar/internal/models"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/VikaPaz/algalar/internal/service")
+
 type Repository interface {
-	CreateUser(user models.User) (string, error)
-	UpdateUser(user models.User) (string, error)
-	GetById(userID string) (models.User, error)
-	ChangePassword(userID, newPassword string) error
-	GetIDByLoginAndPassword(login, password string) (string, error)
-	CreateCar(car models.Car) (models.Car, error)
-	CreateWheel(wheel models.Wheel) (string, error)
-	GetWheelById(wheelID string) (models.Wheel, error)
-	ChangeWheel(wheel models.Wheel) error
-	SelectAny(table string, key string, val any) (bool, error)
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	InLongTx(ctx context.Context, fn func(ctx context.Context) error) error
+	CreateUser(ctx context.Context, user models.User) (string, error)
+	UpdateUser(ctx context.Context, user models.User) (string, error)
+	GetById(ctx context.Context, userID string) (models.User, error)
+	ChangePassword(ctx context.Context, userID, newPassword string) error
+	GetIDByLoginAndPassword(ctx context.Context, login, password string) (string, error)
+	CreateCar(ctx context.Context, car models.Car) (models.Car, error)
+	CreateWheel(ctx context.Context, wheel models.Wheel) (string, error)
+	GetWheelById(ctx context.Context, wheelID string) (models.Wheel, error)
+	ChangeWheel(ctx context.Context, wheel models.Wheel) error
+	SelectAny(ctx context.Context, table string, key string, val any) (bool, error)
 	CreateBreakage(ctx context.Context, breakage models.Breakage) (models.Breakage, error)
-	GetCarById(carID string) (models.Car, error)
-	GetCarByStateNumber(stateNumber string) (models.Car, error)
+	GetCarById(ctx context.Context, carID string) (models.Car, error)
+	GetCarByStateNumber(ctx context.Context, stateNumber string) (models.Car, error)
 	GetCarByDeviceNumber(ctx context.Context, device string) (models.Car, error)
-	GetCarsList(user_id string, offset int, limit int) ([]models.Car, error)
-	GetIdCarByStateNumber(stateNumber string) (string, error)
-	GetBreakagesByCarId(carID string) ([]models.BreakageInfo, error)
-	GetReportData(userId string) ([]models.ReportData, error)
-	GetWheelsByStateNumber(stateNumber string) ([]models.Wheel, error)
-	GetCarWheelData(carID string) (models.CarWithWheels, error)
-	CreateData(newData models.SensorData) (models.SensorData, error)
-	SensorsDataByCarID(carID string) ([]models.SensorsData, error)
-	Temperaturedata(filter models.TemperatureDataByWheelIDFilter) ([]models.TemperatureData, error)
-	Pressuredata(filter models.PressureDataByWheelIDFilter) ([]models.PressureData, error)
-	CreateDriver(driver models.Driver) (models.Driver, error)
-	GetDriversList(user_id string, limit int, offset int) ([]models.DriverStatisticsResponse, error)
-	GetDriverInfo(driverID string) (models.DriverInfoResponse, error)
-	GetDriverByCaDviceNum(ctx context.Context, deviceNum string) (models.Driver, error)
-	UpdateDriverWorktime(deviceNum string, workedTime int) error
+	GetCarsList(ctx context.Context, filter models.CarFilter, page models.PageRequest) (models.Page[models.Car], error)
+	GetIdCarByStateNumber(ctx context.Context, stateNumber string) (string, error)
+	GetBreakagesByCarId(ctx context.Context, filter models.BreakageFilter, page models.PageRequest) (models.Page[models.BreakageInfo], error)
+	GetReportData(ctx context.Context, userId string) ([]models.ReportData, error)
+	GetWheelsByStateNumber(ctx context.Context, stateNumber string) ([]models.Wheel, error)
+	GetCarWheelData(ctx context.Context, carID string) (models.CarWithWheels, error)
+	CreateData(ctx context.Context, newData models.SensorData) (models.SensorData, error)
+	SensorsDataByCarID(ctx context.Context, carID string) ([]models.SensorsData, error)
+	Temperaturedata(ctx context.Context, filter models.TemperatureDataByWheelIDFilter) ([]models.TemperatureData, error)
+	Pressuredata(ctx context.Context, filter models.PressureDataByWheelIDFilter) ([]models.PressureData, error)
+	CreateDriver(ctx context.Context, driver models.Driver) (models.Driver, error)
+	GetDriversList(ctx context.Context, filter models.DriverFilter, page models.PageRequest) (models.Page[models.DriverStatisticsResponse], error)
+	GetDriverInfo(ctx context.Context, driverID string) (models.DriverInfoResponse, error)
+	GetDriverByCaDviceNum(ctx context.Context, deviceNum string, at time.Time) (models.Driver, error)
+	UpdateDriverWorktime(ctx context.Context, deviceNum string, workedTime int, endedAt time.Time) (models.WorkSession, error)
 	CreatePosition(ctx context.Context, position models.Position) (models.Position, error)
 	GetCarRoutePositions(ctx context.Context, carID string, from time.Time, to time.Time) ([]models.Position, error)
 	GetCurrentCarPositions(ctx context.Context, id string) ([]models.CurrentPositionResponse, error)
 	GetCurrentCarPositionsByPoints(ctx context.Context, pointA models.Point, pointB models.Point) ([]models.CurrentPositionResponse, error)
 	CreateBreakageFromMqtt(ctx context.Context, breakage models.BreakageFromMqtt) (models.Breakage, error)
-	CreateNotification(new models.Notification) (models.Notification, error)
+	CreateNotification(ctx context.Context, new models.Notification) (models.Notification, error)
 	UpdateNotificationStatus(ctx context.Context, id string, status string) error
 	UpdateAllNotificationsStatus(ctx context.Context, userID string, status string) error
-	GetNotificationInfo(ctx context.Context, notificationID string) (models.NotificationInfo, error)
-	GetNotificationList(ctx context.Context, userID string, status *string, limit, offset int) ([]models.NotificationListItem, error)
-	CheckDriverExists(ctx context.Context, deviceNumber string) (bool, error)
+	GetNotificationInfo(ctx context.Context, companyID string, notificationID string) (models.NotificationInfo, error)
+	GetNotificationList(ctx context.Context, filter models.NotificationFilter, page models.PageRequest) (models.Page[models.NotificationListItem], error)
+	CheckDriverExists(ctx context.Context, deviceNumber string, at time.Time) (bool, error)
 	CreateOrUpdateCarsPosition(ctx context.Context, position models.CurrentPosition) (models.CurrentPosition, error)
-	UpdateWheelsMilagelData(ctx context.Context, update models.UpdateMileage) error
+	GetCarPositionForUpdate(ctx context.Context, carID string) (models.CurrentPosition, error)
+	UpdateWheelsMilagelData(ctx context.Context, update models.UpdateMileage) ([]models.Wheel, error)
+	GetNotificationForUpdate(ctx context.Context, id string) (models.Notification, error)
+	CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error)
+	GetAuditLog(ctx context.Context, filter models.AuditFilter, page models.PageRequest) (models.Page[models.AuditEntry], error)
+	ExportAuditLog(ctx context.Context, filter models.AuditFilter, w models.AuditWriter) error
+	Search(ctx context.Context, query models.SearchQuery) ([]models.SearchResult, error)
+	GetCarsByStateNumbers(ctx context.Context, stateNumbers []string) ([]models.Car, error)
+	Import(ctx context.Context, batch models.ImportBatch) (int, error)
+	ExportEntity(ctx context.Context, entity string, filter models.ExportFilter, w models.RecordWriter) error
+	Restore(ctx context.Context, companyID string, src models.RecordSource) ([]models.RestoreResult, error)
+	AssignDriver(ctx context.Context, assignment models.DriverAssignment) (models.DriverAssignment, error)
+	UnassignDriver(ctx context.Context, companyID string, driverID string, endedAt time.Time) (models.DriverAssignment, error)
+	GetAssignments(ctx context.Context, filter models.AssignmentFilter, page models.PageRequest) (models.Page[models.DriverAssignment], error)
+	GetDriverTrips(ctx context.Context, companyID string, driverID string, from time.Time, to time.Time) ([]models.DriverTrip, error)
+	GetDriverBehaviour(ctx context.Context, q models.BehaviourQuery) ([]models.DriverBehaviour, error)
+	SaveDriverRatings(ctx context.Context, ratings []models.DriverRating) error
+	StartWorkSession(ctx context.Context, session models.WorkSession) (models.WorkSession, error)
+	EndWorkSession(ctx context.Context, companyID string, driverID string, endedAt time.Time) (models.WorkSession, error)
+	GetWorkSessions(ctx context.Context, filter models.WorkSessionFilter, page models.PageRequest) (models.Page[models.WorkSession], error)
+	GetWorkSessionsInPeriod(ctx context.Context, filter models.WorkSessionFilter) ([]models.WorkSession, error)
+	GetCompanyTimezones(ctx context.Context, companyIDs []string) (map[string]int, error)
+	SaveWorkViolations(ctx context.Context, violations []models.WorkViolation) ([]models.WorkViolation, error)
+	GetWorkViolations(ctx context.Context, filter models.WorkViolationFilter, page models.PageRequest) (models.Page[models.WorkViolation], error)
+	GetDriver(ctx context.Context, companyID string, driverID string) (models.Driver, error)
+	UpdateDriver(ctx context.Context, driver models.Driver) (models.Driver, error)
+	DeactivateDriver(ctx context.Context, companyID string, driverID string, at time.Time) (models.Driver, error)
+	CreateDriverDocument(ctx context.Context, doc models.DriverDocument) (models.DriverDocument, error)
+	GetDriverDocuments(ctx context.Context, companyID string, driverID string) ([]models.DriverDocument, error)
+	GetDriverDocument(ctx context.Context, companyID string, documentID string) (models.DriverDocument, error)
+	DeleteDriverDocument(ctx context.Context, companyID string, documentID string) (models.DriverDocument, error)
+	GetExpiringDocuments(ctx context.Context, before time.Time) ([]models.ExpiryAlert, error)
+	SaveExpiryAlerts(ctx context.Context, alerts []models.ExpiryAlert) ([]models.ExpiryAlert, error)
+	GetBreakage(ctx context.Context, companyID string, breakageID string) (models.BreakageDetails, error)
+	UpdateBreakageRepair(ctx context.Context, companyID string, breakageID string, repair models.BreakageRepair) error
+	ChangeBreakageStatus(ctx context.Context, change models.BreakageStatusChange) (models.BreakageHistoryEntry, error)
+	GetBreakageHistory(ctx context.Context, companyID string, breakageID string) ([]models.BreakageHistoryEntry, error)
+	CreateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error)
+	UpdateBreakageType(ctx context.Context, t models.BreakageType) (models.BreakageType, error)
+	DeleteBreakageType(ctx context.Context, companyID string, typeID string) (models.BreakageType, error)
+	GetBreakageType(ctx context.Context, companyID string, typeID string) (models.BreakageType, error)
+	GetBreakageTypes(ctx context.Context, companyID string) ([]models.BreakageType, error)
+	GetBreakageStats(ctx context.Context, q models.BreakageStatsQuery) ([]models.BreakageStats, error)
+	CreateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error)
+	UpdateMaintenancePlan(ctx context.Context, p models.MaintenancePlan) (models.MaintenancePlan, error)
+	GetMaintenancePlan(ctx context.Context, companyID string, planID string) (models.MaintenancePlan, error)
+	GetMaintenancePlans(ctx context.Context, companyID string) ([]models.MaintenancePlan, error)
+	GetDueMaintenance(ctx context.Context, now time.Time, before time.Time, mileageNotice float64) ([]models.MaintenanceDue, error)
+	SaveMaintenanceTasks(ctx context.Context, due []models.MaintenanceDue) ([]models.MaintenanceDue, error)
+	GetUpcomingMaintenance(ctx context.Context, filter models.MaintenanceFilter, now time.Time) ([]models.MaintenanceDue, error)
+	CreateWorkOrder(ctx context.Context, o models.WorkOrder) (models.WorkOrder, error)
+	GetWorkOrder(ctx context.Context, companyID string, orderID string) (models.WorkOrder, error)
+	GetWorkOrders(ctx context.Context, filter models.WorkOrderFilter, page models.PageRequest) (models.Page[models.WorkOrder], error)
+	CompleteWorkOrder(ctx context.Context, c models.WorkOrderCompletion) (models.WorkOrder, error)
+	CancelWorkOrder(ctx context.Context, companyID string, orderID string, at time.Time) (models.WorkOrder, error)
+	CountSilentDevices(ctx context.Context, since time.Time) (map[string]int, error)
+	GetWheelBySensor(ctx context.Context, deviceNumber string, sensorNumber string) (models.SensorWheel, error)
+	CreateSensorThresholdNotification(ctx context.Context, n models.SensorThresholdNotification) (bool, error)
+	CreateSensorOfflineNotifications(ctx context.Context, since time.Time) (int64, error)
+	CreateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error)
+	UpdateGeofence(ctx context.Context, g models.Geofence) (models.Geofence, error)
+	DeleteGeofence(ctx context.Context, companyID string, geofenceID string) (models.Geofence, error)
+	GetGeofence(ctx context.Context, companyID string, geofenceID string) (models.Geofence, error)
+	GetGeofences(ctx context.Context, companyID string) ([]models.Geofence, error)
+	GetActiveGeofences(ctx context.Context, companyID string) ([]models.Geofence, error)
+	CreateGeofenceNotification(ctx context.Context, n models.GeofenceNotification) (string, error)
+	CreateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error)
+	UpdateNotificationRule(ctx context.Context, n models.NotificationRule) (models.NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, companyID string, ruleID string) (models.NotificationRule, error)
+	GetNotificationRule(ctx context.Context, companyID string, ruleID string) (models.NotificationRule, error)
+	GetNotificationRules(ctx context.Context, companyID string) ([]models.NotificationRule, error)
+	GetActiveNotificationRules(ctx context.Context, companyIDs []string) ([]models.NotificationRule, error)
+	GetUnroutedNotifications(ctx context.Context, limit int) ([]models.RoutedNotification, error)
+	RouteNotifications(ctx context.Context, routing models.Routing) error
+	GetPendingDeliveries(ctx context.Context, now time.Time, limit int) ([]models.PendingDelivery, error)
+	SaveDeliveryAttempt(ctx context.Context, d models.NotificationDelivery) error
+	GetNotificationDeliveries(ctx context.Context, companyID string, notificationID string) ([]models.NotificationDelivery, error)
+	CreateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error)
+	UpdateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error)
+	DeleteWebhook(ctx context.Context, companyID string, webhookID string) (models.WebhookEndpoint, error)
+	GetWebhook(ctx context.Context, companyID string, webhookID string) (models.WebhookEndpoint, error)
+	GetWebhooks(ctx context.Context, companyID string) ([]models.WebhookEndpoint, error)
+	EnqueueWebhookEvent(ctx context.Context, e models.WebhookEvent) error
+	ClaimPendingWebhooks(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.PendingWebhook, error)
+	SaveWebhookAttempt(ctx context.Context, d models.WebhookDelivery, a models.WebhookAttempt) error
+	GetWebhookDeliveries(ctx context.Context, filter models.WebhookDeliveryFilter, page models.PageRequest) (models.Page[models.WebhookDelivery], error)
+	GetWebhookAttempts(ctx context.Context, companyID string, deliveryID string) ([]models.WebhookAttempt, error)
+	ReplayWebhookDelivery(ctx context.Context, companyID string, deliveryID string) (models.WebhookDelivery, error)
+	ClaimIdempotencyKey(ctx context.Context, k models.IdempotencyKey, now time.Time) (models.IdempotencyKey, bool, error)
+	SaveIdempotentResponse(ctx context.Context, k models.IdempotencyKey) error
+	ReleaseIdempotencyKey(ctx context.Context, companyID string, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+}
+
+// Metrics receives the ingestion events of each company.
+type Metrics interface {
+	SensorReadingIngested(companyID string)
+	PositionIngested(companyID string)
+	BreakageIngested(companyID string)
+	MileageUpdated(companyID string)
+	SetSilentDevices(counts map[string]int)
 }
 
 type Service struct {
-	repo Repository
-	log  *logrus.Logger
+	repo     Repository
+	metrics  Metrics
+	senders  map[string]Sender
+	webhooks WebhookClient
+	log      *logrus.Logger
 }
 
-func (s *Service) IsCreatred(table string, key string, val any) (bool, error) {
-	ok, err := s.repo.SelectAny(table, key, val)
+func (s *Service) IsCreatred(ctx context.Context, table string, key string, val any) (bool, error) {
+	ctx, span := tracer.Start(ctx, "Service.IsCreatred")
+	defer span.End()
+
+	ok, err := s.repo.SelectAny(ctx, table, key, val)
 	if err != nil {
 		return false, err
 	}
@@ -68,13 +181,23 @@ func (s *Service) IsCreatred(table string, key string, val any) (bool, error) {
 }
 
 func (s *Service) RegisterUser(ctx context.Context, user models.User) error {
+	ctx, span := tracer.Start(ctx, "Service.RegisterUser")
+	defer span.End()
+
 	if user.Login == "" || user.Password == "" {
 		return models.ErrLoginOrPassword
 	}
 
-	_, err := s.repo.CreateUser(user)
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		id, err := s.repo.CreateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+		user.ID = id
+		return s.audit(context.WithValue(ctx, models.UserIDKey, id), id, models.AuditActionCreate, models.AuditResourceUser, id, nil, user)
+	})
 	if err != nil {
-		s.log.Debugf("Error creating user: %s", user.Login)
+		logging.FromContext(ctx, s.log).Debugf("Error creating user: %+v", logging.Redact(user))
 		return err
 	}
 	return nil
@@ -82,177 +205,332 @@ func (s *Service) RegisterUser(ctx context.Context, user models.User) error {
 
 // UpdateUser updates user information and returns the updated user ID.
 func (s *Service) UpdateUser(ctx context.Context, user models.User) (string, error) {
-	user_id, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.UpdateUser")
+	defer span.End()
+
+	user_id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
-		s.log.Errorf("Invalid context: %v", ctx)
+		logging.FromContext(ctx, s.log).Errorf("Invalid context: %v", ctx)
 		return "", fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
 	}
 	user.ID = user_id
 
-	s.log.Debugf("Updating user with ID: %s", user.ID)
+	logging.FromContext(ctx, s.log).Debugf("Updating user with ID: %s", user.ID)
 
-	res, err := s.repo.UpdateUser(user)
+	before, err := s.repo.GetById(ctx, user.ID)
 	if err != nil {
-		s.log.Errorf("Failed to update user: %v", err)
-		return "", fmt.Errorf("%w: %v", models.ErrUserUpdateFailed, err)
+		logging.FromContext(ctx, s.log).Errorf("Failed to fetch user before update: %v", err)
+		return "", fmt.Errorf("%w: %w", models.ErrUserUpdateFailed, err)
+	}
+	before.ID = user.ID
+
+	var res string
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.UpdateUser(ctx, user)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, user_id, models.AuditActionUpdate, models.AuditResourceUser, user.ID, before, user)
+	})
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to update user: %v", err)
+		return "", fmt.Errorf("%w: %w", models.ErrUserUpdateFailed, err)
 	}
 
-	s.log.Debugf("User updated successfully: %s", res)
+	logging.FromContext(ctx, s.log).Debugf("User updated successfully: %s", res)
 	return res, nil
 }
 
-func (s *Service) RegisterAuto(ctx context.Context, car models.Car) (models.Car, error) {
-	id, ok := ctx.Value("user_id").(string)
+// RegisterAuto registers the car together with the wheels mounted on it, all
+// or none of them.
+func (s *Service) RegisterAuto(ctx context.Context, car models.Car, wheels []models.Wheel) (models.Car, []models.Wheel, error) {
+	ctx, span := tracer.Start(ctx, "Service.RegisterAuto")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
-		return models.Car{}, fmt.Errorf("wrong context: %v", ctx)
+		return models.Car{}, nil, fmt.Errorf("wrong context: %v", ctx)
 	}
 	car.IDCompany = id
 
-	res, err := s.repo.CreateCar(car)
+	var res models.Car
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateCar(ctx, car)
+		if err != nil {
+			return err
+		}
+		if err := s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceCar, res.ID, nil, res); err != nil {
+			return err
+		}
+		for i := range wheels {
+			wheels[i].IDCompany = id
+			wheels[i].IDCar = res.ID
+			if wheels[i].ID, err = s.repo.CreateWheel(ctx, wheels[i]); err != nil {
+				return err
+			}
+			if err := s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceWheel, wheels[i].ID, nil, wheels[i]); err != nil {
+				return err
+			}
+		}
+		return s.enqueue(ctx, id, models.WebhookCarRegistered, carWebhookData(res))
+	})
 	if err != nil {
-		s.log.Debugf("Error registering Auto: %v", car)
-		return models.Car{}, err
+		logging.FromContext(ctx, s.log).Debugf("Error registering Auto: %v", logging.Redact(car))
+		return models.Car{}, nil, err
 	}
-	return res, nil
+
+	return res, wheels, nil
 }
 
 func (s *Service) UpdateUserPassword(ctx context.Context, newPassword string) error {
-	userID, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.UpdateUserPassword")
+	defer span.End()
+
+	userID, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
 		return fmt.Errorf("wrong context: %v", ctx)
 	}
 
-	err := s.repo.ChangePassword(userID, newPassword)
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.ChangePassword(ctx, userID, newPassword); err != nil {
+			return err
+		}
+		return s.audit(ctx, userID, models.AuditActionUpdate, models.AuditResourceUser, userID,
+			nil, map[string]any{"PasswordChanged": true})
+	})
 	if err != nil {
-		s.log.Debugf("Error updating user password: %s", userID)
+		logging.FromContext(ctx, s.log).Debugf("Error updating user password: %s", userID)
 		return err
 	}
 
-	s.log.Debugf("User password updated successfully: %s", userID)
+	logging.FromContext(ctx, s.log).Debugf("User password updated successfully: %s", userID)
 	return nil
 }
 
 func (s *Service) GetUserDetails(ctx context.Context) (models.User, error) {
-	id, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.GetUserDetails")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
 		return models.User{}, fmt.Errorf("wrong context: %v", ctx)
 	}
-	user, err := s.repo.GetById(id)
+	user, err := s.repo.GetById(ctx, id)
 	if err != nil {
-		s.log.Debugf("User not found: %s", id)
+		logging.FromContext(ctx, s.log).Debugf("User not found: %s", id)
 		return models.User{}, err
 	}
 
-	s.log.Debugf("User details fetched successfully: %s", id)
+	logging.FromContext(ctx, s.log).Debugf("User details fetched successfully: %s", id)
 	return user, nil
 }
 
 func (s *Service) RegisterWheel(ctx context.Context, wheel models.Wheel) (models.Wheel, error) {
-	id, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.RegisterWheel")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
 		return models.Wheel{}, fmt.Errorf("wrong context: %v", ctx)
 	}
 	wheel.IDCompany = id
-	id_wheel, err := s.repo.CreateWheel(wheel)
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		id_wheel, err := s.repo.CreateWheel(ctx, wheel)
+		if err != nil {
+			return err
+		}
+		wheel.ID = id_wheel
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceWheel, wheel.ID, nil, wheel)
+	})
 	if err != nil {
-		s.log.Debugf("Error registering wheel: %v", wheel)
+		logging.FromContext(ctx, s.log).Debugf("Error registering wheel: %v", logging.Redact(wheel))
 		return models.Wheel{}, err
 	}
-	wheel.ID = id_wheel
 
-	s.log.Debugf("Wheel registered successfully: %v", wheel)
+	logging.FromContext(ctx, s.log).Debugf("Wheel registered successfully: %v", logging.Redact(wheel))
 	return wheel, nil
 }
 
+// RegisterBeakege stores the breakage together with its notification, so that
+// a breakage is never left without one.
 func (s *Service) RegisterBeakege(ctx context.Context, breakege models.Breakage) (models.Breakage, error) {
-	id, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.RegisterBeakege")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
 		return models.Breakage{}, fmt.Errorf("wrong context: %v", ctx)
 	}
 
-	newDreakage, err := s.repo.CreateBreakage(ctx, breakege)
+	var newDreakage models.Breakage
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		newDreakage, err = s.repo.CreateBreakage(ctx, breakege)
+		if err != nil {
+			return err
+		}
+
+		notification := models.Notification{
+			IDCar:      newDreakage.CarID,
+			IDBreakage: newDreakage.ID,
+			Note:       newDreakage.Description,
+			Status:     models.StatusNew,
+			CreatedAt:  time.Now(),
+		}
+		logging.FromContext(ctx, s.log).Debugf("Creating notification: %+v", logging.Redact(notification))
+		if _, err := s.repo.CreateNotification(ctx, notification); err != nil {
+			return fmt.Errorf("%w: %w", models.ErrFailedToCreateNotification, err)
+		}
+
+		if err := s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceBreakage, newDreakage.ID, nil, newDreakage); err != nil {
+			return err
+		}
+		return s.enqueue(ctx, id, models.WebhookBreakageCreated, breakageWebhookData(newDreakage))
+	})
 	if err != nil {
-		s.log.Debugf("Error registering sensor: %v", id)
+		logging.FromContext(ctx, s.log).Debugf("Error registering sensor: %v", id)
 		return models.Breakage{}, err
 	}
 
-	s.log.Debugf("Sensor registered successfully: %v", id)
+	s.metrics.BreakageIngested(id)
+
+	logging.FromContext(ctx, s.log).Debugf("Sensor registered successfully: %v", id)
 	return newDreakage, nil
 }
 
 func (s *Service) UpdateWheelData(ctx context.Context, wheel models.Wheel) error {
-	err := s.repo.ChangeWheel(wheel)
+	ctx, span := tracer.Start(ctx, "Service.UpdateWheelData")
+	defer span.End()
+
+	var before *models.Wheel
+	car, err := s.repo.GetCarWheelData(ctx, wheel.IDCar)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Debugf("Error fetching wheel before update: %v", err)
+	}
+	for _, w := range car.Wheels {
+		if w.Position == wheel.Position {
+			w := w
+			before = &w
+			break
+		}
+	}
+
+	var old any
+	if before != nil {
+		wheel.ID = before.ID
+		old = *before
+	}
+
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		owner, err := s.repo.GetCarById(ctx, wheel.IDCar)
+		if err != nil {
+			return err
+		}
+		wheel.IDCompany = owner.IDCompany
+
+		if err := s.repo.ChangeWheel(ctx, wheel); err != nil {
+			return err
+		}
+		if err := s.audit(ctx, owner.IDCompany, models.AuditActionUpdate, models.AuditResourceWheel, wheel.ID, old, wheel); err != nil {
+			return err
+		}
+		if before == nil {
+			return nil
+		}
+		return s.enqueue(ctx, owner.IDCompany, models.WebhookWheelChanged, wheelWebhookData(wheel))
+	})
 	if err != nil {
-		s.log.Debugf("Error updating wheel data: %v", wheel)
+		logging.FromContext(ctx, s.log).Debugf("Error updating wheel data: %v", logging.Redact(wheel))
 		return err
 	}
 
-	s.log.Debugf("Wheel data updated successfully: %v", wheel)
+	logging.FromContext(ctx, s.log).Debugf("Wheel data updated successfully: %v", logging.Redact(wheel))
 	return nil
 }
 
 func (s *Service) GetWheelData(ctx context.Context, id string) (models.Wheel, error) {
-	wheel, err := s.repo.GetWheelById(id)
+	ctx, span := tracer.Start(ctx, "Service.GetWheelData")
+	defer span.End()
+
+	wheel, err := s.repo.GetWheelById(ctx, id)
 	if err != nil {
-		s.log.Debugf("Wheel not found: %s", id)
+		logging.FromContext(ctx, s.log).Debugf("Wheel not found: %s", id)
 		return models.Wheel{}, err
 	}
 
-	s.log.Debugf("Wheel data fetched successfully: %s", id)
+	logging.FromContext(ctx, s.log).Debugf("Wheel data fetched successfully: %s", id)
 	return wheel, nil
 }
 
 func (s *Service) GetWheelsData(ctx context.Context, stateNumber string) ([]models.Wheel, error) {
-	data, err := s.repo.GetWheelsByStateNumber(stateNumber)
+	ctx, span := tracer.Start(ctx, "Service.GetWheelsData")
+	defer span.End()
+
+	data, err := s.repo.GetWheelsByStateNumber(ctx, stateNumber)
 	if err != nil {
-		s.log.Debugf("Auto not found: %s", stateNumber)
+		logging.FromContext(ctx, s.log).Debugf("Auto not found: %s", stateNumber)
 		return []models.Wheel{}, err
 	}
 
-	s.log.Debugf("Auto data fetched successfully: %s", stateNumber)
+	logging.FromContext(ctx, s.log).Debugf("Auto data fetched successfully: %s", stateNumber)
 	return data, nil
 }
 
 func (s *Service) GetAutoData(ctx context.Context, id string) (models.Car, error) {
-	auto, err := s.repo.GetCarById(id)
+	ctx, span := tracer.Start(ctx, "Service.GetAutoData")
+	defer span.End()
+
+	auto, err := s.repo.GetCarById(ctx, id)
 	if err != nil {
-		s.log.Debugf("Auto not found: %s", id)
+		logging.FromContext(ctx, s.log).Debugf("Auto not found: %s", id)
 		return models.Car{}, err
 	}
 
-	s.log.Debugf("Auto data fetched successfully: %s", id)
+	logging.FromContext(ctx, s.log).Debugf("Auto data fetched successfully: %s", id)
 	return auto, nil
 }
 
 func (s *Service) GetAutoDataByStateNumber(ctx context.Context, stateNumber string) (models.Car, error) {
-	auto, err := s.repo.GetCarByStateNumber(stateNumber)
+	ctx, span := tracer.Start(ctx, "Service.GetAutoDataByStateNumber")
+	defer span.End()
+
+	auto, err := s.repo.GetCarByStateNumber(ctx, stateNumber)
 	if err != nil {
-		s.log.Debugf("Auto not found: %s", stateNumber)
+		logging.FromContext(ctx, s.log).Debugf("Auto not found: %s", stateNumber)
 		return models.Car{}, err
 	}
 
-	s.log.Debugf("Auto data fetched successfully: %s", stateNumber)
+	logging.FromContext(ctx, s.log).Debugf("Auto data fetched successfully: %s", stateNumber)
 	return auto, nil
 }
 
-func (s *Service) GetAutoList(ctx context.Context, offset int, limit int) ([]models.Car, error) {
-	user_id, ok := ctx.Value("user_id").(string)
+func (s *Service) GetAutoList(ctx context.Context, filter models.CarFilter, page models.PageRequest) (models.Page[models.Car], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetAutoList")
+	defer span.End()
+
+	user_id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
-		return []models.Car{}, fmt.Errorf("wrong context: %v", ctx)
+		return models.Page[models.Car]{}, fmt.Errorf("wrong context: %v", ctx)
 	}
+	filter.IDCompany = user_id
 
-	list, err := s.repo.GetCarsList(user_id, offset, limit)
+	list, err := s.repo.GetCarsList(ctx, filter, page)
 	if err != nil {
-		s.log.Debugf("not found: %s", user_id)
-		return []models.Car{}, err
+		logging.FromContext(ctx, s.log).Debugf("not found: %s", user_id)
+		return models.Page[models.Car]{}, err
 	}
 
-	s.log.Debugf("data fetched successfully: %s", user_id)
+	logging.FromContext(ctx, s.log).Debugf("data fetched successfully: %s", user_id)
 	return list, nil
 }
 
 func (s *Service) GetCarId(ctx context.Context, stateNumber string) (string, error) {
-	id, err := s.repo.GetIdCarByStateNumber(stateNumber)
+	ctx, span := tracer.Start(ctx, "Service.GetCarId")
+	defer span.End()
+
+	id, err := s.repo.GetIdCarByStateNumber(ctx, stateNumber)
 	if err != nil {
 		return "", err
 	}
@@ -260,23 +538,38 @@ func (s *Service) GetCarId(ctx context.Context, stateNumber string) (string, err
 }
 
 func (s *Service) GenerateReport(ctx context.Context) ([]models.ReportData, error) {
-	repost, err := s.repo.GetReportData(ctx.Value("user_id").(string))
+	ctx, span := tracer.Start(ctx, "Service.GenerateReport")
+	defer span.End()
+
+	repost, err := s.repo.GetReportData(ctx, ctx.Value(models.UserIDKey).(string))
 	if err != nil {
 		return []models.ReportData{}, err
 	}
 	return repost, nil
 }
 
-func (s *Service) GetBreakagesByCarId(ctx context.Context, carID string) ([]models.BreakageInfo, error) {
-	list, err := s.repo.GetBreakagesByCarId(carID)
+func (s *Service) GetBreakagesByCarId(ctx context.Context, filter models.BreakageFilter, page models.PageRequest) (models.Page[models.BreakageInfo], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetBreakagesByCarId")
+	defer span.End()
+
+	userID, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Page[models.BreakageInfo]{}, fmt.Errorf("wrong context: %v", ctx)
+	}
+	filter.IDCompany = userID
+
+	list, err := s.repo.GetBreakagesByCarId(ctx, filter, page)
 	if err != nil {
-		return nil, err
+		return models.Page[models.BreakageInfo]{}, err
 	}
 	return list, nil
 }
 
 func (s *Service) GetAutoWheelsData(ctx context.Context, id string) (models.CarWithWheels, error) {
-	resp, err := s.repo.GetCarWheelData(id)
+	ctx, span := tracer.Start(ctx, "Service.GetAutoWheelsData")
+	defer span.End()
+
+	resp, err := s.repo.GetCarWheelData(ctx, id)
 	if err != nil {
 		return models.CarWithWheels{}, err
 	}
@@ -285,15 +578,29 @@ func (s *Service) GetAutoWheelsData(ctx context.Context, id string) (models.CarW
 }
 
 func (s *Service) NewSensorData(ctx context.Context, newData models.SensorData) (models.SensorData, error) {
-	res, err := s.repo.CreateData(newData)
+	ctx, span := tracer.Start(ctx, "Service.NewSensorData")
+	defer span.End()
+
+	res, err := s.repo.CreateData(ctx, newData)
 	if err != nil {
 		return models.SensorData{}, err
 	}
+
+	// The reading is stored whether or not its check fails.
+	if err := s.checkSensorThresholds(ctx, res); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to check thresholds of sensor %s of device %s: %v", res.SensorNumber, res.DeviceNumber, err)
+	}
+
+	companyID, _ := ctx.Value(models.UserIDKey).(string)
+	s.metrics.SensorReadingIngested(companyID)
 	return res, nil
 }
 
 func (s *Service) SensorsDataByCarID(ctx context.Context, carID string) ([]models.SensorsData, error) {
-	res, err := s.repo.SensorsDataByCarID(carID)
+	ctx, span := tracer.Start(ctx, "Service.SensorsDataByCarID")
+	defer span.End()
+
+	res, err := s.repo.SensorsDataByCarID(ctx, carID)
 	if err != nil {
 		return []models.SensorsData{}, err
 	}
@@ -301,7 +608,10 @@ func (s *Service) SensorsDataByCarID(ctx context.Context, carID string) ([]model
 }
 
 func (s *Service) Temperaturedata(ctx context.Context, filter models.TemperatureDataByWheelIDFilter) ([]models.TemperatureData, error) {
-	res, err := s.repo.Temperaturedata(filter)
+	ctx, span := tracer.Start(ctx, "Service.Temperaturedata")
+	defer span.End()
+
+	res, err := s.repo.Temperaturedata(ctx, filter)
 	if err != nil {
 		return []models.TemperatureData{}, err
 	}
@@ -309,7 +619,10 @@ func (s *Service) Temperaturedata(ctx context.Context, filter models.Temperature
 }
 
 func (s *Service) Pressuredata(ctx context.Context, filter models.PressureDataByWheelIDFilter) ([]models.PressureData, error) {
-	res, err := s.repo.Pressuredata(filter)
+	ctx, span := tracer.Start(ctx, "Service.Pressuredata")
+	defer span.End()
+
+	res, err := s.repo.Pressuredata(ctx, filter)
 	if err != nil {
 		return []models.PressureData{}, err
 	}
@@ -318,92 +631,218 @@ func (s *Service) Pressuredata(ctx context.Context, filter models.PressureDataBy
 
 // Driver
 func (s *Service) CreateDriver(ctx context.Context, driver models.Driver) (models.Driver, error) {
-	res, err := s.repo.CreateDriver(driver)
+	ctx, span := tracer.Start(ctx, "Service.CreateDriver")
+	defer span.End()
+
+	var res models.Driver
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateDriver(ctx, driver)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, res.IDCompany, models.AuditActionCreate, models.AuditResourceDriver, res.ID, nil, res)
+	})
 	if err != nil {
 		return models.Driver{}, err
 	}
 	return res, nil
 }
 
-func (s *Service) GetDriversList(ctx context.Context, limit int, offset int) ([]models.DriverStatisticsResponse, error) {
-	res, err := s.repo.GetDriversList(ctx.Value("user_id").(string), limit, offset)
+func (s *Service) GetDriversList(ctx context.Context, filter models.DriverFilter, page models.PageRequest) (models.Page[models.DriverStatisticsResponse], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetDriversList")
+	defer span.End()
+
+	filter.IDCompany = ctx.Value(models.UserIDKey).(string)
+	res, err := s.repo.GetDriversList(ctx, filter, page)
 	if err != nil {
-		return []models.DriverStatisticsResponse{}, err
+		return models.Page[models.DriverStatisticsResponse]{}, err
 	}
 	return res, nil
 }
 
 func (s *Service) GetDriverInfo(ctx context.Context, driverID string) (models.DriverInfoResponse, error) {
-	res, err := s.repo.GetDriverInfo(driverID)
+	ctx, span := tracer.Start(ctx, "Service.GetDriverInfo")
+	defer span.End()
+
+	res, err := s.repo.GetDriverInfo(ctx, driverID)
 	if err != nil {
 		return models.DriverInfoResponse{}, err
 	}
 	return res, nil
 }
 
-func (s *Service) GetDriverByCaDviceNum(ctx context.Context, deviceNum string) (models.Driver, error) {
-	res, err := s.repo.GetDriverByCaDviceNum(ctx, deviceNum)
+// UpdateDriver replaces the personal and licence details of a driver of the
+// company and returns the updated driver.
+func (s *Service) UpdateDriver(ctx context.Context, driver models.Driver) (models.DriverInfoResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.UpdateDriver")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.DriverInfoResponse{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	driver.IDCompany = id
+
+	var res models.Driver
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetDriver(ctx, id, driver.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err = s.repo.UpdateDriver(ctx, driver)
+		if err != nil {
+			return err
+		}
+		res.IDCar = before.IDCar
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceDriver, res.ID, before, res)
+	})
+	if err != nil {
+		return models.DriverInfoResponse{}, err
+	}
+	return s.repo.GetDriverInfo(ctx, res.ID)
+}
+
+// DeactivateDriver deactivates a driver of the company from now on and
+// returns the deactivated driver.
+func (s *Service) DeactivateDriver(ctx context.Context, driverID string) (models.DriverInfoResponse, error) {
+	ctx, span := tracer.Start(ctx, "Service.DeactivateDriver")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.DriverInfoResponse{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	var res models.Driver
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.DeactivateDriver(ctx, id, driverID, time.Now())
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionDeactivate, models.AuditResourceDriver, res.ID,
+			map[string]any{"DeactivatedAt": nil}, map[string]any{"DeactivatedAt": res.DeactivatedAt})
+	})
+	if err != nil {
+		return models.DriverInfoResponse{}, err
+	}
+	return s.repo.GetDriverInfo(ctx, res.ID)
+}
+
+// GetDriverByCaDviceNum returns the driver assigned at the time at to the car
+// with the device.
+func (s *Service) GetDriverByCaDviceNum(ctx context.Context, deviceNum string, at time.Time) (models.Driver, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetDriverByCaDviceNum")
+	defer span.End()
+
+	res, err := s.repo.GetDriverByCaDviceNum(ctx, deviceNum, at)
 	if err != nil {
 		return models.Driver{}, err
 	}
 	return res, nil
 }
 
-func (s *Service) UpdateDriverWorktime(ctx context.Context, deviceNum string, workedTime int) error {
-	err := s.repo.UpdateDriverWorktime(deviceNum, workedTime)
-	if err != nil {
-		return err
+// UpdateDriverWorktime records workedTime minutes of work reported by a
+// device, ending at endedAt or now if it is zero.
+func (s *Service) UpdateDriverWorktime(ctx context.Context, deviceNum string, workedTime int, endedAt time.Time) error {
+	ctx, span := tracer.Start(ctx, "Service.UpdateDriverWorktime")
+	defer span.End()
+
+	if endedAt.IsZero() {
+		endedAt = time.Now()
 	}
-	return nil
+
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		session, err := s.repo.UpdateDriverWorktime(ctx, deviceNum, workedTime, endedAt)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, session.IDCompany, models.AuditActionUpdate, models.AuditResourceDriver, session.IDDriver,
+			nil, map[string]any{"DeviceNumber": deviceNum, "AddedWorkedTime": workedTime, "WorkSession": session.ID})
+	})
 }
 
 // Position
 func (s *Service) CreatePosition(ctx context.Context, position models.Position) (models.Position, error) {
-	idCompany, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.CreatePosition")
+	defer span.End()
+
+	idCompany, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
-		s.log.Errorf("%v: %v", models.ErrInvalidContext, ctx)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrInvalidContext, ctx)
 		return models.Position{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
 	}
 
-	s.log.Debugf("Received request to create position for device number: %s", position.DeviceNumber)
+	logging.FromContext(ctx, s.log).Debugf("Received request to create position for device number: %s", position.DeviceNumber)
 
 	car, err := s.repo.GetCarByDeviceNumber(ctx, position.DeviceNumber)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchCar, err)
-		return models.Position{}, fmt.Errorf("%w: %v", models.ErrFailedToFetchCar, err)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToFetchCar, err)
+		return models.Position{}, fmt.Errorf("%w: %w", models.ErrFailedToFetchCar, err)
 	}
 
-	s.log.Debugf("Fetched car data: %+v", car)
-
-	position, err = s.repo.CreatePosition(ctx, position)
+	logging.FromContext(ctx, s.log).Debugf("Fetched car data: %+v", logging.Redact(car))
+
+	// The position and the current position of the car are stored together,
+	// so that the current position never lags behind the route. The previous
+	// position is locked until then for the geofence check.
+	var prev *models.CurrentPosition
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		cur, err := s.repo.GetCarPositionForUpdate(ctx, car.ID)
+		switch {
+		case err == nil:
+			prev = &cur
+		case !errors.Is(err, models.ErrNoContent):
+			return fmt.Errorf("%w: %v", models.ErrFailedToFetchCarPositions, err)
+		}
+
+		position, err = s.repo.CreatePosition(ctx, position)
+		if err != nil {
+			logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToCreatePosition, err)
+			return fmt.Errorf("%w: %v", models.ErrFailedToCreatePosition, err)
+		}
+
+		logging.FromContext(ctx, s.log).Debugf("Successfully created position: %+v", logging.Redact(position))
+
+		curPosition := models.CurrentPosition{
+			IDCompany: idCompany,
+			IDCar:     car.ID,
+			Location:  position.Location,
+			UpdateAt:  position.CreatedAt,
+		}
+
+		logging.FromContext(ctx, s.log).Debugf("Updating current position for car ID: %s", car.ID)
+
+		if _, err := s.repo.CreateOrUpdateCarsPosition(ctx, curPosition); err != nil {
+			logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToUpdateCurrentPosition, err)
+			return fmt.Errorf("%w: %v", models.ErrFailedToUpdateCurrentPosition, err)
+		}
+		return nil
+	})
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToCreatePosition, err)
-		return models.Position{}, fmt.Errorf("%w: %v", models.ErrFailedToCreatePosition, err)
+		return models.Position{}, err
 	}
 
-	s.log.Debugf("Successfully created position: %+v", position)
+	logging.FromContext(ctx, s.log).Debugf("Successfully updated current position for car ID: %s", car.ID)
+	s.metrics.PositionIngested(idCompany)
 
-	curPosition := models.CurrentPosition{
-		IDCompany: idCompany,
-		IDCar:     car.ID,
-		Location:  position.Location,
-		UpdateAt:  position.CreatedAt,
+	// The position is stored whether or not its check fails.
+	if err := s.checkGeofences(ctx, car, prev, position); err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to check geofences of car %s: %v", car.ID, err)
 	}
 
-	s.log.Debugf("Updating current position for car ID: %s", car.ID)
-
-	_, err = s.repo.CreateOrUpdateCarsPosition(ctx, curPosition)
-	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToUpdateCurrentPosition, err)
-		return models.Position{}, fmt.Errorf("%w: %v", models.ErrFailedToUpdateCurrentPosition, err)
-	}
-
-	s.log.Debugf("Successfully updated current position for car ID: %s", car.ID)
-
 	return position, nil
 }
 
 func (s *Service) GetCarRoutePositions(ctx context.Context, carID string, from time.Time, to time.Time) ([]models.Position, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetCarRoutePositions")
+	defer span.End()
+
 	positions, err := s.repo.GetCarRoutePositions(ctx, carID, from, to)
 	if err != nil {
 		return []models.Position{}, err
@@ -412,74 +851,98 @@ func (s *Service) GetCarRoutePositions(ctx context.Context, carID string, from t
 }
 
 func (s *Service) GetCurrentCarPositions(ctx context.Context) ([]models.CurrentPositionResponse, error) {
-	id, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.GetCurrentCarPositions")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
-		s.log.Errorf("%v: %v", models.ErrInvalidContext, ctx)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrInvalidContext, ctx)
 		return []models.CurrentPositionResponse{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
 	}
 
-	s.log.Debugf("Fetching current car positions for user_id=%s", id)
+	logging.FromContext(ctx, s.log).Debugf("Fetching current car positions for user_id=%s", id)
 
 	positions, err := s.repo.GetCurrentCarPositions(ctx, id)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchCarPositions, err)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToFetchCarPositions, err)
 		return []models.CurrentPositionResponse{}, fmt.Errorf("%w: %v", models.ErrFailedToFetchCarPositions, err)
 	}
 
 	if len(positions) == 0 {
-		s.log.Debugf("%v: No car positions found for user_id=%s", models.ErrNoContent, id)
+		logging.FromContext(ctx, s.log).Debugf("%v: No car positions found for user_id=%s", models.ErrNoContent, id)
 		return nil, models.ErrNoContent
 	}
 
-	s.log.Debugf("Successfully fetched %d current car positions for user_id=%s", len(positions), id)
+	logging.FromContext(ctx, s.log).Debugf("Successfully fetched %d current car positions for user_id=%s", len(positions), id)
 	return positions, nil
 }
 
 func (s *Service) GetCurrentCarPositionsByPoints(ctx context.Context, pointA models.Point, pointB models.Point) ([]models.CurrentPositionResponse, error) {
-	s.log.Debugf("Fetching current car positions: pointA=(%f, %f), pointB=(%f, %f)",
+	ctx, span := tracer.Start(ctx, "Service.GetCurrentCarPositionsByPoints")
+	defer span.End()
+
+	logging.FromContext(ctx, s.log).Debugf("Fetching current car positions: pointA=(%f, %f), pointB=(%f, %f)",
 		pointA.Latitude, pointA.Longitude, pointB.Latitude, pointB.Longitude)
 
 	positions, err := s.repo.GetCurrentCarPositionsByPoints(ctx, pointA, pointB)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToFetchPositions, err)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToFetchPositions, err)
 		return []models.CurrentPositionResponse{}, models.ErrFailedToFetchPositions
 	}
 
 	if len(positions) == 0 {
-		s.log.Debugf("No car positions found in the specified area: pointA=(%f, %f), pointB=(%f, %f)",
+		logging.FromContext(ctx, s.log).Debugf("No car positions found in the specified area: pointA=(%f, %f), pointB=(%f, %f)",
 			pointA.Latitude, pointA.Longitude, pointB.Latitude, pointB.Longitude)
 		return []models.CurrentPositionResponse{}, models.ErrNoContent
 	}
 
-	s.log.Debugf("Successfully fetched %d car positions", len(positions))
+	logging.FromContext(ctx, s.log).Debugf("Successfully fetched %d car positions", len(positions))
 	return positions, nil
 }
 
 func (s *Service) CreateBreakageFromMqtt(ctx context.Context, breakage models.BreakageFromMqtt) (models.Breakage, error) {
-	s.log.Debugf("Creating breakage from MQTT: %+v", breakage)
+	ctx, span := tracer.Start(ctx, "Service.CreateBreakageFromMqtt")
+	defer span.End()
+
+	logging.FromContext(ctx, s.log).Debugf("Creating breakage from MQTT: %+v", logging.Redact(breakage))
 
-	ok, err := s.repo.CheckDriverExists(ctx, breakage.DeviceNum)
+	ok, err := s.repo.CheckDriverExists(ctx, breakage.DeviceNum, breakage.CreatedAt)
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToCreateBreakage, err)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToCreateBreakage, err)
 		return models.Breakage{}, models.ErrFailedToCreateBreakage
 	}
 	if !ok {
-		s.log.Errorf("%v: %v", models.ErrFailedToCreateBreakage, err)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToCreateBreakage, err)
 		return models.Breakage{}, models.ErrFailedToCreateBreakage
 	}
 
-	res, err := s.repo.CreateBreakageFromMqtt(ctx, breakage)
+	var res models.Breakage
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateBreakageFromMqtt(ctx, breakage)
+		if err != nil {
+			return err
+		}
+		car, err := s.repo.GetCarById(ctx, res.CarID)
+		if err != nil {
+			return fmt.Errorf("getting car %s of breakage %s: %w", res.CarID, res.ID, err)
+		}
+		return s.enqueue(ctx, car.IDCompany, models.WebhookBreakageCreated, breakageWebhookData(res))
+	})
 	if err != nil {
-		s.log.Errorf("%v: %v", models.ErrFailedToCreateBreakage, err)
+		logging.FromContext(ctx, s.log).Errorf("%v: %v", models.ErrFailedToCreateBreakage, err)
 		return models.Breakage{}, models.ErrFailedToCreateBreakage
 	}
 
-	s.log.Debugf("Breakage created successfully: %+v", res)
+	logging.FromContext(ctx, s.log).Debugf("Breakage created successfully: %+v", logging.Redact(res))
 	return res, nil
 }
 
 func (s *Service) CreateNotification(ctx context.Context, new models.Notification) (models.Notification, error) {
-	res, err := s.repo.CreateNotification(new)
+	ctx, span := tracer.Start(ctx, "Service.CreateNotification")
+	defer span.End()
+
+	res, err := s.repo.CreateNotification(ctx, new)
 	if err != nil {
 		return models.Notification{}, err
 	}
@@ -487,34 +950,61 @@ func (s *Service) CreateNotification(ctx context.Context, new models.Notificatio
 }
 
 func (s *Service) UpdateNotificationStatus(ctx context.Context, id string, status string) error {
-	err := s.repo.UpdateNotificationStatus(ctx, id, status)
+	ctx, span := tracer.Start(ctx, "Service.UpdateNotificationStatus")
+	defer span.End()
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetNotificationForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.UpdateNotificationStatus(ctx, id, status)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, before.IDCompany, models.AuditActionUpdate, models.AuditResourceNotification, id,
+			map[string]any{"Status": before.Status}, map[string]any{"Status": status})
+	})
 	if err != nil {
 		return err
 	}
-
 	return nil
 }
 
 func (s *Service) UpdateAllNotificationsStatus(ctx context.Context, status string) error {
-	id, ok := ctx.Value("user_id").(string)
+	ctx, span := tracer.Start(ctx, "Service.UpdateAllNotificationsStatus")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
 		return fmt.Errorf("wrong context: %v", ctx)
 	}
 
-	err := s.repo.UpdateAllNotificationsStatus(ctx, id, status)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.UpdateAllNotificationsStatus(ctx, id, status); err != nil {
+			return err
+		}
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceNotification, "",
+			nil, map[string]any{"Status": status})
+	})
 }
 
 func (s *Service) GetNotificationInfo(ctx context.Context, notificationID string) (models.NotificationInfo, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetNotificationInfo")
+	defer span.End()
+
 	if notificationID == "" {
 		return models.NotificationInfo{}, fmt.Errorf("notification ID is required")
 	}
 
-	notificationInfo, err := s.repo.GetNotificationInfo(ctx, notificationID)
+	companyID, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.NotificationInfo{}, fmt.Errorf("wrong context: %v", ctx)
+	}
+
+	notificationInfo, err := s.repo.GetNotificationInfo(ctx, companyID, notificationID)
 	if err != nil {
 		return models.NotificationInfo{}, fmt.Errorf("failed to retrieve notification info: %w", err)
 	}
@@ -522,15 +1012,19 @@ func (s *Service) GetNotificationInfo(ctx context.Context, notificationID string
 	return notificationInfo, nil
 }
 
-func (s *Service) GetNotificationList(ctx context.Context, status *string, limit, offset int) ([]models.NotificationListItem, error) {
-	userID, ok := ctx.Value("user_id").(string)
+func (s *Service) GetNotificationList(ctx context.Context, filter models.NotificationFilter, page models.PageRequest) (models.Page[models.NotificationListItem], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetNotificationList")
+	defer span.End()
+
+	userID, ok := ctx.Value(models.UserIDKey).(string)
 	if !ok {
-		return []models.NotificationListItem{}, fmt.Errorf("wrong context: %v", ctx)
+		return models.Page[models.NotificationListItem]{}, fmt.Errorf("wrong context: %v", ctx)
 	}
+	filter.IDUser = userID
 
-	notifications, err := s.repo.GetNotificationList(ctx, userID, status, limit, offset)
+	notifications, err := s.repo.GetNotificationList(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve notifications: %w", err)
+		return models.Page[models.NotificationListItem]{}, fmt.Errorf("failed to retrieve notifications: %w", err)
 	}
 
 	return notifications, nil
@@ -538,25 +1032,45 @@ func (s *Service) GetNotificationList(ctx context.Context, status *string, limit
 
 // Mileage
 func (s *Service) UpdateWheelsMilagelData(ctx context.Context, update models.UpdateMileage) error {
-	s.log.Debugf("Starting mileage update for device number: %s, mileage: %f", update.DeviceNum, update.Mileage)
-
-	err := s.repo.UpdateWheelsMilagelData(ctx, update)
+	ctx, span := tracer.Start(ctx, "Service.UpdateWheelsMilagelData")
+	defer span.End()
+
+	logging.FromContext(ctx, s.log).Debugf("Starting mileage update for device number: %s, mileage: %f", update.DeviceNum, update.Mileage)
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		wheels, err := s.repo.UpdateWheelsMilagelData(ctx, update)
+		if err != nil {
+			return err
+		}
+		for _, w := range wheels {
+			err := s.audit(ctx, w.IDCompany, models.AuditActionUpdate, models.AuditResourceWheel, w.ID,
+				nil, map[string]any{"DeviceNumber": update.DeviceNum, "AddedMileage": update.Mileage, "Mileage": w.Mileage})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err == models.ErrNoContent {
-		s.log.Errorf("Failed to update mileage for device number %s: %v", update.DeviceNum, models.ErrNoContent)
+		logging.FromContext(ctx, s.log).Errorf("Failed to update mileage for device number %s: %v", update.DeviceNum, models.ErrNoContent)
 		return fmt.Errorf("failed to update mileage: %w", models.ErrNoContent)
 	}
 	if err != nil {
-		s.log.Errorf("Failed to update mileage for device number %s: %v", update.DeviceNum, err)
+		logging.FromContext(ctx, s.log).Errorf("Failed to update mileage for device number %s: %v", update.DeviceNum, err)
 		return fmt.Errorf("failed to update mileage: %w", err)
 	}
 
-	s.log.Debugf("Mileage update successful for device number: %s", update.DeviceNum)
+	companyID, _ := ctx.Value(models.UserIDKey).(string)
+	s.metrics.MileageUpdated(companyID)
+
+	logging.FromContext(ctx, s.log).Debugf("Mileage update successful for device number: %s", update.DeviceNum)
 	return nil
 }
 
-func NewService(repo Repository, log *logrus.Logger) *Service {
+func NewService(repo Repository, metrics Metrics, log *logrus.Logger) *Service {
 	return &Service{
-		repo: repo,
-		log:  log,
+		repo:    repo,
+		metrics: metrics,
+		log:     log,
 	}
 }
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// userRepo fails to get or update the user with the errors it holds.
+type userRepo struct {
+	Repository
+	getErr    error
+	updateErr error
+}
+
+func (r *userRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *userRepo) GetById(ctx context.Context, userID string) (models.User, error) {
+	return models.User{}, r.getErr
+}
+
+func (r *userRepo) UpdateUser(ctx context.Context, user models.User) (string, error) {
+	return user.ID, r.updateErr
+}
+
+func (r *userRepo) CreateAuditEntry(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error) {
+	return entry, nil
+}
+
+func TestUpdateUserKeepsCause(t *testing.T) {
+	ctx := context.WithValue(context.Background(), models.UserIDKey, "u1")
+
+	tests := []struct {
+		name      string
+		getErr    error
+		updateErr error
+		want      error
+	}{
+		{name: "not found before update", getErr: models.ErrNoContent, want: models.ErrNoContent},
+		{name: "not found on update", updateErr: models.ErrNoContent, want: models.ErrNoContent},
+		{name: "failed", updateErr: errors.New("connection refused"), want: models.ErrUserUpdateFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewService(&userRepo{getErr: tt.getErr, updateErr: tt.updateErr}, nil, logrus.New())
+			_, err := s.UpdateUser(ctx, models.User{})
+			assert.ErrorIs(t, err, tt.want)
+			assert.ErrorIs(t, err, models.ErrUserUpdateFailed)
+		})
+	}
+}
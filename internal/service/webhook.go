@@ -0,0 +1,407 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/google/uuid"
+)
+
+// WebhookClient delivers signed fleet events to webhook endpoints.
+type WebhookClient interface {
+	// Deliver returns the status of the response of the endpoint, 0 if there
+	// was none.
+	Deliver(ctx context.Context, req models.WebhookRequest) (int, error)
+}
+
+// SetWebhookClient makes webhook deliveries be made by client. It must be
+// called before the service is used.
+func (s *Service) SetWebhookClient(client WebhookClient) {
+	s.webhooks = client
+}
+
+// WebhookParams are the parameters of the webhook delivery worker.
+type WebhookParams struct {
+	Interval  time.Duration
+	BatchSize int
+	// MaxAttempts is how many times a delivery is attempted before it is
+	// dead.
+	MaxAttempts int
+	// RetryBackoff is the wait before the second attempt of a delivery,
+	// doubled before each further one up to MaxBackoff.
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	// Lease is how long a batch of deliveries is claimed by this worker. Once
+	// it runs out the rest of the batch is left to the next claim.
+	Lease time.Duration
+}
+
+// newWebhookSecret returns a random secret to sign the deliveries of an
+// endpoint with.
+func newWebhookSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(raw), nil
+}
+
+// Webhook endpoints
+// CreateWebhook adds a webhook endpoint to the company with a new secret.
+func (s *Service) CreateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error) {
+	ctx, span := tracer.Start(ctx, "Service.CreateWebhook")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WebhookEndpoint{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	e.IDCompany = id
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return models.WebhookEndpoint{}, err
+	}
+	e.Secret = secret
+
+	var res models.WebhookEndpoint
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.CreateWebhook(ctx, e)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionCreate, models.AuditResourceWebhook, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.WebhookEndpoint{}, err
+	}
+	return res, nil
+}
+
+// UpdateWebhook replaces a webhook endpoint of the company.
+func (s *Service) UpdateWebhook(ctx context.Context, e models.WebhookEndpoint) (models.WebhookEndpoint, error) {
+	ctx, span := tracer.Start(ctx, "Service.UpdateWebhook")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WebhookEndpoint{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	e.IDCompany = id
+
+	var res models.WebhookEndpoint
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetWebhook(ctx, id, e.ID)
+		if err != nil {
+			return err
+		}
+
+		res, err = s.repo.UpdateWebhook(ctx, e)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceWebhook, res.ID, before, res)
+	})
+	if err != nil {
+		return models.WebhookEndpoint{}, err
+	}
+	return res, nil
+}
+
+// DeleteWebhook removes a webhook endpoint of the company with its
+// deliveries.
+func (s *Service) DeleteWebhook(ctx context.Context, webhookID string) error {
+	ctx, span := tracer.Start(ctx, "Service.DeleteWebhook")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		res, err := s.repo.DeleteWebhook(ctx, id, webhookID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionDelete, models.AuditResourceWebhook, res.ID, res, nil)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetWebhooks returns the webhook endpoints of the company.
+func (s *Service) GetWebhooks(ctx context.Context) ([]models.WebhookEndpoint, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWebhooks")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetWebhooks(ctx, id)
+}
+
+// Delivery log
+// GetWebhookDeliveries returns a page of the webhook deliveries of the
+// company.
+func (s *Service) GetWebhookDeliveries(ctx context.Context, filter models.WebhookDeliveryFilter, page models.PageRequest) (models.Page[models.WebhookDelivery], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWebhookDeliveries")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Page[models.WebhookDelivery]{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.GetWebhookDeliveries(ctx, filter, page)
+}
+
+// GetWebhookAttempts returns the attempts of a webhook delivery of the
+// company.
+func (s *Service) GetWebhookAttempts(ctx context.Context, deliveryID string) ([]models.WebhookAttempt, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWebhookAttempts")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	return s.repo.GetWebhookAttempts(ctx, id, deliveryID)
+}
+
+// ReplayWebhookDelivery queues a webhook delivery of the company again, dead
+// or not.
+func (s *Service) ReplayWebhookDelivery(ctx context.Context, deliveryID string) (models.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "Service.ReplayWebhookDelivery")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WebhookDelivery{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	var res models.WebhookDelivery
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.ReplayWebhookDelivery(ctx, id, deliveryID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionUpdate, models.AuditResourceWebhook, res.IDEndpoint,
+			nil, map[string]any{"ReplayedDelivery": res.ID})
+	})
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	return res, nil
+}
+
+// Events
+// newWebhookEvent returns an event of the company with its envelope as the
+// payload.
+func newWebhookEvent(companyID string, eventType string, data any) (models.WebhookEvent, error) {
+	e := models.WebhookEvent{
+		ID:        uuid.NewString(),
+		IDCompany: companyID,
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	payload, err := json.Marshal(models.WebhookEnvelope{
+		ID:        e.ID,
+		Type:      e.Type,
+		CompanyID: companyID,
+		CreatedAt: e.CreatedAt,
+		Data:      data,
+	})
+	if err != nil {
+		return models.WebhookEvent{}, err
+	}
+	e.Payload = payload
+	return e, nil
+}
+
+// enqueue queues an event of the company for its webhook endpoints. Called
+// within a unit of work, the event is only sent if the unit commits.
+func (s *Service) enqueue(ctx context.Context, companyID string, eventType string, data any) error {
+	e, err := newWebhookEvent(companyID, eventType, data)
+	if err != nil {
+		return err
+	}
+	return s.repo.EnqueueWebhookEvent(ctx, e)
+}
+
+func breakageWebhookData(b models.Breakage) models.BreakageWebhookData {
+	return models.BreakageWebhookData{
+		ID:          b.ID,
+		CarID:       b.CarID,
+		DriverID:    b.DriverID,
+		Type:        b.Type,
+		TypeID:      b.IDType,
+		Description: b.Description,
+		Latitude:    b.Location.Latitude,
+		Longitude:   b.Location.Longitude,
+		CreatedAt:   b.CreatedAt,
+	}
+}
+
+func carWebhookData(c models.Car) models.CarWebhookData {
+	return models.CarWebhookData{
+		ID:           c.ID,
+		StateNumber:  c.StateNumber,
+		Brand:        c.Brand,
+		DeviceNumber: c.DeviceNumber,
+		UniqueID:     c.IDUnicum,
+		AxleCount:    c.CountAxis,
+		Type:         c.Type,
+	}
+}
+
+func wheelWebhookData(w models.Wheel) models.WheelWebhookData {
+	return models.WheelWebhookData{
+		ID:           w.ID,
+		CarID:        w.IDCar,
+		Position:     w.Position,
+		AxisNumber:   w.AxisNumber,
+		SensorNumber: w.SensorNumber,
+		Brand:        w.Brand,
+		Model:        w.Model,
+		Size:         w.Size,
+		Mileage:      w.Mileage,
+	}
+}
+
+func notificationWebhookData(n models.RoutedNotification) models.NotificationWebhookData {
+	return models.NotificationWebhookData{
+		ID:        n.ID,
+		Event:     n.Event,
+		Severity:  n.Severity,
+		CarID:     n.IDCar,
+		Note:      n.Note,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func geofenceWebhookData(n models.GeofenceNotification) models.GeofenceWebhookData {
+	return models.GeofenceWebhookData{
+		GeofenceID: n.Notice.GeofenceID,
+		Geofence:   n.Notice.Geofence,
+		CarID:      n.IDCar,
+		Transition: n.Notice.Transition,
+		Latitude:   n.Notice.Latitude,
+		Longitude:  n.Notice.Longitude,
+		OccurredAt: n.Notice.OccurredAt,
+	}
+}
+
+// Delivery
+// DeliverWebhooks attempts the pending webhook deliveries every interval
+// until ctx is done. A failed delivery is retried with exponential backoff
+// and is dead once it runs out of attempts.
+func (s *Service) DeliverWebhooks(ctx context.Context, params WebhookParams) {
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.sendWebhooks(ctx, params)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) sendWebhooks(ctx context.Context, params WebhookParams) {
+	ctx, span := tracer.Start(ctx, "Service.sendWebhooks")
+	defer span.End()
+
+	if s.webhooks == nil {
+		return
+	}
+
+	claimed := time.Now()
+	leaseUntil := claimed.Add(params.Lease)
+	pending, err := s.repo.ClaimPendingWebhooks(ctx, claimed, leaseUntil, params.BatchSize)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to claim pending webhooks: %v", err)
+		return
+	}
+
+	for _, p := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Now().After(leaseUntil) {
+			logging.FromContext(ctx, s.log).Warnf("Lease of %d webhook deliveries ran out before they were attempted", len(pending))
+			return
+		}
+
+		start := time.Now()
+		code, err := s.webhooks.Deliver(ctx, models.WebhookRequest{
+			URL:        p.URL,
+			Secret:     p.Secret,
+			IDDelivery: p.ID,
+			EventType:  p.EventType,
+			Payload:    p.Payload,
+			Timestamp:  start,
+		})
+		now := time.Now()
+
+		d := p.WebhookDelivery
+		d.Attempts++
+		a := models.WebhookAttempt{IDDelivery: d.ID, AttemptedAt: start, Duration: now.Sub(start)}
+		if code != 0 {
+			a.StatusCode = &code
+		}
+		d.LastStatusCode = a.StatusCode
+
+		if err == nil {
+			d.Status = models.WebhookDelivered
+			d.DeliveredAt = &now
+			d.LastError = nil
+		} else {
+			msg := deliveryError(err)
+			a.Error = &msg
+			d.LastError = &msg
+			if d.Attempts >= params.MaxAttempts {
+				d.Status = models.WebhookDead
+			} else {
+				d.NextAttemptAt = now.Add(webhookBackoff(params, d.Attempts))
+			}
+			logging.FromContext(ctx, s.log).Warnf("Attempt %d of webhook delivery %s failed: %v", d.Attempts, d.ID, err)
+		}
+
+		if err := s.repo.SaveWebhookAttempt(ctx, d, a); err != nil {
+			logging.FromContext(ctx, s.log).Errorf("Failed to save attempt of webhook delivery %s: %v", d.ID, err)
+		}
+	}
+}
+
+// webhookBackoff returns the wait after the attempts-th failed attempt of a
+// delivery.
+func webhookBackoff(params WebhookParams, attempts int) time.Duration {
+	wait := params.RetryBackoff
+	for i := 1; i < attempts && wait < params.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, params.MaxBackoff)
+}
@@ -0,0 +1,469 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/logging"
+	"github.com/VikaPaz/algalar/internal/models"
+)
+
+const (
+	// workHoursLookback is how far back work is checked for violations. The
+	// periods that started earlier were checked while they were recent.
+	workHoursLookback = 8 * 24 * time.Hour
+	// timesheetViolationLimit bounds the violations listed in a timesheet.
+	timesheetViolationLimit = 1000
+)
+
+// WorkHoursParams are the parameters of the work hours worker.
+type WorkHoursParams struct {
+	Interval time.Duration
+	Rules    models.WorkRules
+}
+
+// Work sessions
+// StartWorkSession records a manually entered work session of a driver of
+// the company, starting now if StartedAt is not set.
+func (s *Service) StartWorkSession(ctx context.Context, session models.WorkSession) (models.WorkSession, error) {
+	ctx, span := tracer.Start(ctx, "Service.StartWorkSession")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WorkSession{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	session.IDCompany = id
+	session.Source = models.WorkSourceManual
+	if session.StartedAt.IsZero() {
+		session.StartedAt = time.Now()
+	}
+
+	var res models.WorkSession
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.StartWorkSession(ctx, session)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionStart, models.AuditResourceWorkSession, res.ID, nil, res)
+	})
+	if err != nil {
+		return models.WorkSession{}, err
+	}
+	return res, nil
+}
+
+// EndWorkSession ends the open work session of a driver of the company at
+// endedAt, or now if it is zero.
+func (s *Service) EndWorkSession(ctx context.Context, driverID string, endedAt time.Time) (models.WorkSession, error) {
+	ctx, span := tracer.Start(ctx, "Service.EndWorkSession")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WorkSession{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	if endedAt.IsZero() {
+		endedAt = time.Now()
+	}
+
+	var res models.WorkSession
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.repo.EndWorkSession(ctx, id, driverID, endedAt)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, id, models.AuditActionEnd, models.AuditResourceWorkSession, res.ID,
+			map[string]any{"EndedAt": nil}, map[string]any{"EndedAt": res.EndedAt})
+	})
+	if err != nil {
+		return models.WorkSession{}, err
+	}
+	return res, nil
+}
+
+func (s *Service) GetWorkSessions(ctx context.Context, filter models.WorkSessionFilter, page models.PageRequest) (models.Page[models.WorkSession], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWorkSessions")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Page[models.WorkSession]{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.GetWorkSessions(ctx, filter, page)
+}
+
+func (s *Service) GetWorkViolations(ctx context.Context, filter models.WorkViolationFilter, page models.PageRequest) (models.Page[models.WorkViolation], error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWorkViolations")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Page[models.WorkViolation]{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+	filter.IDCompany = id
+
+	return s.repo.GetWorkViolations(ctx, filter, page)
+}
+
+// GetWorkSummary totals the work of a driver of the company by day, from the
+// date of from to the date of to in the company's time zone, and by the weeks
+// of these days.
+func (s *Service) GetWorkSummary(ctx context.Context, driverID string, from time.Time, to time.Time) (models.WorkSummary, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetWorkSummary")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.WorkSummary{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	loc, err := s.companyLocation(ctx, id)
+	if err != nil {
+		return models.WorkSummary{}, err
+	}
+
+	return s.workSummary(ctx, id, driverID, loc, from, to)
+}
+
+// GetTimesheet returns the timesheet of a driver of the company for the month
+// of month in the company's time zone.
+func (s *Service) GetTimesheet(ctx context.Context, driverID string, month time.Time) (models.Timesheet, error) {
+	ctx, span := tracer.Start(ctx, "Service.GetTimesheet")
+	defer span.End()
+
+	id, ok := ctx.Value(models.UserIDKey).(string)
+	if !ok {
+		return models.Timesheet{}, fmt.Errorf("%w: %v", models.ErrInvalidContext, ctx)
+	}
+
+	driver, err := s.repo.GetDriverInfo(ctx, driverID)
+	if err != nil {
+		return models.Timesheet{}, err
+	}
+
+	loc, err := s.companyLocation(ctx, id)
+	if err != nil {
+		return models.Timesheet{}, err
+	}
+
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, loc)
+	summary, err := s.workSummary(ctx, id, driverID, loc, from, from.AddDate(0, 1, -1))
+	if err != nil {
+		return models.Timesheet{}, err
+	}
+
+	filter := models.WorkViolationFilter{IDCompany: id, IDDriver: &driverID, From: &summary.From, To: &summary.To}
+	violations, err := s.repo.GetWorkViolations(ctx, filter, models.PageRequest{Limit: timesheetViolationLimit, Sort: "period_start"})
+	if err != nil {
+		return models.Timesheet{}, err
+	}
+
+	return models.Timesheet{Driver: driver, Month: from, Summary: summary, Violations: violations.Items}, nil
+}
+
+func (s *Service) workSummary(ctx context.Context, companyID string, driverID string, loc *time.Location, from time.Time, to time.Time) (models.WorkSummary, error) {
+	from = startOfDay(from, loc)
+	end := startOfDay(to, loc).AddDate(0, 0, 1)
+	weeksFrom := startOfWeek(from)
+	weeksTo := startOfWeek(end.Add(-time.Nanosecond)).AddDate(0, 0, 7)
+
+	filter := models.WorkSessionFilter{IDCompany: companyID, IDDriver: &driverID, From: &weeksFrom, To: &weeksTo}
+	sessions, err := s.repo.GetWorkSessionsInPeriod(ctx, filter)
+	if err != nil {
+		return models.WorkSummary{}, err
+	}
+
+	now := time.Now()
+	intervals := workIntervals(sessions, now)
+	return models.WorkSummary{
+		IDDriver: driverID,
+		From:     from,
+		To:       end,
+		Days:     workDays(intervals, from, end, now),
+		Weeks:    workWeeks(intervals, weeksFrom, weeksTo),
+	}, nil
+}
+
+func (s *Service) companyLocation(ctx context.Context, companyID string) (*time.Location, error) {
+	timezones, err := s.repo.GetCompanyTimezones(ctx, []string{companyID})
+	if err != nil {
+		return nil, err
+	}
+	return utcOffsetLocation(timezones[companyID]), nil
+}
+
+// CheckWorkHours periodically checks the recent work sessions of every driver
+// against params.Rules and records the violations, raising a notification for
+// each new one. It blocks until ctx is cancelled.
+func (s *Service) CheckWorkHours(ctx context.Context, params WorkHoursParams) {
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.checkWorkHours(ctx, params.Rules)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) checkWorkHours(ctx context.Context, rules models.WorkRules) {
+	ctx, span := tracer.Start(ctx, "Service.checkWorkHours")
+	defer span.End()
+
+	now := time.Now()
+	since := now.Add(-workHoursLookback)
+	sessions, err := s.repo.GetWorkSessionsInPeriod(ctx, models.WorkSessionFilter{From: &since})
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get work sessions: %v", err)
+		return
+	}
+
+	var companyIDs []string
+	for _, session := range sessions {
+		if !slices.Contains(companyIDs, session.IDCompany) {
+			companyIDs = append(companyIDs, session.IDCompany)
+		}
+	}
+	timezones, err := s.repo.GetCompanyTimezones(ctx, companyIDs)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to get company time zones: %v", err)
+		return
+	}
+
+	// The sessions are ordered by driver.
+	var violations []models.WorkViolation
+	for start := 0; start < len(sessions); {
+		end := start + 1
+		for end < len(sessions) && sessions[end].IDDriver == sessions[start].IDDriver {
+			end++
+		}
+
+		driver := sessions[start]
+		loc := utcOffsetLocation(timezones[driver.IDCompany])
+		for _, v := range detectWorkViolations(workIntervals(sessions[start:end], now), rules, loc, since, now) {
+			v.IDCompany = driver.IDCompany
+			v.IDDriver = driver.IDDriver
+			v.Note = workViolationNote(v, loc)
+			violations = append(violations, v)
+		}
+		start = end
+	}
+
+	created, err := s.repo.SaveWorkViolations(ctx, violations)
+	if err != nil {
+		logging.FromContext(ctx, s.log).Errorf("Failed to save work violations: %v", err)
+		return
+	}
+
+	logging.FromContext(ctx, s.log).Debugf("Found %d work violations, %d of them new", len(violations), len(created))
+}
+
+// workInterval is a period of uninterrupted work.
+type workInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+// workIntervals merges overlapping sessions into intervals ordered by start.
+// Open sessions last until now.
+func workIntervals(sessions []models.WorkSession, now time.Time) []workInterval {
+	sorted := slices.Clone(sessions)
+	slices.SortFunc(sorted, func(a, b models.WorkSession) int { return a.StartedAt.Compare(b.StartedAt) })
+
+	var intervals []workInterval
+	for _, session := range sorted {
+		end := now
+		if session.EndedAt != nil {
+			end = *session.EndedAt
+		}
+		if n := len(intervals); n > 0 && !session.StartedAt.After(intervals[n-1].end) {
+			intervals[n-1].end = later(intervals[n-1].end, end)
+			continue
+		}
+		intervals = append(intervals, workInterval{start: session.StartedAt, end: end})
+	}
+	return intervals
+}
+
+// workDays totals the intervals by day from the day from until end.
+func workDays(intervals []workInterval, from time.Time, end time.Time, now time.Time) []models.WorkDay {
+	var days []models.WorkDay
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		wd := models.WorkDay{Date: day}
+		for _, iv := range intervals {
+			if !iv.start.Before(next) || !iv.end.After(day) {
+				continue
+			}
+			start, end := later(iv.start, day), earlier(iv.end, next)
+			if wd.FirstStart == nil {
+				wd.FirstStart = &start
+			}
+			wd.LastEnd = &end
+			wd.Periods++
+			wd.Worked += end.Sub(start)
+		}
+		if wd.FirstStart != nil {
+			wd.LongestRest = longestRest(intervals, *wd.FirstStart, now)
+		}
+		days = append(days, wd)
+	}
+	return days
+}
+
+// workWeeks totals the intervals by week from the week starting on from until
+// the one starting before to.
+func workWeeks(intervals []workInterval, from time.Time, to time.Time) []models.WorkWeek {
+	var weeks []models.WorkWeek
+	for week := from; week.Before(to); week = week.AddDate(0, 0, 7) {
+		weeks = append(weeks, models.WorkWeek{Start: week, Worked: workedBetween(intervals, week, week.AddDate(0, 0, 7))})
+	}
+	return weeks
+}
+
+func workedBetween(intervals []workInterval, from time.Time, to time.Time) time.Duration {
+	var worked time.Duration
+	for _, iv := range intervals {
+		if iv.start.Before(to) && iv.end.After(from) {
+			worked += earlier(iv.end, to).Sub(later(iv.start, from))
+		}
+	}
+	return worked
+}
+
+// longestRest returns the longest rest that started within 24 hours of from.
+// The rest after the last interval lasts until now.
+func longestRest(intervals []workInterval, from time.Time, now time.Time) time.Duration {
+	windowEnd := from.Add(24 * time.Hour)
+
+	var longest time.Duration
+	for i, iv := range intervals {
+		if iv.end.Before(from) || !iv.end.Before(windowEnd) {
+			continue
+		}
+		restEnd := now
+		if i+1 < len(intervals) {
+			restEnd = intervals[i+1].start
+		}
+		longest = max(longest, restEnd.Sub(iv.end))
+	}
+	return longest
+}
+
+// detectWorkViolations checks the work intervals of a driver against rules.
+// Only the stretches, days and weeks that started since since are checked,
+// and the rest of a day only once 24 hours have passed since its first work.
+func detectWorkViolations(intervals []workInterval, rules models.WorkRules, loc *time.Location, since time.Time, now time.Time) []models.WorkViolation {
+	var violations []models.WorkViolation
+	add := func(typ string, start time.Time, end time.Time, limit time.Duration, actual time.Duration) {
+		violations = append(violations, models.WorkViolation{
+			Type:          typ,
+			PeriodStart:   start,
+			PeriodEnd:     end,
+			LimitMinutes:  int(limit.Minutes()),
+			ActualMinutes: int(actual.Minutes()),
+		})
+	}
+
+	// Intervals less than a break apart are one stretch of continuous driving.
+	for i := 0; i < len(intervals); {
+		j, worked := i, intervals[i].end.Sub(intervals[i].start)
+		for j+1 < len(intervals) && intervals[j+1].start.Sub(intervals[j].end) < rules.MinBreak {
+			j++
+			worked += intervals[j].end.Sub(intervals[j].start)
+		}
+		if !intervals[i].start.Before(since) && worked > rules.MaxContinuousDriving {
+			add(models.WorkViolationContinuousDriving, intervals[i].start, intervals[j].end, rules.MaxContinuousDriving, worked)
+		}
+		i = j + 1
+	}
+
+	firstDay := startOfDay(since.In(loc), loc)
+	if firstDay.Before(since) {
+		firstDay = firstDay.AddDate(0, 0, 1)
+	}
+	for _, day := range workDays(intervals, firstDay, now, now) {
+		next := day.Date.AddDate(0, 0, 1)
+		if day.Worked > rules.MaxDailyDriving {
+			add(models.WorkViolationDailyDriving, day.Date, next, rules.MaxDailyDriving, day.Worked)
+		}
+		if day.FirstStart != nil && !day.FirstStart.Add(24*time.Hour).After(now) && day.LongestRest < rules.MinDailyRest {
+			add(models.WorkViolationDailyRest, day.Date, next, rules.MinDailyRest, day.LongestRest)
+		}
+	}
+
+	firstWeek := startOfWeek(startOfDay(since.In(loc), loc))
+	if firstWeek.Before(since) {
+		firstWeek = firstWeek.AddDate(0, 0, 7)
+	}
+	for _, week := range workWeeks(intervals, firstWeek, now) {
+		if week.Worked > rules.MaxWeeklyDriving {
+			add(models.WorkViolationWeeklyDriving, week.Start, week.Start.AddDate(0, 0, 7), rules.MaxWeeklyDriving, week.Worked)
+		}
+	}
+
+	return violations
+}
+
+// workViolationNote describes a violation for its notification.
+func workViolationNote(v models.WorkViolation, loc *time.Location) string {
+	limit, actual := formatMinutes(v.LimitMinutes), formatMinutes(v.ActualMinutes)
+	switch v.Type {
+	case models.WorkViolationContinuousDriving:
+		return fmt.Sprintf("Continuous driving of %s from %s exceeds %s", actual, v.PeriodStart.In(loc).Format("2006-01-02 15:04"), limit)
+	case models.WorkViolationDailyDriving:
+		return fmt.Sprintf("Driving of %s on %s exceeds %s", actual, v.PeriodStart.In(loc).Format(time.DateOnly), limit)
+	case models.WorkViolationDailyRest:
+		return fmt.Sprintf("Daily rest of %s on %s is shorter than %s", actual, v.PeriodStart.In(loc).Format(time.DateOnly), limit)
+	default:
+		return fmt.Sprintf("Driving of %s in the week of %s exceeds %s", actual, v.PeriodStart.In(loc).Format(time.DateOnly), limit)
+	}
+}
+
+func formatMinutes(minutes int) string {
+	return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+}
+
+// utcOffsetLocation returns the time zone of a company with the UTC offset in
+// hours.
+func utcOffsetLocation(offset int) *time.Location {
+	return time.FixedZone(fmt.Sprintf("UTC%+d", offset), offset*3600)
+}
+
+// startOfDay returns the start of the date of t in loc, the date being read
+// in t's own location.
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// startOfWeek returns the start of the Monday of the week of the day day.
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+func later(a time.Time, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a time.Time, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
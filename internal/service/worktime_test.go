@@ -0,0 +1,195 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VikaPaz/algalar/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var testWorkRules = models.WorkRules{
+	MaxContinuousDriving: 270 * time.Minute,
+	MinBreak:             45 * time.Minute,
+	MaxDailyDriving:      9 * time.Hour,
+	MinDailyRest:         11 * time.Hour,
+	MaxWeeklyDriving:     56 * time.Hour,
+}
+
+// moscow is the time zone of a company at UTC+3. March 2, 2026 is a Monday.
+var moscow = utcOffsetLocation(3)
+
+func onMarch(loc *time.Location, day int, hour int, minute int) time.Time {
+	return time.Date(2026, 3, day, hour, minute, 0, 0, loc)
+}
+
+func workSession(start time.Time, end time.Time) models.WorkSession {
+	return models.WorkSession{StartedAt: start, EndedAt: &end}
+}
+
+func TestWorkIntervals(t *testing.T) {
+	now := onMarch(moscow, 2, 18, 0)
+	sessions := []models.WorkSession{
+		workSession(onMarch(moscow, 2, 13, 0), onMarch(moscow, 2, 14, 0)),
+		workSession(onMarch(moscow, 2, 8, 0), onMarch(moscow, 2, 10, 0)),
+		workSession(onMarch(moscow, 2, 9, 0), onMarch(moscow, 2, 11, 0)),
+		workSession(onMarch(moscow, 2, 11, 0), onMarch(moscow, 2, 12, 0)),
+		{StartedAt: onMarch(moscow, 2, 16, 0)},
+	}
+
+	assert.Equal(t, []workInterval{
+		{start: onMarch(moscow, 2, 8, 0), end: onMarch(moscow, 2, 12, 0)},
+		{start: onMarch(moscow, 2, 13, 0), end: onMarch(moscow, 2, 14, 0)},
+		{start: onMarch(moscow, 2, 16, 0), end: now},
+	}, workIntervals(sessions, now))
+}
+
+func TestDetectWorkViolations(t *testing.T) {
+	weeklyRules := testWorkRules
+	weeklyRules.MaxWeeklyDriving = 10 * time.Hour
+
+	// Three four-hour stretches from 13:00 to 03:00 in Moscow: nine hours on
+	// the Moscow day, but twelve on the UTC day from 10:00 to 24:00.
+	acrossMidnight := []models.WorkSession{
+		workSession(onMarch(moscow, 2, 13, 0), onMarch(moscow, 2, 17, 0)),
+		workSession(onMarch(moscow, 2, 18, 0), onMarch(moscow, 2, 22, 0)),
+		workSession(onMarch(moscow, 2, 23, 0), onMarch(moscow, 3, 3, 0)),
+	}
+
+	tests := []struct {
+		name     string
+		sessions []models.WorkSession
+		rules    models.WorkRules
+		loc      *time.Location
+		since    time.Time
+		now      time.Time
+		want     []models.WorkViolation
+	}{
+		{
+			name: "short break does not end continuous driving",
+			sessions: []models.WorkSession{
+				workSession(onMarch(moscow, 2, 6, 0), onMarch(moscow, 2, 8, 0)),
+				workSession(onMarch(moscow, 2, 8, 30), onMarch(moscow, 2, 11, 30)),
+			},
+			rules: testWorkRules,
+			loc:   moscow,
+			since: onMarch(moscow, 2, 0, 0),
+			now:   onMarch(moscow, 3, 12, 0),
+			want: []models.WorkViolation{{
+				Type:          models.WorkViolationContinuousDriving,
+				PeriodStart:   onMarch(moscow, 2, 6, 0),
+				PeriodEnd:     onMarch(moscow, 2, 11, 30),
+				LimitMinutes:  270,
+				ActualMinutes: 300,
+			}},
+		},
+		{
+			name: "full break ends continuous driving",
+			sessions: []models.WorkSession{
+				workSession(onMarch(moscow, 2, 6, 0), onMarch(moscow, 2, 9, 0)),
+				workSession(onMarch(moscow, 2, 9, 45), onMarch(moscow, 2, 12, 0)),
+			},
+			rules: testWorkRules,
+			loc:   moscow,
+			since: onMarch(moscow, 2, 0, 0),
+			now:   onMarch(moscow, 3, 12, 0),
+		},
+		{
+			name:     "days start at midnight of the company",
+			sessions: acrossMidnight,
+			rules:    testWorkRules,
+			loc:      moscow,
+			since:    onMarch(moscow, 2, 0, 0),
+			now:      onMarch(moscow, 4, 12, 0),
+		},
+		{
+			name:     "days start at midnight UTC",
+			sessions: acrossMidnight,
+			rules:    testWorkRules,
+			loc:      time.UTC,
+			since:    onMarch(moscow, 2, 0, 0),
+			now:      onMarch(moscow, 4, 12, 0),
+			want: []models.WorkViolation{{
+				Type:          models.WorkViolationDailyDriving,
+				PeriodStart:   onMarch(time.UTC, 2, 0, 0),
+				PeriodEnd:     onMarch(time.UTC, 3, 0, 0),
+				LimitMinutes:  540,
+				ActualMinutes: 720,
+			}},
+		},
+		{
+			name: "short daily rest",
+			sessions: []models.WorkSession{
+				workSession(onMarch(moscow, 2, 6, 0), onMarch(moscow, 2, 10, 0)),
+				workSession(onMarch(moscow, 2, 11, 0), onMarch(moscow, 2, 14, 0)),
+				workSession(onMarch(moscow, 2, 20, 0), onMarch(moscow, 2, 22, 0)),
+				workSession(onMarch(moscow, 3, 5, 0), onMarch(moscow, 3, 7, 0)),
+			},
+			rules: testWorkRules,
+			loc:   moscow,
+			since: onMarch(moscow, 2, 0, 0),
+			now:   onMarch(moscow, 3, 12, 0),
+			want: []models.WorkViolation{{
+				Type:          models.WorkViolationDailyRest,
+				PeriodStart:   onMarch(moscow, 2, 0, 0),
+				PeriodEnd:     onMarch(moscow, 3, 0, 0),
+				LimitMinutes:  660,
+				ActualMinutes: 420,
+			}},
+		},
+		{
+			name:     "open session lasts until now",
+			sessions: []models.WorkSession{{StartedAt: onMarch(moscow, 2, 6, 0)}},
+			rules:    testWorkRules,
+			loc:      moscow,
+			since:    onMarch(moscow, 2, 0, 0),
+			now:      onMarch(moscow, 2, 12, 0),
+			want: []models.WorkViolation{{
+				Type:          models.WorkViolationContinuousDriving,
+				PeriodStart:   onMarch(moscow, 2, 6, 0),
+				PeriodEnd:     onMarch(moscow, 2, 12, 0),
+				LimitMinutes:  270,
+				ActualMinutes: 360,
+			}},
+		},
+		{
+			name: "weeks start on Monday",
+			sessions: []models.WorkSession{
+				workSession(onMarch(moscow, 1, 8, 0), onMarch(moscow, 1, 12, 0)),
+				workSession(onMarch(moscow, 1, 13, 0), onMarch(moscow, 1, 17, 0)),
+				workSession(onMarch(moscow, 2, 8, 0), onMarch(moscow, 2, 12, 0)),
+				workSession(onMarch(moscow, 2, 13, 0), onMarch(moscow, 2, 17, 0)),
+				workSession(onMarch(moscow, 3, 8, 0), onMarch(moscow, 3, 12, 0)),
+			},
+			rules: weeklyRules,
+			loc:   moscow,
+			since: time.Date(2026, 2, 23, 0, 0, 0, 0, moscow),
+			now:   onMarch(moscow, 4, 12, 0),
+			want: []models.WorkViolation{{
+				Type:          models.WorkViolationWeeklyDriving,
+				PeriodStart:   onMarch(moscow, 2, 0, 0),
+				PeriodEnd:     onMarch(moscow, 9, 0, 0),
+				LimitMinutes:  600,
+				ActualMinutes: 720,
+			}},
+		},
+		{
+			name: "periods started before since are not checked",
+			sessions: []models.WorkSession{
+				workSession(onMarch(moscow, 1, 20, 0), onMarch(moscow, 2, 2, 0)),
+			},
+			rules: testWorkRules,
+			loc:   moscow,
+			since: onMarch(moscow, 2, 0, 0),
+			now:   onMarch(moscow, 3, 12, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			intervals := workIntervals(tt.sessions, tt.now)
+			got := detectWorkViolations(intervals, tt.rules, tt.loc, tt.since, tt.now)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
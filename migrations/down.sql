@@ -1,3 +1,68 @@
+DROP TABLE IF EXISTS geofences;
+ALTER TABLE user_totp DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE user_totp DROP COLUMN IF EXISTS failed_attempts;
+DROP INDEX IF EXISTS notifications_open_threshold_idx;
+DROP INDEX IF EXISTS notifications_user_type_idx;
+ALTER TABLE notifications DROP COLUMN IF EXISTS payload;
+ALTER TABLE notifications DROP COLUMN IF EXISTS id_car;
+ALTER TABLE notifications DROP COLUMN IF EXISTS severity;
+ALTER TABLE notifications DROP COLUMN IF EXISTS type;
+DROP TABLE IF EXISTS idempotency_keys;
+DROP TABLE IF EXISTS webhook_attempts;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
+DROP TABLE IF EXISTS notification_deliveries;
+DROP INDEX IF EXISTS notifications_unrouted_idx;
+ALTER TABLE notifications DROP COLUMN IF EXISTS routed_at;
+DROP TABLE IF EXISTS notification_rules;
+ALTER TABLE notifications DROP COLUMN IF EXISTS id_maintenance_task;
+DROP TABLE IF EXISTS work_orders;
+DROP TABLE IF EXISTS maintenance_tasks;
+DROP TABLE IF EXISTS maintenance_plans;
+ALTER TABLE breakages DROP COLUMN IF EXISTS id_type;
+DROP TABLE IF EXISTS breakage_types;
+DROP TABLE IF EXISTS breakage_status_history;
+DROP INDEX IF EXISTS breakages_car_status_idx;
+ALTER TABLE breakages DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE breakages DROP COLUMN IF EXISTS resolved_at;
+ALTER TABLE breakages DROP COLUMN IF EXISTS cost;
+ALTER TABLE breakages DROP COLUMN IF EXISTS repair_notes;
+ALTER TABLE breakages DROP COLUMN IF EXISTS assignee;
+ALTER TABLE breakages DROP COLUMN IF EXISTS status;
+ALTER TABLE notifications DROP COLUMN IF EXISTS id_expiry_alert;
+DROP TABLE IF EXISTS driver_expiry_alerts;
+DROP TABLE IF EXISTS driver_documents;
+ALTER TABLE drivers DROP COLUMN IF EXISTS deactivated_at;
+ALTER TABLE drivers DROP COLUMN IF EXISTS medical_expires_at;
+ALTER TABLE drivers DROP COLUMN IF EXISTS licence_expires_at;
+ALTER TABLE drivers DROP COLUMN IF EXISTS licence_categories;
+ALTER TABLE drivers DROP COLUMN IF EXISTS licence_number;
+ALTER TABLE notifications DROP COLUMN IF EXISTS id_work_violation;
+DROP TABLE IF EXISTS work_violations;
+DROP TABLE IF EXISTS work_sessions;
+DROP INDEX IF EXISTS sensors_data_device_sensor_created_idx;
+DROP INDEX IF EXISTS position_data_device_created_idx;
+DROP TABLE IF EXISTS driver_ratings;
+ALTER TABLE drivers ADD COLUMN IF NOT EXISTS id_car uuid REFERENCES cars;
+UPDATE drivers d SET id_car = a.id_car
+FROM driver_assignments a
+WHERE a.id_driver = d.id AND a.ended_at IS NULL;
+DROP TABLE IF EXISTS driver_assignments;
+DROP INDEX IF EXISTS breakages_description_fts_idx;
+DROP INDEX IF EXISTS wheels_model_trgm_idx;
+DROP INDEX IF EXISTS wheels_brand_trgm_idx;
+DROP INDEX IF EXISTS drivers_phone_trgm_idx;
+DROP INDEX IF EXISTS cars_device_number_trgm_idx;
+DROP INDEX IF EXISTS cars_brand_trgm_idx;
+DROP INDEX IF EXISTS cars_state_number_trgm_idx;
+DROP INDEX IF EXISTS notifications_user_created_idx;
+DROP INDEX IF EXISTS breakages_car_created_idx;
+DROP INDEX IF EXISTS cars_company_state_number_idx;
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only;
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
+ALTER TABLE users DROP COLUMN IF EXISTS require_2fa;
 DROP TABLE IF EXISTS cars_positions;
 -- DROP TABLE IF EXISTS notifications;
 -- DROP TABLE IF EXISTS breakages;
@@ -72,7 +72,6 @@ CREATE TABLE IF NOT EXISTS position_data (
 CREATE TABLE IF NOT EXISTS drivers (
 	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
 	id_company uuid REFERENCES users,
-	id_car uuid REFERENCES cars,
 	name varchar(100),
 	surname varchar(100),
 	middle_name varchar(100),
@@ -111,4 +110,475 @@ create table if not exists refresh_store (
 	expiration TIMESTAMP NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-)
\ No newline at end of file
+);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS require_2fa boolean DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS user_totp (
+	user_id uuid PRIMARY KEY REFERENCES users,
+	secret varchar(100) NOT NULL,
+	enabled boolean DEFAULT false,
+	last_used_step bigint DEFAULT 0,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	confirmed_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	user_id uuid NOT NULL REFERENCES users,
+	code_hash varchar(100) NOT NULL,
+	used_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid REFERENCES users,
+	actor_id uuid REFERENCES users,
+	action varchar(100) NOT NULL,
+	resource_type varchar(100) NOT NULL,
+	resource_id varchar(100),
+	changes jsonb,
+	metadata jsonb,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_company_created_idx ON audit_log (id_company, created_at DESC);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only
+	BEFORE UPDATE OR DELETE ON audit_log
+	FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+-- Keyset pagination of the list endpoints
+CREATE INDEX IF NOT EXISTS cars_company_state_number_idx ON cars (id_company, state_number, id);
+CREATE INDEX IF NOT EXISTS breakages_car_created_idx ON breakages (id_car, created_at DESC);
+CREATE INDEX IF NOT EXISTS notifications_user_created_idx ON notifications (id_user, created_at DESC);
+
+-- Search
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS cars_state_number_trgm_idx ON cars USING gin (lower(state_number) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS cars_brand_trgm_idx ON cars USING gin (lower(brand) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS cars_device_number_trgm_idx ON cars USING gin (lower(device_number) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS drivers_phone_trgm_idx ON drivers USING gin (lower(phone) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS wheels_brand_trgm_idx ON wheels USING gin (lower(brand) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS wheels_model_trgm_idx ON wheels USING gin (lower(model) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS breakages_description_fts_idx ON breakages USING gin (to_tsvector('simple', COALESCE(description, '')));
+
+-- Driver assignments: which driver drives which car when. A driver and a car
+-- have at most one open assignment each.
+CREATE TABLE IF NOT EXISTS driver_assignments (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_driver uuid NOT NULL REFERENCES drivers,
+	id_car uuid NOT NULL REFERENCES cars,
+	started_at TIMESTAMP NOT NULL,
+	ended_at TIMESTAMP,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	CHECK (ended_at IS NULL OR ended_at >= started_at)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS driver_assignments_open_driver_idx ON driver_assignments (id_driver) WHERE ended_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS driver_assignments_open_car_idx ON driver_assignments (id_car) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS driver_assignments_car_started_idx ON driver_assignments (id_car, started_at DESC);
+CREATE INDEX IF NOT EXISTS driver_assignments_driver_started_idx ON driver_assignments (id_driver, started_at DESC);
+
+-- Drivers used to be tied to a single car by drivers.id_car. Each of them is
+-- assigned to it from their creation until the next driver of the car was
+-- created, the last one being still assigned.
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'drivers' AND column_name = 'id_car') THEN
+		INSERT INTO driver_assignments (id_company, id_driver, id_car, started_at, ended_at)
+		SELECT id_company, id, id_car, COALESCE(created_at, now()),
+			LEAD(COALESCE(created_at, now())) OVER (PARTITION BY id_car ORDER BY created_at, id)
+		FROM drivers
+		WHERE id_car IS NOT NULL AND id_company IS NOT NULL;
+
+		ALTER TABLE drivers DROP COLUMN id_car;
+	END IF;
+END $$;
+
+-- Driver ratings: the latest rating of each driver with its breakdown and the
+-- behaviour it was calculated from.
+CREATE TABLE IF NOT EXISTS driver_ratings (
+	id_driver uuid PRIMARY KEY REFERENCES drivers,
+	id_company uuid NOT NULL REFERENCES users,
+	rating float NOT NULL,
+	safety float NOT NULL,
+	smoothness float NOT NULL,
+	speed_discipline float NOT NULL,
+	tire_care float NOT NULL,
+	experience float NOT NULL,
+	breakages int NOT NULL,
+	harsh_events int NOT NULL,
+	driven_seconds float NOT NULL,
+	speeding_seconds float NOT NULL,
+	distance_km float NOT NULL,
+	under_inflated_seconds float NOT NULL,
+	worked_time int NOT NULL,
+	window_start TIMESTAMP NOT NULL,
+	calculated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS position_data_device_created_idx ON position_data (device_number, created_at);
+CREATE INDEX IF NOT EXISTS sensors_data_device_sensor_created_idx ON sensors_data (device_number, sensor_number, created_at);
+
+-- Work sessions: the periods drivers worked, reported by the device of their
+-- car or entered manually. Ending a session adds its length to worked_time of
+-- the driver.
+CREATE TABLE IF NOT EXISTS work_sessions (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_driver uuid NOT NULL REFERENCES drivers,
+	id_car uuid REFERENCES cars,
+	source varchar(100) NOT NULL,
+	started_at TIMESTAMP NOT NULL,
+	ended_at TIMESTAMP,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	CHECK (ended_at IS NULL OR ended_at >= started_at)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS work_sessions_open_driver_idx ON work_sessions (id_driver) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS work_sessions_driver_started_idx ON work_sessions (id_driver, started_at DESC);
+CREATE INDEX IF NOT EXISTS work_sessions_company_started_idx ON work_sessions (id_company, started_at DESC);
+
+-- Work violations: breaches of the rest-time rules found in the work
+-- sessions, one per driver, rule and period.
+CREATE TABLE IF NOT EXISTS work_violations (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_driver uuid NOT NULL REFERENCES drivers,
+	type varchar(100) NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	period_end TIMESTAMP NOT NULL,
+	limit_minutes int NOT NULL,
+	actual_minutes int NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (id_driver, type, period_start)
+);
+
+CREATE INDEX IF NOT EXISTS work_violations_company_period_idx ON work_violations (id_company, period_start DESC);
+
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS id_work_violation uuid REFERENCES work_violations;
+
+-- Driver documents: licence and medical certificate details of drivers, the
+-- files attached to them, and the expiry alerts raised before a licence or
+-- certificate expires, one per driver, type and expiry date.
+ALTER TABLE drivers ADD COLUMN IF NOT EXISTS licence_number varchar(100);
+ALTER TABLE drivers ADD COLUMN IF NOT EXISTS licence_categories varchar(10)[];
+ALTER TABLE drivers ADD COLUMN IF NOT EXISTS licence_expires_at date;
+ALTER TABLE drivers ADD COLUMN IF NOT EXISTS medical_expires_at date;
+ALTER TABLE drivers ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS driver_documents (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_driver uuid NOT NULL REFERENCES drivers,
+	type varchar(100) NOT NULL,
+	file_name varchar(255) NOT NULL,
+	content_type varchar(255) NOT NULL,
+	size int NOT NULL,
+	content bytea NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS driver_documents_driver_created_idx ON driver_documents (id_driver, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS driver_expiry_alerts (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_driver uuid NOT NULL REFERENCES drivers,
+	type varchar(100) NOT NULL,
+	expires_at date NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (id_driver, type, expires_at)
+);
+
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS id_expiry_alert uuid REFERENCES driver_expiry_alerts;
+
+-- Breakage workflow: a breakage moves from reported through acknowledged and
+-- in repair to resolved and closed, with the details of its repair. Every
+-- status change is kept in breakage_status_history.
+ALTER TABLE breakages ADD COLUMN IF NOT EXISTS status varchar(20) NOT NULL DEFAULT 'reported';
+ALTER TABLE breakages ADD COLUMN IF NOT EXISTS assignee varchar(100);
+ALTER TABLE breakages ADD COLUMN IF NOT EXISTS repair_notes varchar(1000);
+ALTER TABLE breakages ADD COLUMN IF NOT EXISTS cost float;
+ALTER TABLE breakages ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMP;
+ALTER TABLE breakages ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS breakages_car_status_idx ON breakages (id_car, status);
+
+CREATE TABLE IF NOT EXISTS breakage_status_history (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_breakage uuid NOT NULL REFERENCES breakages,
+	from_status varchar(20) NOT NULL,
+	to_status varchar(20) NOT NULL,
+	note varchar(1000),
+	changed_by uuid NOT NULL REFERENCES users,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS breakage_status_history_breakage_created_idx ON breakage_status_history (id_breakage, created_at);
+
+-- Breakage catalogue: the breakage types of each company, with the
+-- device-reported codes that map onto them. Breakages are classified when
+-- they are registered.
+CREATE TABLE IF NOT EXISTS breakage_types (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	code varchar(100) NOT NULL,
+	name varchar(100) NOT NULL,
+	severity varchar(20) NOT NULL,
+	must_stop boolean NOT NULL DEFAULT false,
+	component varchar(20),
+	wheel_position int,
+	device_codes varchar(100)[] NOT NULL DEFAULT '{}',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP,
+	UNIQUE (id_company, code)
+);
+
+CREATE INDEX IF NOT EXISTS breakage_types_device_codes_idx ON breakage_types USING gin (device_codes);
+
+ALTER TABLE breakages ADD COLUMN IF NOT EXISTS id_type uuid REFERENCES breakage_types ON DELETE SET NULL;
+
+-- Maintenance: plans make maintenance of cars and wheels due by mileage, by
+-- calendar interval or by sensor readings. Due tasks are done through work
+-- orders, which can also be opened from a breakage.
+CREATE TABLE IF NOT EXISTS maintenance_plans (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_car uuid REFERENCES cars,
+	name varchar(100) NOT NULL,
+	kind varchar(20) NOT NULL,
+	trigger varchar(20) NOT NULL,
+	mileage_interval float,
+	interval_days int,
+	condition varchar(20),
+	active boolean NOT NULL DEFAULT true,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS maintenance_plans_company_idx ON maintenance_plans (id_company);
+
+CREATE TABLE IF NOT EXISTS maintenance_tasks (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_plan uuid NOT NULL REFERENCES maintenance_plans,
+	id_car uuid NOT NULL REFERENCES cars,
+	id_wheel uuid REFERENCES wheels,
+	reason varchar(255) NOT NULL,
+	due_at TIMESTAMP,
+	due_mileage float,
+	status varchar(20) NOT NULL DEFAULT 'due',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	completed_at TIMESTAMP,
+	completed_mileage float
+);
+
+-- A plan has at most one open task for a car or wheel.
+CREATE UNIQUE INDEX IF NOT EXISTS maintenance_tasks_open_idx ON maintenance_tasks (id_plan, id_car, COALESCE(id_wheel, id_car))
+	WHERE status IN ('due', 'scheduled');
+CREATE INDEX IF NOT EXISTS maintenance_tasks_plan_completed_idx ON maintenance_tasks (id_plan, completed_at) WHERE status = 'done';
+
+CREATE TABLE IF NOT EXISTS work_orders (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	id_car uuid NOT NULL REFERENCES cars,
+	id_wheel uuid REFERENCES wheels,
+	id_breakage uuid REFERENCES breakages,
+	id_task uuid REFERENCES maintenance_tasks,
+	title varchar(255) NOT NULL,
+	status varchar(20) NOT NULL DEFAULT 'open',
+	assignee varchar(100),
+	notes varchar(1000),
+	cost float,
+	created_by uuid NOT NULL REFERENCES users,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP,
+	completed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS work_orders_company_created_idx ON work_orders (id_company, created_at DESC);
+CREATE INDEX IF NOT EXISTS work_orders_task_idx ON work_orders (id_task);
+
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS id_maintenance_task uuid REFERENCES maintenance_tasks;
+
+-- Notification routing: rules of a company route its notifications to
+-- delivery channels. Every channel a notification is routed to has a
+-- delivery, retried until it is sent or runs out of attempts. Notifications
+-- that existed before routing are considered routed.
+CREATE TABLE IF NOT EXISTS notification_rules (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	name varchar(100) NOT NULL,
+	event_types varchar(20)[] NOT NULL DEFAULT '{}',
+	min_severity varchar(20),
+	car_ids uuid[] NOT NULL DEFAULT '{}',
+	channel varchar(20) NOT NULL,
+	target varchar(255) NOT NULL,
+	quiet_from int,
+	quiet_to int,
+	active boolean NOT NULL DEFAULT true,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS notification_rules_company_idx ON notification_rules (id_company);
+
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS routed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE notifications ALTER COLUMN routed_at DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS notifications_unrouted_idx ON notifications (created_at) WHERE routed_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_notification uuid NOT NULL REFERENCES notifications ON DELETE CASCADE,
+	id_rule uuid REFERENCES notification_rules ON DELETE SET NULL,
+	channel varchar(20) NOT NULL,
+	target varchar(255) NOT NULL,
+	status varchar(20) NOT NULL DEFAULT 'pending',
+	attempts int NOT NULL DEFAULT 0,
+	next_attempt_at TIMESTAMP NOT NULL,
+	last_error varchar(500),
+	sent_at TIMESTAMP,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (id_notification, channel, target)
+);
+
+CREATE INDEX IF NOT EXISTS notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- Webhooks: fleet events are queued in the outbox of every endpoint
+-- subscribed to them and delivered signed with the secret of the endpoint.
+-- Deliveries that run out of attempts are dead until replayed. Every attempt
+-- is logged in webhook_attempts.
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	url varchar(2048) NOT NULL,
+	description varchar(255),
+	event_types varchar(50)[] NOT NULL DEFAULT '{}',
+	secret varchar(100) NOT NULL,
+	active boolean NOT NULL DEFAULT true,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS webhook_endpoints_company_idx ON webhook_endpoints (id_company);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_endpoint uuid NOT NULL REFERENCES webhook_endpoints ON DELETE CASCADE,
+	id_company uuid NOT NULL REFERENCES users,
+	id_event uuid NOT NULL,
+	event_type varchar(50) NOT NULL,
+	payload jsonb NOT NULL,
+	status varchar(20) NOT NULL DEFAULT 'pending',
+	attempts int NOT NULL DEFAULT 0,
+	next_attempt_at TIMESTAMP NOT NULL,
+	last_error varchar(500),
+	last_status_code int,
+	delivered_at TIMESTAMP,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (id_endpoint, id_event)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_company_created_idx ON webhook_deliveries (id_company, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS webhook_attempts (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_delivery uuid NOT NULL REFERENCES webhook_deliveries ON DELETE CASCADE,
+	attempted_at TIMESTAMP NOT NULL,
+	status_code int,
+	error varchar(500),
+	duration_ms int NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_attempts_delivery_idx ON webhook_attempts (id_delivery, attempted_at);
+
+-- Idempotency keys: a request to an ingestion endpoint sent with an
+-- Idempotency-Key header is recorded with its response, which retries with
+-- the same key get back until the key expires. status_code is null while the
+-- request is in progress.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+	id_company uuid NOT NULL REFERENCES users,
+	key varchar(255) NOT NULL,
+	route varchar(255) NOT NULL,
+	request_hash varchar(64) NOT NULL,
+	status_code int,
+	content_type varchar(100),
+	response bytea,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (id_company, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_idx ON idempotency_keys (expires_at);
+
+-- Notification types: every notification has a type, a severity and the car
+-- it is about, if any. Notifications of breakages, work violations, expiry
+-- alerts and maintenance tasks refer to the record they are about; those of
+-- sensor readings out of bounds, silent devices and geofences carry their
+-- details in payload. Breakages of a type of the catalogue have its severity.
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS type varchar(30);
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS severity varchar(20);
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS id_car uuid REFERENCES cars;
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS payload jsonb;
+
+UPDATE notifications n SET
+	type = CASE
+		WHEN n.id_breakages IS NOT NULL THEN 'breakage'
+		WHEN n.id_work_violation IS NOT NULL THEN 'work_violation'
+		WHEN n.id_expiry_alert IS NOT NULL THEN 'document_expiry'
+		WHEN n.id_maintenance_task IS NOT NULL THEN 'maintenance_due'
+		ELSE 'breakage'
+	END,
+	severity = COALESCE(
+		(SELECT bt.severity FROM breakages b JOIN breakage_types bt ON bt.id = b.id_type WHERE b.id = n.id_breakages),
+		CASE
+			WHEN n.id_expiry_alert IS NOT NULL OR n.id_maintenance_task IS NOT NULL THEN 'low'
+			ELSE 'medium'
+		END),
+	id_car = COALESCE(
+		(SELECT b.id_car FROM breakages b WHERE b.id = n.id_breakages),
+		(SELECT mt.id_car FROM maintenance_tasks mt WHERE mt.id = n.id_maintenance_task))
+WHERE n.type IS NULL;
+
+ALTER TABLE notifications ALTER COLUMN type SET NOT NULL;
+ALTER TABLE notifications ALTER COLUMN severity SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS notifications_user_type_idx ON notifications (id_user, type, created_at DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS notifications_open_threshold_idx
+	ON notifications (id_user, (payload->>'wheel_id'), (payload->>'metric'))
+	WHERE type = 'sensor_threshold' AND status = 'new';
+
+-- Failed second factor attempts of a user in a row. Once too many fail, the
+-- second factor is locked until locked_until, longer than a login challenge
+-- lives.
+ALTER TABLE user_totp ADD COLUMN IF NOT EXISTS failed_attempts int DEFAULT 0;
+ALTER TABLE user_totp ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+
+-- Geofences: circular areas of a company, radius meters around a point. A
+-- car crossing the boundary of an active geofence raises a notification.
+CREATE TABLE IF NOT EXISTS geofences (
+	id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+	id_company uuid NOT NULL REFERENCES users,
+	name varchar(100) NOT NULL,
+	latitude DOUBLE PRECISION NOT NULL,
+	longitude DOUBLE PRECISION NOT NULL,
+	radius DOUBLE PRECISION NOT NULL,
+	active boolean NOT NULL DEFAULT true,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS geofences_company_idx ON geofences (id_company);